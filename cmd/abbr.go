@@ -0,0 +1,88 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+)
+
+var (
+	abbrCmd = &cobra.Command{
+		Use:   "abbr",
+		Short: "Work with book names and abbreviations",
+		Args:  cobra.NoArgs,
+	}
+
+	abbrAuditCmd = &cobra.Command{
+		Use:   "audit",
+		Short: "Report ambiguous and unreachable book abbreviations",
+		Long: `Report ambiguous and unreachable book abbreviations.
+
+Lists every book name or abbreviation that does not resolve to its own book,
+followed by every input that matches more than one book and every book that is
+not in any canon, such as a misspelled book name. Inputs are listed in the form
+used for matching, in lowercase without spaces or punctuation. Use
+--abbreviations-file to audit your own abbreviations merged with the English
+abbreviations.`,
+		Args: cobra.NoArgs,
+		RunE: RunAbbrAudit,
+	}
+
+	abbrLocale string
+)
+
+func init() {
+	abbrCmd.AddCommand(abbrAuditCmd)
+
+	abbrAuditCmd.Flags().StringVar(&abbrLocale, "locale", "en", "Locale of the book names and abbreviations to audit")
+}
+
+func RunAbbrAudit(cmd *cobra.Command, args []string) error {
+	l, err := selectedLocale(abbrLocale)
+	if err != nil {
+		return err
+	}
+
+	audit := l.Abbreviations.Audit()
+
+	w := tabwriter.NewWriter(cmd.OutOrStdout(), 0, 0, 2, ' ', 0)
+
+	if len(audit.Unreachable) == 0 {
+		fmt.Fprintln(w, "No unreachable abbreviations.")
+	} else {
+		fmt.Fprintln(w, "Unreachable abbreviations:")
+		for _, u := range audit.Unreachable {
+			matches := "no book"
+			if len(u.Matches) > 0 {
+				matches = strings.Join(u.Matches, ", ")
+			}
+			fmt.Fprintf(w, "  %s\t%s\tmatches %s\n", u.Abbreviation, u.Book, matches)
+		}
+	}
+
+	fmt.Fprintln(w)
+
+	if len(audit.Ambiguous) == 0 {
+		fmt.Fprintln(w, "No ambiguous inputs.")
+	} else {
+		fmt.Fprintln(w, "Ambiguous inputs:")
+		for _, a := range audit.Ambiguous {
+			fmt.Fprintf(w, "  %s\t%s\n", a.Input, strings.Join(a.Matches, ", "))
+		}
+	}
+
+	fmt.Fprintln(w)
+
+	if len(audit.Unknown) == 0 {
+		fmt.Fprintln(w, "No unknown books.")
+	} else {
+		fmt.Fprintln(w, "Books not in any canon:")
+		for _, name := range audit.Unknown {
+			fmt.Fprintf(w, "  %s\n", name)
+		}
+	}
+
+	return w.Flush()
+}
@@ -1,9 +1,15 @@
 package cmd
 
 import (
+	"encoding/json"
 	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"text/tabwriter"
 
 	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
 
 	"github.com/zostay/today/pkg/ref"
 )
@@ -11,12 +17,87 @@ import (
 var listBooksCmd = &cobra.Command{
 	Use:   "books",
 	Short: "List the available books",
-	Args:  cobra.NoArgs,
-	Run:   RunListBooks,
+	Long: `List the available books.
+
+Use --long to list the position, testament, chapter and verse counts,
+categories, and alternate names of each book. Use --output to select the
+format of the long listing (table, json, or yaml), which implies --long.`,
+	Args: cobra.NoArgs,
+	RunE: RunListBooks,
+}
+
+var (
+	listBooksUSFM   bool
+	listBooksLong   bool
+	listBooksOutput string
+)
+
+func init() {
+	listBooksCmd.Flags().BoolVar(&listBooksUSFM, "usfm", false, "List the USFM code of each book")
+	listBooksCmd.Flags().BoolVarP(&listBooksLong, "long", "l", false, "List the details of each book")
+	listBooksCmd.Flags().StringVarP(&listBooksOutput, "output", "o", "table", "Output format of the long listing (table|json|yaml)")
 }
 
-func RunListBooks(cmd *cobra.Command, args []string) {
-	for _, b := range ref.Canonical.Books {
+func RunListBooks(cmd *cobra.Command, args []string) error {
+	canon, err := selectedCanon()
+	if err != nil {
+		return err
+	}
+
+	if listBooksLong || cmd.Flags().Changed("output") {
+		return listBookInfos(canon)
+	}
+
+	for _, b := range canon.Books {
+		if listBooksUSFM {
+			fmt.Printf("%s %s\n", b.USFM, b.Name)
+			continue
+		}
 		fmt.Println(b.Name)
 	}
+
+	return nil
+}
+
+// listBookInfos prints the details of each book of the canon in the format
+// selected by --output.
+func listBookInfos(canon *ref.Canon) error {
+	infos, err := canon.BookInfos()
+	if err != nil {
+		return err
+	}
+
+	switch listBooksOutput {
+	case "json":
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		return enc.Encode(infos)
+	case "yaml":
+		enc := yaml.NewEncoder(os.Stdout)
+		enc.SetIndent(2)
+		if err := enc.Encode(infos); err != nil {
+			return err
+		}
+		return enc.Close()
+	case "table":
+	default:
+		return fmt.Errorf("invalid output format: %q (expected table, json, or yaml)", listBooksOutput)
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "#\tBOOK\tUSFM\tTESTAMENT\tCHAPTERS\tVERSES\tCATEGORIES\tALTERNATE NAMES")
+	for _, info := range infos {
+		fmt.Fprintln(w, strings.Join([]string{
+			strconv.Itoa(info.Position),
+			info.Name,
+			info.USFM,
+			string(info.Testament),
+			strconv.Itoa(info.ChapterCount),
+			strconv.Itoa(info.VerseCount),
+			strings.Join(info.Categories, ", "),
+			strings.Join(info.AlternateNames, ", "),
+		}, "\t"))
+	}
+
+	return w.Flush()
 }
@@ -5,7 +5,6 @@ import (
 	"sort"
 
 	"github.com/spf13/cobra"
-	"github.com/zostay/go-std/maps"
 
 	"github.com/zostay/today/pkg/ref"
 )
@@ -15,7 +14,7 @@ var (
 		Use:   "categories",
 		Short: "List the available categories",
 		Args:  cobra.NoArgs,
-		Run:   RunListCategories,
+		RunE:  RunListCategories,
 	}
 
 	listPericopes bool
@@ -25,25 +24,51 @@ func init() {
 	listCategoriesCmd.Flags().BoolVarP(&listPericopes, "pericopes", "p", false, "List the pericopes in each category")
 }
 
-func RunListCategories(cmd *cobra.Command, args []string) {
-	cats := maps.Keys(ref.Canonical.Categories)
-	sort.Strings(cats)
+func RunListCategories(cmd *cobra.Command, args []string) error {
+	canon, err := selectedCanon()
+	if err != nil {
+		return err
+	}
+
+	for _, c := range canon.TopCategories() {
+		if err := listCategory(canon, c, ""); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// listCategory prints the named category at the given indent, followed by its
+// pericopes (when requested) and its subcategories, indented one more level.
+func listCategory(canon *ref.Canon, name, indent string) error {
+	fmt.Printf("%s%s\n", indent, name)
 
-	for _, c := range cats {
-		fmt.Println(c)
-		if listPericopes {
-			ps, err := ref.Canonical.Category(c)
+	if listPericopes {
+		refs := canon.Categories[name]
+		ps := make([]*ref.Pericope, 0, len(refs))
+		for _, r := range refs {
+			p, err := ref.Lookup(canon, r, "")
 			if err != nil {
-				panic(err)
+				return fmt.Errorf("failed to lookup category %q: %w", name, err)
 			}
+			ps = append(ps, p)
+		}
 
-			sort.Slice(ps, func(i, j int) bool {
-				return ps[i].Ref.Ref() < ps[j].Ref.Ref()
-			})
+		sort.Slice(ps, func(i, j int) bool {
+			return ps[i].Ref.Ref() < ps[j].Ref.Ref()
+		})
 
-			for _, p := range ps {
-				fmt.Printf("  %s\n", p.Ref.Ref())
-			}
+		for _, p := range ps {
+			fmt.Printf("%s  %s\n", indent, p.Ref.Ref())
+		}
+	}
+
+	for _, sub := range canon.Subcategories[name] {
+		if err := listCategory(canon, sub, indent+"  "); err != nil {
+			return err
 		}
 	}
+
+	return nil
 }
@@ -64,7 +64,12 @@ func RunTodayRandom(cmd *cobra.Command, args []string) error {
 		return errors.New("cannot specify both --category and --book")
 	}
 
-	var opts []ref.RandomReferenceOption
+	canon, err := selectedCanon()
+	if err != nil {
+		return err
+	}
+
+	opts := []ref.RandomReferenceOption{ref.FromCanon(canon)}
 	if fromCategory != "" {
 		opts = append(opts, ref.FromCategory(fromCategory))
 	}
@@ -109,7 +114,7 @@ func RunTodayRandom(cmd *cobra.Command, args []string) error {
 	if err != nil {
 		panic(err)
 	}
-	svc := text.NewService(ec)
+	svc := text.NewService(ec, text.WithCanon(canon))
 
 	var (
 		v  string
@@ -2,11 +2,16 @@ package cmd
 
 import (
 	"bufio"
+	"errors"
 	"fmt"
+	"net/http"
+	"os"
 	"strings"
+	"unicode"
 
 	"github.com/spf13/cobra"
 
+	"github.com/zostay/today/pkg/ost"
 	"github.com/zostay/today/pkg/ref"
 	"github.com/zostay/today/pkg/text/esv"
 )
@@ -25,25 +30,138 @@ Available styles:
   2letter   - First 2-letter abbreviation (e.g., "Jn 3:16")
   3letter   - First 3-letter abbreviation (e.g., "Jhn 3:16")
   2letter.  - First 2-letter abbreviation with period (e.g., "Jn. 3:16")
-  3letter.  - First 3-letter abbreviation with period (e.g., "Jhn. 3:16")`,
+  3letter.  - First 3-letter abbreviation with period (e.g., "Jhn. 3:16")
+  osis      - OSIS reference (e.g., "John.3.16")
+  usfm      - USFM book code (e.g., "JHN 3:16")
+  sbl       - SBL Handbook of Style (e.g., "1 Cor 13:1–3")
+  chicago   - Chicago Manual of Style (e.g., "1 Cor. 13:1–3")
+  mla       - MLA Handbook (e.g., "1 Cor. 13.1-3")
+  apa       - APA Publication Manual (e.g., "1 Corinthians 13:1–3")
+  md-link   - Markdown link (e.g., "[John 3:16](https://www.esv.org/John%203:16)")
+  html-link - HTML link (e.g., "<a href="https://www.esv.org/John%203:16">John 3:16</a>")
+
+The style may also be a Go text/template that writes each reference, given
+after "template:" (e.g., --style 'template:{{.Abbr}} {{.Chapter}}.{{.Verse}}').
+The template may use the fields .Name, .Abbr, .TwoLetter, .ThreeLetter, .USFM,
+.JustVerse, .Chapter, .Verse, .Part, .EndName, .EndAbbr, .EndChapter,
+.EndVerse, .EndPart, .IsRange, .WholeChapter, .WholeBook, and .Ref, and
+{{.NLetter 4 true}} for other abbreviations. Use --templates to load named
+templates from a YAML file, which may then be used as styles:
+
+  templates:
+    journal: "{{.Abbr}} {{.Chapter}}.{{.Verse}}"
+
+References may also be given as OSIS references (e.g., "Gen.1.1-Gen.1.5").
+
+Use --union, --intersect, and --minus to combine all the references given into
+a single set of verses. The references given with --union are added first, then
+only the verses also found in the --intersect references are kept, and finally
+the verses of the --minus references are removed. For example:
+
+  today ref "Matthew-John" --minus "Matthew 5-7" --minus "John 3"
+
+Use --locale to read and write references using the book names and notation
+of another language (e.g., --locale de for "Joh 3,16"). Use --input-locale to
+read references in a different language than they are written. The sbl,
+chicago, mla, and apa styles always write English book names and the
+punctuation of their style guide.
+
+Use --dash, --book-space, --and-following, and --elide-chapters to set the
+typography of the references written, except in the osis and usfm styles. For
+example, --dash en --and-following --elide-chapters writes "John 3:16-36,
+4:1-2" as "John 3:16ff.; 4:1f." and "John 3:16; 3:18-20" as "John 3:16,
+18–20".
+
+Use --group to format all the references given as a single citation. The
+references are merged where they overlap or adjoin and repeated book names and
+chapters are left out. Add --sort to sort the references into canon order first,
+so that every reference that overlaps or adjoins another is merged. For
+example:
+
+  today ref --group --sort "Romans 8:28" "John 4:1-3" "John 3:16" "John 4:4-6" "John 3:18"
+
+writes "John 3:16, 18; 4:1-6; Romans 8:28".
+
+Use --to-versification to renumber the references for a source that numbers
+chapters and verses differently (e.g., --to-versification mt writes Malachi 4
+as "Malachi 3:19-24"). Statistics are still reported in the numbering of the
+canon.
+
+Use --link-provider with the md-link and html-link styles to select the website
+linked to. The ost provider links each reference to the page of the day on which
+it was the scripture of the day on openscripture.today, which requires fetching
+the index of verses. Use --link-url to replace the URL of the provider with a Go
+text/template given the fields of a template style and .Whole, .OSIS, and .Date
+(e.g., --link-url 'https://example.com/{{pathEscape .Whole}}').
+
+Use --lenient to accept misspelled book names, such as "Revelations" or
+"Habbakuk", that closely resemble the name of one book. Otherwise, these are
+rejected with a list of the books that might have been meant.
+
+Use --titles to list the named pericopes that include each reference (e.g.,
+"For God So Loved the World" and "Jesus and Nicodemus" for John 3:16).
+
+Available locales: ` + strings.Join(ref.LocaleNames(), ", ") + `
+Available dashes: ` + strings.Join(ref.RangeDashNames(), ", ") + `
+Available book spaces: ` + strings.Join(ref.BookSpaceNames(), ", ") + `
+Available versifications: ` + strings.Join(ref.VersificationNames(), ", ") + `
+Available link providers: ` + strings.Join(ref.LinkProviderNames(), ", "),
 	Args: cobra.ArbitraryArgs,
 	RunE: RunRef,
 }
 
 var (
-	refStyle      string
-	refListStyles bool
-	refStat       string
+	refStyle        string
+	refListStyles   bool
+	refStat         string
+	refLocale       string
+	refInputLocale  string
+	refUnion        []string
+	refIntersect    []string
+	refMinus        []string
+	refToVersion    string
+	refTitles       bool
+	refLenient      bool
+	refTemplates    string
+	refDash         string
+	refBookSpace    string
+	refFollowing    bool
+	refElide        bool
+	refGroup        bool
+	refSort         bool
+	refLinkProvider string
+	refLinkURL      string
 )
 
 func init() {
 	refCmd.Flags().StringVarP(&refStyle, "style", "s", "canonical", "Output style for references")
 	refCmd.Flags().BoolVar(&refListStyles, "list-styles", false, "List available styles and exit")
+	refCmd.Flags().StringVar(&refTemplates, "templates", "", "YAML file of named templates to use as styles")
 	refCmd.Flags().StringVar(&refStat, "stat", "off", "Show statistics (off|ref|esv)")
 	refCmd.Flags().Lookup("stat").NoOptDefVal = "ref"
+	refCmd.Flags().StringVar(&refDash, "dash", "", "Dash to write in ranges (defaults to that of the locale or style)")
+	refCmd.Flags().StringVar(&refBookSpace, "book-space", "", "Space to write after book names (defaults to normal)")
+	refCmd.Flags().BoolVar(&refFollowing, "and-following", false, `Write ranges to the next verse as "f." and to the end of the chapter as "ff."`)
+	refCmd.Flags().BoolVar(&refElide, "elide-chapters", false, "Leave out repeated book names and chapters")
+	refCmd.Flags().BoolVar(&refGroup, "group", false, "Format all the references together, merging those that overlap or adjoin")
+	refCmd.Flags().BoolVar(&refSort, "sort", false, "Sort the references into canon order")
+	refCmd.Flags().StringVar(&refLinkProvider, "link-provider", ref.ESVLink.Name, "Website to link references to in the md-link and html-link styles")
+	refCmd.Flags().StringVar(&refLinkURL, "link-url", "", "URL template to link references to (replaces the URL of --link-provider)")
+	refCmd.Flags().StringVar(&refLocale, "locale", "en", "Locale to use for book names and notation")
+	refCmd.Flags().StringVar(&refInputLocale, "input-locale", "", "Locale of the input references (defaults to --locale)")
+	refCmd.Flags().StringArrayVar(&refUnion, "union", nil, "Add the verses of these references to the input")
+	refCmd.Flags().StringArrayVar(&refIntersect, "intersect", nil, "Keep only the verses of the input that are also in these references")
+	refCmd.Flags().StringArrayVar(&refMinus, "minus", nil, "Remove the verses of these references from the input")
+	refCmd.Flags().StringVar(&refToVersion, "to-versification", "", "Renumber the references using another versification")
+	refCmd.Flags().BoolVar(&refLenient, "lenient", false, "Accept misspelled book names that closely resemble one book")
+	refCmd.Flags().BoolVar(&refTitles, "titles", false, "Show the titles of the named pericopes that include each reference")
 }
 
 func RunRef(cmd *cobra.Command, args []string) error {
+	if err := loadRefTemplates(); err != nil {
+		return err
+	}
+
 	// Handle --list-styles
 	if refListStyles {
 		for _, style := range ref.GetAvailableStyles() {
@@ -52,8 +170,53 @@ func RunRef(cmd *cobra.Command, args []string) error {
 		return nil
 	}
 
+	// Get locales
+	outLocale, err := selectedLocale(refLocale)
+	if err != nil {
+		return fmt.Errorf("invalid locale: %w", err)
+	}
+
+	inLocale := outLocale
+	if refInputLocale != "" {
+		inLocale, err = selectedLocale(refInputLocale)
+		if err != nil {
+			return fmt.Errorf("invalid input locale: %w", err)
+		}
+	}
+
+	canon, err := selectedCanon()
+	if err != nil {
+		return err
+	}
+
+	inLocale = withCanonBooks(inLocale, canon)
+	outLocale = withCanonBooks(outLocale, canon)
+
+	if _, err := ref.GetVersification(refToVersion); err != nil {
+		return fmt.Errorf("invalid versification: %w", err)
+	}
+
+	typography, err := refTypography()
+	if err != nil {
+		return err
+	}
+
+	links, err := refLinks(cmd, canon)
+	if err != nil {
+		return err
+	}
+
+	formatOpts := append(outLocale.ResolveOptions(), typography...)
+	formatOpts = append(formatOpts, links...)
+	if refGroup {
+		formatOpts = append(formatOpts, ref.Grouped())
+	}
+	if refSort {
+		formatOpts = append(formatOpts, ref.SortedIn(canon))
+	}
+
 	// Get formatter
-	formatter, err := ref.GetFormatter(refStyle)
+	formatter, err := ref.GetFormatter(refStyle, formatOpts...)
 	if err != nil {
 		return fmt.Errorf("invalid style: %w", err)
 	}
@@ -75,10 +238,21 @@ func RunRef(cmd *cobra.Command, args []string) error {
 		}
 	}
 
+	// Combine the references into a single set of verses
+	if len(refUnion) > 0 || len(refIntersect) > 0 || len(refMinus) > 0 {
+		return runRefSet(cmd, formatter, canon, inLocale, references)
+	}
+
+	// Format all the references together
+	if refGroup || refSort {
+		return runRefGroup(cmd, formatter, canon, inLocale, references)
+	}
+
 	// Process each reference
 	for _, refStr := range references {
-		if err := processReference(cmd, formatter, refStr); err != nil {
+		if err := processReference(cmd, formatter, canon, inLocale, refStr); err != nil {
 			fmt.Fprintf(cmd.ErrOrStderr(), "Error processing %q: %v\n", refStr, err)
+			printParseErrorCaret(cmd, refStr, err)
 			continue
 		}
 	}
@@ -86,38 +260,219 @@ func RunRef(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
-func processReference(cmd *cobra.Command, formatter ref.RefFormatter, refStr string) error {
-	// Try parsing as Proper first, then Multiple
-	var parsed ref.Absolute
-	var err error
+// refTypography returns the options for the typography selected by the
+// --dash, --book-space, --and-following, and --elide-chapters flags.
+func refTypography() ([]ref.ResolveOption, error) {
+	var opts []ref.ResolveOption
+
+	if refDash != "" {
+		dash, err := ref.GetRangeDash(refDash)
+		if err != nil {
+			return nil, fmt.Errorf("invalid dash: %w", err)
+		}
+		opts = append(opts, ref.WithRangeDash(dash))
+	}
+
+	if refBookSpace != "" {
+		space, err := ref.GetBookSpace(refBookSpace)
+		if err != nil {
+			return nil, fmt.Errorf("invalid book space: %w", err)
+		}
+		opts = append(opts, ref.WithBookSpace(space))
+	}
+
+	if refFollowing {
+		opts = append(opts, ref.WithAndFollowing())
+	}
+
+	if refElide {
+		opts = append(opts, ref.WithElidedChapters())
+	}
+
+	return opts, nil
+}
+
+// refLinks returns the options for the website selected by the --link-provider
+// and --link-url flags. The index of verses is fetched from openscripture.today
+// to date the references of a dated provider.
+func refLinks(cmd *cobra.Command, canon *ref.Canon) ([]ref.ResolveOption, error) {
+	provider, err := ref.GetLinkProvider(refLinkProvider)
+	if err != nil {
+		return nil, fmt.Errorf("invalid link provider: %w", err)
+	}
+
+	if refLinkURL != "" {
+		custom := *provider
+		custom.URL = refLinkURL
+		provider = &custom
+	}
+
+	opts := []ref.ResolveOption{ref.WithLinkProvider(provider)}
+	if !provider.Dated || (refStyle != "md-link" && refStyle != "html-link") {
+		return opts, nil
+	}
+
+	client := &ost.Client{Client: http.DefaultClient, BaseURL: ost.DefaultBaseURL}
+	idx, err := client.VerseIndex(cmd.Context())
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch the index of verses: %w", err)
+	}
+
+	return append(opts, ref.WithLinkDate(idx.LinkDate(canon))), nil
+}
+
+// loadRefTemplates registers the named templates of the --templates file as
+// styles.
+func loadRefTemplates() error {
+	if refTemplates == "" {
+		return nil
+	}
 
-	parsed, err = ref.ParseProper(refStr)
+	f, err := os.Open(refTemplates)
 	if err != nil {
-		parsed, err = ref.ParseMultiple(refStr)
+		return err
+	}
+	defer f.Close()
+
+	if _, err := ref.LoadTemplates(f); err != nil {
+		return fmt.Errorf("unable to load templates file %q: %w", refTemplates, err)
+	}
+
+	return nil
+}
+
+func processReference(
+	cmd *cobra.Command,
+	formatter ref.RefFormatter,
+	canon *ref.Canon,
+	locale *ref.Locale,
+	refStr string,
+) error {
+	resolved, err := resolveReference(canon, locale, refStr)
+	if err != nil {
+		return err
+	}
+
+	return outputResolved(cmd, formatter, canon, resolved)
+}
+
+// runRefGroup resolves all the input references and outputs them together, so
+// that the formatter may sort and merge them. References that cannot be
+// resolved are reported and left out.
+func runRefGroup(
+	cmd *cobra.Command,
+	formatter ref.RefFormatter,
+	canon *ref.Canon,
+	locale *ref.Locale,
+	references []string,
+) error {
+	var all []ref.Resolved
+	for _, refStr := range references {
+		resolved, err := resolveReference(canon, locale, refStr)
 		if err != nil {
-			return fmt.Errorf("failed to parse: %w", err)
+			fmt.Fprintf(cmd.ErrOrStderr(), "Error processing %q: %v\n", refStr, err)
+			printParseErrorCaret(cmd, refStr, err)
+			continue
 		}
+
+		all = append(all, resolved...)
 	}
 
-	// Validate
-	if err := parsed.Validate(); err != nil {
-		return fmt.Errorf("validation failed: %w", err)
+	if len(all) == 0 {
+		return nil
 	}
 
-	// Resolve
-	resolved, err := ref.Canonical.Resolve(parsed)
+	return outputResolved(cmd, formatter, canon, all)
+}
+
+// runRefSet combines all the input references into one set of verses, adds
+// the --union references, keeps only the --intersect references, removes the
+// --minus references, and then outputs the verses that remain.
+func runRefSet(
+	cmd *cobra.Command,
+	formatter ref.RefFormatter,
+	canon *ref.Canon,
+	locale *ref.Locale,
+	references []string,
+) error {
+	set, err := resolveSet(cmd, canon, locale, references)
 	if err != nil {
-		return fmt.Errorf("resolution failed: %w", err)
+		return err
+	}
+
+	ops := []struct {
+		refs  []string
+		apply func(*ref.Set, *ref.Set) *ref.Set
+	}{
+		{refUnion, (*ref.Set).Union},
+		{refIntersect, (*ref.Set).Intersect},
+		{refMinus, (*ref.Set).Difference},
+	}
+
+	for _, op := range ops {
+		if len(op.refs) == 0 {
+			continue
+		}
+
+		other, err := resolveSet(cmd, canon, locale, op.refs)
+		if err != nil {
+			return err
+		}
+
+		set = op.apply(set, other)
+	}
+
+	return outputResolved(cmd, formatter, canon, set.Ranges())
+}
+
+// resolveSet resolves all the references and returns the set of verses they
+// contain.
+func resolveSet(cmd *cobra.Command, canon *ref.Canon, locale *ref.Locale, references []string) (*ref.Set, error) {
+	var all []ref.Resolved
+	for _, refStr := range references {
+		resolved, err := resolveReference(canon, locale, refStr)
+		if err != nil {
+			fmt.Fprintf(cmd.ErrOrStderr(), "Error processing %q: %v\n", refStr, err)
+			printParseErrorCaret(cmd, refStr, err)
+			return nil, fmt.Errorf("invalid reference %q", refStr)
+		}
+
+		all = append(all, resolved...)
 	}
 
+	return canon.Set(all...)
+}
+
+// outputResolved formats the resolved references and outputs them along with
+// any requested statistics. The references are renumbered first when
+// --to-versification is given.
+func outputResolved(
+	cmd *cobra.Command,
+	formatter ref.RefFormatter,
+	canon *ref.Canon,
+	resolved []ref.Resolved,
+) error {
 	// Convert []Resolved to []*Resolved
 	resolvedPtrs := make([]*ref.Resolved, len(resolved))
 	for i := range resolved {
 		resolvedPtrs[i] = &resolved[i]
 	}
 
+	outputPtrs := resolvedPtrs
+	if refToVersion != "" {
+		mapped, err := canon.ToVersification(resolved, refToVersion)
+		if err != nil {
+			return fmt.Errorf("invalid versification: %w", err)
+		}
+
+		outputPtrs = make([]*ref.Resolved, len(mapped))
+		for i := range mapped {
+			outputPtrs[i] = &mapped[i]
+		}
+	}
+
 	// Format
-	formatted, err := formatter.Format(resolvedPtrs)
+	formatted, err := formatter.Format(outputPtrs)
 	if err != nil {
 		return fmt.Errorf("formatting failed: %w", err)
 	}
@@ -125,6 +480,12 @@ func processReference(cmd *cobra.Command, formatter ref.RefFormatter, refStr str
 	// Output formatted reference
 	fmt.Fprintln(cmd.OutOrStdout(), formatted)
 
+	if refTitles {
+		if err := printTitles(cmd, canon, resolved); err != nil {
+			return err
+		}
+	}
+
 	// Output stats if requested
 	switch refStat {
 	case "off":
@@ -132,16 +493,19 @@ func processReference(cmd *cobra.Command, formatter ref.RefFormatter, refStr str
 	case "ref":
 		// Group by book for stats
 		bookGroups := groupResolvedByBook(resolvedPtrs)
+		totalVerses := 0
 		for _, group := range bookGroups {
 			stats, err := ref.CalculateRefStats(group)
 			if err != nil {
 				return fmt.Errorf("failed to calculate ref stats: %w", err)
 			}
 			printRefStats(cmd, stats)
+			totalVerses += stats.VerseCount
 			if len(bookGroups) > 1 {
 				fmt.Fprintln(cmd.OutOrStdout()) // Blank line between books
 			}
 		}
+		printTotalVerseCount(cmd, bookGroups, totalVerses)
 	case "esv":
 		ec, err := esv.NewFromEnvironment()
 		if err != nil {
@@ -149,16 +513,19 @@ func processReference(cmd *cobra.Command, formatter ref.RefFormatter, refStr str
 		}
 		// Group by book for stats
 		bookGroups := groupResolvedByBook(resolvedPtrs)
+		totalVerses := 0
 		for _, group := range bookGroups {
 			stats, err := ref.CalculateESVStats(cmd.Context(), group, ec)
 			if err != nil {
 				return fmt.Errorf("failed to calculate ESV stats: %w", err)
 			}
 			printESVStats(cmd, stats)
+			totalVerses += stats.VerseCount
 			if len(bookGroups) > 1 {
 				fmt.Fprintln(cmd.OutOrStdout()) // Blank line between books
 			}
 		}
+		printTotalVerseCount(cmd, bookGroups, totalVerses)
 	default:
 		return fmt.Errorf("invalid stat mode: %q (expected off, ref, or esv)", refStat)
 	}
@@ -166,6 +533,102 @@ func processReference(cmd *cobra.Command, formatter ref.RefFormatter, refStr str
 	return nil
 }
 
+// printTitles prints the titles of the named pericopes of the canon that
+// include each of the resolved references.
+func printTitles(cmd *cobra.Command, canon *ref.Canon, resolved []ref.Resolved) error {
+	seen := map[string]bool{}
+	for i := range resolved {
+		ps, err := canon.PericopesCovering(&resolved[i])
+		if err != nil {
+			return fmt.Errorf("failed to find titles: %w", err)
+		}
+
+		for _, p := range ps {
+			if seen[p.Title] {
+				continue
+			}
+			seen[p.Title] = true
+
+			cs, err := p.Ref.CompactRef()
+			if err != nil {
+				return fmt.Errorf("failed to format title reference: %w", err)
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), "  Title: %s (%s)\n", p.Title, cs)
+		}
+	}
+
+	return nil
+}
+
+// resolveReference parses and resolves the reference. References written as
+// OSIS references (e.g., "Gen.1.1-Gen.1.5") are accepted as well as references
+// written using the book names and notation of the locale. The references are
+// resolved against the given canon.
+func resolveReference(canon *ref.Canon, locale *ref.Locale, refStr string) ([]ref.Resolved, error) {
+	if resolved, err := canon.ParseOSIS(refStr); err == nil {
+		return resolved, nil
+	}
+
+	// Try parsing as Proper first, then Multiple
+	var parsed ref.Absolute
+	var err error
+
+	parseOpts := []ref.ParseOption{
+		ref.ParseWithNotation(locale.Notation),
+		ref.ParseWithAbbreviations(locale.Abbreviations),
+	}
+	resolveOpts := []ref.ResolveOption{
+		ref.WithAbbreviations(locale.Abbreviations),
+	}
+	if refLenient {
+		parseOpts = append(parseOpts, ref.ParseLeniently())
+		resolveOpts = append(resolveOpts, ref.Lenient())
+	}
+	parsed, err = ref.ParseProper(refStr, parseOpts...)
+	if err != nil {
+		parsed, err = ref.ParseMultiple(refStr, parseOpts...)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse: %w", err)
+		}
+	}
+
+	// Validate
+	if err := parsed.Validate(); err != nil {
+		return nil, fmt.Errorf("validation failed: %w", err)
+	}
+
+	// Resolve
+	resolved, err := canon.Resolve(parsed, resolveOpts...)
+	if err != nil {
+		return nil, fmt.Errorf("resolution failed: %w", err)
+	}
+
+	return resolved, nil
+}
+
+// printParseErrorCaret prints the reference with a caret under the column
+// where parsing failed, if the error reports one.
+func printParseErrorCaret(cmd *cobra.Command, refStr string, err error) {
+	var offset int
+
+	var perr *ref.ParseError
+	var mierr *ref.MoreInputError
+	switch {
+	case errors.As(err, &perr):
+		offset = perr.Offset
+	case errors.As(err, &mierr):
+		// point at the start of the trailing input, not the space before it
+		trimmed := strings.TrimLeftFunc(mierr.Remaining, unicode.IsSpace)
+		offset = mierr.Offset + len([]rune(mierr.Remaining)) - len([]rune(trimmed))
+	default:
+		return
+	}
+
+	out := cmd.ErrOrStderr()
+	fmt.Fprintf(out, "    %s\n", refStr)
+	fmt.Fprintf(out, "    %s^\n", strings.Repeat(" ", offset))
+}
+
 // groupResolvedByBook groups resolved references by book name, preserving order.
 func groupResolvedByBook(resolved []*ref.Resolved) [][]*ref.Resolved {
 	if len(resolved) == 0 {
@@ -192,6 +655,14 @@ func groupResolvedByBook(resolved []*ref.Resolved) [][]*ref.Resolved {
 	return groups
 }
 
+// printTotalVerseCount prints the total number of verses across all books when
+// the reference covers more than one book, such as a span.
+func printTotalVerseCount(cmd *cobra.Command, bookGroups [][]*ref.Resolved, total int) {
+	if len(bookGroups) > 1 {
+		fmt.Fprintf(cmd.OutOrStdout(), "Total Verse Count: %d\n", total)
+	}
+}
+
 func printRefStats(cmd *cobra.Command, stats *ref.RefStats) {
 	out := cmd.OutOrStdout()
 	fmt.Fprintf(out, "  Book: %s\n", stats.Book)
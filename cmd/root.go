@@ -1,7 +1,13 @@
 package cmd
 
 import (
+	"fmt"
+	"os"
+	"strings"
+
 	"github.com/spf13/cobra"
+
+	"github.com/zostay/today/pkg/ref"
 )
 
 var (
@@ -9,6 +15,12 @@ var (
 
 	asHtml bool
 
+	canonName      string
+	canonFile      string
+	categoriesFile string
+
+	abbreviationsFile string
+
 	fromCategory string
 	fromBook     string
 )
@@ -19,7 +31,17 @@ func init() {
 		Short: "Read some scripture today",
 	}
 
+	cmd.PersistentFlags().StringVar(&canonName, "canon", "protestant",
+		"Canon of books to use ("+strings.Join(ref.CanonNames(), ", ")+")")
+	cmd.PersistentFlags().StringVar(&canonFile, "canon-file", "",
+		"Load the canon of books and categories from a JSON or YAML file instead of --canon")
+	cmd.PersistentFlags().StringVar(&categoriesFile, "categories-file", "",
+		"Add the categories defined in a JSON or YAML file to the canon")
+	cmd.PersistentFlags().StringVar(&abbreviationsFile, "abbreviations-file", "",
+		"Add the book names and abbreviations defined in a YAML file to the English abbreviations")
+
 	cmd.AddCommand(
+		abbrCmd,
 		listBooksCmd,
 		listCategoriesCmd,
 		ostCmd,
@@ -34,3 +56,109 @@ func Execute() {
 	err := cmd.Execute()
 	cobra.CheckErr(err)
 }
+
+// selectedCanon returns the canon loaded from the --canon-file flag or else the
+// canon named by the --canon flag, with the categories of the --categories-file
+// flag merged in.
+func selectedCanon() (*ref.Canon, error) {
+	c, err := baseCanon()
+	if err != nil {
+		return nil, err
+	}
+
+	if categoriesFile == "" {
+		return c, nil
+	}
+
+	f, err := os.Open(categoriesFile)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	cats, err := ref.LoadCategories(f)
+	if err != nil {
+		return nil, fmt.Errorf("unable to load categories file %q: %w", categoriesFile, err)
+	}
+
+	c, err = c.MergeCategories(cats)
+	if err != nil {
+		return nil, fmt.Errorf("unable to add categories from %q: %w", categoriesFile, err)
+	}
+	return c, nil
+}
+
+// baseCanon returns the canon loaded from the --canon-file flag or else the
+// canon named by the --canon flag.
+func baseCanon() (*ref.Canon, error) {
+	if canonFile != "" {
+		f, err := os.Open(canonFile)
+		if err != nil {
+			return nil, err
+		}
+		defer f.Close()
+
+		c, err := ref.LoadCanon(f)
+		if err != nil {
+			return nil, fmt.Errorf("unable to load canon file %q: %w", canonFile, err)
+		}
+		return c, nil
+	}
+
+	c, err := ref.GetCanon(canonName)
+	if err != nil {
+		return nil, fmt.Errorf("invalid canon: %w", err)
+	}
+	return c, nil
+}
+
+// selectedAbbreviations returns the English book names and abbreviations with
+// those of the --abbreviations-file flag merged over them.
+func selectedAbbreviations() (*ref.BookAbbreviations, error) {
+	if abbreviationsFile == "" {
+		return ref.Abbreviations, nil
+	}
+
+	f, err := os.Open(abbreviationsFile)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	abbrs, err := ref.LoadAbbreviations(f)
+	if err != nil {
+		return nil, fmt.Errorf("unable to load abbreviations file %q: %w", abbreviationsFile, err)
+	}
+
+	return ref.Abbreviations.Merge(abbrs), nil
+}
+
+// selectedLocale returns the named locale. The English locale uses the
+// abbreviations returned by selectedAbbreviations.
+func selectedLocale(name string) (*ref.Locale, error) {
+	l, err := ref.GetLocale(name)
+	if err != nil {
+		return nil, err
+	}
+
+	if l.Abbreviations != ref.Abbreviations {
+		return l, nil
+	}
+
+	abbrs, err := selectedAbbreviations()
+	if err != nil {
+		return nil, err
+	}
+
+	en := *l
+	en.Abbreviations = abbrs
+	return &en, nil
+}
+
+// withCanonBooks returns a copy of the locale whose abbreviations also accept
+// the names of the books of the canon, such as those of a --canon-file.
+func withCanonBooks(l *ref.Locale, c *ref.Canon) *ref.Locale {
+	withBooks := *l
+	withBooks.Abbreviations = l.Abbreviations.WithCanon(c)
+	return &withBooks
+}
@@ -3,6 +3,7 @@ package cmd
 import (
 	"fmt"
 	"html/template"
+	"os"
 	"strings"
 
 	"github.com/bbrks/wrap"
@@ -24,11 +25,27 @@ func init() {
 }
 
 func RunTodayShow(cmd *cobra.Command, args []string) {
+	canon, err := selectedCanon()
+	if err != nil {
+		panic(err)
+	}
+
+	abbrs, err := selectedAbbreviations()
+	if err != nil {
+		panic(err)
+	}
+
 	ec, err := esv.NewFromEnvironment()
 	if err != nil {
 		panic(err)
 	}
-	svc := text.NewService(ec)
+	svc := text.NewService(ec,
+		text.WithCanon(canon),
+		text.WithAbbreviations(abbrs.WithCanon(canon)),
+		text.WithWarnings(func(err error) {
+			fmt.Fprintf(os.Stderr, "Warning: %v\n", err)
+		}),
+	)
 
 	ref := strings.Join(args, " ")
 	var v string
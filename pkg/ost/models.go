@@ -3,10 +3,12 @@ package ost
 import (
 	"encoding/json"
 	"io"
+	"sort"
 
 	"gopkg.in/yaml.v3"
 
 	"github.com/zostay/today/pkg/photo"
+	"github.com/zostay/today/pkg/ref"
 	"github.com/zostay/today/pkg/text"
 )
 
@@ -42,6 +44,43 @@ type Index struct {
 	Verses      map[string]IndexEntry `yaml:"verses" json:"verses"`
 }
 
+// LinkDate returns a function for ref.WithLinkDate that gives each reference the
+// date of the index entry it was resolved from in the given canon, so that it
+// may be linked to the page of that date with ref.OSTLink. A reference that
+// appears on more than one date is given the earliest. Entries that cannot be
+// resolved are skipped.
+func (i *Index) LinkDate(c *ref.Canon) func(*ref.Resolved) (string, bool) {
+	keys := make([]string, 0, len(i.Verses))
+	for date := range i.Verses {
+		keys = append(keys, date)
+	}
+	sort.Strings(keys)
+
+	dates := make(map[string]string, len(keys))
+	for _, date := range keys {
+		parsed, err := ref.ParseMultiple(i.Verses[date].Reference)
+		if err != nil {
+			continue
+		}
+
+		resolved, err := c.Resolve(parsed)
+		if err != nil {
+			continue
+		}
+
+		for _, r := range resolved {
+			if _, seen := dates[r.Ref()]; !seen {
+				dates[r.Ref()] = date
+			}
+		}
+	}
+
+	return func(r *ref.Resolved) (string, bool) {
+		date, ok := dates[r.Ref()]
+		return date, ok
+	}
+}
+
 // LoadPhotoYaml loads a photo descriptor file in YAML format.
 func LoadPhotoYaml(r io.Reader, p *Photo) error {
 	dec := yaml.NewDecoder(r)
@@ -5,8 +5,10 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"github.com/zostay/today/pkg/ost"
 	"github.com/zostay/today/pkg/photo"
+	"github.com/zostay/today/pkg/ref"
 	"github.com/zostay/today/pkg/text"
 )
 
@@ -173,6 +175,30 @@ func TestLoadIndexJson(t *testing.T) {
 	assert.Equal(t, firstIndex, &idx)
 }
 
+func TestIndex_LinkDate(t *testing.T) {
+	t.Parallel()
+
+	linkDate := firstIndex.LinkDate(ref.Canonical)
+
+	parsed, err := ref.ParseMultiple("Romans 8:31-39; Psalm 5; John 3:16")
+	require.NoError(t, err)
+
+	resolved, err := ref.Canonical.Resolve(parsed)
+	require.NoError(t, err)
+	require.Len(t, resolved, 3)
+
+	date, ok := linkDate(&resolved[0])
+	assert.True(t, ok)
+	assert.Equal(t, "2023/12/21", date)
+
+	date, ok = linkDate(&resolved[1])
+	assert.True(t, ok)
+	assert.Equal(t, "2023/12/27", date)
+
+	_, ok = linkDate(&resolved[2])
+	assert.False(t, ok)
+}
+
 func TestLoadVerseYaml(t *testing.T) {
 	t.Parallel()
 
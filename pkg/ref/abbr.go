@@ -6,6 +6,7 @@ var Abbreviations = &BookAbbreviations{
 	Abbreviations: []BookAbbreviation{
 		{
 			Name:      "Genesis",
+			USFM:      "GEN",
 			Preferred: "Gen.",
 			Accepts: []string{
 				"Genesis",
@@ -14,6 +15,7 @@ var Abbreviations = &BookAbbreviations{
 		},
 		{
 			Name:      "Exodus",
+			USFM:      "EXO",
 			Preferred: "Ex.",
 			Accepts: []string{
 				"Exodus",
@@ -21,6 +23,7 @@ var Abbreviations = &BookAbbreviations{
 		},
 		{
 			Name:      "Leviticus",
+			USFM:      "LEV",
 			Preferred: "Lev.",
 			Accepts: []string{
 				"Leviticus",
@@ -29,6 +32,7 @@ var Abbreviations = &BookAbbreviations{
 		},
 		{
 			Name:      "Numbers",
+			USFM:      "NUM",
 			Preferred: "Num.",
 			Accepts: []string{
 				"Numbers",
@@ -38,6 +42,7 @@ var Abbreviations = &BookAbbreviations{
 		},
 		{
 			Name:      "Deuteronomy",
+			USFM:      "DEU",
 			Preferred: "Deut.",
 			Accepts: []string{
 				"Deuteronomy",
@@ -46,6 +51,7 @@ var Abbreviations = &BookAbbreviations{
 		},
 		{
 			Name:      "Joshua",
+			USFM:      "JOS",
 			Preferred: "Josh.",
 			Accepts: []string{
 				"Joshua",
@@ -54,6 +60,7 @@ var Abbreviations = &BookAbbreviations{
 		},
 		{
 			Name:      "Judges",
+			USFM:      "JDG",
 			Preferred: "Judg.",
 			Accepts: []string{
 				"Judges",
@@ -63,6 +70,7 @@ var Abbreviations = &BookAbbreviations{
 		},
 		{
 			Name:      "Ruth",
+			USFM:      "RUT",
 			Preferred: "Ruth",
 			Accepts: []string{
 				"Ruth",
@@ -71,6 +79,7 @@ var Abbreviations = &BookAbbreviations{
 		},
 		{
 			Name:      "1 Samuel",
+			USFM:      "1SA",
 			Preferred: "1 Sam.",
 			Ordinal:   1,
 			Accepts: []string{
@@ -88,6 +97,7 @@ var Abbreviations = &BookAbbreviations{
 		},
 		{
 			Name:      "2 Samuel",
+			USFM:      "2SA",
 			Preferred: "2 Sam.",
 			Ordinal:   2,
 			Accepts: []string{
@@ -105,6 +115,7 @@ var Abbreviations = &BookAbbreviations{
 		},
 		{
 			Name:      "1 Kings",
+			USFM:      "1KI",
 			Preferred: "1 Kings",
 			Ordinal:   1,
 			Accepts: []string{
@@ -122,6 +133,7 @@ var Abbreviations = &BookAbbreviations{
 		},
 		{
 			Name:      "2 Kings",
+			USFM:      "2KI",
 			Preferred: "2 Kings",
 			Ordinal:   2,
 			Accepts: []string{
@@ -139,6 +151,7 @@ var Abbreviations = &BookAbbreviations{
 		},
 		{
 			Name:      "1 Chronicles",
+			USFM:      "1CH",
 			Preferred: "1 Chron.",
 			Ordinal:   1,
 			Accepts: []string{
@@ -156,6 +169,7 @@ var Abbreviations = &BookAbbreviations{
 		},
 		{
 			Name:      "2 Chronicles",
+			USFM:      "2CH",
 			Preferred: "2 Chron.",
 			Ordinal:   2,
 			Accepts: []string{
@@ -173,6 +187,7 @@ var Abbreviations = &BookAbbreviations{
 		},
 		{
 			Name:      "Ezra",
+			USFM:      "EZR",
 			Preferred: "Ezra",
 			Accepts: []string{
 				"Ezra",
@@ -180,6 +195,7 @@ var Abbreviations = &BookAbbreviations{
 		},
 		{
 			Name:      "Nehemiah",
+			USFM:      "NEH",
 			Preferred: "Neh.",
 			Accepts: []string{
 				"Nehemiah",
@@ -187,6 +203,7 @@ var Abbreviations = &BookAbbreviations{
 		},
 		{
 			Name:      "Esther",
+			USFM:      "EST",
 			Preferred: "Est.",
 			Accepts: []string{
 				"Esther",
@@ -194,6 +211,7 @@ var Abbreviations = &BookAbbreviations{
 		},
 		{
 			Name:      "Job",
+			USFM:      "JOB",
 			Preferred: "Job",
 			Accepts: []string{
 				"Job",
@@ -202,6 +220,7 @@ var Abbreviations = &BookAbbreviations{
 		},
 		{
 			Name:      "Psalms",
+			USFM:      "PSA",
 			Preferred: "Ps.",
 			Singular:  "Psalm",
 			Accepts: []string{
@@ -213,6 +232,7 @@ var Abbreviations = &BookAbbreviations{
 		},
 		{
 			Name:      "Proverbs",
+			USFM:      "PRO",
 			Preferred: "Prov.",
 			Accepts: []string{
 				"Proverbs",
@@ -221,6 +241,7 @@ var Abbreviations = &BookAbbreviations{
 		},
 		{
 			Name:      "Ecclesiastes",
+			USFM:      "ECC",
 			Preferred: "Eccles.",
 			Accepts: []string{
 				"Ecclesiastes",
@@ -229,6 +250,7 @@ var Abbreviations = &BookAbbreviations{
 		},
 		{
 			Name:      "Song of Solomon",
+			USFM:      "SNG",
 			Preferred: "Song",
 			Accepts: []string{
 				"Song of Solomon",
@@ -240,6 +262,7 @@ var Abbreviations = &BookAbbreviations{
 		},
 		{
 			Name:      "Isaiah",
+			USFM:      "ISA",
 			Preferred: "Isa.",
 			Accepts: []string{
 				"Isaiah",
@@ -247,6 +270,7 @@ var Abbreviations = &BookAbbreviations{
 		},
 		{
 			Name:      "Jeremiah",
+			USFM:      "JER",
 			Preferred: "Jer.",
 			Accepts: []string{
 				"Jeremiah",
@@ -255,6 +279,7 @@ var Abbreviations = &BookAbbreviations{
 		},
 		{
 			Name:      "Lamentations",
+			USFM:      "LAM",
 			Preferred: "Lam.",
 			Accepts: []string{
 				"Lamentations",
@@ -262,6 +287,7 @@ var Abbreviations = &BookAbbreviations{
 		},
 		{
 			Name:      "Ezekiel",
+			USFM:      "EZK",
 			Preferred: "Ezek.",
 			Accepts: []string{
 				"Ezekiel",
@@ -270,6 +296,7 @@ var Abbreviations = &BookAbbreviations{
 		},
 		{
 			Name:      "Daniel",
+			USFM:      "DAN",
 			Preferred: "Dan.",
 			Accepts: []string{
 				"Daniel",
@@ -278,6 +305,7 @@ var Abbreviations = &BookAbbreviations{
 		},
 		{
 			Name:      "Hosea",
+			USFM:      "HOS",
 			Preferred: "Hos.",
 			Accepts: []string{
 				"Hosea",
@@ -285,6 +313,7 @@ var Abbreviations = &BookAbbreviations{
 		},
 		{
 			Name:      "Joel",
+			USFM:      "JOL",
 			Preferred: "Joel",
 			Accepts: []string{
 				"Joel",
@@ -293,6 +322,7 @@ var Abbreviations = &BookAbbreviations{
 		},
 		{
 			Name:      "Amos",
+			USFM:      "AMO",
 			Preferred: "Amos",
 			Accepts: []string{
 				"Amos",
@@ -300,6 +330,7 @@ var Abbreviations = &BookAbbreviations{
 		},
 		{
 			Name:      "Obadiah",
+			USFM:      "OBA",
 			Preferred: "Obad.",
 			Accepts: []string{
 				"Obadiah",
@@ -307,6 +338,7 @@ var Abbreviations = &BookAbbreviations{
 		},
 		{
 			Name:      "Jonah",
+			USFM:      "JON",
 			Preferred: "Jonah",
 			Accepts: []string{
 				"Jonah",
@@ -315,6 +347,7 @@ var Abbreviations = &BookAbbreviations{
 		},
 		{
 			Name:      "Micah",
+			USFM:      "MIC",
 			Preferred: "Mic.",
 			Accepts: []string{
 				"Micah",
@@ -323,6 +356,7 @@ var Abbreviations = &BookAbbreviations{
 		},
 		{
 			Name:      "Nahum",
+			USFM:      "NAM",
 			Preferred: "Nah.",
 			Accepts: []string{
 				"Nahum",
@@ -330,6 +364,7 @@ var Abbreviations = &BookAbbreviations{
 		},
 		{
 			Name:      "Habakkuk",
+			USFM:      "HAB",
 			Preferred: "Hab.",
 			Accepts: []string{
 				"Habakkuk",
@@ -338,6 +373,7 @@ var Abbreviations = &BookAbbreviations{
 		},
 		{
 			Name:      "Zephaniah",
+			USFM:      "ZEP",
 			Preferred: "Zeph.",
 			Accepts: []string{
 				"Zephaniah",
@@ -346,6 +382,7 @@ var Abbreviations = &BookAbbreviations{
 		},
 		{
 			Name:      "Haggai",
+			USFM:      "HAG",
 			Preferred: "Hag.",
 			Accepts: []string{
 				"Haggai",
@@ -354,6 +391,7 @@ var Abbreviations = &BookAbbreviations{
 		},
 		{
 			Name:      "Zechariah",
+			USFM:      "ZEC",
 			Preferred: "Zech.",
 			Accepts: []string{
 				"Zechariah",
@@ -362,6 +400,7 @@ var Abbreviations = &BookAbbreviations{
 		},
 		{
 			Name:      "Malachi",
+			USFM:      "MAL",
 			Preferred: "Mal.",
 			Accepts: []string{
 				"Malachi",
@@ -370,6 +409,7 @@ var Abbreviations = &BookAbbreviations{
 		},
 		{
 			Name:      "Matthew",
+			USFM:      "MAT",
 			Preferred: "Matt.",
 			Accepts: []string{
 				"Matthew",
@@ -378,6 +418,7 @@ var Abbreviations = &BookAbbreviations{
 		},
 		{
 			Name:      "Mark",
+			USFM:      "MRK",
 			Preferred: "Mark",
 			Accepts: []string{
 				"Mark",
@@ -387,6 +428,7 @@ var Abbreviations = &BookAbbreviations{
 		},
 		{
 			Name:      "Luke",
+			USFM:      "LUK",
 			Preferred: "Luke",
 			Accepts: []string{
 				"Luke",
@@ -395,6 +437,7 @@ var Abbreviations = &BookAbbreviations{
 		},
 		{
 			Name:      "John",
+			USFM:      "JHN",
 			Preferred: "John",
 			Accepts: []string{
 				"John",
@@ -404,6 +447,7 @@ var Abbreviations = &BookAbbreviations{
 		},
 		{
 			Name:      "Acts",
+			USFM:      "ACT",
 			Preferred: "Acts",
 			Accepts: []string{
 				"Acts",
@@ -411,6 +455,7 @@ var Abbreviations = &BookAbbreviations{
 		},
 		{
 			Name:      "Romans",
+			USFM:      "ROM",
 			Preferred: "Rom.",
 			Accepts: []string{
 				"Romans",
@@ -419,6 +464,7 @@ var Abbreviations = &BookAbbreviations{
 		},
 		{
 			Name:      "1 Corinthians",
+			USFM:      "1CO",
 			Preferred: "1 Cor.",
 			Ordinal:   1,
 			Accepts: []string{
@@ -431,6 +477,7 @@ var Abbreviations = &BookAbbreviations{
 		},
 		{
 			Name:      "2 Corinthians",
+			USFM:      "2CO",
 			Preferred: "2 Cor.",
 			Ordinal:   2,
 			Accepts: []string{
@@ -443,6 +490,7 @@ var Abbreviations = &BookAbbreviations{
 		},
 		{
 			Name:      "Galatians",
+			USFM:      "GAL",
 			Preferred: "Gal.",
 			Accepts: []string{
 				"Galatians",
@@ -450,6 +498,7 @@ var Abbreviations = &BookAbbreviations{
 		},
 		{
 			Name:      "Ephesians",
+			USFM:      "EPH",
 			Preferred: "Eph.",
 			Accepts: []string{
 				"Ephesians",
@@ -457,15 +506,18 @@ var Abbreviations = &BookAbbreviations{
 		},
 		{
 			Name:      "Philippians",
+			USFM:      "PHP",
 			Preferred: "Phil.",
 			Accepts: []string{
 				"Philippians",
 				"Php",
+				"Phil",
 				"Pp",
 			},
 		},
 		{
 			Name:      "Colossians",
+			USFM:      "COL",
 			Preferred: "Col.",
 			Accepts: []string{
 				"Colossians",
@@ -473,6 +525,7 @@ var Abbreviations = &BookAbbreviations{
 		},
 		{
 			Name:      "1 Thessalonians",
+			USFM:      "1TH",
 			Preferred: "1 Thess.",
 			Ordinal:   1,
 			Accepts: []string{
@@ -485,6 +538,7 @@ var Abbreviations = &BookAbbreviations{
 		},
 		{
 			Name:      "2 Thessalonians",
+			USFM:      "2TH",
 			Preferred: "2 Thess.",
 			Ordinal:   2,
 			Accepts: []string{
@@ -497,6 +551,7 @@ var Abbreviations = &BookAbbreviations{
 		},
 		{
 			Name:      "1 Timothy",
+			USFM:      "1TI",
 			Preferred: "1 Tim.",
 			Ordinal:   1,
 			Accepts: []string{
@@ -509,6 +564,7 @@ var Abbreviations = &BookAbbreviations{
 		},
 		{
 			Name:      "2 Timothy",
+			USFM:      "2TI",
 			Preferred: "2 Tim.",
 			Ordinal:   2,
 			Accepts: []string{
@@ -521,6 +577,7 @@ var Abbreviations = &BookAbbreviations{
 		},
 		{
 			Name:      "Titus",
+			USFM:      "TIT",
 			Preferred: "Titus",
 			Accepts: []string{
 				"Titus",
@@ -528,15 +585,18 @@ var Abbreviations = &BookAbbreviations{
 		},
 		{
 			Name:      "Philemon",
+			USFM:      "PHM",
 			Preferred: "Philem.",
 			Accepts: []string{
 				"Philemon",
+				"Phlm",
 				"Phm",
 				"Pm",
 			},
 		},
 		{
 			Name:      "Hebrews",
+			USFM:      "HEB",
 			Preferred: "Heb.",
 			Accepts: []string{
 				"Hebrews",
@@ -544,6 +604,7 @@ var Abbreviations = &BookAbbreviations{
 		},
 		{
 			Name:      "James",
+			USFM:      "JAS",
 			Preferred: "James",
 			Accepts: []string{
 				"James",
@@ -553,6 +614,7 @@ var Abbreviations = &BookAbbreviations{
 		},
 		{
 			Name:      "1 Peter",
+			USFM:      "1PE",
 			Preferred: "1 Pet.",
 			Ordinal:   1,
 			Accepts: []string{
@@ -570,6 +632,7 @@ var Abbreviations = &BookAbbreviations{
 		},
 		{
 			Name:      "2 Peter",
+			USFM:      "2PE",
 			Preferred: "2 Pet.",
 			Ordinal:   2,
 			Accepts: []string{
@@ -587,6 +650,7 @@ var Abbreviations = &BookAbbreviations{
 		},
 		{
 			Name:      "1 John",
+			USFM:      "1JN",
 			Preferred: "1 John",
 			Ordinal:   1,
 			Accepts: []string{
@@ -609,6 +673,7 @@ var Abbreviations = &BookAbbreviations{
 		},
 		{
 			Name:      "2 John",
+			USFM:      "2JN",
 			Preferred: "2 John",
 			Ordinal:   2,
 			Accepts: []string{
@@ -631,6 +696,7 @@ var Abbreviations = &BookAbbreviations{
 		},
 		{
 			Name:      "3 John",
+			USFM:      "3JN",
 			Preferred: "3 John",
 			Ordinal:   3,
 			Accepts: []string{
@@ -653,6 +719,7 @@ var Abbreviations = &BookAbbreviations{
 		},
 		{
 			Name:      "Jude",
+			USFM:      "JUD",
 			Preferred: "Jude",
 			Accepts: []string{
 				"Jude",
@@ -661,11 +728,205 @@ var Abbreviations = &BookAbbreviations{
 		},
 		{
 			Name:      "Revelation",
+			USFM:      "REV",
 			Preferred: "Rev.",
 			Accepts: []string{
 				"Revelation",
 				"Rv",
 				"The Revelation",
+				"The Apocalypse",
+				"Apocalypse",
+			},
+		},
+		{
+			Name:      "Tobit",
+			USFM:      "TOB",
+			Preferred: "Tob.",
+			Accepts: []string{
+				"Tobit",
+				"Tb",
+			},
+		},
+		{
+			Name:      "Judith",
+			USFM:      "JDT",
+			Preferred: "Jth.",
+			Accepts: []string{
+				"Judith",
+				"Jdt",
+				"Jth",
+			},
+		},
+		{
+			Name:      "Additions to Esther",
+			USFM:      "ESG",
+			Preferred: "Add. Esth.",
+			Accepts: []string{
+				"Additions to Esther",
+				"Add Esth",
+				"Rest of Esther",
+				"Greek Esther",
+			},
+		},
+		{
+			Name:      "Wisdom of Solomon",
+			USFM:      "WIS",
+			Preferred: "Wis.",
+			Accepts: []string{
+				"Wisdom of Solomon",
+				"Wisdom",
+				"Wis",
+				"Ws",
+			},
+		},
+		{
+			Name:      "Sirach",
+			USFM:      "SIR",
+			Preferred: "Sir.",
+			Accepts: []string{
+				"Sirach",
+				"Ben Sira",
+			},
+		},
+		{
+			Name:      "Baruch",
+			USFM:      "BAR",
+			Preferred: "Bar.",
+			Accepts: []string{
+				"Baruch",
+			},
+		},
+		{
+			Name:      "Letter of Jeremiah",
+			USFM:      "LJE",
+			Preferred: "Let. Jer.",
+			Accepts: []string{
+				"Letter of Jeremiah",
+				"Let Jer",
+			},
+		},
+		{
+			Name:      "Prayer of Azariah",
+			USFM:      "S3Y",
+			Preferred: "Pr. Azar.",
+			Accepts: []string{
+				"Prayer of Azariah",
+				"Pr Azar",
+				"Azariah",
+			},
+		},
+		{
+			Name:      "Susanna",
+			USFM:      "SUS",
+			Preferred: "Sus.",
+			Accepts: []string{
+				"Susanna",
+			},
+		},
+		{
+			Name:      "Bel and the Dragon",
+			USFM:      "BEL",
+			Preferred: "Bel",
+			Accepts: []string{
+				"Bel and the Dragon",
+				"Bel",
+			},
+		},
+		{
+			Name:      "1 Maccabees",
+			USFM:      "1MA",
+			Preferred: "1 Macc.",
+			Ordinal:   1,
+			Accepts: []string{
+				"1Maccabees",
+				"FirstMaccabees",
+				"1stMaccabees",
+				"IMaccabees",
+				"ⅠMaccabees",
+				"1Macc",
+				"FirstMacc",
+				"1stMacc",
+				"IMacc",
+				"ⅠMacc",
+				"1Mc",
+				"FirstMc",
+				"1stMc",
+				"IMc",
+				"ⅠMc",
+			},
+		},
+		{
+			Name:      "2 Maccabees",
+			USFM:      "2MA",
+			Preferred: "2 Macc.",
+			Ordinal:   2,
+			Accepts: []string{
+				"2Maccabees",
+				"SecondMaccabees",
+				"2ndMaccabees",
+				"IIMaccabees",
+				"ⅡMaccabees",
+				"2Macc",
+				"SecondMacc",
+				"2ndMacc",
+				"IIMacc",
+				"ⅡMacc",
+				"2Mc",
+				"SecondMc",
+				"2ndMc",
+				"IIMc",
+				"ⅡMc",
+			},
+		},
+		{
+			Name:      "3 Maccabees",
+			USFM:      "3MA",
+			Preferred: "3 Macc.",
+			Ordinal:   3,
+			Accepts: []string{
+				"3Maccabees",
+				"ThirdMaccabees",
+				"3rdMaccabees",
+				"IIIMaccabees",
+				"ⅢMaccabees",
+				"3Macc",
+				"ThirdMacc",
+				"3rdMacc",
+				"IIIMacc",
+				"ⅢMacc",
+				"3Mc",
+				"ThirdMc",
+				"3rdMc",
+				"IIIMc",
+				"ⅢMc",
+			},
+		},
+		{
+			Name:      "1 Esdras",
+			USFM:      "1ES",
+			Preferred: "1 Esd.",
+			Ordinal:   1,
+			Accepts: []string{
+				"1Esdras",
+				"FirstEsdras",
+				"1stEsdras",
+				"IEsdras",
+				"ⅠEsdras",
+				"1Esd",
+				"FirstEsd",
+				"1stEsd",
+				"IEsd",
+				"ⅠEsd",
+			},
+		},
+		{
+			Name:      "Prayer of Manasseh",
+			USFM:      "MAN",
+			Preferred: "Pr. Man.",
+			Accepts: []string{
+				"Prayer of Manasseh",
+				"Pr Man",
+				"Manasseh",
 			},
 		},
 	},
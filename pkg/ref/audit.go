@@ -0,0 +1,122 @@
+package ref
+
+import (
+	"sort"
+)
+
+// AbbreviationAudit reports the problems found in a set of book names and
+// abbreviations by BookAbbreviations.Audit.
+type AbbreviationAudit struct {
+	// Ambiguous lists the error BookName returns for every input that matches
+	// more than one book, sorted by input. Each input is given in the form
+	// used for matching: in lowercase without spaces or punctuation (e.g.,
+	// "jo" for "Jo.").
+	Ambiguous []*MultipleMatchError
+
+	// Unreachable lists the names and abbreviations of the books that do not
+	// resolve to their book, in the order of the books.
+	Unreachable []UnreachableAbbreviation
+
+	// Unknown lists the names of the books that are not in any of the
+	// built-in Canons, in the order of the books. These are either misspelled
+	// (e.g., "Phillipians") or books added with their own USFM code (e.g.,
+	// Enoch).
+	Unknown []string
+}
+
+// UnreachableAbbreviation is a name or abbreviation given for a book that
+// BookName does not resolve to that book.
+type UnreachableAbbreviation struct {
+	// Book is the name of the book.
+	Book string
+
+	// Abbreviation is the name or abbreviation of the book that does not
+	// resolve to it.
+	Abbreviation string
+
+	// Matches lists the names of the books the abbreviation matches instead,
+	// sorted. It is empty if the abbreviation matches no book.
+	Matches []string
+}
+
+// Audit walks the abbreviations tree used by BookName and reports every input
+// that matches more than one book as well as every accepted name, local name,
+// preferred abbreviation, and singular form of a book that does not resolve
+// to that book. It also reports every book that is not in a built-in canon.
+// The accepted names of the books should have no such problems, though a short
+// prefix of two names (e.g., "Jo" for John and Job) is always ambiguous.
+func (b *BookAbbreviations) Audit() *AbbreviationAudit {
+	if b.root == nil {
+		b.root = NewAbbrTree(b)
+	}
+
+	audit := &AbbreviationAudit{}
+
+	var walk func(in []rune, t *AbbrTree)
+	walk = func(in []rune, t *AbbrTree) {
+		if len(in) > 0 {
+			if matches := b.root.Get(string(in)); len(matches) > 1 {
+				audit.Ambiguous = append(audit.Ambiguous, &MultipleMatchError{
+					Input:   string(in),
+					Matches: matchNames(matches),
+				})
+			}
+		}
+
+		for c, child := range t.Children {
+			walk(append(in, c), child)
+		}
+	}
+	walk(nil, b.root)
+
+	sort.Slice(audit.Ambiguous, func(i, j int) bool {
+		return audit.Ambiguous[i].Input < audit.Ambiguous[j].Input
+	})
+
+	canonical := map[string]bool{}
+	for _, c := range Canons {
+		for i := range c.Books {
+			canonical[c.Books[i].Name] = true
+		}
+	}
+
+	for i := range b.Abbreviations {
+		abbr := &b.Abbreviations[i]
+
+		if !canonical[abbr.Name] {
+			audit.Unknown = append(audit.Unknown, abbr.Name)
+		}
+
+		names := append([]string{abbr.Local, abbr.Preferred, abbr.Singular}, abbr.accepted()...)
+		seen := map[string]bool{}
+		for _, name := range names {
+			if name == "" || seen[name] {
+				continue
+			}
+			seen[name] = true
+
+			matches := b.root.Get(name)
+			if _, found := matches[abbr.Name]; found && len(matches) == 1 {
+				continue
+			}
+
+			audit.Unreachable = append(audit.Unreachable, UnreachableAbbreviation{
+				Book:         abbr.Name,
+				Abbreviation: name,
+				Matches:      matchNames(matches),
+			})
+		}
+	}
+
+	return audit
+}
+
+// matchNames returns the names of the matched books, sorted.
+func matchNames(matches map[string]*BookAbbreviation) []string {
+	names := make([]string, 0, len(matches))
+	for name := range matches {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
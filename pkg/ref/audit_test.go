@@ -0,0 +1,80 @@
+package ref_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zostay/today/pkg/ref"
+)
+
+func TestBookAbbreviations_Audit(t *testing.T) {
+	t.Parallel()
+
+	abbrs, err := ref.LoadAbbreviations(strings.NewReader(`
+books:
+  - name: John
+    standard: Jn.
+    accept: [John, Jn]
+  - name: Jonah
+    standard: Jon.
+    accept: [Jonah, Jon, Jn]
+  - name: Job
+    standard: Jb.
+    accept: [Job]
+`))
+	require.NoError(t, err)
+
+	audit := abbrs.Audit()
+
+	assert.Equal(t, []ref.UnreachableAbbreviation{
+		{Book: "John", Abbreviation: "Jn.", Matches: []string{"John", "Jonah"}},
+		{Book: "John", Abbreviation: "Jn", Matches: []string{"John", "Jonah"}},
+		{Book: "Jonah", Abbreviation: "Jn", Matches: []string{"John", "Jonah"}},
+		{Book: "Job", Abbreviation: "Jb.", Matches: []string{}},
+	}, audit.Unreachable)
+
+	assert.Equal(t, []*ref.MultipleMatchError{
+		{Input: "j", Matches: []string{"Job", "John", "Jonah"}},
+		{Input: "jn", Matches: []string{"John", "Jonah"}},
+		{Input: "jo", Matches: []string{"Job", "John", "Jonah"}},
+	}, audit.Ambiguous)
+	assert.Empty(t, audit.Unknown)
+
+	for _, a := range audit.Ambiguous {
+		_, err := abbrs.BookName(a.Input)
+		assert.Equal(t, a, err)
+	}
+}
+
+func TestAbbreviations_Audit(t *testing.T) {
+	t.Parallel()
+
+	for _, l := range ref.Locales {
+		assert.Empty(t, l.Abbreviations.Audit().Unreachable, l.Name)
+		assert.Empty(t, l.Abbreviations.Audit().Unknown, l.Name)
+	}
+
+	for _, g := range ref.StyleGuides {
+		assert.Empty(t, g.Abbreviations.Audit().Unreachable, g.Name)
+	}
+}
+
+func TestBookAbbreviations_Audit_Unknown(t *testing.T) {
+	t.Parallel()
+
+	house, err := ref.LoadAbbreviations(strings.NewReader(`
+books:
+  - name: Enoch
+    usfm: ENO
+    accept: [Enoch]
+  - name: Philippians
+    accept: [Phl]
+`))
+	require.NoError(t, err)
+
+	audit := ref.Abbreviations.Merge(house).Audit()
+	assert.Equal(t, []string{"Enoch"}, audit.Unknown)
+}
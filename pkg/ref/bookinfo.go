@@ -0,0 +1,100 @@
+package ref
+
+import "sort"
+
+// Testament identifies the part of the Bible to which a book belongs.
+type Testament string
+
+const (
+	OldTestament Testament = "Old Testament"
+	NewTestament Testament = "New Testament"
+)
+
+// BookInfo summarizes a book of a canon.
+type BookInfo struct {
+	// Position is the position of the book in the canon, starting from 1.
+	Position int `json:"position" yaml:"position"`
+
+	Name      string    `json:"name" yaml:"name"`
+	USFM      string    `json:"usfm" yaml:"usfm"`
+	Testament Testament `json:"testament,omitempty" yaml:"testament,omitempty"`
+
+	// Categories lists the names of the categories of the canon that include
+	// any part of the book, sorted.
+	Categories []string `json:"categories,omitempty" yaml:"categories,omitempty"`
+
+	ChapterCount int `json:"chapters" yaml:"chapters"`
+	VerseCount   int `json:"verses" yaml:"verses"`
+
+	AlternateNames []string `json:"alternate_names,omitempty" yaml:"alternate_names,omitempty"`
+}
+
+// BookInfo returns a summary of the book, which must belong to this canon. It
+// returns ErrNotFound if it does not.
+func (c *Canon) BookInfo(b *Book) (*BookInfo, error) {
+	infos, err := c.BookInfos()
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range infos {
+		if infos[i].Name == b.Name {
+			return &infos[i], nil
+		}
+	}
+
+	return nil, ErrNotFound
+}
+
+// BookInfos returns a summary of every book of the canon, in canon order.
+// Returns an error if there is a problem with a category definition.
+func (c *Canon) BookInfos() ([]BookInfo, error) {
+	cats, err := c.bookCategories()
+	if err != nil {
+		return nil, err
+	}
+
+	infos := make([]BookInfo, len(c.Books))
+	for i := range c.Books {
+		b := &c.Books[i]
+		infos[i] = BookInfo{
+			Position:       i + 1,
+			Name:           b.Name,
+			USFM:           b.USFM,
+			Testament:      b.Testament,
+			Categories:     cats[b.Name],
+			ChapterCount:   b.ChapterCount(),
+			VerseCount:     b.VerseCount(),
+			AlternateNames: b.AlternateNames,
+		}
+	}
+
+	return infos, nil
+}
+
+// bookCategories returns the sorted names of the categories that include any
+// part of each book, mapped from book name.
+func (c *Canon) bookCategories() (map[string][]string, error) {
+	cats := map[string][]string{}
+	for _, name := range c.CategoryNames() {
+		ps, err := c.Category(name)
+		if err != nil {
+			return nil, err
+		}
+
+		seen := map[string]bool{}
+		for _, p := range ps {
+			bn := p.Ref.Book.Name
+			if !seen[bn] {
+				seen[bn] = true
+				cats[bn] = append(cats[bn], name)
+			}
+		}
+	}
+
+	for _, names := range cats {
+		sort.Strings(names)
+	}
+
+	return cats, nil
+}
@@ -0,0 +1,81 @@
+package ref_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zostay/today/pkg/ref"
+)
+
+func TestCanon_BookInfos(t *testing.T) {
+	t.Parallel()
+
+	infos, err := ref.Canonical.BookInfos()
+	require.NoError(t, err)
+	require.Len(t, infos, len(ref.Canonical.Books))
+
+	assert.Equal(t, ref.BookInfo{
+		Position:     1,
+		Name:         "Genesis",
+		USFM:         "GEN",
+		Testament:    ref.OldTestament,
+		Categories:   []string{"Law"},
+		ChapterCount: 50,
+		VerseCount:   1533,
+	}, infos[0])
+
+	assert.Equal(t, ref.BookInfo{
+		Position:     22,
+		Name:         "Song of Solomon",
+		USFM:         "SNG",
+		Testament:    ref.OldTestament,
+		Categories:   []string{"Wisdom"},
+		ChapterCount: 8,
+		VerseCount:   117,
+		AlternateNames: []string{
+			"Song of Songs",
+			"Canticle of Canticles",
+			"Canticles",
+		},
+	}, infos[21])
+
+	assert.Equal(t, "Matthew", infos[39].Name)
+	assert.Equal(t, ref.NewTestament, infos[39].Testament)
+	assert.Equal(t, ref.OldTestament, infos[38].Testament)
+}
+
+func TestCanon_BookInfo(t *testing.T) {
+	t.Parallel()
+
+	b, err := ref.CatholicCanon.Book("Tobit")
+	require.NoError(t, err)
+
+	info, err := ref.CatholicCanon.BookInfo(b)
+	require.NoError(t, err)
+	assert.Equal(t, 17, info.Position)
+	assert.Equal(t, ref.OldTestament, info.Testament)
+	assert.Equal(t, []string{"Deuterocanon", "History"}, info.Categories)
+	assert.Equal(t, 14, info.ChapterCount)
+
+	// categories include any part of a book and any nested categories
+	cats, err := ref.LoadCategories(strings.NewReader(`
+categories:
+  Visions:
+    Apocalyptic:
+`))
+	require.NoError(t, err)
+	c, err := ref.Canonical.MergeCategories(cats)
+	require.NoError(t, err)
+
+	b, err = c.Book("Daniel")
+	require.NoError(t, err)
+	info, err = c.BookInfo(b)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"Apocalyptic", "Prophets", "Visions"}, info.Categories)
+
+	_, err = c.BookInfo(&ref.Book{Name: "Enoch"})
+	assert.ErrorIs(t, err, ref.ErrNotFound)
+}
@@ -3,8 +3,8 @@ package ref
 import (
 	"errors"
 	"fmt"
-	"sort"
 	"strings"
+	"unicode"
 )
 
 var (
@@ -14,6 +14,10 @@ var (
 	// ErrWideRange is returned when the second reference in a range is not
 	// found.
 	ErrWideRange = errors.New("last verse is after the end of the book")
+
+	// ErrBackwardSpan is returned when the last book of a span comes before
+	// the first book in the canon.
+	ErrBackwardSpan = errors.New("last book of span is before the first book")
 )
 
 type MultipleMatchError struct {
@@ -28,16 +32,61 @@ func (m *MultipleMatchError) Error() string {
 // Book is a book of the Bible. We use this with a global map to do client-side
 // verification of book names, chapter, and verse references.
 type Book struct {
-	Name      string
+	Name string
+
+	// USFM is the three-character USFM (Paratext) code identifying the book
+	// (e.g., "GEN" or "1CO").
+	USFM string
+
+	// Testament is the testament the book belongs to. It is empty if the
+	// testament is not known, which may be the case for a loaded canon.
+	Testament Testament
+
+	// AlternateNames lists other names by which the book is known (e.g., "Song
+	// of Songs" for Song of Solomon).
+	AlternateNames []string
+
 	JustVerse bool
-	Verses    []Verse
+
+	// Verses lists every verse of the book in order.
+	//
+	// Deprecated: The methods of Book work from Runs, which records the same
+	// verses far more compactly. A Book built with only Verses has its runs
+	// worked out from them, but Verses is never filled in, not even for the
+	// books of the built-in canons. Use Runs and the Contains, FirstVerse,
+	// LastVerse, and VerseCount methods instead.
+	Verses []Verse
+
+	// Runs lists the verses of the book in order as runs of consecutive verses
+	// within a chapter. See VerseRun.
+	Runs []VerseRun
 }
 
 // Canon is primarily a collection of books, but may include other metadata.
 type Canon struct {
-	Name       string
+	Name string
+
+	// Versification names the scheme used to number the chapters and verses
+	// of the books (e.g., "kjv"). See Versifications. An empty versification
+	// is the same as "kjv".
+	Versification string
+
 	Books      []Book
 	Categories map[string][]string
+
+	// Subcategories maps the name of a category to the names of the categories
+	// nested within it. The pericopes of a category include those of its
+	// subcategories.
+	Subcategories map[string][]string
+
+	// Pericopes maps the title of each named passage (e.g., "The Sermon on the
+	// Mount") to its reference (e.g., "Matthew 5-7"). See PericopeByTitle.
+	Pericopes map[string]string
+
+	// OmittedVerses maps the abbreviated name of a translation (e.g., "ESV")
+	// to the references of the verses of the canon that it omits from its
+	// text. See Omissions.
+	OmittedVerses map[string][]string
 }
 
 // BookAbbreviations is configuration for book names and abbreviations according
@@ -58,6 +107,15 @@ type BookAbbreviation struct {
 	Singular  string
 	Ordinal   int
 	Accepts   []string
+
+	// Local is the name of the book in the language of these abbreviations
+	// (e.g., "Römer" for Romans). It is empty when the language is English, in
+	// which case Name is used.
+	Local string
+
+	// USFM is the three-character USFM (Paratext) code identifying the book
+	// (e.g., "JHN"). It is accepted when parsing book names in every language.
+	USFM string
 }
 
 // Book will return the Book with the exact given name.
@@ -67,12 +125,41 @@ func (c *Canon) Book(in string, opt ...ResolveOption) (*Book, error) {
 	opts := makeResolveOpts(opt)
 	if opts.Abbreviations != nil {
 		var err error
-		name, err = opts.Abbreviations.BookName(in)
+		name, err = opts.Abbreviations.BookName(in, opt...)
 		if err != nil {
+			if b := c.ownBook(in); b != nil {
+				return b, nil
+			}
 			return nil, err
 		}
 	}
 
+	return c.bookNamed(name)
+}
+
+// ownBook returns the book whose name or one of whose alternate names is the
+// given name, ignoring case, spaces, and punctuation. This finds the books of a
+// loaded canon that the abbreviations do not know (e.g., "Enoch"). It returns
+// nil if there is no such book.
+func (c *Canon) ownBook(in string) *Book {
+	in = cleanAbbreviation(in)
+	for i := range c.Books {
+		b := &c.Books[i]
+		if cleanAbbreviation(b.Name) == in {
+			return b
+		}
+		for _, alt := range b.AlternateNames {
+			if cleanAbbreviation(alt) == in {
+				return b
+			}
+		}
+	}
+	return nil
+}
+
+// bookNamed returns the book with exactly the given name. It returns
+// ErrNotFound if the canon has no such book.
+func (c *Canon) bookNamed(name string) (*Book, error) {
 	for i := range c.Books {
 		b := &c.Books[i]
 		if b.Name == name {
@@ -83,26 +170,55 @@ func (c *Canon) Book(in string, opt ...ResolveOption) (*Book, error) {
 }
 
 // Category returns a list of Pericopes associated with that Category or nil if
-// no such category is defined. Returns nil and error if there's a problem with
-// the category definition.
+// no such category is defined. The list includes the Pericopes of every
+// subcategory, in the order they are nested. Returns nil and error if there's a
+// problem with the category definition.
 func (c *Canon) Category(name string) ([]*Pericope, error) {
-	if refs, hasCategory := c.Categories[name]; hasCategory {
-		var ps []*Pericope
-		for i := range refs {
-			p, err := Lookup(c, refs[i], "")
-			if err != nil {
-				return nil, fmt.Errorf("failed to lookup ref %q: %w", refs[i], err)
-			}
-			ps = append(ps, p)
+	if !c.HasCategory(name) {
+		return nil, nil
+	}
+	return c.categoryPericopes(name, map[string]bool{})
+}
+
+// categoryPericopes returns the Pericopes of the named category and its
+// subcategories, skipping any category already seen.
+func (c *Canon) categoryPericopes(name string, seen map[string]bool) ([]*Pericope, error) {
+	if seen[name] {
+		return nil, nil
+	}
+	seen[name] = true
+
+	var ps []*Pericope
+	refs := c.Categories[name]
+	for i := range refs {
+		p, err := Lookup(c, refs[i], "")
+		if err != nil {
+			return nil, fmt.Errorf("failed to lookup ref %q: %w", refs[i], err)
 		}
-		return ps, nil
+		ps = append(ps, p)
 	}
-	return nil, nil
+
+	for _, sub := range c.Subcategories[name] {
+		subPs, err := c.categoryPericopes(sub, seen)
+		if err != nil {
+			return nil, fmt.Errorf("failed to lookup subcategory %q: %w", sub, err)
+		}
+		ps = append(ps, subPs...)
+	}
+
+	return ps, nil
 }
 
 type resolveOpts struct {
 	Abbreviations *BookAbbreviations
 	Singular      bool
+	Notation      Notation
+	Typography    Typography
+	Grouped       bool
+	SortIn        *Canon
+	Lenient       bool
+	LinkProvider  *LinkProvider
+	LinkDate      func(*Resolved) (string, bool)
 }
 
 type ResolveOption func(*resolveOpts)
@@ -135,6 +251,7 @@ func AsSingleChapter() ResolveOption {
 func makeResolveOpts(opts []ResolveOption) *resolveOpts {
 	o := &resolveOpts{
 		Abbreviations: Abbreviations,
+		Notation:      StandardNotation,
 	}
 	for i := range opts {
 		opts[i](o)
@@ -145,9 +262,13 @@ func makeResolveOpts(opts []ResolveOption) *resolveOpts {
 // Clone returns a copy of the Canon.
 func (c *Canon) Clone() *Canon {
 	newC := Canon{
-		Name:       c.Name,
-		Books:      make([]Book, len(c.Books)),
-		Categories: make(map[string][]string, len(c.Categories)),
+		Name:          c.Name,
+		Versification: c.Versification,
+		Books:         make([]Book, len(c.Books)),
+		Categories:    make(map[string][]string, len(c.Categories)),
+		Subcategories: make(map[string][]string, len(c.Subcategories)),
+		Pericopes:     make(map[string]string, len(c.Pericopes)),
+		OmittedVerses: make(map[string][]string, len(c.OmittedVerses)),
 	}
 
 	for i := range c.Books {
@@ -159,6 +280,20 @@ func (c *Canon) Clone() *Canon {
 		copy(newC.Categories[k], v)
 	}
 
+	for k, v := range c.Subcategories {
+		newC.Subcategories[k] = make([]string, len(v))
+		copy(newC.Subcategories[k], v)
+	}
+
+	for k, v := range c.Pericopes {
+		newC.Pericopes[k] = v
+	}
+
+	for k, v := range c.OmittedVerses {
+		newC.OmittedVerses[k] = make([]string, len(v))
+		copy(newC.OmittedVerses[k], v)
+	}
+
 	return &newC
 }
 
@@ -176,6 +311,8 @@ func (c *Canon) Resolve(ref Absolute, opt ...ResolveOption) ([]Resolved, error)
 		return c.resolveMultiple(r, opts)
 	case *Proper:
 		return c.resolveProper(r, opts)
+	case *Span:
+		return c.resolveSpan(r, opts)
 	case *Resolved:
 		return []Resolved{*r}, nil
 	}
@@ -184,12 +321,17 @@ func (c *Canon) Resolve(ref Absolute, opt ...ResolveOption) ([]Resolved, error)
 
 func (c *Canon) resolveBook(in string, opts *resolveOpts) (*Book, error) {
 	if opts.Abbreviations != nil {
-		name, err := opts.Abbreviations.BookName(in)
+		m, err := opts.Abbreviations.matchBookName(in, opts)
+		if err != nil || m.Confidence < 1 {
+			if b := c.ownBook(in); b != nil {
+				return b, nil
+			}
+		}
 		if err != nil {
 			return nil, err
 		}
 
-		return c.Book(name)
+		return c.bookNamed(m.Name)
 	}
 
 	return c.Book(in)
@@ -212,6 +354,18 @@ func (c *Canon) resolveMultiple(m *Multiple, opts *resolveOpts) ([]Resolved, err
 				return nil, err
 			}
 			rs = append(rs, thisRs...)
+		case *Span:
+			var err error
+			b, err = c.resolveBook(r.LastBook, opts)
+			if err != nil {
+				return nil, err
+			}
+
+			thisRs, err := c.resolveSpan(r, opts)
+			if err != nil {
+				return nil, err
+			}
+			rs = append(rs, thisRs...)
 		case Relative:
 			thisRs, err := c.resolveRelative(b, r)
 			if err != nil {
@@ -226,6 +380,8 @@ func (c *Canon) resolveMultiple(m *Multiple, opts *resolveOpts) ([]Resolved, err
 
 func (c *Canon) resolveRelative(b *Book, r Relative) ([]Resolved, error) {
 	switch r := r.(type) {
+	case *Single:
+		return c.resolveSingle(b, r)
 	case *AndFollowing:
 		return c.resolveAndFollowing(b, r)
 	case *Range:
@@ -255,27 +411,143 @@ func (c *Canon) resolveProper(p *Proper, opts *resolveOpts) ([]Resolved, error)
 	return nil, fmt.Errorf("unknown reference type: %T", p.Verse)
 }
 
+// bookIndex returns the index of the book of this canon with the same name as
+// the given book or -1 if the book does not belong to this canon. Books are
+// matched by name so that a book taken from a clone of this canon or a canon
+// filtered from it is found as well.
+func (c *Canon) bookIndex(b *Book) int {
+	for i := range c.Books {
+		if &c.Books[i] == b || c.Books[i].Name == b.Name {
+			return i
+		}
+	}
+	return -1
+}
+
+// resolveSpan expands the span into one Resolved reference per book, starting
+// with the first book and ending with the last. Every segment but the last is
+// marked Continued.
+func (c *Canon) resolveSpan(s *Span, opts *resolveOpts) ([]Resolved, error) {
+	fb, err := c.resolveBook(s.FirstBook, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	lb, err := c.resolveBook(s.LastBook, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	fi, li := c.bookIndex(fb), c.bookIndex(lb)
+	if fi >= li {
+		return nil, fmt.Errorf("%w: %s-%s", ErrBackwardSpan, fb.Name, lb.Name)
+	}
+
+	first, err := spanStartVerse(fb, s.First)
+	if err != nil {
+		return nil, err
+	}
+
+	last, err := spanEndVerse(lb, s.Last)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.spanSegments(fi, li, first, last), nil
+}
+
+// spanStartVerse returns the verse of b where a span starting at v begins. If
+// v is nil, the span begins at the start of the book. If v names a chapter, the
+// span begins at the start of that chapter.
+func spanStartVerse(b *Book, v Verse) (Verse, error) {
+	if v == nil {
+		return b.FirstVerse(), nil
+	}
+
+	first, _, err := ensureVerseMatchesBook(b, v)
+	if err != nil {
+		return nil, err
+	}
+
+	if !b.Contains(first) {
+		return nil, ErrNotFound
+	}
+
+	return first, nil
+}
+
+// spanEndVerse returns the verse of b where a span ending at v ends. If v is
+// nil, the span ends at the end of the book. If v names a chapter, the span
+// ends at the end of that chapter.
+func spanEndVerse(b *Book, v Verse) (Verse, error) {
+	if v == nil {
+		return b.LastVerse(), nil
+	}
+
+	last, wholeChapter, err := ensureVerseMatchesBook(b, v)
+	if err != nil {
+		return nil, err
+	}
+
+	if wholeChapter {
+		last, err = lastVerseInChapter(b, last)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if !b.Contains(last) {
+		return nil, ErrNotFound
+	}
+
+	return last, nil
+}
+
+// spanSegments returns one Resolved reference per book from the book at index
+// fi to the book at index li, starting at first and ending at last. Every
+// segment but the last is marked Continued.
+func (c *Canon) spanSegments(fi, li int, first, last Verse) []Resolved {
+	rs := make([]Resolved, 0, li-fi+1)
+	for i := fi; i <= li; i++ {
+		b := &c.Books[i]
+		r := Resolved{
+			Book:      b,
+			First:     b.FirstVerse(),
+			Last:      b.LastVerse(),
+			Continued: i < li,
+		}
+
+		if i == fi {
+			r.First = first
+		}
+
+		if i == li {
+			r.Last = last
+		}
+
+		rs = append(rs, r)
+	}
+
+	return rs
+}
+
 func ensureVerseMatchesBook(b *Book, v Verse) (Verse, bool, error) {
 	wholeChapter := false
 
 	// enforce N to CV
 	if !b.JustVerse {
 		if nv, isN := v.(N); isN {
+			if nv.Part != "" {
+				return nil, false, errors.New("expected a chapter, but got a verse part")
+			}
+
 			wholeChapter = true
 
 			// if we have a chapter-only reference, we need to find the first
 			// verse of the chapter, which might not be 1.
-			found := false
-			for i := range b.Verses {
-				if b.Verses[i].(CV).Chapter == nv.Number {
-					v = CV{Chapter: nv.Number, Verse: b.Verses[i].(CV).Verse}
-					found = true
-					break
-				}
-			}
-
-			if !found {
-				v = CV{Chapter: nv.Number, Verse: 1}
+			v = CV{Chapter: nv.Number, Verse: 1}
+			if ri, _ := b.chapterRuns(nv.Number); ri >= 0 {
+				v = CV{Chapter: nv.Number, Verse: b.verseRuns()[ri].First}
 			}
 		}
 	}
@@ -322,6 +594,13 @@ func (c *Canon) resolveAndFollowing(
 		return nil, err
 	}
 
+	// a book need not start at chapter 1 (e.g., Additions to Esther starts at
+	// 10:4), so a reference to the rest of the book from before its first verse
+	// starts with the first verse
+	if a.Following == FollowingRemainingBook && !b.Contains(v) && b.verseBefore(v) == nil {
+		v = b.FirstVerse()
+	}
+
 	if !b.Contains(v) {
 		return nil, ErrNotFound
 	}
@@ -332,7 +611,7 @@ func (c *Canon) resolveAndFollowing(
 			{
 				Book:  b,
 				First: v,
-				Last:  b.Verses[len(b.Verses)-1],
+				Last:  b.LastVerse(),
 			},
 		}, nil
 	default:
@@ -351,54 +630,41 @@ func (c *Canon) resolveAndFollowing(
 	}
 }
 
+// LastVerseInChapter returns the number of the last verse in the given chapter
+// of the book. For a book without chapters, this is the last verse of the book.
 func (b Book) LastVerseInChapter(
 	n int,
 ) (int, error) {
+	runs := b.verseRuns()
 	if b.JustVerse {
-		return b.Verses[len(b.Verses)-1].(N).Number, nil
+		return runs[len(runs)-1].Last, nil
 	}
 
-	fv := CV{Chapter: n, Verse: 1}
-	lv, err := lastVerseInChapter(&b, fv)
-
-	if err != nil {
-		return 0, err
+	_, ri := b.chapterRuns(n)
+	if ri < 0 {
+		return 0, ErrNotFound
 	}
 
-	return lv.(CV).Verse, nil
+	return runs[ri].Last, nil
 }
 
+// lastVerseInChapter returns the last verse in the chapter of the verse v,
+// which must be in the book.
 func lastVerseInChapter(
 	b *Book,
 	v Verse,
 ) (Verse, error) {
 	if b.JustVerse {
-		return b.Verses[len(b.Verses)-1], nil
-	}
-
-	lv := v
-	started := false
-	for i := range b.Verses {
-		if !started {
-			if b.Verses[i].Equal(lv) {
-				started = true
-			}
-			continue
-		}
-
-		cv := b.Verses[i].(CV)
-		if !(N{Number: cv.Chapter}).Equal(v) {
-			break
-		}
-
-		lv = b.Verses[i]
+		return b.LastVerse(), nil
 	}
 
-	if !started {
+	chapter, verse, ok := b.position(v)
+	if !ok || b.findRun(chapter, verse) < 0 {
 		return nil, ErrNotFound
 	}
 
-	return lv, nil
+	_, ri := b.chapterRuns(chapter)
+	return b.verse(chapter, b.verseRuns()[ri].Last), nil
 }
 
 func (c *Canon) resolveRange(
@@ -448,11 +714,17 @@ func (c *Canon) resolveRelated(
 	b *Book,
 	r *Related,
 ) ([]Resolved, error) {
-	var rs []Resolved
+	var (
+		rs      []Resolved
+		chapter int
+	)
 	for i := range r.Refs {
+		var rel Relative
+		rel, chapter = inChapter(r.Refs[i], chapter)
+
 		thisRs, err := c.resolveProper(&Proper{
 			Book:  b.Name,
-			Verse: r.Refs[i],
+			Verse: rel,
 		}, &resolveOpts{})
 		if err != nil {
 			return nil, err
@@ -464,34 +736,86 @@ func (c *Canon) resolveRelated(
 	return rs, nil
 }
 
+// inChapter returns the relative reference with each number that follows a
+// chapter and verse in a list of related references placed in the chapter
+// given, as the 18 of "3:16, 18" (or "3,16.18" in EuropeanNotation) is verse
+// 18 of chapter 3. The chapter is zero if no chapter and verse came before, in
+// which case a number is left as is (e.g., the chapters of "John 3, 5"). It
+// also returns the chapter of the last chapter and verse of the reference,
+// which the numbers of the next reference belong to.
+func inChapter(rel Relative, chapter int) (Relative, int) {
+	place := func(v Verse) Verse {
+		switch v := v.(type) {
+		case CV:
+			chapter = v.Chapter
+		case N:
+			if chapter > 0 {
+				return CV{Chapter: chapter, Verse: v.Number, Part: v.Part}
+			}
+		}
+		return v
+	}
+
+	switch r := rel.(type) {
+	case *Single:
+		return &Single{Verse: place(r.Verse)}, chapter
+	case *AndFollowing:
+		return &AndFollowing{Verse: place(r.Verse), Following: r.Following}, chapter
+	case *Range:
+		first := place(r.First)
+		last := place(r.Last)
+		return &Range{First: first, Last: last}, chapter
+	}
+	return rel, chapter
+}
+
 func (b Book) Clone() Book {
 	newB := Book{
 		Name:      b.Name,
-		Verses:    make([]Verse, len(b.Verses)),
+		USFM:      b.USFM,
+		Testament: b.Testament,
 		JustVerse: b.JustVerse,
 	}
-	copy(newB.Verses, b.Verses)
+
+	runs := b.verseRuns()
+	newB.Runs = make([]VerseRun, len(runs))
+	copy(newB.Runs, runs)
+
+	if b.AlternateNames != nil {
+		newB.AlternateNames = make([]string, len(b.AlternateNames))
+		copy(newB.AlternateNames, b.AlternateNames)
+	}
+
 	return newB
 }
 
 // Contains returns true if the given verse is in the book.
 func (b Book) Contains(v Verse) bool {
-	for i := range b.Verses {
-		if b.Verses[i].Equal(v) {
-			return true
-		}
-	}
-	return false
+	chapter, verse, ok := b.position(v)
+	return ok && b.findRun(chapter, verse) >= 0
 }
 
 // BookName returns the book name that matches the given string. This will apply as
 // liberal a match as possible against the abberviations in the configurations.
 // The word is checked against all possible abbreviations.
 //
-// If there are no matches, this will return ErrNotFound. If there are multiple
-// matches, this will return a MultipleMatchError, which can be interrogated to
-// determine all book names that matched.
-func (b *BookAbbreviations) BookName(in string) (string, error) {
+// If there are no matches, this will return an UnknownBookError, which matches
+// ErrNotFound and suggests similar book names, unless the Lenient option is
+// given and one book is a close enough match. If there are multiple matches,
+// this will return a MultipleMatchError, which can be interrogated to
+// determine all book names that matched. See MatchBookName for details.
+func (b *BookAbbreviations) BookName(in string, opt ...ResolveOption) (string, error) {
+	m, err := b.MatchBookName(in, opt...)
+	if err != nil {
+		return "", err
+	}
+	return m.Name, nil
+}
+
+// exactBookName returns the book name that matches the given string without
+// trying to correct misspellings. It returns ErrNotFound if there is no match
+// or a MultipleMatchError if there is more than one.
+func (b *BookAbbreviations) exactBookName(in string) (string, error) {
 	if b.root == nil {
 		b.root = NewAbbrTree(b)
 	}
@@ -507,17 +831,25 @@ func (b *BookAbbreviations) BookName(in string) (string, error) {
 		return "", fmt.Errorf("%w: %s", ErrNotFound, in)
 	}
 
-	matchNames := make([]string, 0, len(matches))
-	for _, m := range matches {
-		matchNames = append(matchNames, m.Name)
-	}
-
-	sort.Strings(matchNames)
-
 	return "", &MultipleMatchError{
 		Input:   in,
-		Matches: matchNames,
+		Matches: matchNames(matches),
+	}
+}
+
+// suggestBookNames returns the names of up to maxBookSuggestions books with an
+// accepted name or abbreviation similar to the given unknown book name, most
+// similar first.
+func (b *BookAbbreviations) suggestBookNames(in string) []string {
+	near := b.NearBookNames(in)
+	names := make([]string, len(near))
+	for i := range near {
+		names[i] = near[i].Name
+		if local, err := b.LocalName(near[i].Name); err == nil {
+			names[i] = local
+		}
 	}
+	return names
 }
 
 // SingularName returns the name to use for the singular form of the book name.
@@ -534,13 +866,38 @@ func (b *BookAbbreviations) SingularName(name string) (string, error) {
 			if abbr.Singular != "" {
 				return abbr.Singular, nil
 			}
-			return abbr.Name, nil
+			return abbr.localName(), nil
 		}
 	}
 
 	return "", fmt.Errorf("%w: %s", ErrNotFound, name)
 }
 
+// LocalName returns the name of the book in the language of these
+// abbreviations. For English abbreviations, this is the same as the given name.
+// The given name should be the full name of the book as resolved via the
+// BookName method.
+//
+// If the given book is not found in the abbreviations, this will return
+// ErrNotFound.
+func (b *BookAbbreviations) LocalName(name string) (string, error) {
+	for _, abbr := range b.Abbreviations {
+		if abbr.Name == name {
+			return abbr.localName(), nil
+		}
+	}
+
+	return "", fmt.Errorf("%w: %s", ErrNotFound, name)
+}
+
+// localName returns the Local name of the book, if set, or the Name.
+func (a *BookAbbreviation) localName() string {
+	if a.Local != "" {
+		return a.Local
+	}
+	return a.Name
+}
+
 // PreferredAbbreviation returns the preferred abbreviation for the given book
 // name.
 func (b *BookAbbreviations) PreferredAbbreviation(name string) (string, error) {
@@ -567,13 +924,14 @@ func (b *BookAbbreviations) NLetterAbbreviation(name string, n int, withPeriod b
 
 		// Extract number prefix if present (e.g., "1" from "1 John")
 		var prefix string
-		bookName := name
+		localName := abbr.localName()
+		bookName := localName
 		if abbr.Ordinal > 0 {
 			// Find the space after the number
-			spaceIdx := strings.Index(name, " ")
+			spaceIdx := strings.Index(localName, " ")
 			if spaceIdx > 0 {
-				prefix = name[:spaceIdx+1] // Include the space
-				bookName = name[spaceIdx+1:]
+				prefix = localName[:spaceIdx+1] // Include the space
+				bookName = localName[spaceIdx+1:]
 			}
 		}
 
@@ -587,11 +945,9 @@ func (b *BookAbbreviations) NLetterAbbreviation(name string, n int, withPeriod b
 				ordinalPrefixes := []string{
 					"First", "Second", "Third",
 					"1st", "2nd", "3rd",
-					"III", "II", "I",
-					"Ⅲ", "Ⅱ", "Ⅰ",
-					"IV", "V",
-					"Ⅳ", "Ⅴ",
-					"1", "2", "3",
+					"III", "II", "IV", "I", "V",
+					"Ⅲ", "Ⅱ", "Ⅰ", "Ⅳ", "Ⅴ",
+					"1", "2", "3", "4", "5",
 				}
 				for _, pfx := range ordinalPrefixes {
 					if strings.HasPrefix(accept, pfx) {
@@ -604,7 +960,7 @@ func (b *BookAbbreviations) NLetterAbbreviation(name string, n int, withPeriod b
 			// Count only letters (ignore spaces, periods, numbers)
 			letterCount := 0
 			for _, ch := range acceptName {
-				if (ch >= 'A' && ch <= 'Z') || (ch >= 'a' && ch <= 'z') {
+				if unicode.IsLetter(ch) {
 					letterCount++
 				}
 			}
@@ -621,7 +977,7 @@ func (b *BookAbbreviations) NLetterAbbreviation(name string, n int, withPeriod b
 		// Fallback: truncate book name to N letters
 		letters := make([]rune, 0, n)
 		for _, ch := range bookName {
-			if (ch >= 'A' && ch <= 'Z') || (ch >= 'a' && ch <= 'z') {
+			if unicode.IsLetter(ch) {
 				letters = append(letters, ch)
 				if len(letters) == n {
 					break
@@ -552,12 +552,13 @@ func TestBook_LastVerseInChapter(t *testing.T) {
 	for _, b := range ref.Canonical.Books {
 		var lastLv int
 		var prevV ref.Verse
-		for _, v := range b.Verses {
+		verses := bookVerses(&b)
+		for _, v := range verses {
 			if b.JustVerse {
 				lv, err := b.LastVerseInChapter(v.(ref.N).Number)
 				assert.NoError(t, err)
 
-				assert.Equal(t, lv, b.Verses[len(b.Verses)-1].(ref.N).Number)
+				assert.Equal(t, lv, verses[len(verses)-1].(ref.N).Number)
 
 				lastLv = lv
 			} else {
@@ -594,6 +595,17 @@ func TestBook_LastVerseInChapter(t *testing.T) {
 	assert.Error(t, err)
 }
 
+func TestBook_Clone(t *testing.T) {
+	t.Parallel()
+
+	b := ref.Canonical.Books[42].Clone()
+	assert.Equal(t, ref.Canonical.Books[42], b)
+	assert.Equal(t, "JHN", b.USFM)
+
+	c := ref.Canonical.Clone()
+	assert.Equal(t, "JHN", c.Books[42].USFM)
+}
+
 func TestBookAbbreviations_BookName(t *testing.T) {
 	t.Parallel()
 
@@ -665,6 +677,35 @@ func TestBookAbbreviations_BookName(t *testing.T) {
 	assert.ErrorIs(t, err, ref.ErrNotFound)
 }
 
+func TestBookAbbreviations_BookName_USFM(t *testing.T) {
+	t.Parallel()
+
+	for _, abbrs := range []*ref.BookAbbreviations{
+		ref.Abbreviations,
+		ref.AbbreviationsES,
+		ref.AbbreviationsDE,
+	} {
+		for _, b := range ref.Canonical.Books {
+			name, err := abbrs.BookName(b.USFM)
+			assert.NoError(t, err, b.USFM)
+			assert.Equal(t, b.Name, name, b.USFM)
+		}
+	}
+
+	// the USFM code is a complete name, so it wins over Judges
+	name, err := ref.Abbreviations.BookName("JUD")
+	assert.NoError(t, err)
+	assert.Equal(t, "Jude", name)
+
+	p, err := ref.ParseProper("1CO 13:4", ref.ParseWithAbbreviations(ref.Abbreviations))
+	require.NoError(t, err)
+
+	rs, err := ref.Canonical.Resolve(p)
+	require.NoError(t, err)
+	require.Len(t, rs, 1)
+	assert.Equal(t, "1 Corinthians 13:4", rs[0].Ref())
+}
+
 func TestBookAbbreviations_SingularName(t *testing.T) {
 	t.Parallel()
 
@@ -828,3 +869,272 @@ func TestBookAbbreviations_NLetterAbbreviation(t *testing.T) {
 		})
 	}
 }
+
+func TestCanon_Resolve_Span(t *testing.T) {
+	t.Parallel()
+
+	rs, err := ref.Canonical.Resolve(&ref.Span{
+		FirstBook: "Ruth",
+		First:     ref.CV{Chapter: 4, Verse: 18},
+		LastBook:  "1 Samuel",
+		Last:      ref.N{Number: 2},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []ref.Resolved{
+		{
+			Book:      &ref.Canonical.Books[7],
+			First:     ref.CV{Chapter: 4, Verse: 18},
+			Last:      ref.CV{Chapter: 4, Verse: 22},
+			Continued: true,
+		},
+		{
+			Book:  &ref.Canonical.Books[8],
+			First: ref.CV{Chapter: 1, Verse: 1},
+			Last:  ref.CV{Chapter: 2, Verse: 36},
+		},
+	}, rs)
+
+	rs, err = ref.Canonical.Resolve(&ref.Span{
+		FirstBook: "Genesis",
+		LastBook:  "Leviticus",
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []ref.Resolved{
+		{
+			Book:      &ref.Canonical.Books[0],
+			First:     ref.CV{Chapter: 1, Verse: 1},
+			Last:      ref.CV{Chapter: 50, Verse: 26},
+			Continued: true,
+		},
+		{
+			Book:      &ref.Canonical.Books[1],
+			First:     ref.CV{Chapter: 1, Verse: 1},
+			Last:      ref.CV{Chapter: 40, Verse: 38},
+			Continued: true,
+		},
+		{
+			Book:  &ref.Canonical.Books[2],
+			First: ref.CV{Chapter: 1, Verse: 1},
+			Last:  ref.CV{Chapter: 27, Verse: 34},
+		},
+	}, rs)
+
+	_, err = ref.Canonical.Resolve(&ref.Span{
+		FirstBook: "Exodus",
+		LastBook:  "Genesis",
+	})
+	assert.ErrorIs(t, err, ref.ErrBackwardSpan)
+
+	_, err = ref.Canonical.Resolve(&ref.Span{
+		FirstBook: "Genesis",
+		First:     ref.CV{Chapter: 51, Verse: 1},
+		LastBook:  "Exodus",
+	})
+	assert.ErrorIs(t, err, ref.ErrNotFound)
+
+	_, err = ref.Canonical.Resolve(&ref.Span{
+		FirstBook: "Genesis",
+		LastBook:  "Exodus",
+		Last:      ref.CV{Chapter: 41, Verse: 1},
+	})
+	assert.ErrorIs(t, err, ref.ErrNotFound)
+}
+
+func TestCanon_Resolve_Multiple_Span_Abbr(t *testing.T) {
+	t.Parallel()
+
+	rs, err := ref.Canonical.Resolve(
+		&ref.Multiple{
+			Refs: []ref.Ref{
+				&ref.Span{
+					FirstBook: "Mal.",
+					First:     ref.N{Number: 4},
+					LastBook:  "Mt.",
+					Last:      ref.N{Number: 1},
+				},
+			},
+		},
+	)
+	assert.NoError(t, err)
+	assert.Equal(t, []ref.Resolved{
+		{
+			Book:      &ref.Canonical.Books[38],
+			First:     ref.CV{Chapter: 4, Verse: 1},
+			Last:      ref.CV{Chapter: 4, Verse: 6},
+			Continued: true,
+		},
+		{
+			Book:  &ref.Canonical.Books[39],
+			First: ref.CV{Chapter: 1, Verse: 1},
+			Last:  ref.CV{Chapter: 1, Verse: 25},
+		},
+	}, rs)
+}
+
+func TestCanon_Resolve_Multiple_Single(t *testing.T) {
+	t.Parallel()
+
+	rs, err := ref.Canonical.Resolve(
+		&ref.Multiple{
+			Refs: []ref.Ref{
+				&ref.Proper{Book: "John", Verse: &ref.Single{Verse: ref.CV{Chapter: 3, Verse: 16}}},
+				&ref.Single{Verse: ref.CV{Chapter: 4, Verse: 1}},
+				&ref.Proper{Book: "Matthew", Verse: &ref.Single{Verse: ref.CV{Chapter: 5, Verse: 1}}},
+				&ref.Single{Verse: ref.CV{Chapter: 5, Verse: 3}},
+			},
+		},
+	)
+	assert.NoError(t, err)
+	assert.Equal(t, []ref.Resolved{
+		{
+			Book:  &ref.Canonical.Books[42],
+			First: ref.CV{Chapter: 3, Verse: 16},
+			Last:  ref.CV{Chapter: 3, Verse: 16},
+		},
+		{
+			Book:  &ref.Canonical.Books[42],
+			First: ref.CV{Chapter: 4, Verse: 1},
+			Last:  ref.CV{Chapter: 4, Verse: 1},
+		},
+		{
+			Book:  &ref.Canonical.Books[39],
+			First: ref.CV{Chapter: 5, Verse: 1},
+			Last:  ref.CV{Chapter: 5, Verse: 1},
+		},
+		{
+			Book:  &ref.Canonical.Books[39],
+			First: ref.CV{Chapter: 5, Verse: 3},
+			Last:  ref.CV{Chapter: 5, Verse: 3},
+		},
+	}, rs)
+}
+
+func TestCanon_Resolve_Part(t *testing.T) {
+	t.Parallel()
+
+	p, err := ref.ParseProper("Romans 8:28b-29")
+	require.NoError(t, err)
+
+	rs, err := ref.Canonical.Resolve(p)
+	assert.NoError(t, err)
+	assert.Equal(t, []ref.Resolved{
+		{
+			Book:  &ref.Canonical.Books[44],
+			First: ref.CV{Chapter: 8, Verse: 28, Part: "b"},
+			Last:  ref.CV{Chapter: 8, Verse: 29},
+		},
+	}, rs)
+
+	p, err = ref.ParseProper("Philemon 6b")
+	require.NoError(t, err)
+
+	rs, err = ref.Canonical.Resolve(p)
+	assert.NoError(t, err)
+	assert.Equal(t, []ref.Resolved{
+		{
+			Book:  &ref.Canonical.Books[56],
+			First: ref.N{Number: 6, Part: "b"},
+			Last:  ref.N{Number: 6, Part: "b"},
+		},
+	}, rs)
+
+	p, err = ref.ParseProper("John 3:36c")
+	require.NoError(t, err)
+
+	_, err = ref.Canonical.Resolve(p)
+	assert.NoError(t, err)
+
+	p, err = ref.ParseProper("John 3:37a")
+	require.NoError(t, err)
+
+	_, err = ref.Canonical.Resolve(p)
+	assert.ErrorIs(t, err, ref.ErrNotFound)
+
+	p, err = ref.ParseProper("Isaiah 24a")
+	require.NoError(t, err)
+
+	_, err = ref.Canonical.Resolve(p)
+	assert.Error(t, err)
+}
+
+func TestBookAbbreviations_LocalName(t *testing.T) {
+	t.Parallel()
+
+	name, err := ref.AbbreviationsDE.LocalName("Romans")
+	assert.NoError(t, err)
+	assert.Equal(t, "Römer", name)
+
+	name, err = ref.Abbreviations.LocalName("Romans")
+	assert.NoError(t, err)
+	assert.Equal(t, "Romans", name)
+
+	name, err = ref.AbbreviationsES.SingularName("Psalms")
+	assert.NoError(t, err)
+	assert.Equal(t, "Salmo", name)
+
+	name, err = ref.AbbreviationsES.SingularName("John")
+	assert.NoError(t, err)
+	assert.Equal(t, "Juan", name)
+
+	_, err = ref.AbbreviationsDE.LocalName("Sterling")
+	assert.ErrorIs(t, err, ref.ErrNotFound)
+}
+
+func TestBookAbbreviations_NLetterAbbreviation_Local(t *testing.T) {
+	t.Parallel()
+
+	abbr, err := ref.AbbreviationsDE.NLetterAbbreviation("Romans", 3, false)
+	assert.NoError(t, err)
+	assert.Equal(t, "Röm", abbr)
+
+	abbr, err = ref.AbbreviationsDE.NLetterAbbreviation("Leviticus", 2, true)
+	assert.NoError(t, err)
+	assert.Equal(t, "3. Mo.", abbr)
+
+	abbr, err = ref.AbbreviationsES.NLetterAbbreviation("Acts", 3, false)
+	assert.NoError(t, err)
+	assert.Equal(t, "Hch", abbr)
+}
+
+func TestCanon_Resolve_Related_Verses(t *testing.T) {
+	t.Parallel()
+
+	p, err := ref.ParseProper("John 3:16, 18b-20, 22ff, 4:1, 3")
+	require.NoError(t, err)
+
+	john := &ref.Canonical.Books[42]
+	rs, err := ref.Canonical.Resolve(p)
+	assert.NoError(t, err)
+	assert.Equal(t, []ref.Resolved{
+		{Book: john, First: ref.CV{Chapter: 3, Verse: 16}, Last: ref.CV{Chapter: 3, Verse: 16}},
+		{Book: john, First: ref.CV{Chapter: 3, Verse: 18, Part: "b"}, Last: ref.CV{Chapter: 3, Verse: 20}},
+		{Book: john, First: ref.CV{Chapter: 3, Verse: 22}, Last: ref.CV{Chapter: 3, Verse: 36}},
+		{Book: john, First: ref.CV{Chapter: 4, Verse: 1}, Last: ref.CV{Chapter: 4, Verse: 1}},
+		{Book: john, First: ref.CV{Chapter: 4, Verse: 3}, Last: ref.CV{Chapter: 4, Verse: 3}},
+	}, rs)
+
+	p, err = ref.ParseProper("John 3, 5")
+	require.NoError(t, err)
+
+	rs, err = ref.Canonical.Resolve(p)
+	assert.NoError(t, err)
+	assert.Equal(t, []ref.Resolved{
+		{Book: john, First: ref.CV{Chapter: 3, Verse: 1}, Last: ref.CV{Chapter: 3, Verse: 36}},
+		{Book: john, First: ref.CV{Chapter: 5, Verse: 1}, Last: ref.CV{Chapter: 5, Verse: 47}},
+	}, rs)
+}
+
+func BenchmarkCanon_Resolve(b *testing.B) {
+	p, err := ref.ParseProper("Psalm 119:105-112")
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	b.ReportAllocs()
+	for b.Loop() {
+		_, err := ref.Canonical.Resolve(p)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+}
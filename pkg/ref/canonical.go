@@ -3,8183 +3,1737 @@
 package ref
 
 var Canonical = &Canon{
-	Name: "Protestant Canon",
+	Name:          "Protestant Canon",
+	Versification: "kjv",
 	Books: []Book{
 		{
 			Name:      "Genesis",
+			USFM:      "GEN",
+			Testament: OldTestament,
 			JustVerse: false,
-			Verses: []Verse{CV{1, 1},
-				CV{1, 2}, CV{1, 3}, CV{1, 4}, CV{1, 5},
-				CV{1, 6}, CV{1, 7}, CV{1, 8}, CV{1, 9},
-				CV{1, 10}, CV{1, 11}, CV{1, 12}, CV{1, 13},
-				CV{1, 14}, CV{1, 15}, CV{1, 16}, CV{1, 17},
-				CV{1, 18}, CV{1, 19}, CV{1, 20}, CV{1, 21},
-				CV{1, 22}, CV{1, 23}, CV{1, 24}, CV{1, 25},
-				CV{1, 26}, CV{1, 27}, CV{1, 28}, CV{1, 29},
-				CV{1, 30}, CV{1, 31}, CV{2, 1}, CV{2, 2},
-				CV{2, 3}, CV{2, 4}, CV{2, 5}, CV{2, 6},
-				CV{2, 7}, CV{2, 8}, CV{2, 9}, CV{2, 10},
-				CV{2, 11}, CV{2, 12}, CV{2, 13}, CV{2, 14},
-				CV{2, 15}, CV{2, 16}, CV{2, 17}, CV{2, 18},
-				CV{2, 19}, CV{2, 20}, CV{2, 21}, CV{2, 22},
-				CV{2, 23}, CV{2, 24}, CV{2, 25}, CV{3, 1},
-				CV{3, 2}, CV{3, 3}, CV{3, 4}, CV{3, 5},
-				CV{3, 6}, CV{3, 7}, CV{3, 8}, CV{3, 9},
-				CV{3, 10}, CV{3, 11}, CV{3, 12}, CV{3, 13},
-				CV{3, 14}, CV{3, 15}, CV{3, 16}, CV{3, 17},
-				CV{3, 18}, CV{3, 19}, CV{3, 20}, CV{3, 21},
-				CV{3, 22}, CV{3, 23}, CV{3, 24}, CV{4, 1},
-				CV{4, 2}, CV{4, 3}, CV{4, 4}, CV{4, 5},
-				CV{4, 6}, CV{4, 7}, CV{4, 8}, CV{4, 9},
-				CV{4, 10}, CV{4, 11}, CV{4, 12}, CV{4, 13},
-				CV{4, 14}, CV{4, 15}, CV{4, 16}, CV{4, 17},
-				CV{4, 18}, CV{4, 19}, CV{4, 20}, CV{4, 21},
-				CV{4, 22}, CV{4, 23}, CV{4, 24}, CV{4, 25},
-				CV{4, 26}, CV{5, 1}, CV{5, 2}, CV{5, 3},
-				CV{5, 4}, CV{5, 5}, CV{5, 6}, CV{5, 7},
-				CV{5, 8}, CV{5, 9}, CV{5, 10}, CV{5, 11},
-				CV{5, 12}, CV{5, 13}, CV{5, 14}, CV{5, 15},
-				CV{5, 16}, CV{5, 17}, CV{5, 18}, CV{5, 19},
-				CV{5, 20}, CV{5, 21}, CV{5, 22}, CV{5, 23},
-				CV{5, 24}, CV{5, 25}, CV{5, 26}, CV{5, 27},
-				CV{5, 28}, CV{5, 29}, CV{5, 30}, CV{5, 31},
-				CV{5, 32}, CV{6, 1}, CV{6, 2}, CV{6, 3},
-				CV{6, 4}, CV{6, 5}, CV{6, 6}, CV{6, 7},
-				CV{6, 8}, CV{6, 9}, CV{6, 10}, CV{6, 11},
-				CV{6, 12}, CV{6, 13}, CV{6, 14}, CV{6, 15},
-				CV{6, 16}, CV{6, 17}, CV{6, 18}, CV{6, 19},
-				CV{6, 20}, CV{6, 21}, CV{6, 22}, CV{7, 1},
-				CV{7, 2}, CV{7, 3}, CV{7, 4}, CV{7, 5},
-				CV{7, 6}, CV{7, 7}, CV{7, 8}, CV{7, 9},
-				CV{7, 10}, CV{7, 11}, CV{7, 12}, CV{7, 13},
-				CV{7, 14}, CV{7, 15}, CV{7, 16}, CV{7, 17},
-				CV{7, 18}, CV{7, 19}, CV{7, 20}, CV{7, 21},
-				CV{7, 22}, CV{7, 23}, CV{7, 24}, CV{8, 1},
-				CV{8, 2}, CV{8, 3}, CV{8, 4}, CV{8, 5},
-				CV{8, 6}, CV{8, 7}, CV{8, 8}, CV{8, 9},
-				CV{8, 10}, CV{8, 11}, CV{8, 12}, CV{8, 13},
-				CV{8, 14}, CV{8, 15}, CV{8, 16}, CV{8, 17},
-				CV{8, 18}, CV{8, 19}, CV{8, 20}, CV{8, 21},
-				CV{8, 22}, CV{9, 1}, CV{9, 2}, CV{9, 3},
-				CV{9, 4}, CV{9, 5}, CV{9, 6}, CV{9, 7},
-				CV{9, 8}, CV{9, 9}, CV{9, 10}, CV{9, 11},
-				CV{9, 12}, CV{9, 13}, CV{9, 14}, CV{9, 15},
-				CV{9, 16}, CV{9, 17}, CV{9, 18}, CV{9, 19},
-				CV{9, 20}, CV{9, 21}, CV{9, 22}, CV{9, 23},
-				CV{9, 24}, CV{9, 25}, CV{9, 26}, CV{9, 27},
-				CV{9, 28}, CV{9, 29}, CV{10, 1}, CV{10, 2},
-				CV{10, 3}, CV{10, 4}, CV{10, 5}, CV{10, 6},
-				CV{10, 7}, CV{10, 8}, CV{10, 9}, CV{10, 10},
-				CV{10, 11}, CV{10, 12}, CV{10, 13}, CV{10, 14},
-				CV{10, 15}, CV{10, 16}, CV{10, 17}, CV{10, 18},
-				CV{10, 19}, CV{10, 20}, CV{10, 21}, CV{10, 22},
-				CV{10, 23}, CV{10, 24}, CV{10, 25}, CV{10, 26},
-				CV{10, 27}, CV{10, 28}, CV{10, 29}, CV{10, 30},
-				CV{10, 31}, CV{10, 32}, CV{11, 1}, CV{11, 2},
-				CV{11, 3}, CV{11, 4}, CV{11, 5}, CV{11, 6},
-				CV{11, 7}, CV{11, 8}, CV{11, 9}, CV{11, 10},
-				CV{11, 11}, CV{11, 12}, CV{11, 13}, CV{11, 14},
-				CV{11, 15}, CV{11, 16}, CV{11, 17}, CV{11, 18},
-				CV{11, 19}, CV{11, 20}, CV{11, 21}, CV{11, 22},
-				CV{11, 23}, CV{11, 24}, CV{11, 25}, CV{11, 26},
-				CV{11, 27}, CV{11, 28}, CV{11, 29}, CV{11, 30},
-				CV{11, 31}, CV{11, 32}, CV{12, 1}, CV{12, 2},
-				CV{12, 3}, CV{12, 4}, CV{12, 5}, CV{12, 6},
-				CV{12, 7}, CV{12, 8}, CV{12, 9}, CV{12, 10},
-				CV{12, 11}, CV{12, 12}, CV{12, 13}, CV{12, 14},
-				CV{12, 15}, CV{12, 16}, CV{12, 17}, CV{12, 18},
-				CV{12, 19}, CV{12, 20}, CV{13, 1}, CV{13, 2},
-				CV{13, 3}, CV{13, 4}, CV{13, 5}, CV{13, 6},
-				CV{13, 7}, CV{13, 8}, CV{13, 9}, CV{13, 10},
-				CV{13, 11}, CV{13, 12}, CV{13, 13}, CV{13, 14},
-				CV{13, 15}, CV{13, 16}, CV{13, 17}, CV{13, 18},
-				CV{14, 1}, CV{14, 2}, CV{14, 3}, CV{14, 4},
-				CV{14, 5}, CV{14, 6}, CV{14, 7}, CV{14, 8},
-				CV{14, 9}, CV{14, 10}, CV{14, 11}, CV{14, 12},
-				CV{14, 13}, CV{14, 14}, CV{14, 15}, CV{14, 16},
-				CV{14, 17}, CV{14, 18}, CV{14, 19}, CV{14, 20},
-				CV{14, 21}, CV{14, 22}, CV{14, 23}, CV{14, 24},
-				CV{15, 1}, CV{15, 2}, CV{15, 3}, CV{15, 4},
-				CV{15, 5}, CV{15, 6}, CV{15, 7}, CV{15, 8},
-				CV{15, 9}, CV{15, 10}, CV{15, 11}, CV{15, 12},
-				CV{15, 13}, CV{15, 14}, CV{15, 15}, CV{15, 16},
-				CV{15, 17}, CV{15, 18}, CV{15, 19}, CV{15, 20},
-				CV{15, 21}, CV{16, 1}, CV{16, 2}, CV{16, 3},
-				CV{16, 4}, CV{16, 5}, CV{16, 6}, CV{16, 7},
-				CV{16, 8}, CV{16, 9}, CV{16, 10}, CV{16, 11},
-				CV{16, 12}, CV{16, 13}, CV{16, 14}, CV{16, 15},
-				CV{16, 16}, CV{17, 1}, CV{17, 2}, CV{17, 3},
-				CV{17, 4}, CV{17, 5}, CV{17, 6}, CV{17, 7},
-				CV{17, 8}, CV{17, 9}, CV{17, 10}, CV{17, 11},
-				CV{17, 12}, CV{17, 13}, CV{17, 14}, CV{17, 15},
-				CV{17, 16}, CV{17, 17}, CV{17, 18}, CV{17, 19},
-				CV{17, 20}, CV{17, 21}, CV{17, 22}, CV{17, 23},
-				CV{17, 24}, CV{17, 25}, CV{17, 26}, CV{17, 27},
-				CV{18, 1}, CV{18, 2}, CV{18, 3}, CV{18, 4},
-				CV{18, 5}, CV{18, 6}, CV{18, 7}, CV{18, 8},
-				CV{18, 9}, CV{18, 10}, CV{18, 11}, CV{18, 12},
-				CV{18, 13}, CV{18, 14}, CV{18, 15}, CV{18, 16},
-				CV{18, 17}, CV{18, 18}, CV{18, 19}, CV{18, 20},
-				CV{18, 21}, CV{18, 22}, CV{18, 23}, CV{18, 24},
-				CV{18, 25}, CV{18, 26}, CV{18, 27}, CV{18, 28},
-				CV{18, 29}, CV{18, 30}, CV{18, 31}, CV{18, 32},
-				CV{18, 33}, CV{19, 1}, CV{19, 2}, CV{19, 3},
-				CV{19, 4}, CV{19, 5}, CV{19, 6}, CV{19, 7},
-				CV{19, 8}, CV{19, 9}, CV{19, 10}, CV{19, 11},
-				CV{19, 12}, CV{19, 13}, CV{19, 14}, CV{19, 15},
-				CV{19, 16}, CV{19, 17}, CV{19, 18}, CV{19, 19},
-				CV{19, 20}, CV{19, 21}, CV{19, 22}, CV{19, 23},
-				CV{19, 24}, CV{19, 25}, CV{19, 26}, CV{19, 27},
-				CV{19, 28}, CV{19, 29}, CV{19, 30}, CV{19, 31},
-				CV{19, 32}, CV{19, 33}, CV{19, 34}, CV{19, 35},
-				CV{19, 36}, CV{19, 37}, CV{19, 38}, CV{20, 1},
-				CV{20, 2}, CV{20, 3}, CV{20, 4}, CV{20, 5},
-				CV{20, 6}, CV{20, 7}, CV{20, 8}, CV{20, 9},
-				CV{20, 10}, CV{20, 11}, CV{20, 12}, CV{20, 13},
-				CV{20, 14}, CV{20, 15}, CV{20, 16}, CV{20, 17},
-				CV{20, 18}, CV{21, 1}, CV{21, 2}, CV{21, 3},
-				CV{21, 4}, CV{21, 5}, CV{21, 6}, CV{21, 7},
-				CV{21, 8}, CV{21, 9}, CV{21, 10}, CV{21, 11},
-				CV{21, 12}, CV{21, 13}, CV{21, 14}, CV{21, 15},
-				CV{21, 16}, CV{21, 17}, CV{21, 18}, CV{21, 19},
-				CV{21, 20}, CV{21, 21}, CV{21, 22}, CV{21, 23},
-				CV{21, 24}, CV{21, 25}, CV{21, 26}, CV{21, 27},
-				CV{21, 28}, CV{21, 29}, CV{21, 30}, CV{21, 31},
-				CV{21, 32}, CV{21, 33}, CV{21, 34}, CV{22, 1},
-				CV{22, 2}, CV{22, 3}, CV{22, 4}, CV{22, 5},
-				CV{22, 6}, CV{22, 7}, CV{22, 8}, CV{22, 9},
-				CV{22, 10}, CV{22, 11}, CV{22, 12}, CV{22, 13},
-				CV{22, 14}, CV{22, 15}, CV{22, 16}, CV{22, 17},
-				CV{22, 18}, CV{22, 19}, CV{22, 20}, CV{22, 21},
-				CV{22, 22}, CV{22, 23}, CV{22, 24}, CV{23, 1},
-				CV{23, 2}, CV{23, 3}, CV{23, 4}, CV{23, 5},
-				CV{23, 6}, CV{23, 7}, CV{23, 8}, CV{23, 9},
-				CV{23, 10}, CV{23, 11}, CV{23, 12}, CV{23, 13},
-				CV{23, 14}, CV{23, 15}, CV{23, 16}, CV{23, 17},
-				CV{23, 18}, CV{23, 19}, CV{23, 20}, CV{24, 1},
-				CV{24, 2}, CV{24, 3}, CV{24, 4}, CV{24, 5},
-				CV{24, 6}, CV{24, 7}, CV{24, 8}, CV{24, 9},
-				CV{24, 10}, CV{24, 11}, CV{24, 12}, CV{24, 13},
-				CV{24, 14}, CV{24, 15}, CV{24, 16}, CV{24, 17},
-				CV{24, 18}, CV{24, 19}, CV{24, 20}, CV{24, 21},
-				CV{24, 22}, CV{24, 23}, CV{24, 24}, CV{24, 25},
-				CV{24, 26}, CV{24, 27}, CV{24, 28}, CV{24, 29},
-				CV{24, 30}, CV{24, 31}, CV{24, 32}, CV{24, 33},
-				CV{24, 34}, CV{24, 35}, CV{24, 36}, CV{24, 37},
-				CV{24, 38}, CV{24, 39}, CV{24, 40}, CV{24, 41},
-				CV{24, 42}, CV{24, 43}, CV{24, 44}, CV{24, 45},
-				CV{24, 46}, CV{24, 47}, CV{24, 48}, CV{24, 49},
-				CV{24, 50}, CV{24, 51}, CV{24, 52}, CV{24, 53},
-				CV{24, 54}, CV{24, 55}, CV{24, 56}, CV{24, 57},
-				CV{24, 58}, CV{24, 59}, CV{24, 60}, CV{24, 61},
-				CV{24, 62}, CV{24, 63}, CV{24, 64}, CV{24, 65},
-				CV{24, 66}, CV{24, 67}, CV{25, 1}, CV{25, 2},
-				CV{25, 3}, CV{25, 4}, CV{25, 5}, CV{25, 6},
-				CV{25, 7}, CV{25, 8}, CV{25, 9}, CV{25, 10},
-				CV{25, 11}, CV{25, 12}, CV{25, 13}, CV{25, 14},
-				CV{25, 15}, CV{25, 16}, CV{25, 17}, CV{25, 18},
-				CV{25, 19}, CV{25, 20}, CV{25, 21}, CV{25, 22},
-				CV{25, 23}, CV{25, 24}, CV{25, 25}, CV{25, 26},
-				CV{25, 27}, CV{25, 28}, CV{25, 29}, CV{25, 30},
-				CV{25, 31}, CV{25, 32}, CV{25, 33}, CV{25, 34},
-				CV{26, 1}, CV{26, 2}, CV{26, 3}, CV{26, 4},
-				CV{26, 5}, CV{26, 6}, CV{26, 7}, CV{26, 8},
-				CV{26, 9}, CV{26, 10}, CV{26, 11}, CV{26, 12},
-				CV{26, 13}, CV{26, 14}, CV{26, 15}, CV{26, 16},
-				CV{26, 17}, CV{26, 18}, CV{26, 19}, CV{26, 20},
-				CV{26, 21}, CV{26, 22}, CV{26, 23}, CV{26, 24},
-				CV{26, 25}, CV{26, 26}, CV{26, 27}, CV{26, 28},
-				CV{26, 29}, CV{26, 30}, CV{26, 31}, CV{26, 32},
-				CV{26, 33}, CV{26, 34}, CV{26, 35}, CV{27, 1},
-				CV{27, 2}, CV{27, 3}, CV{27, 4}, CV{27, 5},
-				CV{27, 6}, CV{27, 7}, CV{27, 8}, CV{27, 9},
-				CV{27, 10}, CV{27, 11}, CV{27, 12}, CV{27, 13},
-				CV{27, 14}, CV{27, 15}, CV{27, 16}, CV{27, 17},
-				CV{27, 18}, CV{27, 19}, CV{27, 20}, CV{27, 21},
-				CV{27, 22}, CV{27, 23}, CV{27, 24}, CV{27, 25},
-				CV{27, 26}, CV{27, 27}, CV{27, 28}, CV{27, 29},
-				CV{27, 30}, CV{27, 31}, CV{27, 32}, CV{27, 33},
-				CV{27, 34}, CV{27, 35}, CV{27, 36}, CV{27, 37},
-				CV{27, 38}, CV{27, 39}, CV{27, 40}, CV{27, 41},
-				CV{27, 42}, CV{27, 43}, CV{27, 44}, CV{27, 45},
-				CV{27, 46}, CV{28, 1}, CV{28, 2}, CV{28, 3},
-				CV{28, 4}, CV{28, 5}, CV{28, 6}, CV{28, 7},
-				CV{28, 8}, CV{28, 9}, CV{28, 10}, CV{28, 11},
-				CV{28, 12}, CV{28, 13}, CV{28, 14}, CV{28, 15},
-				CV{28, 16}, CV{28, 17}, CV{28, 18}, CV{28, 19},
-				CV{28, 20}, CV{28, 21}, CV{28, 22}, CV{29, 1},
-				CV{29, 2}, CV{29, 3}, CV{29, 4}, CV{29, 5},
-				CV{29, 6}, CV{29, 7}, CV{29, 8}, CV{29, 9},
-				CV{29, 10}, CV{29, 11}, CV{29, 12}, CV{29, 13},
-				CV{29, 14}, CV{29, 15}, CV{29, 16}, CV{29, 17},
-				CV{29, 18}, CV{29, 19}, CV{29, 20}, CV{29, 21},
-				CV{29, 22}, CV{29, 23}, CV{29, 24}, CV{29, 25},
-				CV{29, 26}, CV{29, 27}, CV{29, 28}, CV{29, 29},
-				CV{29, 30}, CV{29, 31}, CV{29, 32}, CV{29, 33},
-				CV{29, 34}, CV{29, 35}, CV{30, 1}, CV{30, 2},
-				CV{30, 3}, CV{30, 4}, CV{30, 5}, CV{30, 6},
-				CV{30, 7}, CV{30, 8}, CV{30, 9}, CV{30, 10},
-				CV{30, 11}, CV{30, 12}, CV{30, 13}, CV{30, 14},
-				CV{30, 15}, CV{30, 16}, CV{30, 17}, CV{30, 18},
-				CV{30, 19}, CV{30, 20}, CV{30, 21}, CV{30, 22},
-				CV{30, 23}, CV{30, 24}, CV{30, 25}, CV{30, 26},
-				CV{30, 27}, CV{30, 28}, CV{30, 29}, CV{30, 30},
-				CV{30, 31}, CV{30, 32}, CV{30, 33}, CV{30, 34},
-				CV{30, 35}, CV{30, 36}, CV{30, 37}, CV{30, 38},
-				CV{30, 39}, CV{30, 40}, CV{30, 41}, CV{30, 42},
-				CV{30, 43}, CV{31, 1}, CV{31, 2}, CV{31, 3},
-				CV{31, 4}, CV{31, 5}, CV{31, 6}, CV{31, 7},
-				CV{31, 8}, CV{31, 9}, CV{31, 10}, CV{31, 11},
-				CV{31, 12}, CV{31, 13}, CV{31, 14}, CV{31, 15},
-				CV{31, 16}, CV{31, 17}, CV{31, 18}, CV{31, 19},
-				CV{31, 20}, CV{31, 21}, CV{31, 22}, CV{31, 23},
-				CV{31, 24}, CV{31, 25}, CV{31, 26}, CV{31, 27},
-				CV{31, 28}, CV{31, 29}, CV{31, 30}, CV{31, 31},
-				CV{31, 32}, CV{31, 33}, CV{31, 34}, CV{31, 35},
-				CV{31, 36}, CV{31, 37}, CV{31, 38}, CV{31, 39},
-				CV{31, 40}, CV{31, 41}, CV{31, 42}, CV{31, 43},
-				CV{31, 44}, CV{31, 45}, CV{31, 46}, CV{31, 47},
-				CV{31, 48}, CV{31, 49}, CV{31, 50}, CV{31, 51},
-				CV{31, 52}, CV{31, 53}, CV{31, 54}, CV{31, 55},
-				CV{32, 1}, CV{32, 2}, CV{32, 3}, CV{32, 4},
-				CV{32, 5}, CV{32, 6}, CV{32, 7}, CV{32, 8},
-				CV{32, 9}, CV{32, 10}, CV{32, 11}, CV{32, 12},
-				CV{32, 13}, CV{32, 14}, CV{32, 15}, CV{32, 16},
-				CV{32, 17}, CV{32, 18}, CV{32, 19}, CV{32, 20},
-				CV{32, 21}, CV{32, 22}, CV{32, 23}, CV{32, 24},
-				CV{32, 25}, CV{32, 26}, CV{32, 27}, CV{32, 28},
-				CV{32, 29}, CV{32, 30}, CV{32, 31}, CV{32, 32},
-				CV{33, 1}, CV{33, 2}, CV{33, 3}, CV{33, 4},
-				CV{33, 5}, CV{33, 6}, CV{33, 7}, CV{33, 8},
-				CV{33, 9}, CV{33, 10}, CV{33, 11}, CV{33, 12},
-				CV{33, 13}, CV{33, 14}, CV{33, 15}, CV{33, 16},
-				CV{33, 17}, CV{33, 18}, CV{33, 19}, CV{33, 20},
-				CV{34, 1}, CV{34, 2}, CV{34, 3}, CV{34, 4},
-				CV{34, 5}, CV{34, 6}, CV{34, 7}, CV{34, 8},
-				CV{34, 9}, CV{34, 10}, CV{34, 11}, CV{34, 12},
-				CV{34, 13}, CV{34, 14}, CV{34, 15}, CV{34, 16},
-				CV{34, 17}, CV{34, 18}, CV{34, 19}, CV{34, 20},
-				CV{34, 21}, CV{34, 22}, CV{34, 23}, CV{34, 24},
-				CV{34, 25}, CV{34, 26}, CV{34, 27}, CV{34, 28},
-				CV{34, 29}, CV{34, 30}, CV{34, 31}, CV{35, 1},
-				CV{35, 2}, CV{35, 3}, CV{35, 4}, CV{35, 5},
-				CV{35, 6}, CV{35, 7}, CV{35, 8}, CV{35, 9},
-				CV{35, 10}, CV{35, 11}, CV{35, 12}, CV{35, 13},
-				CV{35, 14}, CV{35, 15}, CV{35, 16}, CV{35, 17},
-				CV{35, 18}, CV{35, 19}, CV{35, 20}, CV{35, 21},
-				CV{35, 22}, CV{35, 23}, CV{35, 24}, CV{35, 25},
-				CV{35, 26}, CV{35, 27}, CV{35, 28}, CV{35, 29},
-				CV{36, 1}, CV{36, 2}, CV{36, 3}, CV{36, 4},
-				CV{36, 5}, CV{36, 6}, CV{36, 7}, CV{36, 8},
-				CV{36, 9}, CV{36, 10}, CV{36, 11}, CV{36, 12},
-				CV{36, 13}, CV{36, 14}, CV{36, 15}, CV{36, 16},
-				CV{36, 17}, CV{36, 18}, CV{36, 19}, CV{36, 20},
-				CV{36, 21}, CV{36, 22}, CV{36, 23}, CV{36, 24},
-				CV{36, 25}, CV{36, 26}, CV{36, 27}, CV{36, 28},
-				CV{36, 29}, CV{36, 30}, CV{36, 31}, CV{36, 32},
-				CV{36, 33}, CV{36, 34}, CV{36, 35}, CV{36, 36},
-				CV{36, 37}, CV{36, 38}, CV{36, 39}, CV{36, 40},
-				CV{36, 41}, CV{36, 42}, CV{36, 43}, CV{37, 1},
-				CV{37, 2}, CV{37, 3}, CV{37, 4}, CV{37, 5},
-				CV{37, 6}, CV{37, 7}, CV{37, 8}, CV{37, 9},
-				CV{37, 10}, CV{37, 11}, CV{37, 12}, CV{37, 13},
-				CV{37, 14}, CV{37, 15}, CV{37, 16}, CV{37, 17},
-				CV{37, 18}, CV{37, 19}, CV{37, 20}, CV{37, 21},
-				CV{37, 22}, CV{37, 23}, CV{37, 24}, CV{37, 25},
-				CV{37, 26}, CV{37, 27}, CV{37, 28}, CV{37, 29},
-				CV{37, 30}, CV{37, 31}, CV{37, 32}, CV{37, 33},
-				CV{37, 34}, CV{37, 35}, CV{37, 36}, CV{38, 1},
-				CV{38, 2}, CV{38, 3}, CV{38, 4}, CV{38, 5},
-				CV{38, 6}, CV{38, 7}, CV{38, 8}, CV{38, 9},
-				CV{38, 10}, CV{38, 11}, CV{38, 12}, CV{38, 13},
-				CV{38, 14}, CV{38, 15}, CV{38, 16}, CV{38, 17},
-				CV{38, 18}, CV{38, 19}, CV{38, 20}, CV{38, 21},
-				CV{38, 22}, CV{38, 23}, CV{38, 24}, CV{38, 25},
-				CV{38, 26}, CV{38, 27}, CV{38, 28}, CV{38, 29},
-				CV{38, 30}, CV{39, 1}, CV{39, 2}, CV{39, 3},
-				CV{39, 4}, CV{39, 5}, CV{39, 6}, CV{39, 7},
-				CV{39, 8}, CV{39, 9}, CV{39, 10}, CV{39, 11},
-				CV{39, 12}, CV{39, 13}, CV{39, 14}, CV{39, 15},
-				CV{39, 16}, CV{39, 17}, CV{39, 18}, CV{39, 19},
-				CV{39, 20}, CV{39, 21}, CV{39, 22}, CV{39, 23},
-				CV{40, 1}, CV{40, 2}, CV{40, 3}, CV{40, 4},
-				CV{40, 5}, CV{40, 6}, CV{40, 7}, CV{40, 8},
-				CV{40, 9}, CV{40, 10}, CV{40, 11}, CV{40, 12},
-				CV{40, 13}, CV{40, 14}, CV{40, 15}, CV{40, 16},
-				CV{40, 17}, CV{40, 18}, CV{40, 19}, CV{40, 20},
-				CV{40, 21}, CV{40, 22}, CV{40, 23}, CV{41, 1},
-				CV{41, 2}, CV{41, 3}, CV{41, 4}, CV{41, 5},
-				CV{41, 6}, CV{41, 7}, CV{41, 8}, CV{41, 9},
-				CV{41, 10}, CV{41, 11}, CV{41, 12}, CV{41, 13},
-				CV{41, 14}, CV{41, 15}, CV{41, 16}, CV{41, 17},
-				CV{41, 18}, CV{41, 19}, CV{41, 20}, CV{41, 21},
-				CV{41, 22}, CV{41, 23}, CV{41, 24}, CV{41, 25},
-				CV{41, 26}, CV{41, 27}, CV{41, 28}, CV{41, 29},
-				CV{41, 30}, CV{41, 31}, CV{41, 32}, CV{41, 33},
-				CV{41, 34}, CV{41, 35}, CV{41, 36}, CV{41, 37},
-				CV{41, 38}, CV{41, 39}, CV{41, 40}, CV{41, 41},
-				CV{41, 42}, CV{41, 43}, CV{41, 44}, CV{41, 45},
-				CV{41, 46}, CV{41, 47}, CV{41, 48}, CV{41, 49},
-				CV{41, 50}, CV{41, 51}, CV{41, 52}, CV{41, 53},
-				CV{41, 54}, CV{41, 55}, CV{41, 56}, CV{41, 57},
-				CV{42, 1}, CV{42, 2}, CV{42, 3}, CV{42, 4},
-				CV{42, 5}, CV{42, 6}, CV{42, 7}, CV{42, 8},
-				CV{42, 9}, CV{42, 10}, CV{42, 11}, CV{42, 12},
-				CV{42, 13}, CV{42, 14}, CV{42, 15}, CV{42, 16},
-				CV{42, 17}, CV{42, 18}, CV{42, 19}, CV{42, 20},
-				CV{42, 21}, CV{42, 22}, CV{42, 23}, CV{42, 24},
-				CV{42, 25}, CV{42, 26}, CV{42, 27}, CV{42, 28},
-				CV{42, 29}, CV{42, 30}, CV{42, 31}, CV{42, 32},
-				CV{42, 33}, CV{42, 34}, CV{42, 35}, CV{42, 36},
-				CV{42, 37}, CV{42, 38}, CV{43, 1}, CV{43, 2},
-				CV{43, 3}, CV{43, 4}, CV{43, 5}, CV{43, 6},
-				CV{43, 7}, CV{43, 8}, CV{43, 9}, CV{43, 10},
-				CV{43, 11}, CV{43, 12}, CV{43, 13}, CV{43, 14},
-				CV{43, 15}, CV{43, 16}, CV{43, 17}, CV{43, 18},
-				CV{43, 19}, CV{43, 20}, CV{43, 21}, CV{43, 22},
-				CV{43, 23}, CV{43, 24}, CV{43, 25}, CV{43, 26},
-				CV{43, 27}, CV{43, 28}, CV{43, 29}, CV{43, 30},
-				CV{43, 31}, CV{43, 32}, CV{43, 33}, CV{43, 34},
-				CV{44, 1}, CV{44, 2}, CV{44, 3}, CV{44, 4},
-				CV{44, 5}, CV{44, 6}, CV{44, 7}, CV{44, 8},
-				CV{44, 9}, CV{44, 10}, CV{44, 11}, CV{44, 12},
-				CV{44, 13}, CV{44, 14}, CV{44, 15}, CV{44, 16},
-				CV{44, 17}, CV{44, 18}, CV{44, 19}, CV{44, 20},
-				CV{44, 21}, CV{44, 22}, CV{44, 23}, CV{44, 24},
-				CV{44, 25}, CV{44, 26}, CV{44, 27}, CV{44, 28},
-				CV{44, 29}, CV{44, 30}, CV{44, 31}, CV{44, 32},
-				CV{44, 33}, CV{44, 34}, CV{45, 1}, CV{45, 2},
-				CV{45, 3}, CV{45, 4}, CV{45, 5}, CV{45, 6},
-				CV{45, 7}, CV{45, 8}, CV{45, 9}, CV{45, 10},
-				CV{45, 11}, CV{45, 12}, CV{45, 13}, CV{45, 14},
-				CV{45, 15}, CV{45, 16}, CV{45, 17}, CV{45, 18},
-				CV{45, 19}, CV{45, 20}, CV{45, 21}, CV{45, 22},
-				CV{45, 23}, CV{45, 24}, CV{45, 25}, CV{45, 26},
-				CV{45, 27}, CV{45, 28}, CV{46, 1}, CV{46, 2},
-				CV{46, 3}, CV{46, 4}, CV{46, 5}, CV{46, 6},
-				CV{46, 7}, CV{46, 8}, CV{46, 9}, CV{46, 10},
-				CV{46, 11}, CV{46, 12}, CV{46, 13}, CV{46, 14},
-				CV{46, 15}, CV{46, 16}, CV{46, 17}, CV{46, 18},
-				CV{46, 19}, CV{46, 20}, CV{46, 21}, CV{46, 22},
-				CV{46, 23}, CV{46, 24}, CV{46, 25}, CV{46, 26},
-				CV{46, 27}, CV{46, 28}, CV{46, 29}, CV{46, 30},
-				CV{46, 31}, CV{46, 32}, CV{46, 33}, CV{46, 34},
-				CV{47, 1}, CV{47, 2}, CV{47, 3}, CV{47, 4},
-				CV{47, 5}, CV{47, 6}, CV{47, 7}, CV{47, 8},
-				CV{47, 9}, CV{47, 10}, CV{47, 11}, CV{47, 12},
-				CV{47, 13}, CV{47, 14}, CV{47, 15}, CV{47, 16},
-				CV{47, 17}, CV{47, 18}, CV{47, 19}, CV{47, 20},
-				CV{47, 21}, CV{47, 22}, CV{47, 23}, CV{47, 24},
-				CV{47, 25}, CV{47, 26}, CV{47, 27}, CV{47, 28},
-				CV{47, 29}, CV{47, 30}, CV{47, 31}, CV{48, 1},
-				CV{48, 2}, CV{48, 3}, CV{48, 4}, CV{48, 5},
-				CV{48, 6}, CV{48, 7}, CV{48, 8}, CV{48, 9},
-				CV{48, 10}, CV{48, 11}, CV{48, 12}, CV{48, 13},
-				CV{48, 14}, CV{48, 15}, CV{48, 16}, CV{48, 17},
-				CV{48, 18}, CV{48, 19}, CV{48, 20}, CV{48, 21},
-				CV{48, 22}, CV{49, 1}, CV{49, 2}, CV{49, 3},
-				CV{49, 4}, CV{49, 5}, CV{49, 6}, CV{49, 7},
-				CV{49, 8}, CV{49, 9}, CV{49, 10}, CV{49, 11},
-				CV{49, 12}, CV{49, 13}, CV{49, 14}, CV{49, 15},
-				CV{49, 16}, CV{49, 17}, CV{49, 18}, CV{49, 19},
-				CV{49, 20}, CV{49, 21}, CV{49, 22}, CV{49, 23},
-				CV{49, 24}, CV{49, 25}, CV{49, 26}, CV{49, 27},
-				CV{49, 28}, CV{49, 29}, CV{49, 30}, CV{49, 31},
-				CV{49, 32}, CV{49, 33}, CV{50, 1}, CV{50, 2},
-				CV{50, 3}, CV{50, 4}, CV{50, 5}, CV{50, 6},
-				CV{50, 7}, CV{50, 8}, CV{50, 9}, CV{50, 10},
-				CV{50, 11}, CV{50, 12}, CV{50, 13}, CV{50, 14},
-				CV{50, 15}, CV{50, 16}, CV{50, 17}, CV{50, 18},
-				CV{50, 19}, CV{50, 20}, CV{50, 21}, CV{50, 22},
-				CV{50, 23}, CV{50, 24}, CV{50, 25}, CV{50, 26},
+			Runs: []VerseRun{
+				{Chapter: 1, First: 1, Last: 31},
+				{Chapter: 2, First: 1, Last: 25},
+				{Chapter: 3, First: 1, Last: 24},
+				{Chapter: 4, First: 1, Last: 26},
+				{Chapter: 5, First: 1, Last: 32},
+				{Chapter: 6, First: 1, Last: 22},
+				{Chapter: 7, First: 1, Last: 24},
+				{Chapter: 8, First: 1, Last: 22},
+				{Chapter: 9, First: 1, Last: 29},
+				{Chapter: 10, First: 1, Last: 32},
+				{Chapter: 11, First: 1, Last: 32},
+				{Chapter: 12, First: 1, Last: 20},
+				{Chapter: 13, First: 1, Last: 18},
+				{Chapter: 14, First: 1, Last: 24},
+				{Chapter: 15, First: 1, Last: 21},
+				{Chapter: 16, First: 1, Last: 16},
+				{Chapter: 17, First: 1, Last: 27},
+				{Chapter: 18, First: 1, Last: 33},
+				{Chapter: 19, First: 1, Last: 38},
+				{Chapter: 20, First: 1, Last: 18},
+				{Chapter: 21, First: 1, Last: 34},
+				{Chapter: 22, First: 1, Last: 24},
+				{Chapter: 23, First: 1, Last: 20},
+				{Chapter: 24, First: 1, Last: 67},
+				{Chapter: 25, First: 1, Last: 34},
+				{Chapter: 26, First: 1, Last: 35},
+				{Chapter: 27, First: 1, Last: 46},
+				{Chapter: 28, First: 1, Last: 22},
+				{Chapter: 29, First: 1, Last: 35},
+				{Chapter: 30, First: 1, Last: 43},
+				{Chapter: 31, First: 1, Last: 55},
+				{Chapter: 32, First: 1, Last: 32},
+				{Chapter: 33, First: 1, Last: 20},
+				{Chapter: 34, First: 1, Last: 31},
+				{Chapter: 35, First: 1, Last: 29},
+				{Chapter: 36, First: 1, Last: 43},
+				{Chapter: 37, First: 1, Last: 36},
+				{Chapter: 38, First: 1, Last: 30},
+				{Chapter: 39, First: 1, Last: 23},
+				{Chapter: 40, First: 1, Last: 23},
+				{Chapter: 41, First: 1, Last: 57},
+				{Chapter: 42, First: 1, Last: 38},
+				{Chapter: 43, First: 1, Last: 34},
+				{Chapter: 44, First: 1, Last: 34},
+				{Chapter: 45, First: 1, Last: 28},
+				{Chapter: 46, First: 1, Last: 34},
+				{Chapter: 47, First: 1, Last: 31},
+				{Chapter: 48, First: 1, Last: 22},
+				{Chapter: 49, First: 1, Last: 33},
+				{Chapter: 50, First: 1, Last: 26},
 			},
 		},
 		{
 			Name:      "Exodus",
+			USFM:      "EXO",
+			Testament: OldTestament,
 			JustVerse: false,
-			Verses: []Verse{CV{1, 1},
-				CV{1, 2}, CV{1, 3}, CV{1, 4}, CV{1, 5},
-				CV{1, 6}, CV{1, 7}, CV{1, 8}, CV{1, 9},
-				CV{1, 10}, CV{1, 11}, CV{1, 12}, CV{1, 13},
-				CV{1, 14}, CV{1, 15}, CV{1, 16}, CV{1, 17},
-				CV{1, 18}, CV{1, 19}, CV{1, 20}, CV{1, 21},
-				CV{1, 22}, CV{2, 1}, CV{2, 2}, CV{2, 3},
-				CV{2, 4}, CV{2, 5}, CV{2, 6}, CV{2, 7},
-				CV{2, 8}, CV{2, 9}, CV{2, 10}, CV{2, 11},
-				CV{2, 12}, CV{2, 13}, CV{2, 14}, CV{2, 15},
-				CV{2, 16}, CV{2, 17}, CV{2, 18}, CV{2, 19},
-				CV{2, 20}, CV{2, 21}, CV{2, 22}, CV{2, 23},
-				CV{2, 24}, CV{2, 25}, CV{3, 1}, CV{3, 2},
-				CV{3, 3}, CV{3, 4}, CV{3, 5}, CV{3, 6},
-				CV{3, 7}, CV{3, 8}, CV{3, 9}, CV{3, 10},
-				CV{3, 11}, CV{3, 12}, CV{3, 13}, CV{3, 14},
-				CV{3, 15}, CV{3, 16}, CV{3, 17}, CV{3, 18},
-				CV{3, 19}, CV{3, 20}, CV{3, 21}, CV{3, 22},
-				CV{4, 1}, CV{4, 2}, CV{4, 3}, CV{4, 4},
-				CV{4, 5}, CV{4, 6}, CV{4, 7}, CV{4, 8},
-				CV{4, 9}, CV{4, 10}, CV{4, 11}, CV{4, 12},
-				CV{4, 13}, CV{4, 14}, CV{4, 15}, CV{4, 16},
-				CV{4, 17}, CV{4, 18}, CV{4, 19}, CV{4, 20},
-				CV{4, 21}, CV{4, 22}, CV{4, 23}, CV{4, 24},
-				CV{4, 25}, CV{4, 26}, CV{4, 27}, CV{4, 28},
-				CV{4, 29}, CV{4, 30}, CV{4, 31}, CV{5, 1},
-				CV{5, 2}, CV{5, 3}, CV{5, 4}, CV{5, 5},
-				CV{5, 6}, CV{5, 7}, CV{5, 8}, CV{5, 9},
-				CV{5, 10}, CV{5, 11}, CV{5, 12}, CV{5, 13},
-				CV{5, 14}, CV{5, 15}, CV{5, 16}, CV{5, 17},
-				CV{5, 18}, CV{5, 19}, CV{5, 20}, CV{5, 21},
-				CV{5, 22}, CV{5, 23}, CV{6, 1}, CV{6, 2},
-				CV{6, 3}, CV{6, 4}, CV{6, 5}, CV{6, 6},
-				CV{6, 7}, CV{6, 8}, CV{6, 9}, CV{6, 10},
-				CV{6, 11}, CV{6, 12}, CV{6, 13}, CV{6, 14},
-				CV{6, 15}, CV{6, 16}, CV{6, 17}, CV{6, 18},
-				CV{6, 19}, CV{6, 20}, CV{6, 21}, CV{6, 22},
-				CV{6, 23}, CV{6, 24}, CV{6, 25}, CV{6, 26},
-				CV{6, 27}, CV{6, 28}, CV{6, 29}, CV{6, 30},
-				CV{7, 1}, CV{7, 2}, CV{7, 3}, CV{7, 4},
-				CV{7, 5}, CV{7, 6}, CV{7, 7}, CV{7, 8},
-				CV{7, 9}, CV{7, 10}, CV{7, 11}, CV{7, 12},
-				CV{7, 13}, CV{7, 14}, CV{7, 15}, CV{7, 16},
-				CV{7, 17}, CV{7, 18}, CV{7, 19}, CV{7, 20},
-				CV{7, 21}, CV{7, 22}, CV{7, 23}, CV{7, 24},
-				CV{7, 25}, CV{8, 1}, CV{8, 2}, CV{8, 3},
-				CV{8, 4}, CV{8, 5}, CV{8, 6}, CV{8, 7},
-				CV{8, 8}, CV{8, 9}, CV{8, 10}, CV{8, 11},
-				CV{8, 12}, CV{8, 13}, CV{8, 14}, CV{8, 15},
-				CV{8, 16}, CV{8, 17}, CV{8, 18}, CV{8, 19},
-				CV{8, 20}, CV{8, 21}, CV{8, 22}, CV{8, 23},
-				CV{8, 24}, CV{8, 25}, CV{8, 26}, CV{8, 27},
-				CV{8, 28}, CV{8, 29}, CV{8, 30}, CV{8, 31},
-				CV{8, 32}, CV{9, 1}, CV{9, 2}, CV{9, 3},
-				CV{9, 4}, CV{9, 5}, CV{9, 6}, CV{9, 7},
-				CV{9, 8}, CV{9, 9}, CV{9, 10}, CV{9, 11},
-				CV{9, 12}, CV{9, 13}, CV{9, 14}, CV{9, 15},
-				CV{9, 16}, CV{9, 17}, CV{9, 18}, CV{9, 19},
-				CV{9, 20}, CV{9, 21}, CV{9, 22}, CV{9, 23},
-				CV{9, 24}, CV{9, 25}, CV{9, 26}, CV{9, 27},
-				CV{9, 28}, CV{9, 29}, CV{9, 30}, CV{9, 31},
-				CV{9, 32}, CV{9, 33}, CV{9, 34}, CV{9, 35},
-				CV{10, 1}, CV{10, 2}, CV{10, 3}, CV{10, 4},
-				CV{10, 5}, CV{10, 6}, CV{10, 7}, CV{10, 8},
-				CV{10, 9}, CV{10, 10}, CV{10, 11}, CV{10, 12},
-				CV{10, 13}, CV{10, 14}, CV{10, 15}, CV{10, 16},
-				CV{10, 17}, CV{10, 18}, CV{10, 19}, CV{10, 20},
-				CV{10, 21}, CV{10, 22}, CV{10, 23}, CV{10, 24},
-				CV{10, 25}, CV{10, 26}, CV{10, 27}, CV{10, 28},
-				CV{10, 29}, CV{11, 1}, CV{11, 2}, CV{11, 3},
-				CV{11, 4}, CV{11, 5}, CV{11, 6}, CV{11, 7},
-				CV{11, 8}, CV{11, 9}, CV{11, 10}, CV{12, 1},
-				CV{12, 2}, CV{12, 3}, CV{12, 4}, CV{12, 5},
-				CV{12, 6}, CV{12, 7}, CV{12, 8}, CV{12, 9},
-				CV{12, 10}, CV{12, 11}, CV{12, 12}, CV{12, 13},
-				CV{12, 14}, CV{12, 15}, CV{12, 16}, CV{12, 17},
-				CV{12, 18}, CV{12, 19}, CV{12, 20}, CV{12, 21},
-				CV{12, 22}, CV{12, 23}, CV{12, 24}, CV{12, 25},
-				CV{12, 26}, CV{12, 27}, CV{12, 28}, CV{12, 29},
-				CV{12, 30}, CV{12, 31}, CV{12, 32}, CV{12, 33},
-				CV{12, 34}, CV{12, 35}, CV{12, 36}, CV{12, 37},
-				CV{12, 38}, CV{12, 39}, CV{12, 40}, CV{12, 41},
-				CV{12, 42}, CV{12, 43}, CV{12, 44}, CV{12, 45},
-				CV{12, 46}, CV{12, 47}, CV{12, 48}, CV{12, 49},
-				CV{12, 50}, CV{12, 51}, CV{13, 1}, CV{13, 2},
-				CV{13, 3}, CV{13, 4}, CV{13, 5}, CV{13, 6},
-				CV{13, 7}, CV{13, 8}, CV{13, 9}, CV{13, 10},
-				CV{13, 11}, CV{13, 12}, CV{13, 13}, CV{13, 14},
-				CV{13, 15}, CV{13, 16}, CV{13, 17}, CV{13, 18},
-				CV{13, 19}, CV{13, 20}, CV{13, 21}, CV{13, 22},
-				CV{14, 1}, CV{14, 2}, CV{14, 3}, CV{14, 4},
-				CV{14, 5}, CV{14, 6}, CV{14, 7}, CV{14, 8},
-				CV{14, 9}, CV{14, 10}, CV{14, 11}, CV{14, 12},
-				CV{14, 13}, CV{14, 14}, CV{14, 15}, CV{14, 16},
-				CV{14, 17}, CV{14, 18}, CV{14, 19}, CV{14, 20},
-				CV{14, 21}, CV{14, 22}, CV{14, 23}, CV{14, 24},
-				CV{14, 25}, CV{14, 26}, CV{14, 27}, CV{14, 28},
-				CV{14, 29}, CV{14, 30}, CV{14, 31}, CV{15, 1},
-				CV{15, 2}, CV{15, 3}, CV{15, 4}, CV{15, 5},
-				CV{15, 6}, CV{15, 7}, CV{15, 8}, CV{15, 9},
-				CV{15, 10}, CV{15, 11}, CV{15, 12}, CV{15, 13},
-				CV{15, 14}, CV{15, 15}, CV{15, 16}, CV{15, 17},
-				CV{15, 18}, CV{15, 19}, CV{15, 20}, CV{15, 21},
-				CV{15, 22}, CV{15, 23}, CV{15, 24}, CV{15, 25},
-				CV{15, 26}, CV{15, 27}, CV{16, 1}, CV{16, 2},
-				CV{16, 3}, CV{16, 4}, CV{16, 5}, CV{16, 6},
-				CV{16, 7}, CV{16, 8}, CV{16, 9}, CV{16, 10},
-				CV{16, 11}, CV{16, 12}, CV{16, 13}, CV{16, 14},
-				CV{16, 15}, CV{16, 16}, CV{16, 17}, CV{16, 18},
-				CV{16, 19}, CV{16, 20}, CV{16, 21}, CV{16, 22},
-				CV{16, 23}, CV{16, 24}, CV{16, 25}, CV{16, 26},
-				CV{16, 27}, CV{16, 28}, CV{16, 29}, CV{16, 30},
-				CV{16, 31}, CV{16, 32}, CV{16, 33}, CV{16, 34},
-				CV{16, 35}, CV{16, 36}, CV{17, 1}, CV{17, 2},
-				CV{17, 3}, CV{17, 4}, CV{17, 5}, CV{17, 6},
-				CV{17, 7}, CV{17, 8}, CV{17, 9}, CV{17, 10},
-				CV{17, 11}, CV{17, 12}, CV{17, 13}, CV{17, 14},
-				CV{17, 15}, CV{17, 16}, CV{18, 1}, CV{18, 2},
-				CV{18, 3}, CV{18, 4}, CV{18, 5}, CV{18, 6},
-				CV{18, 7}, CV{18, 8}, CV{18, 9}, CV{18, 10},
-				CV{18, 11}, CV{18, 12}, CV{18, 13}, CV{18, 14},
-				CV{18, 15}, CV{18, 16}, CV{18, 17}, CV{18, 18},
-				CV{18, 19}, CV{18, 20}, CV{18, 21}, CV{18, 22},
-				CV{18, 23}, CV{18, 24}, CV{18, 25}, CV{18, 26},
-				CV{18, 27}, CV{19, 1}, CV{19, 2}, CV{19, 3},
-				CV{19, 4}, CV{19, 5}, CV{19, 6}, CV{19, 7},
-				CV{19, 8}, CV{19, 9}, CV{19, 10}, CV{19, 11},
-				CV{19, 12}, CV{19, 13}, CV{19, 14}, CV{19, 15},
-				CV{19, 16}, CV{19, 17}, CV{19, 18}, CV{19, 19},
-				CV{19, 20}, CV{19, 21}, CV{19, 22}, CV{19, 23},
-				CV{19, 24}, CV{19, 25}, CV{20, 1}, CV{20, 2},
-				CV{20, 3}, CV{20, 4}, CV{20, 5}, CV{20, 6},
-				CV{20, 7}, CV{20, 8}, CV{20, 9}, CV{20, 10},
-				CV{20, 11}, CV{20, 12}, CV{20, 13}, CV{20, 14},
-				CV{20, 15}, CV{20, 16}, CV{20, 17}, CV{20, 18},
-				CV{20, 19}, CV{20, 20}, CV{20, 21}, CV{20, 22},
-				CV{20, 23}, CV{20, 24}, CV{20, 25}, CV{20, 26},
-				CV{21, 1}, CV{21, 2}, CV{21, 3}, CV{21, 4},
-				CV{21, 5}, CV{21, 6}, CV{21, 7}, CV{21, 8},
-				CV{21, 9}, CV{21, 10}, CV{21, 11}, CV{21, 12},
-				CV{21, 13}, CV{21, 14}, CV{21, 15}, CV{21, 16},
-				CV{21, 17}, CV{21, 18}, CV{21, 19}, CV{21, 20},
-				CV{21, 21}, CV{21, 22}, CV{21, 23}, CV{21, 24},
-				CV{21, 25}, CV{21, 26}, CV{21, 27}, CV{21, 28},
-				CV{21, 29}, CV{21, 30}, CV{21, 31}, CV{21, 32},
-				CV{21, 33}, CV{21, 34}, CV{21, 35}, CV{21, 36},
-				CV{22, 1}, CV{22, 2}, CV{22, 3}, CV{22, 4},
-				CV{22, 5}, CV{22, 6}, CV{22, 7}, CV{22, 8},
-				CV{22, 9}, CV{22, 10}, CV{22, 11}, CV{22, 12},
-				CV{22, 13}, CV{22, 14}, CV{22, 15}, CV{22, 16},
-				CV{22, 17}, CV{22, 18}, CV{22, 19}, CV{22, 20},
-				CV{22, 21}, CV{22, 22}, CV{22, 23}, CV{22, 24},
-				CV{22, 25}, CV{22, 26}, CV{22, 27}, CV{22, 28},
-				CV{22, 29}, CV{22, 30}, CV{22, 31}, CV{23, 1},
-				CV{23, 2}, CV{23, 3}, CV{23, 4}, CV{23, 5},
-				CV{23, 6}, CV{23, 7}, CV{23, 8}, CV{23, 9},
-				CV{23, 10}, CV{23, 11}, CV{23, 12}, CV{23, 13},
-				CV{23, 14}, CV{23, 15}, CV{23, 16}, CV{23, 17},
-				CV{23, 18}, CV{23, 19}, CV{23, 20}, CV{23, 21},
-				CV{23, 22}, CV{23, 23}, CV{23, 24}, CV{23, 25},
-				CV{23, 26}, CV{23, 27}, CV{23, 28}, CV{23, 29},
-				CV{23, 30}, CV{23, 31}, CV{23, 32}, CV{23, 33},
-				CV{24, 1}, CV{24, 2}, CV{24, 3}, CV{24, 4},
-				CV{24, 5}, CV{24, 6}, CV{24, 7}, CV{24, 8},
-				CV{24, 9}, CV{24, 10}, CV{24, 11}, CV{24, 12},
-				CV{24, 13}, CV{24, 14}, CV{24, 15}, CV{24, 16},
-				CV{24, 17}, CV{24, 18}, CV{25, 1}, CV{25, 2},
-				CV{25, 3}, CV{25, 4}, CV{25, 5}, CV{25, 6},
-				CV{25, 7}, CV{25, 8}, CV{25, 9}, CV{25, 10},
-				CV{25, 11}, CV{25, 12}, CV{25, 13}, CV{25, 14},
-				CV{25, 15}, CV{25, 16}, CV{25, 17}, CV{25, 18},
-				CV{25, 19}, CV{25, 20}, CV{25, 21}, CV{25, 22},
-				CV{25, 23}, CV{25, 24}, CV{25, 25}, CV{25, 26},
-				CV{25, 27}, CV{25, 28}, CV{25, 29}, CV{25, 30},
-				CV{25, 31}, CV{25, 32}, CV{25, 33}, CV{25, 34},
-				CV{25, 35}, CV{25, 36}, CV{25, 37}, CV{25, 38},
-				CV{25, 39}, CV{25, 40}, CV{26, 1}, CV{26, 2},
-				CV{26, 3}, CV{26, 4}, CV{26, 5}, CV{26, 6},
-				CV{26, 7}, CV{26, 8}, CV{26, 9}, CV{26, 10},
-				CV{26, 11}, CV{26, 12}, CV{26, 13}, CV{26, 14},
-				CV{26, 15}, CV{26, 16}, CV{26, 17}, CV{26, 18},
-				CV{26, 19}, CV{26, 20}, CV{26, 21}, CV{26, 22},
-				CV{26, 23}, CV{26, 24}, CV{26, 25}, CV{26, 26},
-				CV{26, 27}, CV{26, 28}, CV{26, 29}, CV{26, 30},
-				CV{26, 31}, CV{26, 32}, CV{26, 33}, CV{26, 34},
-				CV{26, 35}, CV{26, 36}, CV{26, 37}, CV{27, 1},
-				CV{27, 2}, CV{27, 3}, CV{27, 4}, CV{27, 5},
-				CV{27, 6}, CV{27, 7}, CV{27, 8}, CV{27, 9},
-				CV{27, 10}, CV{27, 11}, CV{27, 12}, CV{27, 13},
-				CV{27, 14}, CV{27, 15}, CV{27, 16}, CV{27, 17},
-				CV{27, 18}, CV{27, 19}, CV{27, 20}, CV{27, 21},
-				CV{28, 1}, CV{28, 2}, CV{28, 3}, CV{28, 4},
-				CV{28, 5}, CV{28, 6}, CV{28, 7}, CV{28, 8},
-				CV{28, 9}, CV{28, 10}, CV{28, 11}, CV{28, 12},
-				CV{28, 13}, CV{28, 14}, CV{28, 15}, CV{28, 16},
-				CV{28, 17}, CV{28, 18}, CV{28, 19}, CV{28, 20},
-				CV{28, 21}, CV{28, 22}, CV{28, 23}, CV{28, 24},
-				CV{28, 25}, CV{28, 26}, CV{28, 27}, CV{28, 28},
-				CV{28, 29}, CV{28, 30}, CV{28, 31}, CV{28, 32},
-				CV{28, 33}, CV{28, 34}, CV{28, 35}, CV{28, 36},
-				CV{28, 37}, CV{28, 38}, CV{28, 39}, CV{28, 40},
-				CV{28, 41}, CV{28, 42}, CV{28, 43}, CV{29, 1},
-				CV{29, 2}, CV{29, 3}, CV{29, 4}, CV{29, 5},
-				CV{29, 6}, CV{29, 7}, CV{29, 8}, CV{29, 9},
-				CV{29, 10}, CV{29, 11}, CV{29, 12}, CV{29, 13},
-				CV{29, 14}, CV{29, 15}, CV{29, 16}, CV{29, 17},
-				CV{29, 18}, CV{29, 19}, CV{29, 20}, CV{29, 21},
-				CV{29, 22}, CV{29, 23}, CV{29, 24}, CV{29, 25},
-				CV{29, 26}, CV{29, 27}, CV{29, 28}, CV{29, 29},
-				CV{29, 30}, CV{29, 31}, CV{29, 32}, CV{29, 33},
-				CV{29, 34}, CV{29, 35}, CV{29, 36}, CV{29, 37},
-				CV{29, 38}, CV{29, 39}, CV{29, 40}, CV{29, 41},
-				CV{29, 42}, CV{29, 43}, CV{29, 44}, CV{29, 45},
-				CV{29, 46}, CV{30, 1}, CV{30, 2}, CV{30, 3},
-				CV{30, 4}, CV{30, 5}, CV{30, 6}, CV{30, 7},
-				CV{30, 8}, CV{30, 9}, CV{30, 10}, CV{30, 11},
-				CV{30, 12}, CV{30, 13}, CV{30, 14}, CV{30, 15},
-				CV{30, 16}, CV{30, 17}, CV{30, 18}, CV{30, 19},
-				CV{30, 20}, CV{30, 21}, CV{30, 22}, CV{30, 23},
-				CV{30, 24}, CV{30, 25}, CV{30, 26}, CV{30, 27},
-				CV{30, 28}, CV{30, 29}, CV{30, 30}, CV{30, 31},
-				CV{30, 32}, CV{30, 33}, CV{30, 34}, CV{30, 35},
-				CV{30, 36}, CV{30, 37}, CV{30, 38}, CV{31, 1},
-				CV{31, 2}, CV{31, 3}, CV{31, 4}, CV{31, 5},
-				CV{31, 6}, CV{31, 7}, CV{31, 8}, CV{31, 9},
-				CV{31, 10}, CV{31, 11}, CV{31, 12}, CV{31, 13},
-				CV{31, 14}, CV{31, 15}, CV{31, 16}, CV{31, 17},
-				CV{31, 18}, CV{32, 1}, CV{32, 2}, CV{32, 3},
-				CV{32, 4}, CV{32, 5}, CV{32, 6}, CV{32, 7},
-				CV{32, 8}, CV{32, 9}, CV{32, 10}, CV{32, 11},
-				CV{32, 12}, CV{32, 13}, CV{32, 14}, CV{32, 15},
-				CV{32, 16}, CV{32, 17}, CV{32, 18}, CV{32, 19},
-				CV{32, 20}, CV{32, 21}, CV{32, 22}, CV{32, 23},
-				CV{32, 24}, CV{32, 25}, CV{32, 26}, CV{32, 27},
-				CV{32, 28}, CV{32, 29}, CV{32, 30}, CV{32, 31},
-				CV{32, 32}, CV{32, 33}, CV{32, 34}, CV{32, 35},
-				CV{33, 1}, CV{33, 2}, CV{33, 3}, CV{33, 4},
-				CV{33, 5}, CV{33, 6}, CV{33, 7}, CV{33, 8},
-				CV{33, 9}, CV{33, 10}, CV{33, 11}, CV{33, 12},
-				CV{33, 13}, CV{33, 14}, CV{33, 15}, CV{33, 16},
-				CV{33, 17}, CV{33, 18}, CV{33, 19}, CV{33, 20},
-				CV{33, 21}, CV{33, 22}, CV{33, 23}, CV{34, 1},
-				CV{34, 2}, CV{34, 3}, CV{34, 4}, CV{34, 5},
-				CV{34, 6}, CV{34, 7}, CV{34, 8}, CV{34, 9},
-				CV{34, 10}, CV{34, 11}, CV{34, 12}, CV{34, 13},
-				CV{34, 14}, CV{34, 15}, CV{34, 16}, CV{34, 17},
-				CV{34, 18}, CV{34, 19}, CV{34, 20}, CV{34, 21},
-				CV{34, 22}, CV{34, 23}, CV{34, 24}, CV{34, 25},
-				CV{34, 26}, CV{34, 27}, CV{34, 28}, CV{34, 29},
-				CV{34, 30}, CV{34, 31}, CV{34, 32}, CV{34, 33},
-				CV{34, 34}, CV{34, 35}, CV{35, 1}, CV{35, 2},
-				CV{35, 3}, CV{35, 4}, CV{35, 5}, CV{35, 6},
-				CV{35, 7}, CV{35, 8}, CV{35, 9}, CV{35, 10},
-				CV{35, 11}, CV{35, 12}, CV{35, 13}, CV{35, 14},
-				CV{35, 15}, CV{35, 16}, CV{35, 17}, CV{35, 18},
-				CV{35, 19}, CV{35, 20}, CV{35, 21}, CV{35, 22},
-				CV{35, 23}, CV{35, 24}, CV{35, 25}, CV{35, 26},
-				CV{35, 27}, CV{35, 28}, CV{35, 29}, CV{35, 30},
-				CV{35, 31}, CV{35, 32}, CV{35, 33}, CV{35, 34},
-				CV{35, 35}, CV{36, 1}, CV{36, 2}, CV{36, 3},
-				CV{36, 4}, CV{36, 5}, CV{36, 6}, CV{36, 7},
-				CV{36, 8}, CV{36, 9}, CV{36, 10}, CV{36, 11},
-				CV{36, 12}, CV{36, 13}, CV{36, 14}, CV{36, 15},
-				CV{36, 16}, CV{36, 17}, CV{36, 18}, CV{36, 19},
-				CV{36, 20}, CV{36, 21}, CV{36, 22}, CV{36, 23},
-				CV{36, 24}, CV{36, 25}, CV{36, 26}, CV{36, 27},
-				CV{36, 28}, CV{36, 29}, CV{36, 30}, CV{36, 31},
-				CV{36, 32}, CV{36, 33}, CV{36, 34}, CV{36, 35},
-				CV{36, 36}, CV{36, 37}, CV{36, 38}, CV{37, 1},
-				CV{37, 2}, CV{37, 3}, CV{37, 4}, CV{37, 5},
-				CV{37, 6}, CV{37, 7}, CV{37, 8}, CV{37, 9},
-				CV{37, 10}, CV{37, 11}, CV{37, 12}, CV{37, 13},
-				CV{37, 14}, CV{37, 15}, CV{37, 16}, CV{37, 17},
-				CV{37, 18}, CV{37, 19}, CV{37, 20}, CV{37, 21},
-				CV{37, 22}, CV{37, 23}, CV{37, 24}, CV{37, 25},
-				CV{37, 26}, CV{37, 27}, CV{37, 28}, CV{37, 29},
-				CV{38, 1}, CV{38, 2}, CV{38, 3}, CV{38, 4},
-				CV{38, 5}, CV{38, 6}, CV{38, 7}, CV{38, 8},
-				CV{38, 9}, CV{38, 10}, CV{38, 11}, CV{38, 12},
-				CV{38, 13}, CV{38, 14}, CV{38, 15}, CV{38, 16},
-				CV{38, 17}, CV{38, 18}, CV{38, 19}, CV{38, 20},
-				CV{38, 21}, CV{38, 22}, CV{38, 23}, CV{38, 24},
-				CV{38, 25}, CV{38, 26}, CV{38, 27}, CV{38, 28},
-				CV{38, 29}, CV{38, 30}, CV{38, 31}, CV{39, 1},
-				CV{39, 2}, CV{39, 3}, CV{39, 4}, CV{39, 5},
-				CV{39, 6}, CV{39, 7}, CV{39, 8}, CV{39, 9},
-				CV{39, 10}, CV{39, 11}, CV{39, 12}, CV{39, 13},
-				CV{39, 14}, CV{39, 15}, CV{39, 16}, CV{39, 17},
-				CV{39, 18}, CV{39, 19}, CV{39, 20}, CV{39, 21},
-				CV{39, 22}, CV{39, 23}, CV{39, 24}, CV{39, 25},
-				CV{39, 26}, CV{39, 27}, CV{39, 28}, CV{39, 29},
-				CV{39, 30}, CV{39, 31}, CV{39, 32}, CV{39, 33},
-				CV{39, 34}, CV{39, 35}, CV{39, 36}, CV{39, 37},
-				CV{39, 38}, CV{39, 39}, CV{39, 40}, CV{39, 41},
-				CV{39, 42}, CV{39, 43}, CV{40, 1}, CV{40, 2},
-				CV{40, 3}, CV{40, 4}, CV{40, 5}, CV{40, 6},
-				CV{40, 7}, CV{40, 8}, CV{40, 9}, CV{40, 10},
-				CV{40, 11}, CV{40, 12}, CV{40, 13}, CV{40, 14},
-				CV{40, 15}, CV{40, 16}, CV{40, 17}, CV{40, 18},
-				CV{40, 19}, CV{40, 20}, CV{40, 21}, CV{40, 22},
-				CV{40, 23}, CV{40, 24}, CV{40, 25}, CV{40, 26},
-				CV{40, 27}, CV{40, 28}, CV{40, 29}, CV{40, 30},
-				CV{40, 31}, CV{40, 32}, CV{40, 33}, CV{40, 34},
-				CV{40, 35}, CV{40, 36}, CV{40, 37}, CV{40, 38},
+			Runs: []VerseRun{
+				{Chapter: 1, First: 1, Last: 22},
+				{Chapter: 2, First: 1, Last: 25},
+				{Chapter: 3, First: 1, Last: 22},
+				{Chapter: 4, First: 1, Last: 31},
+				{Chapter: 5, First: 1, Last: 23},
+				{Chapter: 6, First: 1, Last: 30},
+				{Chapter: 7, First: 1, Last: 25},
+				{Chapter: 8, First: 1, Last: 32},
+				{Chapter: 9, First: 1, Last: 35},
+				{Chapter: 10, First: 1, Last: 29},
+				{Chapter: 11, First: 1, Last: 10},
+				{Chapter: 12, First: 1, Last: 51},
+				{Chapter: 13, First: 1, Last: 22},
+				{Chapter: 14, First: 1, Last: 31},
+				{Chapter: 15, First: 1, Last: 27},
+				{Chapter: 16, First: 1, Last: 36},
+				{Chapter: 17, First: 1, Last: 16},
+				{Chapter: 18, First: 1, Last: 27},
+				{Chapter: 19, First: 1, Last: 25},
+				{Chapter: 20, First: 1, Last: 26},
+				{Chapter: 21, First: 1, Last: 36},
+				{Chapter: 22, First: 1, Last: 31},
+				{Chapter: 23, First: 1, Last: 33},
+				{Chapter: 24, First: 1, Last: 18},
+				{Chapter: 25, First: 1, Last: 40},
+				{Chapter: 26, First: 1, Last: 37},
+				{Chapter: 27, First: 1, Last: 21},
+				{Chapter: 28, First: 1, Last: 43},
+				{Chapter: 29, First: 1, Last: 46},
+				{Chapter: 30, First: 1, Last: 38},
+				{Chapter: 31, First: 1, Last: 18},
+				{Chapter: 32, First: 1, Last: 35},
+				{Chapter: 33, First: 1, Last: 23},
+				{Chapter: 34, First: 1, Last: 35},
+				{Chapter: 35, First: 1, Last: 35},
+				{Chapter: 36, First: 1, Last: 38},
+				{Chapter: 37, First: 1, Last: 29},
+				{Chapter: 38, First: 1, Last: 31},
+				{Chapter: 39, First: 1, Last: 43},
+				{Chapter: 40, First: 1, Last: 38},
 			},
 		},
 		{
 			Name:      "Leviticus",
+			USFM:      "LEV",
+			Testament: OldTestament,
 			JustVerse: false,
-			Verses: []Verse{CV{1, 1},
-				CV{1, 2}, CV{1, 3}, CV{1, 4}, CV{1, 5},
-				CV{1, 6}, CV{1, 7}, CV{1, 8}, CV{1, 9},
-				CV{1, 10}, CV{1, 11}, CV{1, 12}, CV{1, 13},
-				CV{1, 14}, CV{1, 15}, CV{1, 16}, CV{1, 17},
-				CV{2, 1}, CV{2, 2}, CV{2, 3}, CV{2, 4},
-				CV{2, 5}, CV{2, 6}, CV{2, 7}, CV{2, 8},
-				CV{2, 9}, CV{2, 10}, CV{2, 11}, CV{2, 12},
-				CV{2, 13}, CV{2, 14}, CV{2, 15}, CV{2, 16},
-				CV{3, 1}, CV{3, 2}, CV{3, 3}, CV{3, 4},
-				CV{3, 5}, CV{3, 6}, CV{3, 7}, CV{3, 8},
-				CV{3, 9}, CV{3, 10}, CV{3, 11}, CV{3, 12},
-				CV{3, 13}, CV{3, 14}, CV{3, 15}, CV{3, 16},
-				CV{3, 17}, CV{4, 1}, CV{4, 2}, CV{4, 3},
-				CV{4, 4}, CV{4, 5}, CV{4, 6}, CV{4, 7},
-				CV{4, 8}, CV{4, 9}, CV{4, 10}, CV{4, 11},
-				CV{4, 12}, CV{4, 13}, CV{4, 14}, CV{4, 15},
-				CV{4, 16}, CV{4, 17}, CV{4, 18}, CV{4, 19},
-				CV{4, 20}, CV{4, 21}, CV{4, 22}, CV{4, 23},
-				CV{4, 24}, CV{4, 25}, CV{4, 26}, CV{4, 27},
-				CV{4, 28}, CV{4, 29}, CV{4, 30}, CV{4, 31},
-				CV{4, 32}, CV{4, 33}, CV{4, 34}, CV{4, 35},
-				CV{5, 1}, CV{5, 2}, CV{5, 3}, CV{5, 4},
-				CV{5, 5}, CV{5, 6}, CV{5, 7}, CV{5, 8},
-				CV{5, 9}, CV{5, 10}, CV{5, 11}, CV{5, 12},
-				CV{5, 13}, CV{5, 14}, CV{5, 15}, CV{5, 16},
-				CV{5, 17}, CV{5, 18}, CV{5, 19}, CV{6, 1},
-				CV{6, 2}, CV{6, 3}, CV{6, 4}, CV{6, 5},
-				CV{6, 6}, CV{6, 7}, CV{6, 8}, CV{6, 9},
-				CV{6, 10}, CV{6, 11}, CV{6, 12}, CV{6, 13},
-				CV{6, 14}, CV{6, 15}, CV{6, 16}, CV{6, 17},
-				CV{6, 18}, CV{6, 19}, CV{6, 20}, CV{6, 21},
-				CV{6, 22}, CV{6, 23}, CV{6, 24}, CV{6, 25},
-				CV{6, 26}, CV{6, 27}, CV{6, 28}, CV{6, 29},
-				CV{6, 30}, CV{7, 1}, CV{7, 2}, CV{7, 3},
-				CV{7, 4}, CV{7, 5}, CV{7, 6}, CV{7, 7},
-				CV{7, 8}, CV{7, 9}, CV{7, 10}, CV{7, 11},
-				CV{7, 12}, CV{7, 13}, CV{7, 14}, CV{7, 15},
-				CV{7, 16}, CV{7, 17}, CV{7, 18}, CV{7, 19},
-				CV{7, 20}, CV{7, 21}, CV{7, 22}, CV{7, 23},
-				CV{7, 24}, CV{7, 25}, CV{7, 26}, CV{7, 27},
-				CV{7, 28}, CV{7, 29}, CV{7, 30}, CV{7, 31},
-				CV{7, 32}, CV{7, 33}, CV{7, 34}, CV{7, 35},
-				CV{7, 36}, CV{7, 37}, CV{7, 38}, CV{8, 1},
-				CV{8, 2}, CV{8, 3}, CV{8, 4}, CV{8, 5},
-				CV{8, 6}, CV{8, 7}, CV{8, 8}, CV{8, 9},
-				CV{8, 10}, CV{8, 11}, CV{8, 12}, CV{8, 13},
-				CV{8, 14}, CV{8, 15}, CV{8, 16}, CV{8, 17},
-				CV{8, 18}, CV{8, 19}, CV{8, 20}, CV{8, 21},
-				CV{8, 22}, CV{8, 23}, CV{8, 24}, CV{8, 25},
-				CV{8, 26}, CV{8, 27}, CV{8, 28}, CV{8, 29},
-				CV{8, 30}, CV{8, 31}, CV{8, 32}, CV{8, 33},
-				CV{8, 34}, CV{8, 35}, CV{8, 36}, CV{9, 1},
-				CV{9, 2}, CV{9, 3}, CV{9, 4}, CV{9, 5},
-				CV{9, 6}, CV{9, 7}, CV{9, 8}, CV{9, 9},
-				CV{9, 10}, CV{9, 11}, CV{9, 12}, CV{9, 13},
-				CV{9, 14}, CV{9, 15}, CV{9, 16}, CV{9, 17},
-				CV{9, 18}, CV{9, 19}, CV{9, 20}, CV{9, 21},
-				CV{9, 22}, CV{9, 23}, CV{9, 24}, CV{10, 1},
-				CV{10, 2}, CV{10, 3}, CV{10, 4}, CV{10, 5},
-				CV{10, 6}, CV{10, 7}, CV{10, 8}, CV{10, 9},
-				CV{10, 10}, CV{10, 11}, CV{10, 12}, CV{10, 13},
-				CV{10, 14}, CV{10, 15}, CV{10, 16}, CV{10, 17},
-				CV{10, 18}, CV{10, 19}, CV{10, 20}, CV{11, 1},
-				CV{11, 2}, CV{11, 3}, CV{11, 4}, CV{11, 5},
-				CV{11, 6}, CV{11, 7}, CV{11, 8}, CV{11, 9},
-				CV{11, 10}, CV{11, 11}, CV{11, 12}, CV{11, 13},
-				CV{11, 14}, CV{11, 15}, CV{11, 16}, CV{11, 17},
-				CV{11, 18}, CV{11, 19}, CV{11, 20}, CV{11, 21},
-				CV{11, 22}, CV{11, 23}, CV{11, 24}, CV{11, 25},
-				CV{11, 26}, CV{11, 27}, CV{11, 28}, CV{11, 29},
-				CV{11, 30}, CV{11, 31}, CV{11, 32}, CV{11, 33},
-				CV{11, 34}, CV{11, 35}, CV{11, 36}, CV{11, 37},
-				CV{11, 38}, CV{11, 39}, CV{11, 40}, CV{11, 41},
-				CV{11, 42}, CV{11, 43}, CV{11, 44}, CV{11, 45},
-				CV{11, 46}, CV{11, 47}, CV{12, 1}, CV{12, 2},
-				CV{12, 3}, CV{12, 4}, CV{12, 5}, CV{12, 6},
-				CV{12, 7}, CV{12, 8}, CV{13, 1}, CV{13, 2},
-				CV{13, 3}, CV{13, 4}, CV{13, 5}, CV{13, 6},
-				CV{13, 7}, CV{13, 8}, CV{13, 9}, CV{13, 10},
-				CV{13, 11}, CV{13, 12}, CV{13, 13}, CV{13, 14},
-				CV{13, 15}, CV{13, 16}, CV{13, 17}, CV{13, 18},
-				CV{13, 19}, CV{13, 20}, CV{13, 21}, CV{13, 22},
-				CV{13, 23}, CV{13, 24}, CV{13, 25}, CV{13, 26},
-				CV{13, 27}, CV{13, 28}, CV{13, 29}, CV{13, 30},
-				CV{13, 31}, CV{13, 32}, CV{13, 33}, CV{13, 34},
-				CV{13, 35}, CV{13, 36}, CV{13, 37}, CV{13, 38},
-				CV{13, 39}, CV{13, 40}, CV{13, 41}, CV{13, 42},
-				CV{13, 43}, CV{13, 44}, CV{13, 45}, CV{13, 46},
-				CV{13, 47}, CV{13, 48}, CV{13, 49}, CV{13, 50},
-				CV{13, 51}, CV{13, 52}, CV{13, 53}, CV{13, 54},
-				CV{13, 55}, CV{13, 56}, CV{13, 57}, CV{13, 58},
-				CV{13, 59}, CV{14, 1}, CV{14, 2}, CV{14, 3},
-				CV{14, 4}, CV{14, 5}, CV{14, 6}, CV{14, 7},
-				CV{14, 8}, CV{14, 9}, CV{14, 10}, CV{14, 11},
-				CV{14, 12}, CV{14, 13}, CV{14, 14}, CV{14, 15},
-				CV{14, 16}, CV{14, 17}, CV{14, 18}, CV{14, 19},
-				CV{14, 20}, CV{14, 21}, CV{14, 22}, CV{14, 23},
-				CV{14, 24}, CV{14, 25}, CV{14, 26}, CV{14, 27},
-				CV{14, 28}, CV{14, 29}, CV{14, 30}, CV{14, 31},
-				CV{14, 32}, CV{14, 33}, CV{14, 34}, CV{14, 35},
-				CV{14, 36}, CV{14, 37}, CV{14, 38}, CV{14, 39},
-				CV{14, 40}, CV{14, 41}, CV{14, 42}, CV{14, 43},
-				CV{14, 44}, CV{14, 45}, CV{14, 46}, CV{14, 47},
-				CV{14, 48}, CV{14, 49}, CV{14, 50}, CV{14, 51},
-				CV{14, 52}, CV{14, 53}, CV{14, 54}, CV{14, 55},
-				CV{14, 56}, CV{14, 57}, CV{15, 1}, CV{15, 2},
-				CV{15, 3}, CV{15, 4}, CV{15, 5}, CV{15, 6},
-				CV{15, 7}, CV{15, 8}, CV{15, 9}, CV{15, 10},
-				CV{15, 11}, CV{15, 12}, CV{15, 13}, CV{15, 14},
-				CV{15, 15}, CV{15, 16}, CV{15, 17}, CV{15, 18},
-				CV{15, 19}, CV{15, 20}, CV{15, 21}, CV{15, 22},
-				CV{15, 23}, CV{15, 24}, CV{15, 25}, CV{15, 26},
-				CV{15, 27}, CV{15, 28}, CV{15, 29}, CV{15, 30},
-				CV{15, 31}, CV{15, 32}, CV{15, 33}, CV{16, 1},
-				CV{16, 2}, CV{16, 3}, CV{16, 4}, CV{16, 5},
-				CV{16, 6}, CV{16, 7}, CV{16, 8}, CV{16, 9},
-				CV{16, 10}, CV{16, 11}, CV{16, 12}, CV{16, 13},
-				CV{16, 14}, CV{16, 15}, CV{16, 16}, CV{16, 17},
-				CV{16, 18}, CV{16, 19}, CV{16, 20}, CV{16, 21},
-				CV{16, 22}, CV{16, 23}, CV{16, 24}, CV{16, 25},
-				CV{16, 26}, CV{16, 27}, CV{16, 28}, CV{16, 29},
-				CV{16, 30}, CV{16, 31}, CV{16, 32}, CV{16, 33},
-				CV{16, 34}, CV{17, 1}, CV{17, 2}, CV{17, 3},
-				CV{17, 4}, CV{17, 5}, CV{17, 6}, CV{17, 7},
-				CV{17, 8}, CV{17, 9}, CV{17, 10}, CV{17, 11},
-				CV{17, 12}, CV{17, 13}, CV{17, 14}, CV{17, 15},
-				CV{17, 16}, CV{18, 1}, CV{18, 2}, CV{18, 3},
-				CV{18, 4}, CV{18, 5}, CV{18, 6}, CV{18, 7},
-				CV{18, 8}, CV{18, 9}, CV{18, 10}, CV{18, 11},
-				CV{18, 12}, CV{18, 13}, CV{18, 14}, CV{18, 15},
-				CV{18, 16}, CV{18, 17}, CV{18, 18}, CV{18, 19},
-				CV{18, 20}, CV{18, 21}, CV{18, 22}, CV{18, 23},
-				CV{18, 24}, CV{18, 25}, CV{18, 26}, CV{18, 27},
-				CV{18, 28}, CV{18, 29}, CV{18, 30}, CV{19, 1},
-				CV{19, 2}, CV{19, 3}, CV{19, 4}, CV{19, 5},
-				CV{19, 6}, CV{19, 7}, CV{19, 8}, CV{19, 9},
-				CV{19, 10}, CV{19, 11}, CV{19, 12}, CV{19, 13},
-				CV{19, 14}, CV{19, 15}, CV{19, 16}, CV{19, 17},
-				CV{19, 18}, CV{19, 19}, CV{19, 20}, CV{19, 21},
-				CV{19, 22}, CV{19, 23}, CV{19, 24}, CV{19, 25},
-				CV{19, 26}, CV{19, 27}, CV{19, 28}, CV{19, 29},
-				CV{19, 30}, CV{19, 31}, CV{19, 32}, CV{19, 33},
-				CV{19, 34}, CV{19, 35}, CV{19, 36}, CV{19, 37},
-				CV{20, 1}, CV{20, 2}, CV{20, 3}, CV{20, 4},
-				CV{20, 5}, CV{20, 6}, CV{20, 7}, CV{20, 8},
-				CV{20, 9}, CV{20, 10}, CV{20, 11}, CV{20, 12},
-				CV{20, 13}, CV{20, 14}, CV{20, 15}, CV{20, 16},
-				CV{20, 17}, CV{20, 18}, CV{20, 19}, CV{20, 20},
-				CV{20, 21}, CV{20, 22}, CV{20, 23}, CV{20, 24},
-				CV{20, 25}, CV{20, 26}, CV{20, 27}, CV{21, 1},
-				CV{21, 2}, CV{21, 3}, CV{21, 4}, CV{21, 5},
-				CV{21, 6}, CV{21, 7}, CV{21, 8}, CV{21, 9},
-				CV{21, 10}, CV{21, 11}, CV{21, 12}, CV{21, 13},
-				CV{21, 14}, CV{21, 15}, CV{21, 16}, CV{21, 17},
-				CV{21, 18}, CV{21, 19}, CV{21, 20}, CV{21, 21},
-				CV{21, 22}, CV{21, 23}, CV{21, 24}, CV{22, 1},
-				CV{22, 2}, CV{22, 3}, CV{22, 4}, CV{22, 5},
-				CV{22, 6}, CV{22, 7}, CV{22, 8}, CV{22, 9},
-				CV{22, 10}, CV{22, 11}, CV{22, 12}, CV{22, 13},
-				CV{22, 14}, CV{22, 15}, CV{22, 16}, CV{22, 17},
-				CV{22, 18}, CV{22, 19}, CV{22, 20}, CV{22, 21},
-				CV{22, 22}, CV{22, 23}, CV{22, 24}, CV{22, 25},
-				CV{22, 26}, CV{22, 27}, CV{22, 28}, CV{22, 29},
-				CV{22, 30}, CV{22, 31}, CV{22, 32}, CV{22, 33},
-				CV{23, 1}, CV{23, 2}, CV{23, 3}, CV{23, 4},
-				CV{23, 5}, CV{23, 6}, CV{23, 7}, CV{23, 8},
-				CV{23, 9}, CV{23, 10}, CV{23, 11}, CV{23, 12},
-				CV{23, 13}, CV{23, 14}, CV{23, 15}, CV{23, 16},
-				CV{23, 17}, CV{23, 18}, CV{23, 19}, CV{23, 20},
-				CV{23, 21}, CV{23, 22}, CV{23, 23}, CV{23, 24},
-				CV{23, 25}, CV{23, 26}, CV{23, 27}, CV{23, 28},
-				CV{23, 29}, CV{23, 30}, CV{23, 31}, CV{23, 32},
-				CV{23, 33}, CV{23, 34}, CV{23, 35}, CV{23, 36},
-				CV{23, 37}, CV{23, 38}, CV{23, 39}, CV{23, 40},
-				CV{23, 41}, CV{23, 42}, CV{23, 43}, CV{23, 44},
-				CV{24, 1}, CV{24, 2}, CV{24, 3}, CV{24, 4},
-				CV{24, 5}, CV{24, 6}, CV{24, 7}, CV{24, 8},
-				CV{24, 9}, CV{24, 10}, CV{24, 11}, CV{24, 12},
-				CV{24, 13}, CV{24, 14}, CV{24, 15}, CV{24, 16},
-				CV{24, 17}, CV{24, 18}, CV{24, 19}, CV{24, 20},
-				CV{24, 21}, CV{24, 22}, CV{24, 23}, CV{25, 1},
-				CV{25, 2}, CV{25, 3}, CV{25, 4}, CV{25, 5},
-				CV{25, 6}, CV{25, 7}, CV{25, 8}, CV{25, 9},
-				CV{25, 10}, CV{25, 11}, CV{25, 12}, CV{25, 13},
-				CV{25, 14}, CV{25, 15}, CV{25, 16}, CV{25, 17},
-				CV{25, 18}, CV{25, 19}, CV{25, 20}, CV{25, 21},
-				CV{25, 22}, CV{25, 23}, CV{25, 24}, CV{25, 25},
-				CV{25, 26}, CV{25, 27}, CV{25, 28}, CV{25, 29},
-				CV{25, 30}, CV{25, 31}, CV{25, 32}, CV{25, 33},
-				CV{25, 34}, CV{25, 35}, CV{25, 36}, CV{25, 37},
-				CV{25, 38}, CV{25, 39}, CV{25, 40}, CV{25, 41},
-				CV{25, 42}, CV{25, 43}, CV{25, 44}, CV{25, 45},
-				CV{25, 46}, CV{25, 47}, CV{25, 48}, CV{25, 49},
-				CV{25, 50}, CV{25, 51}, CV{25, 52}, CV{25, 53},
-				CV{25, 54}, CV{25, 55}, CV{26, 1}, CV{26, 2},
-				CV{26, 3}, CV{26, 4}, CV{26, 5}, CV{26, 6},
-				CV{26, 7}, CV{26, 8}, CV{26, 9}, CV{26, 10},
-				CV{26, 11}, CV{26, 12}, CV{26, 13}, CV{26, 14},
-				CV{26, 15}, CV{26, 16}, CV{26, 17}, CV{26, 18},
-				CV{26, 19}, CV{26, 20}, CV{26, 21}, CV{26, 22},
-				CV{26, 23}, CV{26, 24}, CV{26, 25}, CV{26, 26},
-				CV{26, 27}, CV{26, 28}, CV{26, 29}, CV{26, 30},
-				CV{26, 31}, CV{26, 32}, CV{26, 33}, CV{26, 34},
-				CV{26, 35}, CV{26, 36}, CV{26, 37}, CV{26, 38},
-				CV{26, 39}, CV{26, 40}, CV{26, 41}, CV{26, 42},
-				CV{26, 43}, CV{26, 44}, CV{26, 45}, CV{26, 46},
-				CV{27, 1}, CV{27, 2}, CV{27, 3}, CV{27, 4},
-				CV{27, 5}, CV{27, 6}, CV{27, 7}, CV{27, 8},
-				CV{27, 9}, CV{27, 10}, CV{27, 11}, CV{27, 12},
-				CV{27, 13}, CV{27, 14}, CV{27, 15}, CV{27, 16},
-				CV{27, 17}, CV{27, 18}, CV{27, 19}, CV{27, 20},
-				CV{27, 21}, CV{27, 22}, CV{27, 23}, CV{27, 24},
-				CV{27, 25}, CV{27, 26}, CV{27, 27}, CV{27, 28},
-				CV{27, 29}, CV{27, 30}, CV{27, 31}, CV{27, 32},
-				CV{27, 33}, CV{27, 34},
+			Runs: []VerseRun{
+				{Chapter: 1, First: 1, Last: 17},
+				{Chapter: 2, First: 1, Last: 16},
+				{Chapter: 3, First: 1, Last: 17},
+				{Chapter: 4, First: 1, Last: 35},
+				{Chapter: 5, First: 1, Last: 19},
+				{Chapter: 6, First: 1, Last: 30},
+				{Chapter: 7, First: 1, Last: 38},
+				{Chapter: 8, First: 1, Last: 36},
+				{Chapter: 9, First: 1, Last: 24},
+				{Chapter: 10, First: 1, Last: 20},
+				{Chapter: 11, First: 1, Last: 47},
+				{Chapter: 12, First: 1, Last: 8},
+				{Chapter: 13, First: 1, Last: 59},
+				{Chapter: 14, First: 1, Last: 57},
+				{Chapter: 15, First: 1, Last: 33},
+				{Chapter: 16, First: 1, Last: 34},
+				{Chapter: 17, First: 1, Last: 16},
+				{Chapter: 18, First: 1, Last: 30},
+				{Chapter: 19, First: 1, Last: 37},
+				{Chapter: 20, First: 1, Last: 27},
+				{Chapter: 21, First: 1, Last: 24},
+				{Chapter: 22, First: 1, Last: 33},
+				{Chapter: 23, First: 1, Last: 44},
+				{Chapter: 24, First: 1, Last: 23},
+				{Chapter: 25, First: 1, Last: 55},
+				{Chapter: 26, First: 1, Last: 46},
+				{Chapter: 27, First: 1, Last: 34},
 			},
 		},
 		{
 			Name:      "Numbers",
+			USFM:      "NUM",
+			Testament: OldTestament,
 			JustVerse: false,
-			Verses: []Verse{CV{1, 1},
-				CV{1, 2}, CV{1, 3}, CV{1, 4}, CV{1, 5},
-				CV{1, 6}, CV{1, 7}, CV{1, 8}, CV{1, 9},
-				CV{1, 10}, CV{1, 11}, CV{1, 12}, CV{1, 13},
-				CV{1, 14}, CV{1, 15}, CV{1, 16}, CV{1, 17},
-				CV{1, 18}, CV{1, 19}, CV{1, 20}, CV{1, 21},
-				CV{1, 22}, CV{1, 23}, CV{1, 24}, CV{1, 25},
-				CV{1, 26}, CV{1, 27}, CV{1, 28}, CV{1, 29},
-				CV{1, 30}, CV{1, 31}, CV{1, 32}, CV{1, 33},
-				CV{1, 34}, CV{1, 35}, CV{1, 36}, CV{1, 37},
-				CV{1, 38}, CV{1, 39}, CV{1, 40}, CV{1, 41},
-				CV{1, 42}, CV{1, 43}, CV{1, 44}, CV{1, 45},
-				CV{1, 46}, CV{1, 47}, CV{1, 48}, CV{1, 49},
-				CV{1, 50}, CV{1, 51}, CV{1, 52}, CV{1, 53},
-				CV{1, 54}, CV{2, 1}, CV{2, 2}, CV{2, 3},
-				CV{2, 4}, CV{2, 5}, CV{2, 6}, CV{2, 7},
-				CV{2, 8}, CV{2, 9}, CV{2, 10}, CV{2, 11},
-				CV{2, 12}, CV{2, 13}, CV{2, 14}, CV{2, 15},
-				CV{2, 16}, CV{2, 17}, CV{2, 18}, CV{2, 19},
-				CV{2, 20}, CV{2, 21}, CV{2, 22}, CV{2, 23},
-				CV{2, 24}, CV{2, 25}, CV{2, 26}, CV{2, 27},
-				CV{2, 28}, CV{2, 29}, CV{2, 30}, CV{2, 31},
-				CV{2, 32}, CV{2, 33}, CV{2, 34}, CV{3, 1},
-				CV{3, 2}, CV{3, 3}, CV{3, 4}, CV{3, 5},
-				CV{3, 6}, CV{3, 7}, CV{3, 8}, CV{3, 9},
-				CV{3, 10}, CV{3, 11}, CV{3, 12}, CV{3, 13},
-				CV{3, 14}, CV{3, 15}, CV{3, 16}, CV{3, 17},
-				CV{3, 18}, CV{3, 19}, CV{3, 20}, CV{3, 21},
-				CV{3, 22}, CV{3, 23}, CV{3, 24}, CV{3, 25},
-				CV{3, 26}, CV{3, 27}, CV{3, 28}, CV{3, 29},
-				CV{3, 30}, CV{3, 31}, CV{3, 32}, CV{3, 33},
-				CV{3, 34}, CV{3, 35}, CV{3, 36}, CV{3, 37},
-				CV{3, 38}, CV{3, 39}, CV{3, 40}, CV{3, 41},
-				CV{3, 42}, CV{3, 43}, CV{3, 44}, CV{3, 45},
-				CV{3, 46}, CV{3, 47}, CV{3, 48}, CV{3, 49},
-				CV{3, 50}, CV{3, 51}, CV{4, 1}, CV{4, 2},
-				CV{4, 3}, CV{4, 4}, CV{4, 5}, CV{4, 6},
-				CV{4, 7}, CV{4, 8}, CV{4, 9}, CV{4, 10},
-				CV{4, 11}, CV{4, 12}, CV{4, 13}, CV{4, 14},
-				CV{4, 15}, CV{4, 16}, CV{4, 17}, CV{4, 18},
-				CV{4, 19}, CV{4, 20}, CV{4, 21}, CV{4, 22},
-				CV{4, 23}, CV{4, 24}, CV{4, 25}, CV{4, 26},
-				CV{4, 27}, CV{4, 28}, CV{4, 29}, CV{4, 30},
-				CV{4, 31}, CV{4, 32}, CV{4, 33}, CV{4, 34},
-				CV{4, 35}, CV{4, 36}, CV{4, 37}, CV{4, 38},
-				CV{4, 39}, CV{4, 40}, CV{4, 41}, CV{4, 42},
-				CV{4, 43}, CV{4, 44}, CV{4, 45}, CV{4, 46},
-				CV{4, 47}, CV{4, 48}, CV{4, 49}, CV{5, 1},
-				CV{5, 2}, CV{5, 3}, CV{5, 4}, CV{5, 5},
-				CV{5, 6}, CV{5, 7}, CV{5, 8}, CV{5, 9},
-				CV{5, 10}, CV{5, 11}, CV{5, 12}, CV{5, 13},
-				CV{5, 14}, CV{5, 15}, CV{5, 16}, CV{5, 17},
-				CV{5, 18}, CV{5, 19}, CV{5, 20}, CV{5, 21},
-				CV{5, 22}, CV{5, 23}, CV{5, 24}, CV{5, 25},
-				CV{5, 26}, CV{5, 27}, CV{5, 28}, CV{5, 29},
-				CV{5, 30}, CV{5, 31}, CV{6, 1}, CV{6, 2},
-				CV{6, 3}, CV{6, 4}, CV{6, 5}, CV{6, 6},
-				CV{6, 7}, CV{6, 8}, CV{6, 9}, CV{6, 10},
-				CV{6, 11}, CV{6, 12}, CV{6, 13}, CV{6, 14},
-				CV{6, 15}, CV{6, 16}, CV{6, 17}, CV{6, 18},
-				CV{6, 19}, CV{6, 20}, CV{6, 21}, CV{6, 22},
-				CV{6, 23}, CV{6, 24}, CV{6, 25}, CV{6, 26},
-				CV{6, 27}, CV{7, 1}, CV{7, 2}, CV{7, 3},
-				CV{7, 4}, CV{7, 5}, CV{7, 6}, CV{7, 7},
-				CV{7, 8}, CV{7, 9}, CV{7, 10}, CV{7, 11},
-				CV{7, 12}, CV{7, 13}, CV{7, 14}, CV{7, 15},
-				CV{7, 16}, CV{7, 17}, CV{7, 18}, CV{7, 19},
-				CV{7, 20}, CV{7, 21}, CV{7, 22}, CV{7, 23},
-				CV{7, 24}, CV{7, 25}, CV{7, 26}, CV{7, 27},
-				CV{7, 28}, CV{7, 29}, CV{7, 30}, CV{7, 31},
-				CV{7, 32}, CV{7, 33}, CV{7, 34}, CV{7, 35},
-				CV{7, 36}, CV{7, 37}, CV{7, 38}, CV{7, 39},
-				CV{7, 40}, CV{7, 41}, CV{7, 42}, CV{7, 43},
-				CV{7, 44}, CV{7, 45}, CV{7, 46}, CV{7, 47},
-				CV{7, 48}, CV{7, 49}, CV{7, 50}, CV{7, 51},
-				CV{7, 52}, CV{7, 53}, CV{7, 54}, CV{7, 55},
-				CV{7, 56}, CV{7, 57}, CV{7, 58}, CV{7, 59},
-				CV{7, 60}, CV{7, 61}, CV{7, 62}, CV{7, 63},
-				CV{7, 64}, CV{7, 65}, CV{7, 66}, CV{7, 67},
-				CV{7, 68}, CV{7, 69}, CV{7, 70}, CV{7, 71},
-				CV{7, 72}, CV{7, 73}, CV{7, 74}, CV{7, 75},
-				CV{7, 76}, CV{7, 77}, CV{7, 78}, CV{7, 79},
-				CV{7, 80}, CV{7, 81}, CV{7, 82}, CV{7, 83},
-				CV{7, 84}, CV{7, 85}, CV{7, 86}, CV{7, 87},
-				CV{7, 88}, CV{7, 89}, CV{8, 1}, CV{8, 2},
-				CV{8, 3}, CV{8, 4}, CV{8, 5}, CV{8, 6},
-				CV{8, 7}, CV{8, 8}, CV{8, 9}, CV{8, 10},
-				CV{8, 11}, CV{8, 12}, CV{8, 13}, CV{8, 14},
-				CV{8, 15}, CV{8, 16}, CV{8, 17}, CV{8, 18},
-				CV{8, 19}, CV{8, 20}, CV{8, 21}, CV{8, 22},
-				CV{8, 23}, CV{8, 24}, CV{8, 25}, CV{8, 26},
-				CV{9, 1}, CV{9, 2}, CV{9, 3}, CV{9, 4},
-				CV{9, 5}, CV{9, 6}, CV{9, 7}, CV{9, 8},
-				CV{9, 9}, CV{9, 10}, CV{9, 11}, CV{9, 12},
-				CV{9, 13}, CV{9, 14}, CV{9, 15}, CV{9, 16},
-				CV{9, 17}, CV{9, 18}, CV{9, 19}, CV{9, 20},
-				CV{9, 21}, CV{9, 22}, CV{9, 23}, CV{10, 1},
-				CV{10, 2}, CV{10, 3}, CV{10, 4}, CV{10, 5},
-				CV{10, 6}, CV{10, 7}, CV{10, 8}, CV{10, 9},
-				CV{10, 10}, CV{10, 11}, CV{10, 12}, CV{10, 13},
-				CV{10, 14}, CV{10, 15}, CV{10, 16}, CV{10, 17},
-				CV{10, 18}, CV{10, 19}, CV{10, 20}, CV{10, 21},
-				CV{10, 22}, CV{10, 23}, CV{10, 24}, CV{10, 25},
-				CV{10, 26}, CV{10, 27}, CV{10, 28}, CV{10, 29},
-				CV{10, 30}, CV{10, 31}, CV{10, 32}, CV{10, 33},
-				CV{10, 34}, CV{10, 35}, CV{10, 36}, CV{11, 1},
-				CV{11, 2}, CV{11, 3}, CV{11, 4}, CV{11, 5},
-				CV{11, 6}, CV{11, 7}, CV{11, 8}, CV{11, 9},
-				CV{11, 10}, CV{11, 11}, CV{11, 12}, CV{11, 13},
-				CV{11, 14}, CV{11, 15}, CV{11, 16}, CV{11, 17},
-				CV{11, 18}, CV{11, 19}, CV{11, 20}, CV{11, 21},
-				CV{11, 22}, CV{11, 23}, CV{11, 24}, CV{11, 25},
-				CV{11, 26}, CV{11, 27}, CV{11, 28}, CV{11, 29},
-				CV{11, 30}, CV{11, 31}, CV{11, 32}, CV{11, 33},
-				CV{11, 34}, CV{11, 35}, CV{12, 1}, CV{12, 2},
-				CV{12, 3}, CV{12, 4}, CV{12, 5}, CV{12, 6},
-				CV{12, 7}, CV{12, 8}, CV{12, 9}, CV{12, 10},
-				CV{12, 11}, CV{12, 12}, CV{12, 13}, CV{12, 14},
-				CV{12, 15}, CV{12, 16}, CV{13, 1}, CV{13, 2},
-				CV{13, 3}, CV{13, 4}, CV{13, 5}, CV{13, 6},
-				CV{13, 7}, CV{13, 8}, CV{13, 9}, CV{13, 10},
-				CV{13, 11}, CV{13, 12}, CV{13, 13}, CV{13, 14},
-				CV{13, 15}, CV{13, 16}, CV{13, 17}, CV{13, 18},
-				CV{13, 19}, CV{13, 20}, CV{13, 21}, CV{13, 22},
-				CV{13, 23}, CV{13, 24}, CV{13, 25}, CV{13, 26},
-				CV{13, 27}, CV{13, 28}, CV{13, 29}, CV{13, 30},
-				CV{13, 31}, CV{13, 32}, CV{13, 33}, CV{14, 1},
-				CV{14, 2}, CV{14, 3}, CV{14, 4}, CV{14, 5},
-				CV{14, 6}, CV{14, 7}, CV{14, 8}, CV{14, 9},
-				CV{14, 10}, CV{14, 11}, CV{14, 12}, CV{14, 13},
-				CV{14, 14}, CV{14, 15}, CV{14, 16}, CV{14, 17},
-				CV{14, 18}, CV{14, 19}, CV{14, 20}, CV{14, 21},
-				CV{14, 22}, CV{14, 23}, CV{14, 24}, CV{14, 25},
-				CV{14, 26}, CV{14, 27}, CV{14, 28}, CV{14, 29},
-				CV{14, 30}, CV{14, 31}, CV{14, 32}, CV{14, 33},
-				CV{14, 34}, CV{14, 35}, CV{14, 36}, CV{14, 37},
-				CV{14, 38}, CV{14, 39}, CV{14, 40}, CV{14, 41},
-				CV{14, 42}, CV{14, 43}, CV{14, 44}, CV{14, 45},
-				CV{15, 1}, CV{15, 2}, CV{15, 3}, CV{15, 4},
-				CV{15, 5}, CV{15, 6}, CV{15, 7}, CV{15, 8},
-				CV{15, 9}, CV{15, 10}, CV{15, 11}, CV{15, 12},
-				CV{15, 13}, CV{15, 14}, CV{15, 15}, CV{15, 16},
-				CV{15, 17}, CV{15, 18}, CV{15, 19}, CV{15, 20},
-				CV{15, 21}, CV{15, 22}, CV{15, 23}, CV{15, 24},
-				CV{15, 25}, CV{15, 26}, CV{15, 27}, CV{15, 28},
-				CV{15, 29}, CV{15, 30}, CV{15, 31}, CV{15, 32},
-				CV{15, 33}, CV{15, 34}, CV{15, 35}, CV{15, 36},
-				CV{15, 37}, CV{15, 38}, CV{15, 39}, CV{15, 40},
-				CV{15, 41}, CV{16, 1}, CV{16, 2}, CV{16, 3},
-				CV{16, 4}, CV{16, 5}, CV{16, 6}, CV{16, 7},
-				CV{16, 8}, CV{16, 9}, CV{16, 10}, CV{16, 11},
-				CV{16, 12}, CV{16, 13}, CV{16, 14}, CV{16, 15},
-				CV{16, 16}, CV{16, 17}, CV{16, 18}, CV{16, 19},
-				CV{16, 20}, CV{16, 21}, CV{16, 22}, CV{16, 23},
-				CV{16, 24}, CV{16, 25}, CV{16, 26}, CV{16, 27},
-				CV{16, 28}, CV{16, 29}, CV{16, 30}, CV{16, 31},
-				CV{16, 32}, CV{16, 33}, CV{16, 34}, CV{16, 35},
-				CV{16, 36}, CV{16, 37}, CV{16, 38}, CV{16, 39},
-				CV{16, 40}, CV{16, 41}, CV{16, 42}, CV{16, 43},
-				CV{16, 44}, CV{16, 45}, CV{16, 46}, CV{16, 47},
-				CV{16, 48}, CV{16, 49}, CV{16, 50}, CV{17, 1},
-				CV{17, 2}, CV{17, 3}, CV{17, 4}, CV{17, 5},
-				CV{17, 6}, CV{17, 7}, CV{17, 8}, CV{17, 9},
-				CV{17, 10}, CV{17, 11}, CV{17, 12}, CV{17, 13},
-				CV{18, 1}, CV{18, 2}, CV{18, 3}, CV{18, 4},
-				CV{18, 5}, CV{18, 6}, CV{18, 7}, CV{18, 8},
-				CV{18, 9}, CV{18, 10}, CV{18, 11}, CV{18, 12},
-				CV{18, 13}, CV{18, 14}, CV{18, 15}, CV{18, 16},
-				CV{18, 17}, CV{18, 18}, CV{18, 19}, CV{18, 20},
-				CV{18, 21}, CV{18, 22}, CV{18, 23}, CV{18, 24},
-				CV{18, 25}, CV{18, 26}, CV{18, 27}, CV{18, 28},
-				CV{18, 29}, CV{18, 30}, CV{18, 31}, CV{18, 32},
-				CV{19, 1}, CV{19, 2}, CV{19, 3}, CV{19, 4},
-				CV{19, 5}, CV{19, 6}, CV{19, 7}, CV{19, 8},
-				CV{19, 9}, CV{19, 10}, CV{19, 11}, CV{19, 12},
-				CV{19, 13}, CV{19, 14}, CV{19, 15}, CV{19, 16},
-				CV{19, 17}, CV{19, 18}, CV{19, 19}, CV{19, 20},
-				CV{19, 21}, CV{19, 22}, CV{20, 1}, CV{20, 2},
-				CV{20, 3}, CV{20, 4}, CV{20, 5}, CV{20, 6},
-				CV{20, 7}, CV{20, 8}, CV{20, 9}, CV{20, 10},
-				CV{20, 11}, CV{20, 12}, CV{20, 13}, CV{20, 14},
-				CV{20, 15}, CV{20, 16}, CV{20, 17}, CV{20, 18},
-				CV{20, 19}, CV{20, 20}, CV{20, 21}, CV{20, 22},
-				CV{20, 23}, CV{20, 24}, CV{20, 25}, CV{20, 26},
-				CV{20, 27}, CV{20, 28}, CV{20, 29}, CV{21, 1},
-				CV{21, 2}, CV{21, 3}, CV{21, 4}, CV{21, 5},
-				CV{21, 6}, CV{21, 7}, CV{21, 8}, CV{21, 9},
-				CV{21, 10}, CV{21, 11}, CV{21, 12}, CV{21, 13},
-				CV{21, 14}, CV{21, 15}, CV{21, 16}, CV{21, 17},
-				CV{21, 18}, CV{21, 19}, CV{21, 20}, CV{21, 21},
-				CV{21, 22}, CV{21, 23}, CV{21, 24}, CV{21, 25},
-				CV{21, 26}, CV{21, 27}, CV{21, 28}, CV{21, 29},
-				CV{21, 30}, CV{21, 31}, CV{21, 32}, CV{21, 33},
-				CV{21, 34}, CV{21, 35}, CV{22, 1}, CV{22, 2},
-				CV{22, 3}, CV{22, 4}, CV{22, 5}, CV{22, 6},
-				CV{22, 7}, CV{22, 8}, CV{22, 9}, CV{22, 10},
-				CV{22, 11}, CV{22, 12}, CV{22, 13}, CV{22, 14},
-				CV{22, 15}, CV{22, 16}, CV{22, 17}, CV{22, 18},
-				CV{22, 19}, CV{22, 20}, CV{22, 21}, CV{22, 22},
-				CV{22, 23}, CV{22, 24}, CV{22, 25}, CV{22, 26},
-				CV{22, 27}, CV{22, 28}, CV{22, 29}, CV{22, 30},
-				CV{22, 31}, CV{22, 32}, CV{22, 33}, CV{22, 34},
-				CV{22, 35}, CV{22, 36}, CV{22, 37}, CV{22, 38},
-				CV{22, 39}, CV{22, 40}, CV{22, 41}, CV{23, 1},
-				CV{23, 2}, CV{23, 3}, CV{23, 4}, CV{23, 5},
-				CV{23, 6}, CV{23, 7}, CV{23, 8}, CV{23, 9},
-				CV{23, 10}, CV{23, 11}, CV{23, 12}, CV{23, 13},
-				CV{23, 14}, CV{23, 15}, CV{23, 16}, CV{23, 17},
-				CV{23, 18}, CV{23, 19}, CV{23, 20}, CV{23, 21},
-				CV{23, 22}, CV{23, 23}, CV{23, 24}, CV{23, 25},
-				CV{23, 26}, CV{23, 27}, CV{23, 28}, CV{23, 29},
-				CV{23, 30}, CV{24, 1}, CV{24, 2}, CV{24, 3},
-				CV{24, 4}, CV{24, 5}, CV{24, 6}, CV{24, 7},
-				CV{24, 8}, CV{24, 9}, CV{24, 10}, CV{24, 11},
-				CV{24, 12}, CV{24, 13}, CV{24, 14}, CV{24, 15},
-				CV{24, 16}, CV{24, 17}, CV{24, 18}, CV{24, 19},
-				CV{24, 20}, CV{24, 21}, CV{24, 22}, CV{24, 23},
-				CV{24, 24}, CV{24, 25}, CV{25, 1}, CV{25, 2},
-				CV{25, 3}, CV{25, 4}, CV{25, 5}, CV{25, 6},
-				CV{25, 7}, CV{25, 8}, CV{25, 9}, CV{25, 10},
-				CV{25, 11}, CV{25, 12}, CV{25, 13}, CV{25, 14},
-				CV{25, 15}, CV{25, 16}, CV{25, 17}, CV{25, 18},
-				CV{26, 1}, CV{26, 2}, CV{26, 3}, CV{26, 4},
-				CV{26, 5}, CV{26, 6}, CV{26, 7}, CV{26, 8},
-				CV{26, 9}, CV{26, 10}, CV{26, 11}, CV{26, 12},
-				CV{26, 13}, CV{26, 14}, CV{26, 15}, CV{26, 16},
-				CV{26, 17}, CV{26, 18}, CV{26, 19}, CV{26, 20},
-				CV{26, 21}, CV{26, 22}, CV{26, 23}, CV{26, 24},
-				CV{26, 25}, CV{26, 26}, CV{26, 27}, CV{26, 28},
-				CV{26, 29}, CV{26, 30}, CV{26, 31}, CV{26, 32},
-				CV{26, 33}, CV{26, 34}, CV{26, 35}, CV{26, 36},
-				CV{26, 37}, CV{26, 38}, CV{26, 39}, CV{26, 40},
-				CV{26, 41}, CV{26, 42}, CV{26, 43}, CV{26, 44},
-				CV{26, 45}, CV{26, 46}, CV{26, 47}, CV{26, 48},
-				CV{26, 49}, CV{26, 50}, CV{26, 51}, CV{26, 52},
-				CV{26, 53}, CV{26, 54}, CV{26, 55}, CV{26, 56},
-				CV{26, 57}, CV{26, 58}, CV{26, 59}, CV{26, 60},
-				CV{26, 61}, CV{26, 62}, CV{26, 63}, CV{26, 64},
-				CV{26, 65}, CV{27, 1}, CV{27, 2}, CV{27, 3},
-				CV{27, 4}, CV{27, 5}, CV{27, 6}, CV{27, 7},
-				CV{27, 8}, CV{27, 9}, CV{27, 10}, CV{27, 11},
-				CV{27, 12}, CV{27, 13}, CV{27, 14}, CV{27, 15},
-				CV{27, 16}, CV{27, 17}, CV{27, 18}, CV{27, 19},
-				CV{27, 20}, CV{27, 21}, CV{27, 22}, CV{27, 23},
-				CV{28, 1}, CV{28, 2}, CV{28, 3}, CV{28, 4},
-				CV{28, 5}, CV{28, 6}, CV{28, 7}, CV{28, 8},
-				CV{28, 9}, CV{28, 10}, CV{28, 11}, CV{28, 12},
-				CV{28, 13}, CV{28, 14}, CV{28, 15}, CV{28, 16},
-				CV{28, 17}, CV{28, 18}, CV{28, 19}, CV{28, 20},
-				CV{28, 21}, CV{28, 22}, CV{28, 23}, CV{28, 24},
-				CV{28, 25}, CV{28, 26}, CV{28, 27}, CV{28, 28},
-				CV{28, 29}, CV{28, 30}, CV{28, 31}, CV{29, 1},
-				CV{29, 2}, CV{29, 3}, CV{29, 4}, CV{29, 5},
-				CV{29, 6}, CV{29, 7}, CV{29, 8}, CV{29, 9},
-				CV{29, 10}, CV{29, 11}, CV{29, 12}, CV{29, 13},
-				CV{29, 14}, CV{29, 15}, CV{29, 16}, CV{29, 17},
-				CV{29, 18}, CV{29, 19}, CV{29, 20}, CV{29, 21},
-				CV{29, 22}, CV{29, 23}, CV{29, 24}, CV{29, 25},
-				CV{29, 26}, CV{29, 27}, CV{29, 28}, CV{29, 29},
-				CV{29, 30}, CV{29, 31}, CV{29, 32}, CV{29, 33},
-				CV{29, 34}, CV{29, 35}, CV{29, 36}, CV{29, 37},
-				CV{29, 38}, CV{29, 39}, CV{29, 40}, CV{30, 1},
-				CV{30, 2}, CV{30, 3}, CV{30, 4}, CV{30, 5},
-				CV{30, 6}, CV{30, 7}, CV{30, 8}, CV{30, 9},
-				CV{30, 10}, CV{30, 11}, CV{30, 12}, CV{30, 13},
-				CV{30, 14}, CV{30, 15}, CV{30, 16}, CV{31, 1},
-				CV{31, 2}, CV{31, 3}, CV{31, 4}, CV{31, 5},
-				CV{31, 6}, CV{31, 7}, CV{31, 8}, CV{31, 9},
-				CV{31, 10}, CV{31, 11}, CV{31, 12}, CV{31, 13},
-				CV{31, 14}, CV{31, 15}, CV{31, 16}, CV{31, 17},
-				CV{31, 18}, CV{31, 19}, CV{31, 20}, CV{31, 21},
-				CV{31, 22}, CV{31, 23}, CV{31, 24}, CV{31, 25},
-				CV{31, 26}, CV{31, 27}, CV{31, 28}, CV{31, 29},
-				CV{31, 30}, CV{31, 31}, CV{31, 32}, CV{31, 33},
-				CV{31, 34}, CV{31, 35}, CV{31, 36}, CV{31, 37},
-				CV{31, 38}, CV{31, 39}, CV{31, 40}, CV{31, 41},
-				CV{31, 42}, CV{31, 43}, CV{31, 44}, CV{31, 45},
-				CV{31, 46}, CV{31, 47}, CV{31, 48}, CV{31, 49},
-				CV{31, 50}, CV{31, 51}, CV{31, 52}, CV{31, 53},
-				CV{31, 54}, CV{32, 1}, CV{32, 2}, CV{32, 3},
-				CV{32, 4}, CV{32, 5}, CV{32, 6}, CV{32, 7},
-				CV{32, 8}, CV{32, 9}, CV{32, 10}, CV{32, 11},
-				CV{32, 12}, CV{32, 13}, CV{32, 14}, CV{32, 15},
-				CV{32, 16}, CV{32, 17}, CV{32, 18}, CV{32, 19},
-				CV{32, 20}, CV{32, 21}, CV{32, 22}, CV{32, 23},
-				CV{32, 24}, CV{32, 25}, CV{32, 26}, CV{32, 27},
-				CV{32, 28}, CV{32, 29}, CV{32, 30}, CV{32, 31},
-				CV{32, 32}, CV{32, 33}, CV{32, 34}, CV{32, 35},
-				CV{32, 36}, CV{32, 37}, CV{32, 38}, CV{32, 39},
-				CV{32, 40}, CV{32, 41}, CV{32, 42}, CV{33, 1},
-				CV{33, 2}, CV{33, 3}, CV{33, 4}, CV{33, 5},
-				CV{33, 6}, CV{33, 7}, CV{33, 8}, CV{33, 9},
-				CV{33, 10}, CV{33, 11}, CV{33, 12}, CV{33, 13},
-				CV{33, 14}, CV{33, 15}, CV{33, 16}, CV{33, 17},
-				CV{33, 18}, CV{33, 19}, CV{33, 20}, CV{33, 21},
-				CV{33, 22}, CV{33, 23}, CV{33, 24}, CV{33, 25},
-				CV{33, 26}, CV{33, 27}, CV{33, 28}, CV{33, 29},
-				CV{33, 30}, CV{33, 31}, CV{33, 32}, CV{33, 33},
-				CV{33, 34}, CV{33, 35}, CV{33, 36}, CV{33, 37},
-				CV{33, 38}, CV{33, 39}, CV{33, 40}, CV{33, 41},
-				CV{33, 42}, CV{33, 43}, CV{33, 44}, CV{33, 45},
-				CV{33, 46}, CV{33, 47}, CV{33, 48}, CV{33, 49},
-				CV{33, 50}, CV{33, 51}, CV{33, 52}, CV{33, 53},
-				CV{33, 54}, CV{33, 55}, CV{33, 56}, CV{34, 1},
-				CV{34, 2}, CV{34, 3}, CV{34, 4}, CV{34, 5},
-				CV{34, 6}, CV{34, 7}, CV{34, 8}, CV{34, 9},
-				CV{34, 10}, CV{34, 11}, CV{34, 12}, CV{34, 13},
-				CV{34, 14}, CV{34, 15}, CV{34, 16}, CV{34, 17},
-				CV{34, 18}, CV{34, 19}, CV{34, 20}, CV{34, 21},
-				CV{34, 22}, CV{34, 23}, CV{34, 24}, CV{34, 25},
-				CV{34, 26}, CV{34, 27}, CV{34, 28}, CV{34, 29},
-				CV{35, 1}, CV{35, 2}, CV{35, 3}, CV{35, 4},
-				CV{35, 5}, CV{35, 6}, CV{35, 7}, CV{35, 8},
-				CV{35, 9}, CV{35, 10}, CV{35, 11}, CV{35, 12},
-				CV{35, 13}, CV{35, 14}, CV{35, 15}, CV{35, 16},
-				CV{35, 17}, CV{35, 18}, CV{35, 19}, CV{35, 20},
-				CV{35, 21}, CV{35, 22}, CV{35, 23}, CV{35, 24},
-				CV{35, 25}, CV{35, 26}, CV{35, 27}, CV{35, 28},
-				CV{35, 29}, CV{35, 30}, CV{35, 31}, CV{35, 32},
-				CV{35, 33}, CV{35, 34}, CV{36, 1}, CV{36, 2},
-				CV{36, 3}, CV{36, 4}, CV{36, 5}, CV{36, 6},
-				CV{36, 7}, CV{36, 8}, CV{36, 9}, CV{36, 10},
-				CV{36, 11}, CV{36, 12}, CV{36, 13},
+			Runs: []VerseRun{
+				{Chapter: 1, First: 1, Last: 54},
+				{Chapter: 2, First: 1, Last: 34},
+				{Chapter: 3, First: 1, Last: 51},
+				{Chapter: 4, First: 1, Last: 49},
+				{Chapter: 5, First: 1, Last: 31},
+				{Chapter: 6, First: 1, Last: 27},
+				{Chapter: 7, First: 1, Last: 89},
+				{Chapter: 8, First: 1, Last: 26},
+				{Chapter: 9, First: 1, Last: 23},
+				{Chapter: 10, First: 1, Last: 36},
+				{Chapter: 11, First: 1, Last: 35},
+				{Chapter: 12, First: 1, Last: 16},
+				{Chapter: 13, First: 1, Last: 33},
+				{Chapter: 14, First: 1, Last: 45},
+				{Chapter: 15, First: 1, Last: 41},
+				{Chapter: 16, First: 1, Last: 50},
+				{Chapter: 17, First: 1, Last: 13},
+				{Chapter: 18, First: 1, Last: 32},
+				{Chapter: 19, First: 1, Last: 22},
+				{Chapter: 20, First: 1, Last: 29},
+				{Chapter: 21, First: 1, Last: 35},
+				{Chapter: 22, First: 1, Last: 41},
+				{Chapter: 23, First: 1, Last: 30},
+				{Chapter: 24, First: 1, Last: 25},
+				{Chapter: 25, First: 1, Last: 18},
+				{Chapter: 26, First: 1, Last: 65},
+				{Chapter: 27, First: 1, Last: 23},
+				{Chapter: 28, First: 1, Last: 31},
+				{Chapter: 29, First: 1, Last: 40},
+				{Chapter: 30, First: 1, Last: 16},
+				{Chapter: 31, First: 1, Last: 54},
+				{Chapter: 32, First: 1, Last: 42},
+				{Chapter: 33, First: 1, Last: 56},
+				{Chapter: 34, First: 1, Last: 29},
+				{Chapter: 35, First: 1, Last: 34},
+				{Chapter: 36, First: 1, Last: 13},
 			},
 		},
 		{
 			Name:      "Deuteronomy",
+			USFM:      "DEU",
+			Testament: OldTestament,
 			JustVerse: false,
-			Verses: []Verse{CV{1, 1},
-				CV{1, 2}, CV{1, 3}, CV{1, 4}, CV{1, 5},
-				CV{1, 6}, CV{1, 7}, CV{1, 8}, CV{1, 9},
-				CV{1, 10}, CV{1, 11}, CV{1, 12}, CV{1, 13},
-				CV{1, 14}, CV{1, 15}, CV{1, 16}, CV{1, 17},
-				CV{1, 18}, CV{1, 19}, CV{1, 20}, CV{1, 21},
-				CV{1, 22}, CV{1, 23}, CV{1, 24}, CV{1, 25},
-				CV{1, 26}, CV{1, 27}, CV{1, 28}, CV{1, 29},
-				CV{1, 30}, CV{1, 31}, CV{1, 32}, CV{1, 33},
-				CV{1, 34}, CV{1, 35}, CV{1, 36}, CV{1, 37},
-				CV{1, 38}, CV{1, 39}, CV{1, 40}, CV{1, 41},
-				CV{1, 42}, CV{1, 43}, CV{1, 44}, CV{1, 45},
-				CV{1, 46}, CV{2, 1}, CV{2, 2}, CV{2, 3},
-				CV{2, 4}, CV{2, 5}, CV{2, 6}, CV{2, 7},
-				CV{2, 8}, CV{2, 9}, CV{2, 10}, CV{2, 11},
-				CV{2, 12}, CV{2, 13}, CV{2, 14}, CV{2, 15},
-				CV{2, 16}, CV{2, 17}, CV{2, 18}, CV{2, 19},
-				CV{2, 20}, CV{2, 21}, CV{2, 22}, CV{2, 23},
-				CV{2, 24}, CV{2, 25}, CV{2, 26}, CV{2, 27},
-				CV{2, 28}, CV{2, 29}, CV{2, 30}, CV{2, 31},
-				CV{2, 32}, CV{2, 33}, CV{2, 34}, CV{2, 35},
-				CV{2, 36}, CV{2, 37}, CV{3, 1}, CV{3, 2},
-				CV{3, 3}, CV{3, 4}, CV{3, 5}, CV{3, 6},
-				CV{3, 7}, CV{3, 8}, CV{3, 9}, CV{3, 10},
-				CV{3, 11}, CV{3, 12}, CV{3, 13}, CV{3, 14},
-				CV{3, 15}, CV{3, 16}, CV{3, 17}, CV{3, 18},
-				CV{3, 19}, CV{3, 20}, CV{3, 21}, CV{3, 22},
-				CV{3, 23}, CV{3, 24}, CV{3, 25}, CV{3, 26},
-				CV{3, 27}, CV{3, 28}, CV{3, 29}, CV{4, 1},
-				CV{4, 2}, CV{4, 3}, CV{4, 4}, CV{4, 5},
-				CV{4, 6}, CV{4, 7}, CV{4, 8}, CV{4, 9},
-				CV{4, 10}, CV{4, 11}, CV{4, 12}, CV{4, 13},
-				CV{4, 14}, CV{4, 15}, CV{4, 16}, CV{4, 17},
-				CV{4, 18}, CV{4, 19}, CV{4, 20}, CV{4, 21},
-				CV{4, 22}, CV{4, 23}, CV{4, 24}, CV{4, 25},
-				CV{4, 26}, CV{4, 27}, CV{4, 28}, CV{4, 29},
-				CV{4, 30}, CV{4, 31}, CV{4, 32}, CV{4, 33},
-				CV{4, 34}, CV{4, 35}, CV{4, 36}, CV{4, 37},
-				CV{4, 38}, CV{4, 39}, CV{4, 40}, CV{4, 41},
-				CV{4, 42}, CV{4, 43}, CV{4, 44}, CV{4, 45},
-				CV{4, 46}, CV{4, 47}, CV{4, 48}, CV{4, 49},
-				CV{5, 1}, CV{5, 2}, CV{5, 3}, CV{5, 4},
-				CV{5, 5}, CV{5, 6}, CV{5, 7}, CV{5, 8},
-				CV{5, 9}, CV{5, 10}, CV{5, 11}, CV{5, 12},
-				CV{5, 13}, CV{5, 14}, CV{5, 15}, CV{5, 16},
-				CV{5, 17}, CV{5, 18}, CV{5, 19}, CV{5, 20},
-				CV{5, 21}, CV{5, 22}, CV{5, 23}, CV{5, 24},
-				CV{5, 25}, CV{5, 26}, CV{5, 27}, CV{5, 28},
-				CV{5, 29}, CV{5, 30}, CV{5, 31}, CV{5, 32},
-				CV{5, 33}, CV{6, 1}, CV{6, 2}, CV{6, 3},
-				CV{6, 4}, CV{6, 5}, CV{6, 6}, CV{6, 7},
-				CV{6, 8}, CV{6, 9}, CV{6, 10}, CV{6, 11},
-				CV{6, 12}, CV{6, 13}, CV{6, 14}, CV{6, 15},
-				CV{6, 16}, CV{6, 17}, CV{6, 18}, CV{6, 19},
-				CV{6, 20}, CV{6, 21}, CV{6, 22}, CV{6, 23},
-				CV{6, 24}, CV{6, 25}, CV{7, 1}, CV{7, 2},
-				CV{7, 3}, CV{7, 4}, CV{7, 5}, CV{7, 6},
-				CV{7, 7}, CV{7, 8}, CV{7, 9}, CV{7, 10},
-				CV{7, 11}, CV{7, 12}, CV{7, 13}, CV{7, 14},
-				CV{7, 15}, CV{7, 16}, CV{7, 17}, CV{7, 18},
-				CV{7, 19}, CV{7, 20}, CV{7, 21}, CV{7, 22},
-				CV{7, 23}, CV{7, 24}, CV{7, 25}, CV{7, 26},
-				CV{8, 1}, CV{8, 2}, CV{8, 3}, CV{8, 4},
-				CV{8, 5}, CV{8, 6}, CV{8, 7}, CV{8, 8},
-				CV{8, 9}, CV{8, 10}, CV{8, 11}, CV{8, 12},
-				CV{8, 13}, CV{8, 14}, CV{8, 15}, CV{8, 16},
-				CV{8, 17}, CV{8, 18}, CV{8, 19}, CV{8, 20},
-				CV{9, 1}, CV{9, 2}, CV{9, 3}, CV{9, 4},
-				CV{9, 5}, CV{9, 6}, CV{9, 7}, CV{9, 8},
-				CV{9, 9}, CV{9, 10}, CV{9, 11}, CV{9, 12},
-				CV{9, 13}, CV{9, 14}, CV{9, 15}, CV{9, 16},
-				CV{9, 17}, CV{9, 18}, CV{9, 19}, CV{9, 20},
-				CV{9, 21}, CV{9, 22}, CV{9, 23}, CV{9, 24},
-				CV{9, 25}, CV{9, 26}, CV{9, 27}, CV{9, 28},
-				CV{9, 29}, CV{10, 1}, CV{10, 2}, CV{10, 3},
-				CV{10, 4}, CV{10, 5}, CV{10, 6}, CV{10, 7},
-				CV{10, 8}, CV{10, 9}, CV{10, 10}, CV{10, 11},
-				CV{10, 12}, CV{10, 13}, CV{10, 14}, CV{10, 15},
-				CV{10, 16}, CV{10, 17}, CV{10, 18}, CV{10, 19},
-				CV{10, 20}, CV{10, 21}, CV{10, 22}, CV{11, 1},
-				CV{11, 2}, CV{11, 3}, CV{11, 4}, CV{11, 5},
-				CV{11, 6}, CV{11, 7}, CV{11, 8}, CV{11, 9},
-				CV{11, 10}, CV{11, 11}, CV{11, 12}, CV{11, 13},
-				CV{11, 14}, CV{11, 15}, CV{11, 16}, CV{11, 17},
-				CV{11, 18}, CV{11, 19}, CV{11, 20}, CV{11, 21},
-				CV{11, 22}, CV{11, 23}, CV{11, 24}, CV{11, 25},
-				CV{11, 26}, CV{11, 27}, CV{11, 28}, CV{11, 29},
-				CV{11, 30}, CV{11, 31}, CV{11, 32}, CV{12, 1},
-				CV{12, 2}, CV{12, 3}, CV{12, 4}, CV{12, 5},
-				CV{12, 6}, CV{12, 7}, CV{12, 8}, CV{12, 9},
-				CV{12, 10}, CV{12, 11}, CV{12, 12}, CV{12, 13},
-				CV{12, 14}, CV{12, 15}, CV{12, 16}, CV{12, 17},
-				CV{12, 18}, CV{12, 19}, CV{12, 20}, CV{12, 21},
-				CV{12, 22}, CV{12, 23}, CV{12, 24}, CV{12, 25},
-				CV{12, 26}, CV{12, 27}, CV{12, 28}, CV{12, 29},
-				CV{12, 30}, CV{12, 31}, CV{12, 32}, CV{13, 1},
-				CV{13, 2}, CV{13, 3}, CV{13, 4}, CV{13, 5},
-				CV{13, 6}, CV{13, 7}, CV{13, 8}, CV{13, 9},
-				CV{13, 10}, CV{13, 11}, CV{13, 12}, CV{13, 13},
-				CV{13, 14}, CV{13, 15}, CV{13, 16}, CV{13, 17},
-				CV{13, 18}, CV{14, 1}, CV{14, 2}, CV{14, 3},
-				CV{14, 4}, CV{14, 5}, CV{14, 6}, CV{14, 7},
-				CV{14, 8}, CV{14, 9}, CV{14, 10}, CV{14, 11},
-				CV{14, 12}, CV{14, 13}, CV{14, 14}, CV{14, 15},
-				CV{14, 16}, CV{14, 17}, CV{14, 18}, CV{14, 19},
-				CV{14, 20}, CV{14, 21}, CV{14, 22}, CV{14, 23},
-				CV{14, 24}, CV{14, 25}, CV{14, 26}, CV{14, 27},
-				CV{14, 28}, CV{14, 29}, CV{15, 1}, CV{15, 2},
-				CV{15, 3}, CV{15, 4}, CV{15, 5}, CV{15, 6},
-				CV{15, 7}, CV{15, 8}, CV{15, 9}, CV{15, 10},
-				CV{15, 11}, CV{15, 12}, CV{15, 13}, CV{15, 14},
-				CV{15, 15}, CV{15, 16}, CV{15, 17}, CV{15, 18},
-				CV{15, 19}, CV{15, 20}, CV{15, 21}, CV{15, 22},
-				CV{15, 23}, CV{16, 1}, CV{16, 2}, CV{16, 3},
-				CV{16, 4}, CV{16, 5}, CV{16, 6}, CV{16, 7},
-				CV{16, 8}, CV{16, 9}, CV{16, 10}, CV{16, 11},
-				CV{16, 12}, CV{16, 13}, CV{16, 14}, CV{16, 15},
-				CV{16, 16}, CV{16, 17}, CV{16, 18}, CV{16, 19},
-				CV{16, 20}, CV{16, 21}, CV{16, 22}, CV{17, 1},
-				CV{17, 2}, CV{17, 3}, CV{17, 4}, CV{17, 5},
-				CV{17, 6}, CV{17, 7}, CV{17, 8}, CV{17, 9},
-				CV{17, 10}, CV{17, 11}, CV{17, 12}, CV{17, 13},
-				CV{17, 14}, CV{17, 15}, CV{17, 16}, CV{17, 17},
-				CV{17, 18}, CV{17, 19}, CV{17, 20}, CV{18, 1},
-				CV{18, 2}, CV{18, 3}, CV{18, 4}, CV{18, 5},
-				CV{18, 6}, CV{18, 7}, CV{18, 8}, CV{18, 9},
-				CV{18, 10}, CV{18, 11}, CV{18, 12}, CV{18, 13},
-				CV{18, 14}, CV{18, 15}, CV{18, 16}, CV{18, 17},
-				CV{18, 18}, CV{18, 19}, CV{18, 20}, CV{18, 21},
-				CV{18, 22}, CV{19, 1}, CV{19, 2}, CV{19, 3},
-				CV{19, 4}, CV{19, 5}, CV{19, 6}, CV{19, 7},
-				CV{19, 8}, CV{19, 9}, CV{19, 10}, CV{19, 11},
-				CV{19, 12}, CV{19, 13}, CV{19, 14}, CV{19, 15},
-				CV{19, 16}, CV{19, 17}, CV{19, 18}, CV{19, 19},
-				CV{19, 20}, CV{19, 21}, CV{20, 1}, CV{20, 2},
-				CV{20, 3}, CV{20, 4}, CV{20, 5}, CV{20, 6},
-				CV{20, 7}, CV{20, 8}, CV{20, 9}, CV{20, 10},
-				CV{20, 11}, CV{20, 12}, CV{20, 13}, CV{20, 14},
-				CV{20, 15}, CV{20, 16}, CV{20, 17}, CV{20, 18},
-				CV{20, 19}, CV{20, 20}, CV{21, 1}, CV{21, 2},
-				CV{21, 3}, CV{21, 4}, CV{21, 5}, CV{21, 6},
-				CV{21, 7}, CV{21, 8}, CV{21, 9}, CV{21, 10},
-				CV{21, 11}, CV{21, 12}, CV{21, 13}, CV{21, 14},
-				CV{21, 15}, CV{21, 16}, CV{21, 17}, CV{21, 18},
-				CV{21, 19}, CV{21, 20}, CV{21, 21}, CV{21, 22},
-				CV{21, 23}, CV{22, 1}, CV{22, 2}, CV{22, 3},
-				CV{22, 4}, CV{22, 5}, CV{22, 6}, CV{22, 7},
-				CV{22, 8}, CV{22, 9}, CV{22, 10}, CV{22, 11},
-				CV{22, 12}, CV{22, 13}, CV{22, 14}, CV{22, 15},
-				CV{22, 16}, CV{22, 17}, CV{22, 18}, CV{22, 19},
-				CV{22, 20}, CV{22, 21}, CV{22, 22}, CV{22, 23},
-				CV{22, 24}, CV{22, 25}, CV{22, 26}, CV{22, 27},
-				CV{22, 28}, CV{22, 29}, CV{22, 30}, CV{23, 1},
-				CV{23, 2}, CV{23, 3}, CV{23, 4}, CV{23, 5},
-				CV{23, 6}, CV{23, 7}, CV{23, 8}, CV{23, 9},
-				CV{23, 10}, CV{23, 11}, CV{23, 12}, CV{23, 13},
-				CV{23, 14}, CV{23, 15}, CV{23, 16}, CV{23, 17},
-				CV{23, 18}, CV{23, 19}, CV{23, 20}, CV{23, 21},
-				CV{23, 22}, CV{23, 23}, CV{23, 24}, CV{23, 25},
-				CV{24, 1}, CV{24, 2}, CV{24, 3}, CV{24, 4},
-				CV{24, 5}, CV{24, 6}, CV{24, 7}, CV{24, 8},
-				CV{24, 9}, CV{24, 10}, CV{24, 11}, CV{24, 12},
-				CV{24, 13}, CV{24, 14}, CV{24, 15}, CV{24, 16},
-				CV{24, 17}, CV{24, 18}, CV{24, 19}, CV{24, 20},
-				CV{24, 21}, CV{24, 22}, CV{25, 1}, CV{25, 2},
-				CV{25, 3}, CV{25, 4}, CV{25, 5}, CV{25, 6},
-				CV{25, 7}, CV{25, 8}, CV{25, 9}, CV{25, 10},
-				CV{25, 11}, CV{25, 12}, CV{25, 13}, CV{25, 14},
-				CV{25, 15}, CV{25, 16}, CV{25, 17}, CV{25, 18},
-				CV{25, 19}, CV{26, 1}, CV{26, 2}, CV{26, 3},
-				CV{26, 4}, CV{26, 5}, CV{26, 6}, CV{26, 7},
-				CV{26, 8}, CV{26, 9}, CV{26, 10}, CV{26, 11},
-				CV{26, 12}, CV{26, 13}, CV{26, 14}, CV{26, 15},
-				CV{26, 16}, CV{26, 17}, CV{26, 18}, CV{26, 19},
-				CV{27, 1}, CV{27, 2}, CV{27, 3}, CV{27, 4},
-				CV{27, 5}, CV{27, 6}, CV{27, 7}, CV{27, 8},
-				CV{27, 9}, CV{27, 10}, CV{27, 11}, CV{27, 12},
-				CV{27, 13}, CV{27, 14}, CV{27, 15}, CV{27, 16},
-				CV{27, 17}, CV{27, 18}, CV{27, 19}, CV{27, 20},
-				CV{27, 21}, CV{27, 22}, CV{27, 23}, CV{27, 24},
-				CV{27, 25}, CV{27, 26}, CV{28, 1}, CV{28, 2},
-				CV{28, 3}, CV{28, 4}, CV{28, 5}, CV{28, 6},
-				CV{28, 7}, CV{28, 8}, CV{28, 9}, CV{28, 10},
-				CV{28, 11}, CV{28, 12}, CV{28, 13}, CV{28, 14},
-				CV{28, 15}, CV{28, 16}, CV{28, 17}, CV{28, 18},
-				CV{28, 19}, CV{28, 20}, CV{28, 21}, CV{28, 22},
-				CV{28, 23}, CV{28, 24}, CV{28, 25}, CV{28, 26},
-				CV{28, 27}, CV{28, 28}, CV{28, 29}, CV{28, 30},
-				CV{28, 31}, CV{28, 32}, CV{28, 33}, CV{28, 34},
-				CV{28, 35}, CV{28, 36}, CV{28, 37}, CV{28, 38},
-				CV{28, 39}, CV{28, 40}, CV{28, 41}, CV{28, 42},
-				CV{28, 43}, CV{28, 44}, CV{28, 45}, CV{28, 46},
-				CV{28, 47}, CV{28, 48}, CV{28, 49}, CV{28, 50},
-				CV{28, 51}, CV{28, 52}, CV{28, 53}, CV{28, 54},
-				CV{28, 55}, CV{28, 56}, CV{28, 57}, CV{28, 58},
-				CV{28, 59}, CV{28, 60}, CV{28, 61}, CV{28, 62},
-				CV{28, 63}, CV{28, 64}, CV{28, 65}, CV{28, 66},
-				CV{28, 67}, CV{28, 68}, CV{29, 1}, CV{29, 2},
-				CV{29, 3}, CV{29, 4}, CV{29, 5}, CV{29, 6},
-				CV{29, 7}, CV{29, 8}, CV{29, 9}, CV{29, 10},
-				CV{29, 11}, CV{29, 12}, CV{29, 13}, CV{29, 14},
-				CV{29, 15}, CV{29, 16}, CV{29, 17}, CV{29, 18},
-				CV{29, 19}, CV{29, 20}, CV{29, 21}, CV{29, 22},
-				CV{29, 23}, CV{29, 24}, CV{29, 25}, CV{29, 26},
-				CV{29, 27}, CV{29, 28}, CV{29, 29}, CV{30, 1},
-				CV{30, 2}, CV{30, 3}, CV{30, 4}, CV{30, 5},
-				CV{30, 6}, CV{30, 7}, CV{30, 8}, CV{30, 9},
-				CV{30, 10}, CV{30, 11}, CV{30, 12}, CV{30, 13},
-				CV{30, 14}, CV{30, 15}, CV{30, 16}, CV{30, 17},
-				CV{30, 18}, CV{30, 19}, CV{30, 20}, CV{31, 1},
-				CV{31, 2}, CV{31, 3}, CV{31, 4}, CV{31, 5},
-				CV{31, 6}, CV{31, 7}, CV{31, 8}, CV{31, 9},
-				CV{31, 10}, CV{31, 11}, CV{31, 12}, CV{31, 13},
-				CV{31, 14}, CV{31, 15}, CV{31, 16}, CV{31, 17},
-				CV{31, 18}, CV{31, 19}, CV{31, 20}, CV{31, 21},
-				CV{31, 22}, CV{31, 23}, CV{31, 24}, CV{31, 25},
-				CV{31, 26}, CV{31, 27}, CV{31, 28}, CV{31, 29},
-				CV{31, 30}, CV{32, 1}, CV{32, 2}, CV{32, 3},
-				CV{32, 4}, CV{32, 5}, CV{32, 6}, CV{32, 7},
-				CV{32, 8}, CV{32, 9}, CV{32, 10}, CV{32, 11},
-				CV{32, 12}, CV{32, 13}, CV{32, 14}, CV{32, 15},
-				CV{32, 16}, CV{32, 17}, CV{32, 18}, CV{32, 19},
-				CV{32, 20}, CV{32, 21}, CV{32, 22}, CV{32, 23},
-				CV{32, 24}, CV{32, 25}, CV{32, 26}, CV{32, 27},
-				CV{32, 28}, CV{32, 29}, CV{32, 30}, CV{32, 31},
-				CV{32, 32}, CV{32, 33}, CV{32, 34}, CV{32, 35},
-				CV{32, 36}, CV{32, 37}, CV{32, 38}, CV{32, 39},
-				CV{32, 40}, CV{32, 41}, CV{32, 42}, CV{32, 43},
-				CV{32, 44}, CV{32, 45}, CV{32, 46}, CV{32, 47},
-				CV{32, 48}, CV{32, 49}, CV{32, 50}, CV{32, 51},
-				CV{32, 52}, CV{33, 1}, CV{33, 2}, CV{33, 3},
-				CV{33, 4}, CV{33, 5}, CV{33, 6}, CV{33, 7},
-				CV{33, 8}, CV{33, 9}, CV{33, 10}, CV{33, 11},
-				CV{33, 12}, CV{33, 13}, CV{33, 14}, CV{33, 15},
-				CV{33, 16}, CV{33, 17}, CV{33, 18}, CV{33, 19},
-				CV{33, 20}, CV{33, 21}, CV{33, 22}, CV{33, 23},
-				CV{33, 24}, CV{33, 25}, CV{33, 26}, CV{33, 27},
-				CV{33, 28}, CV{33, 29}, CV{34, 1}, CV{34, 2},
-				CV{34, 3}, CV{34, 4}, CV{34, 5}, CV{34, 6},
-				CV{34, 7}, CV{34, 8}, CV{34, 9}, CV{34, 10},
-				CV{34, 11}, CV{34, 12},
+			Runs: []VerseRun{
+				{Chapter: 1, First: 1, Last: 46},
+				{Chapter: 2, First: 1, Last: 37},
+				{Chapter: 3, First: 1, Last: 29},
+				{Chapter: 4, First: 1, Last: 49},
+				{Chapter: 5, First: 1, Last: 33},
+				{Chapter: 6, First: 1, Last: 25},
+				{Chapter: 7, First: 1, Last: 26},
+				{Chapter: 8, First: 1, Last: 20},
+				{Chapter: 9, First: 1, Last: 29},
+				{Chapter: 10, First: 1, Last: 22},
+				{Chapter: 11, First: 1, Last: 32},
+				{Chapter: 12, First: 1, Last: 32},
+				{Chapter: 13, First: 1, Last: 18},
+				{Chapter: 14, First: 1, Last: 29},
+				{Chapter: 15, First: 1, Last: 23},
+				{Chapter: 16, First: 1, Last: 22},
+				{Chapter: 17, First: 1, Last: 20},
+				{Chapter: 18, First: 1, Last: 22},
+				{Chapter: 19, First: 1, Last: 21},
+				{Chapter: 20, First: 1, Last: 20},
+				{Chapter: 21, First: 1, Last: 23},
+				{Chapter: 22, First: 1, Last: 30},
+				{Chapter: 23, First: 1, Last: 25},
+				{Chapter: 24, First: 1, Last: 22},
+				{Chapter: 25, First: 1, Last: 19},
+				{Chapter: 26, First: 1, Last: 19},
+				{Chapter: 27, First: 1, Last: 26},
+				{Chapter: 28, First: 1, Last: 68},
+				{Chapter: 29, First: 1, Last: 29},
+				{Chapter: 30, First: 1, Last: 20},
+				{Chapter: 31, First: 1, Last: 30},
+				{Chapter: 32, First: 1, Last: 52},
+				{Chapter: 33, First: 1, Last: 29},
+				{Chapter: 34, First: 1, Last: 12},
 			},
 		},
 		{
 			Name:      "Joshua",
+			USFM:      "JOS",
+			Testament: OldTestament,
 			JustVerse: false,
-			Verses: []Verse{CV{1, 1},
-				CV{1, 2}, CV{1, 3}, CV{1, 4}, CV{1, 5},
-				CV{1, 6}, CV{1, 7}, CV{1, 8}, CV{1, 9},
-				CV{1, 10}, CV{1, 11}, CV{1, 12}, CV{1, 13},
-				CV{1, 14}, CV{1, 15}, CV{1, 16}, CV{1, 17},
-				CV{1, 18}, CV{2, 1}, CV{2, 2}, CV{2, 3},
-				CV{2, 4}, CV{2, 5}, CV{2, 6}, CV{2, 7},
-				CV{2, 8}, CV{2, 9}, CV{2, 10}, CV{2, 11},
-				CV{2, 12}, CV{2, 13}, CV{2, 14}, CV{2, 15},
-				CV{2, 16}, CV{2, 17}, CV{2, 18}, CV{2, 19},
-				CV{2, 20}, CV{2, 21}, CV{2, 22}, CV{2, 23},
-				CV{2, 24}, CV{3, 1}, CV{3, 2}, CV{3, 3},
-				CV{3, 4}, CV{3, 5}, CV{3, 6}, CV{3, 7},
-				CV{3, 8}, CV{3, 9}, CV{3, 10}, CV{3, 11},
-				CV{3, 12}, CV{3, 13}, CV{3, 14}, CV{3, 15},
-				CV{3, 16}, CV{3, 17}, CV{4, 1}, CV{4, 2},
-				CV{4, 3}, CV{4, 4}, CV{4, 5}, CV{4, 6},
-				CV{4, 7}, CV{4, 8}, CV{4, 9}, CV{4, 10},
-				CV{4, 11}, CV{4, 12}, CV{4, 13}, CV{4, 14},
-				CV{4, 15}, CV{4, 16}, CV{4, 17}, CV{4, 18},
-				CV{4, 19}, CV{4, 20}, CV{4, 21}, CV{4, 22},
-				CV{4, 23}, CV{4, 24}, CV{5, 1}, CV{5, 2},
-				CV{5, 3}, CV{5, 4}, CV{5, 5}, CV{5, 6},
-				CV{5, 7}, CV{5, 8}, CV{5, 9}, CV{5, 10},
-				CV{5, 11}, CV{5, 12}, CV{5, 13}, CV{5, 14},
-				CV{5, 15}, CV{6, 1}, CV{6, 2}, CV{6, 3},
-				CV{6, 4}, CV{6, 5}, CV{6, 6}, CV{6, 7},
-				CV{6, 8}, CV{6, 9}, CV{6, 10}, CV{6, 11},
-				CV{6, 12}, CV{6, 13}, CV{6, 14}, CV{6, 15},
-				CV{6, 16}, CV{6, 17}, CV{6, 18}, CV{6, 19},
-				CV{6, 20}, CV{6, 21}, CV{6, 22}, CV{6, 23},
-				CV{6, 24}, CV{6, 25}, CV{6, 26}, CV{6, 27},
-				CV{7, 1}, CV{7, 2}, CV{7, 3}, CV{7, 4},
-				CV{7, 5}, CV{7, 6}, CV{7, 7}, CV{7, 8},
-				CV{7, 9}, CV{7, 10}, CV{7, 11}, CV{7, 12},
-				CV{7, 13}, CV{7, 14}, CV{7, 15}, CV{7, 16},
-				CV{7, 17}, CV{7, 18}, CV{7, 19}, CV{7, 20},
-				CV{7, 21}, CV{7, 22}, CV{7, 23}, CV{7, 24},
-				CV{7, 25}, CV{7, 26}, CV{8, 1}, CV{8, 2},
-				CV{8, 3}, CV{8, 4}, CV{8, 5}, CV{8, 6},
-				CV{8, 7}, CV{8, 8}, CV{8, 9}, CV{8, 10},
-				CV{8, 11}, CV{8, 12}, CV{8, 13}, CV{8, 14},
-				CV{8, 15}, CV{8, 16}, CV{8, 17}, CV{8, 18},
-				CV{8, 19}, CV{8, 20}, CV{8, 21}, CV{8, 22},
-				CV{8, 23}, CV{8, 24}, CV{8, 25}, CV{8, 26},
-				CV{8, 27}, CV{8, 28}, CV{8, 29}, CV{8, 30},
-				CV{8, 31}, CV{8, 32}, CV{8, 33}, CV{8, 34},
-				CV{8, 35}, CV{9, 1}, CV{9, 2}, CV{9, 3},
-				CV{9, 4}, CV{9, 5}, CV{9, 6}, CV{9, 7},
-				CV{9, 8}, CV{9, 9}, CV{9, 10}, CV{9, 11},
-				CV{9, 12}, CV{9, 13}, CV{9, 14}, CV{9, 15},
-				CV{9, 16}, CV{9, 17}, CV{9, 18}, CV{9, 19},
-				CV{9, 20}, CV{9, 21}, CV{9, 22}, CV{9, 23},
-				CV{9, 24}, CV{9, 25}, CV{9, 26}, CV{9, 27},
-				CV{10, 1}, CV{10, 2}, CV{10, 3}, CV{10, 4},
-				CV{10, 5}, CV{10, 6}, CV{10, 7}, CV{10, 8},
-				CV{10, 9}, CV{10, 10}, CV{10, 11}, CV{10, 12},
-				CV{10, 13}, CV{10, 14}, CV{10, 15}, CV{10, 16},
-				CV{10, 17}, CV{10, 18}, CV{10, 19}, CV{10, 20},
-				CV{10, 21}, CV{10, 22}, CV{10, 23}, CV{10, 24},
-				CV{10, 25}, CV{10, 26}, CV{10, 27}, CV{10, 28},
-				CV{10, 29}, CV{10, 30}, CV{10, 31}, CV{10, 32},
-				CV{10, 33}, CV{10, 34}, CV{10, 35}, CV{10, 36},
-				CV{10, 37}, CV{10, 38}, CV{10, 39}, CV{10, 40},
-				CV{10, 41}, CV{10, 42}, CV{10, 43}, CV{11, 1},
-				CV{11, 2}, CV{11, 3}, CV{11, 4}, CV{11, 5},
-				CV{11, 6}, CV{11, 7}, CV{11, 8}, CV{11, 9},
-				CV{11, 10}, CV{11, 11}, CV{11, 12}, CV{11, 13},
-				CV{11, 14}, CV{11, 15}, CV{11, 16}, CV{11, 17},
-				CV{11, 18}, CV{11, 19}, CV{11, 20}, CV{11, 21},
-				CV{11, 22}, CV{11, 23}, CV{12, 1}, CV{12, 2},
-				CV{12, 3}, CV{12, 4}, CV{12, 5}, CV{12, 6},
-				CV{12, 7}, CV{12, 8}, CV{12, 9}, CV{12, 10},
-				CV{12, 11}, CV{12, 12}, CV{12, 13}, CV{12, 14},
-				CV{12, 15}, CV{12, 16}, CV{12, 17}, CV{12, 18},
-				CV{12, 19}, CV{12, 20}, CV{12, 21}, CV{12, 22},
-				CV{12, 23}, CV{12, 24}, CV{13, 1}, CV{13, 2},
-				CV{13, 3}, CV{13, 4}, CV{13, 5}, CV{13, 6},
-				CV{13, 7}, CV{13, 8}, CV{13, 9}, CV{13, 10},
-				CV{13, 11}, CV{13, 12}, CV{13, 13}, CV{13, 14},
-				CV{13, 15}, CV{13, 16}, CV{13, 17}, CV{13, 18},
-				CV{13, 19}, CV{13, 20}, CV{13, 21}, CV{13, 22},
-				CV{13, 23}, CV{13, 24}, CV{13, 25}, CV{13, 26},
-				CV{13, 27}, CV{13, 28}, CV{13, 29}, CV{13, 30},
-				CV{13, 31}, CV{13, 32}, CV{13, 33}, CV{14, 1},
-				CV{14, 2}, CV{14, 3}, CV{14, 4}, CV{14, 5},
-				CV{14, 6}, CV{14, 7}, CV{14, 8}, CV{14, 9},
-				CV{14, 10}, CV{14, 11}, CV{14, 12}, CV{14, 13},
-				CV{14, 14}, CV{14, 15}, CV{15, 1}, CV{15, 2},
-				CV{15, 3}, CV{15, 4}, CV{15, 5}, CV{15, 6},
-				CV{15, 7}, CV{15, 8}, CV{15, 9}, CV{15, 10},
-				CV{15, 11}, CV{15, 12}, CV{15, 13}, CV{15, 14},
-				CV{15, 15}, CV{15, 16}, CV{15, 17}, CV{15, 18},
-				CV{15, 19}, CV{15, 20}, CV{15, 21}, CV{15, 22},
-				CV{15, 23}, CV{15, 24}, CV{15, 25}, CV{15, 26},
-				CV{15, 27}, CV{15, 28}, CV{15, 29}, CV{15, 30},
-				CV{15, 31}, CV{15, 32}, CV{15, 33}, CV{15, 34},
-				CV{15, 35}, CV{15, 36}, CV{15, 37}, CV{15, 38},
-				CV{15, 39}, CV{15, 40}, CV{15, 41}, CV{15, 42},
-				CV{15, 43}, CV{15, 44}, CV{15, 45}, CV{15, 46},
-				CV{15, 47}, CV{15, 48}, CV{15, 49}, CV{15, 50},
-				CV{15, 51}, CV{15, 52}, CV{15, 53}, CV{15, 54},
-				CV{15, 55}, CV{15, 56}, CV{15, 57}, CV{15, 58},
-				CV{15, 59}, CV{15, 60}, CV{15, 61}, CV{15, 62},
-				CV{15, 63}, CV{16, 1}, CV{16, 2}, CV{16, 3},
-				CV{16, 4}, CV{16, 5}, CV{16, 6}, CV{16, 7},
-				CV{16, 8}, CV{16, 9}, CV{16, 10}, CV{17, 1},
-				CV{17, 2}, CV{17, 3}, CV{17, 4}, CV{17, 5},
-				CV{17, 6}, CV{17, 7}, CV{17, 8}, CV{17, 9},
-				CV{17, 10}, CV{17, 11}, CV{17, 12}, CV{17, 13},
-				CV{17, 14}, CV{17, 15}, CV{17, 16}, CV{17, 17},
-				CV{17, 18}, CV{18, 1}, CV{18, 2}, CV{18, 3},
-				CV{18, 4}, CV{18, 5}, CV{18, 6}, CV{18, 7},
-				CV{18, 8}, CV{18, 9}, CV{18, 10}, CV{18, 11},
-				CV{18, 12}, CV{18, 13}, CV{18, 14}, CV{18, 15},
-				CV{18, 16}, CV{18, 17}, CV{18, 18}, CV{18, 19},
-				CV{18, 20}, CV{18, 21}, CV{18, 22}, CV{18, 23},
-				CV{18, 24}, CV{18, 25}, CV{18, 26}, CV{18, 27},
-				CV{18, 28}, CV{19, 1}, CV{19, 2}, CV{19, 3},
-				CV{19, 4}, CV{19, 5}, CV{19, 6}, CV{19, 7},
-				CV{19, 8}, CV{19, 9}, CV{19, 10}, CV{19, 11},
-				CV{19, 12}, CV{19, 13}, CV{19, 14}, CV{19, 15},
-				CV{19, 16}, CV{19, 17}, CV{19, 18}, CV{19, 19},
-				CV{19, 20}, CV{19, 21}, CV{19, 22}, CV{19, 23},
-				CV{19, 24}, CV{19, 25}, CV{19, 26}, CV{19, 27},
-				CV{19, 28}, CV{19, 29}, CV{19, 30}, CV{19, 31},
-				CV{19, 32}, CV{19, 33}, CV{19, 34}, CV{19, 35},
-				CV{19, 36}, CV{19, 37}, CV{19, 38}, CV{19, 39},
-				CV{19, 40}, CV{19, 41}, CV{19, 42}, CV{19, 43},
-				CV{19, 44}, CV{19, 45}, CV{19, 46}, CV{19, 47},
-				CV{19, 48}, CV{19, 49}, CV{19, 50}, CV{19, 51},
-				CV{20, 1}, CV{20, 2}, CV{20, 3}, CV{20, 4},
-				CV{20, 5}, CV{20, 6}, CV{20, 7}, CV{20, 8},
-				CV{20, 9}, CV{21, 1}, CV{21, 2}, CV{21, 3},
-				CV{21, 4}, CV{21, 5}, CV{21, 6}, CV{21, 7},
-				CV{21, 8}, CV{21, 9}, CV{21, 10}, CV{21, 11},
-				CV{21, 12}, CV{21, 13}, CV{21, 14}, CV{21, 15},
-				CV{21, 16}, CV{21, 17}, CV{21, 18}, CV{21, 19},
-				CV{21, 20}, CV{21, 21}, CV{21, 22}, CV{21, 23},
-				CV{21, 24}, CV{21, 25}, CV{21, 26}, CV{21, 27},
-				CV{21, 28}, CV{21, 29}, CV{21, 30}, CV{21, 31},
-				CV{21, 32}, CV{21, 33}, CV{21, 34}, CV{21, 35},
-				CV{21, 36}, CV{21, 37}, CV{21, 38}, CV{21, 39},
-				CV{21, 40}, CV{21, 41}, CV{21, 42}, CV{21, 43},
-				CV{21, 44}, CV{21, 45}, CV{22, 1}, CV{22, 2},
-				CV{22, 3}, CV{22, 4}, CV{22, 5}, CV{22, 6},
-				CV{22, 7}, CV{22, 8}, CV{22, 9}, CV{22, 10},
-				CV{22, 11}, CV{22, 12}, CV{22, 13}, CV{22, 14},
-				CV{22, 15}, CV{22, 16}, CV{22, 17}, CV{22, 18},
-				CV{22, 19}, CV{22, 20}, CV{22, 21}, CV{22, 22},
-				CV{22, 23}, CV{22, 24}, CV{22, 25}, CV{22, 26},
-				CV{22, 27}, CV{22, 28}, CV{22, 29}, CV{22, 30},
-				CV{22, 31}, CV{22, 32}, CV{22, 33}, CV{22, 34},
-				CV{23, 1}, CV{23, 2}, CV{23, 3}, CV{23, 4},
-				CV{23, 5}, CV{23, 6}, CV{23, 7}, CV{23, 8},
-				CV{23, 9}, CV{23, 10}, CV{23, 11}, CV{23, 12},
-				CV{23, 13}, CV{23, 14}, CV{23, 15}, CV{23, 16},
-				CV{24, 1}, CV{24, 2}, CV{24, 3}, CV{24, 4},
-				CV{24, 5}, CV{24, 6}, CV{24, 7}, CV{24, 8},
-				CV{24, 9}, CV{24, 10}, CV{24, 11}, CV{24, 12},
-				CV{24, 13}, CV{24, 14}, CV{24, 15}, CV{24, 16},
-				CV{24, 17}, CV{24, 18}, CV{24, 19}, CV{24, 20},
-				CV{24, 21}, CV{24, 22}, CV{24, 23}, CV{24, 24},
-				CV{24, 25}, CV{24, 26}, CV{24, 27}, CV{24, 28},
-				CV{24, 29}, CV{24, 30}, CV{24, 31}, CV{24, 32},
-				CV{24, 33},
+			Runs: []VerseRun{
+				{Chapter: 1, First: 1, Last: 18},
+				{Chapter: 2, First: 1, Last: 24},
+				{Chapter: 3, First: 1, Last: 17},
+				{Chapter: 4, First: 1, Last: 24},
+				{Chapter: 5, First: 1, Last: 15},
+				{Chapter: 6, First: 1, Last: 27},
+				{Chapter: 7, First: 1, Last: 26},
+				{Chapter: 8, First: 1, Last: 35},
+				{Chapter: 9, First: 1, Last: 27},
+				{Chapter: 10, First: 1, Last: 43},
+				{Chapter: 11, First: 1, Last: 23},
+				{Chapter: 12, First: 1, Last: 24},
+				{Chapter: 13, First: 1, Last: 33},
+				{Chapter: 14, First: 1, Last: 15},
+				{Chapter: 15, First: 1, Last: 63},
+				{Chapter: 16, First: 1, Last: 10},
+				{Chapter: 17, First: 1, Last: 18},
+				{Chapter: 18, First: 1, Last: 28},
+				{Chapter: 19, First: 1, Last: 51},
+				{Chapter: 20, First: 1, Last: 9},
+				{Chapter: 21, First: 1, Last: 45},
+				{Chapter: 22, First: 1, Last: 34},
+				{Chapter: 23, First: 1, Last: 16},
+				{Chapter: 24, First: 1, Last: 33},
 			},
 		},
 		{
 			Name:      "Judges",
+			USFM:      "JDG",
+			Testament: OldTestament,
 			JustVerse: false,
-			Verses: []Verse{CV{1, 1},
-				CV{1, 2}, CV{1, 3}, CV{1, 4}, CV{1, 5},
-				CV{1, 6}, CV{1, 7}, CV{1, 8}, CV{1, 9},
-				CV{1, 10}, CV{1, 11}, CV{1, 12}, CV{1, 13},
-				CV{1, 14}, CV{1, 15}, CV{1, 16}, CV{1, 17},
-				CV{1, 18}, CV{1, 19}, CV{1, 20}, CV{1, 21},
-				CV{1, 22}, CV{1, 23}, CV{1, 24}, CV{1, 25},
-				CV{1, 26}, CV{1, 27}, CV{1, 28}, CV{1, 29},
-				CV{1, 30}, CV{1, 31}, CV{1, 32}, CV{1, 33},
-				CV{1, 34}, CV{1, 35}, CV{1, 36}, CV{2, 1},
-				CV{2, 2}, CV{2, 3}, CV{2, 4}, CV{2, 5},
-				CV{2, 6}, CV{2, 7}, CV{2, 8}, CV{2, 9},
-				CV{2, 10}, CV{2, 11}, CV{2, 12}, CV{2, 13},
-				CV{2, 14}, CV{2, 15}, CV{2, 16}, CV{2, 17},
-				CV{2, 18}, CV{2, 19}, CV{2, 20}, CV{2, 21},
-				CV{2, 22}, CV{2, 23}, CV{3, 1}, CV{3, 2},
-				CV{3, 3}, CV{3, 4}, CV{3, 5}, CV{3, 6},
-				CV{3, 7}, CV{3, 8}, CV{3, 9}, CV{3, 10},
-				CV{3, 11}, CV{3, 12}, CV{3, 13}, CV{3, 14},
-				CV{3, 15}, CV{3, 16}, CV{3, 17}, CV{3, 18},
-				CV{3, 19}, CV{3, 20}, CV{3, 21}, CV{3, 22},
-				CV{3, 23}, CV{3, 24}, CV{3, 25}, CV{3, 26},
-				CV{3, 27}, CV{3, 28}, CV{3, 29}, CV{3, 30},
-				CV{3, 31}, CV{4, 1}, CV{4, 2}, CV{4, 3},
-				CV{4, 4}, CV{4, 5}, CV{4, 6}, CV{4, 7},
-				CV{4, 8}, CV{4, 9}, CV{4, 10}, CV{4, 11},
-				CV{4, 12}, CV{4, 13}, CV{4, 14}, CV{4, 15},
-				CV{4, 16}, CV{4, 17}, CV{4, 18}, CV{4, 19},
-				CV{4, 20}, CV{4, 21}, CV{4, 22}, CV{4, 23},
-				CV{4, 24}, CV{5, 1}, CV{5, 2}, CV{5, 3},
-				CV{5, 4}, CV{5, 5}, CV{5, 6}, CV{5, 7},
-				CV{5, 8}, CV{5, 9}, CV{5, 10}, CV{5, 11},
-				CV{5, 12}, CV{5, 13}, CV{5, 14}, CV{5, 15},
-				CV{5, 16}, CV{5, 17}, CV{5, 18}, CV{5, 19},
-				CV{5, 20}, CV{5, 21}, CV{5, 22}, CV{5, 23},
-				CV{5, 24}, CV{5, 25}, CV{5, 26}, CV{5, 27},
-				CV{5, 28}, CV{5, 29}, CV{5, 30}, CV{5, 31},
-				CV{6, 1}, CV{6, 2}, CV{6, 3}, CV{6, 4},
-				CV{6, 5}, CV{6, 6}, CV{6, 7}, CV{6, 8},
-				CV{6, 9}, CV{6, 10}, CV{6, 11}, CV{6, 12},
-				CV{6, 13}, CV{6, 14}, CV{6, 15}, CV{6, 16},
-				CV{6, 17}, CV{6, 18}, CV{6, 19}, CV{6, 20},
-				CV{6, 21}, CV{6, 22}, CV{6, 23}, CV{6, 24},
-				CV{6, 25}, CV{6, 26}, CV{6, 27}, CV{6, 28},
-				CV{6, 29}, CV{6, 30}, CV{6, 31}, CV{6, 32},
-				CV{6, 33}, CV{6, 34}, CV{6, 35}, CV{6, 36},
-				CV{6, 37}, CV{6, 38}, CV{6, 39}, CV{6, 40},
-				CV{7, 1}, CV{7, 2}, CV{7, 3}, CV{7, 4},
-				CV{7, 5}, CV{7, 6}, CV{7, 7}, CV{7, 8},
-				CV{7, 9}, CV{7, 10}, CV{7, 11}, CV{7, 12},
-				CV{7, 13}, CV{7, 14}, CV{7, 15}, CV{7, 16},
-				CV{7, 17}, CV{7, 18}, CV{7, 19}, CV{7, 20},
-				CV{7, 21}, CV{7, 22}, CV{7, 23}, CV{7, 24},
-				CV{7, 25}, CV{8, 1}, CV{8, 2}, CV{8, 3},
-				CV{8, 4}, CV{8, 5}, CV{8, 6}, CV{8, 7},
-				CV{8, 8}, CV{8, 9}, CV{8, 10}, CV{8, 11},
-				CV{8, 12}, CV{8, 13}, CV{8, 14}, CV{8, 15},
-				CV{8, 16}, CV{8, 17}, CV{8, 18}, CV{8, 19},
-				CV{8, 20}, CV{8, 21}, CV{8, 22}, CV{8, 23},
-				CV{8, 24}, CV{8, 25}, CV{8, 26}, CV{8, 27},
-				CV{8, 28}, CV{8, 29}, CV{8, 30}, CV{8, 31},
-				CV{8, 32}, CV{8, 33}, CV{8, 34}, CV{8, 35},
-				CV{9, 1}, CV{9, 2}, CV{9, 3}, CV{9, 4},
-				CV{9, 5}, CV{9, 6}, CV{9, 7}, CV{9, 8},
-				CV{9, 9}, CV{9, 10}, CV{9, 11}, CV{9, 12},
-				CV{9, 13}, CV{9, 14}, CV{9, 15}, CV{9, 16},
-				CV{9, 17}, CV{9, 18}, CV{9, 19}, CV{9, 20},
-				CV{9, 21}, CV{9, 22}, CV{9, 23}, CV{9, 24},
-				CV{9, 25}, CV{9, 26}, CV{9, 27}, CV{9, 28},
-				CV{9, 29}, CV{9, 30}, CV{9, 31}, CV{9, 32},
-				CV{9, 33}, CV{9, 34}, CV{9, 35}, CV{9, 36},
-				CV{9, 37}, CV{9, 38}, CV{9, 39}, CV{9, 40},
-				CV{9, 41}, CV{9, 42}, CV{9, 43}, CV{9, 44},
-				CV{9, 45}, CV{9, 46}, CV{9, 47}, CV{9, 48},
-				CV{9, 49}, CV{9, 50}, CV{9, 51}, CV{9, 52},
-				CV{9, 53}, CV{9, 54}, CV{9, 55}, CV{9, 56},
-				CV{9, 57}, CV{10, 1}, CV{10, 2}, CV{10, 3},
-				CV{10, 4}, CV{10, 5}, CV{10, 6}, CV{10, 7},
-				CV{10, 8}, CV{10, 9}, CV{10, 10}, CV{10, 11},
-				CV{10, 12}, CV{10, 13}, CV{10, 14}, CV{10, 15},
-				CV{10, 16}, CV{10, 17}, CV{10, 18}, CV{11, 1},
-				CV{11, 2}, CV{11, 3}, CV{11, 4}, CV{11, 5},
-				CV{11, 6}, CV{11, 7}, CV{11, 8}, CV{11, 9},
-				CV{11, 10}, CV{11, 11}, CV{11, 12}, CV{11, 13},
-				CV{11, 14}, CV{11, 15}, CV{11, 16}, CV{11, 17},
-				CV{11, 18}, CV{11, 19}, CV{11, 20}, CV{11, 21},
-				CV{11, 22}, CV{11, 23}, CV{11, 24}, CV{11, 25},
-				CV{11, 26}, CV{11, 27}, CV{11, 28}, CV{11, 29},
-				CV{11, 30}, CV{11, 31}, CV{11, 32}, CV{11, 33},
-				CV{11, 34}, CV{11, 35}, CV{11, 36}, CV{11, 37},
-				CV{11, 38}, CV{11, 39}, CV{11, 40}, CV{12, 1},
-				CV{12, 2}, CV{12, 3}, CV{12, 4}, CV{12, 5},
-				CV{12, 6}, CV{12, 7}, CV{12, 8}, CV{12, 9},
-				CV{12, 10}, CV{12, 11}, CV{12, 12}, CV{12, 13},
-				CV{12, 14}, CV{12, 15}, CV{13, 1}, CV{13, 2},
-				CV{13, 3}, CV{13, 4}, CV{13, 5}, CV{13, 6},
-				CV{13, 7}, CV{13, 8}, CV{13, 9}, CV{13, 10},
-				CV{13, 11}, CV{13, 12}, CV{13, 13}, CV{13, 14},
-				CV{13, 15}, CV{13, 16}, CV{13, 17}, CV{13, 18},
-				CV{13, 19}, CV{13, 20}, CV{13, 21}, CV{13, 22},
-				CV{13, 23}, CV{13, 24}, CV{13, 25}, CV{14, 1},
-				CV{14, 2}, CV{14, 3}, CV{14, 4}, CV{14, 5},
-				CV{14, 6}, CV{14, 7}, CV{14, 8}, CV{14, 9},
-				CV{14, 10}, CV{14, 11}, CV{14, 12}, CV{14, 13},
-				CV{14, 14}, CV{14, 15}, CV{14, 16}, CV{14, 17},
-				CV{14, 18}, CV{14, 19}, CV{14, 20}, CV{15, 1},
-				CV{15, 2}, CV{15, 3}, CV{15, 4}, CV{15, 5},
-				CV{15, 6}, CV{15, 7}, CV{15, 8}, CV{15, 9},
-				CV{15, 10}, CV{15, 11}, CV{15, 12}, CV{15, 13},
-				CV{15, 14}, CV{15, 15}, CV{15, 16}, CV{15, 17},
-				CV{15, 18}, CV{15, 19}, CV{15, 20}, CV{16, 1},
-				CV{16, 2}, CV{16, 3}, CV{16, 4}, CV{16, 5},
-				CV{16, 6}, CV{16, 7}, CV{16, 8}, CV{16, 9},
-				CV{16, 10}, CV{16, 11}, CV{16, 12}, CV{16, 13},
-				CV{16, 14}, CV{16, 15}, CV{16, 16}, CV{16, 17},
-				CV{16, 18}, CV{16, 19}, CV{16, 20}, CV{16, 21},
-				CV{16, 22}, CV{16, 23}, CV{16, 24}, CV{16, 25},
-				CV{16, 26}, CV{16, 27}, CV{16, 28}, CV{16, 29},
-				CV{16, 30}, CV{16, 31}, CV{17, 1}, CV{17, 2},
-				CV{17, 3}, CV{17, 4}, CV{17, 5}, CV{17, 6},
-				CV{17, 7}, CV{17, 8}, CV{17, 9}, CV{17, 10},
-				CV{17, 11}, CV{17, 12}, CV{17, 13}, CV{18, 1},
-				CV{18, 2}, CV{18, 3}, CV{18, 4}, CV{18, 5},
-				CV{18, 6}, CV{18, 7}, CV{18, 8}, CV{18, 9},
-				CV{18, 10}, CV{18, 11}, CV{18, 12}, CV{18, 13},
-				CV{18, 14}, CV{18, 15}, CV{18, 16}, CV{18, 17},
-				CV{18, 18}, CV{18, 19}, CV{18, 20}, CV{18, 21},
-				CV{18, 22}, CV{18, 23}, CV{18, 24}, CV{18, 25},
-				CV{18, 26}, CV{18, 27}, CV{18, 28}, CV{18, 29},
-				CV{18, 30}, CV{18, 31}, CV{19, 1}, CV{19, 2},
-				CV{19, 3}, CV{19, 4}, CV{19, 5}, CV{19, 6},
-				CV{19, 7}, CV{19, 8}, CV{19, 9}, CV{19, 10},
-				CV{19, 11}, CV{19, 12}, CV{19, 13}, CV{19, 14},
-				CV{19, 15}, CV{19, 16}, CV{19, 17}, CV{19, 18},
-				CV{19, 19}, CV{19, 20}, CV{19, 21}, CV{19, 22},
-				CV{19, 23}, CV{19, 24}, CV{19, 25}, CV{19, 26},
-				CV{19, 27}, CV{19, 28}, CV{19, 29}, CV{19, 30},
-				CV{20, 1}, CV{20, 2}, CV{20, 3}, CV{20, 4},
-				CV{20, 5}, CV{20, 6}, CV{20, 7}, CV{20, 8},
-				CV{20, 9}, CV{20, 10}, CV{20, 11}, CV{20, 12},
-				CV{20, 13}, CV{20, 14}, CV{20, 15}, CV{20, 16},
-				CV{20, 17}, CV{20, 18}, CV{20, 19}, CV{20, 20},
-				CV{20, 21}, CV{20, 22}, CV{20, 23}, CV{20, 24},
-				CV{20, 25}, CV{20, 26}, CV{20, 27}, CV{20, 28},
-				CV{20, 29}, CV{20, 30}, CV{20, 31}, CV{20, 32},
-				CV{20, 33}, CV{20, 34}, CV{20, 35}, CV{20, 36},
-				CV{20, 37}, CV{20, 38}, CV{20, 39}, CV{20, 40},
-				CV{20, 41}, CV{20, 42}, CV{20, 43}, CV{20, 44},
-				CV{20, 45}, CV{20, 46}, CV{20, 47}, CV{20, 48},
-				CV{21, 1}, CV{21, 2}, CV{21, 3}, CV{21, 4},
-				CV{21, 5}, CV{21, 6}, CV{21, 7}, CV{21, 8},
-				CV{21, 9}, CV{21, 10}, CV{21, 11}, CV{21, 12},
-				CV{21, 13}, CV{21, 14}, CV{21, 15}, CV{21, 16},
-				CV{21, 17}, CV{21, 18}, CV{21, 19}, CV{21, 20},
-				CV{21, 21}, CV{21, 22}, CV{21, 23}, CV{21, 24},
-				CV{21, 25},
+			Runs: []VerseRun{
+				{Chapter: 1, First: 1, Last: 36},
+				{Chapter: 2, First: 1, Last: 23},
+				{Chapter: 3, First: 1, Last: 31},
+				{Chapter: 4, First: 1, Last: 24},
+				{Chapter: 5, First: 1, Last: 31},
+				{Chapter: 6, First: 1, Last: 40},
+				{Chapter: 7, First: 1, Last: 25},
+				{Chapter: 8, First: 1, Last: 35},
+				{Chapter: 9, First: 1, Last: 57},
+				{Chapter: 10, First: 1, Last: 18},
+				{Chapter: 11, First: 1, Last: 40},
+				{Chapter: 12, First: 1, Last: 15},
+				{Chapter: 13, First: 1, Last: 25},
+				{Chapter: 14, First: 1, Last: 20},
+				{Chapter: 15, First: 1, Last: 20},
+				{Chapter: 16, First: 1, Last: 31},
+				{Chapter: 17, First: 1, Last: 13},
+				{Chapter: 18, First: 1, Last: 31},
+				{Chapter: 19, First: 1, Last: 30},
+				{Chapter: 20, First: 1, Last: 48},
+				{Chapter: 21, First: 1, Last: 25},
 			},
 		},
 		{
 			Name:      "Ruth",
+			USFM:      "RUT",
+			Testament: OldTestament,
 			JustVerse: false,
-			Verses: []Verse{CV{1, 1},
-				CV{1, 2}, CV{1, 3}, CV{1, 4}, CV{1, 5},
-				CV{1, 6}, CV{1, 7}, CV{1, 8}, CV{1, 9},
-				CV{1, 10}, CV{1, 11}, CV{1, 12}, CV{1, 13},
-				CV{1, 14}, CV{1, 15}, CV{1, 16}, CV{1, 17},
-				CV{1, 18}, CV{1, 19}, CV{1, 20}, CV{1, 21},
-				CV{1, 22}, CV{2, 1}, CV{2, 2}, CV{2, 3},
-				CV{2, 4}, CV{2, 5}, CV{2, 6}, CV{2, 7},
-				CV{2, 8}, CV{2, 9}, CV{2, 10}, CV{2, 11},
-				CV{2, 12}, CV{2, 13}, CV{2, 14}, CV{2, 15},
-				CV{2, 16}, CV{2, 17}, CV{2, 18}, CV{2, 19},
-				CV{2, 20}, CV{2, 21}, CV{2, 22}, CV{2, 23},
-				CV{3, 1}, CV{3, 2}, CV{3, 3}, CV{3, 4},
-				CV{3, 5}, CV{3, 6}, CV{3, 7}, CV{3, 8},
-				CV{3, 9}, CV{3, 10}, CV{3, 11}, CV{3, 12},
-				CV{3, 13}, CV{3, 14}, CV{3, 15}, CV{3, 16},
-				CV{3, 17}, CV{3, 18}, CV{4, 1}, CV{4, 2},
-				CV{4, 3}, CV{4, 4}, CV{4, 5}, CV{4, 6},
-				CV{4, 7}, CV{4, 8}, CV{4, 9}, CV{4, 10},
-				CV{4, 11}, CV{4, 12}, CV{4, 13}, CV{4, 14},
-				CV{4, 15}, CV{4, 16}, CV{4, 17}, CV{4, 18},
-				CV{4, 19}, CV{4, 20}, CV{4, 21}, CV{4, 22},
+			Runs: []VerseRun{
+				{Chapter: 1, First: 1, Last: 22},
+				{Chapter: 2, First: 1, Last: 23},
+				{Chapter: 3, First: 1, Last: 18},
+				{Chapter: 4, First: 1, Last: 22},
 			},
 		},
 		{
 			Name:      "1 Samuel",
+			USFM:      "1SA",
+			Testament: OldTestament,
 			JustVerse: false,
-			Verses: []Verse{CV{1, 1},
-				CV{1, 2}, CV{1, 3}, CV{1, 4}, CV{1, 5},
-				CV{1, 6}, CV{1, 7}, CV{1, 8}, CV{1, 9},
-				CV{1, 10}, CV{1, 11}, CV{1, 12}, CV{1, 13},
-				CV{1, 14}, CV{1, 15}, CV{1, 16}, CV{1, 17},
-				CV{1, 18}, CV{1, 19}, CV{1, 20}, CV{1, 21},
-				CV{1, 22}, CV{1, 23}, CV{1, 24}, CV{1, 25},
-				CV{1, 26}, CV{1, 27}, CV{1, 28}, CV{2, 1},
-				CV{2, 2}, CV{2, 3}, CV{2, 4}, CV{2, 5},
-				CV{2, 6}, CV{2, 7}, CV{2, 8}, CV{2, 9},
-				CV{2, 10}, CV{2, 11}, CV{2, 12}, CV{2, 13},
-				CV{2, 14}, CV{2, 15}, CV{2, 16}, CV{2, 17},
-				CV{2, 18}, CV{2, 19}, CV{2, 20}, CV{2, 21},
-				CV{2, 22}, CV{2, 23}, CV{2, 24}, CV{2, 25},
-				CV{2, 26}, CV{2, 27}, CV{2, 28}, CV{2, 29},
-				CV{2, 30}, CV{2, 31}, CV{2, 32}, CV{2, 33},
-				CV{2, 34}, CV{2, 35}, CV{2, 36}, CV{3, 1},
-				CV{3, 2}, CV{3, 3}, CV{3, 4}, CV{3, 5},
-				CV{3, 6}, CV{3, 7}, CV{3, 8}, CV{3, 9},
-				CV{3, 10}, CV{3, 11}, CV{3, 12}, CV{3, 13},
-				CV{3, 14}, CV{3, 15}, CV{3, 16}, CV{3, 17},
-				CV{3, 18}, CV{3, 19}, CV{3, 20}, CV{3, 21},
-				CV{4, 1}, CV{4, 2}, CV{4, 3}, CV{4, 4},
-				CV{4, 5}, CV{4, 6}, CV{4, 7}, CV{4, 8},
-				CV{4, 9}, CV{4, 10}, CV{4, 11}, CV{4, 12},
-				CV{4, 13}, CV{4, 14}, CV{4, 15}, CV{4, 16},
-				CV{4, 17}, CV{4, 18}, CV{4, 19}, CV{4, 20},
-				CV{4, 21}, CV{4, 22}, CV{5, 1}, CV{5, 2},
-				CV{5, 3}, CV{5, 4}, CV{5, 5}, CV{5, 6},
-				CV{5, 7}, CV{5, 8}, CV{5, 9}, CV{5, 10},
-				CV{5, 11}, CV{5, 12}, CV{6, 1}, CV{6, 2},
-				CV{6, 3}, CV{6, 4}, CV{6, 5}, CV{6, 6},
-				CV{6, 7}, CV{6, 8}, CV{6, 9}, CV{6, 10},
-				CV{6, 11}, CV{6, 12}, CV{6, 13}, CV{6, 14},
-				CV{6, 15}, CV{6, 16}, CV{6, 17}, CV{6, 18},
-				CV{6, 19}, CV{6, 20}, CV{6, 21}, CV{7, 1},
-				CV{7, 2}, CV{7, 3}, CV{7, 4}, CV{7, 5},
-				CV{7, 6}, CV{7, 7}, CV{7, 8}, CV{7, 9},
-				CV{7, 10}, CV{7, 11}, CV{7, 12}, CV{7, 13},
-				CV{7, 14}, CV{7, 15}, CV{7, 16}, CV{7, 17},
-				CV{8, 1}, CV{8, 2}, CV{8, 3}, CV{8, 4},
-				CV{8, 5}, CV{8, 6}, CV{8, 7}, CV{8, 8},
-				CV{8, 9}, CV{8, 10}, CV{8, 11}, CV{8, 12},
-				CV{8, 13}, CV{8, 14}, CV{8, 15}, CV{8, 16},
-				CV{8, 17}, CV{8, 18}, CV{8, 19}, CV{8, 20},
-				CV{8, 21}, CV{8, 22}, CV{9, 1}, CV{9, 2},
-				CV{9, 3}, CV{9, 4}, CV{9, 5}, CV{9, 6},
-				CV{9, 7}, CV{9, 8}, CV{9, 9}, CV{9, 10},
-				CV{9, 11}, CV{9, 12}, CV{9, 13}, CV{9, 14},
-				CV{9, 15}, CV{9, 16}, CV{9, 17}, CV{9, 18},
-				CV{9, 19}, CV{9, 20}, CV{9, 21}, CV{9, 22},
-				CV{9, 23}, CV{9, 24}, CV{9, 25}, CV{9, 26},
-				CV{9, 27}, CV{10, 1}, CV{10, 2}, CV{10, 3},
-				CV{10, 4}, CV{10, 5}, CV{10, 6}, CV{10, 7},
-				CV{10, 8}, CV{10, 9}, CV{10, 10}, CV{10, 11},
-				CV{10, 12}, CV{10, 13}, CV{10, 14}, CV{10, 15},
-				CV{10, 16}, CV{10, 17}, CV{10, 18}, CV{10, 19},
-				CV{10, 20}, CV{10, 21}, CV{10, 22}, CV{10, 23},
-				CV{10, 24}, CV{10, 25}, CV{10, 26}, CV{10, 27},
-				CV{11, 1}, CV{11, 2}, CV{11, 3}, CV{11, 4},
-				CV{11, 5}, CV{11, 6}, CV{11, 7}, CV{11, 8},
-				CV{11, 9}, CV{11, 10}, CV{11, 11}, CV{11, 12},
-				CV{11, 13}, CV{11, 14}, CV{11, 15}, CV{12, 1},
-				CV{12, 2}, CV{12, 3}, CV{12, 4}, CV{12, 5},
-				CV{12, 6}, CV{12, 7}, CV{12, 8}, CV{12, 9},
-				CV{12, 10}, CV{12, 11}, CV{12, 12}, CV{12, 13},
-				CV{12, 14}, CV{12, 15}, CV{12, 16}, CV{12, 17},
-				CV{12, 18}, CV{12, 19}, CV{12, 20}, CV{12, 21},
-				CV{12, 22}, CV{12, 23}, CV{12, 24}, CV{12, 25},
-				CV{13, 1}, CV{13, 2}, CV{13, 3}, CV{13, 4},
-				CV{13, 5}, CV{13, 6}, CV{13, 7}, CV{13, 8},
-				CV{13, 9}, CV{13, 10}, CV{13, 11}, CV{13, 12},
-				CV{13, 13}, CV{13, 14}, CV{13, 15}, CV{13, 16},
-				CV{13, 17}, CV{13, 18}, CV{13, 19}, CV{13, 20},
-				CV{13, 21}, CV{13, 22}, CV{13, 23}, CV{14, 1},
-				CV{14, 2}, CV{14, 3}, CV{14, 4}, CV{14, 5},
-				CV{14, 6}, CV{14, 7}, CV{14, 8}, CV{14, 9},
-				CV{14, 10}, CV{14, 11}, CV{14, 12}, CV{14, 13},
-				CV{14, 14}, CV{14, 15}, CV{14, 16}, CV{14, 17},
-				CV{14, 18}, CV{14, 19}, CV{14, 20}, CV{14, 21},
-				CV{14, 22}, CV{14, 23}, CV{14, 24}, CV{14, 25},
-				CV{14, 26}, CV{14, 27}, CV{14, 28}, CV{14, 29},
-				CV{14, 30}, CV{14, 31}, CV{14, 32}, CV{14, 33},
-				CV{14, 34}, CV{14, 35}, CV{14, 36}, CV{14, 37},
-				CV{14, 38}, CV{14, 39}, CV{14, 40}, CV{14, 41},
-				CV{14, 42}, CV{14, 43}, CV{14, 44}, CV{14, 45},
-				CV{14, 46}, CV{14, 47}, CV{14, 48}, CV{14, 49},
-				CV{14, 50}, CV{14, 51}, CV{14, 52}, CV{15, 1},
-				CV{15, 2}, CV{15, 3}, CV{15, 4}, CV{15, 5},
-				CV{15, 6}, CV{15, 7}, CV{15, 8}, CV{15, 9},
-				CV{15, 10}, CV{15, 11}, CV{15, 12}, CV{15, 13},
-				CV{15, 14}, CV{15, 15}, CV{15, 16}, CV{15, 17},
-				CV{15, 18}, CV{15, 19}, CV{15, 20}, CV{15, 21},
-				CV{15, 22}, CV{15, 23}, CV{15, 24}, CV{15, 25},
-				CV{15, 26}, CV{15, 27}, CV{15, 28}, CV{15, 29},
-				CV{15, 30}, CV{15, 31}, CV{15, 32}, CV{15, 33},
-				CV{15, 34}, CV{15, 35}, CV{16, 1}, CV{16, 2},
-				CV{16, 3}, CV{16, 4}, CV{16, 5}, CV{16, 6},
-				CV{16, 7}, CV{16, 8}, CV{16, 9}, CV{16, 10},
-				CV{16, 11}, CV{16, 12}, CV{16, 13}, CV{16, 14},
-				CV{16, 15}, CV{16, 16}, CV{16, 17}, CV{16, 18},
-				CV{16, 19}, CV{16, 20}, CV{16, 21}, CV{16, 22},
-				CV{16, 23}, CV{17, 1}, CV{17, 2}, CV{17, 3},
-				CV{17, 4}, CV{17, 5}, CV{17, 6}, CV{17, 7},
-				CV{17, 8}, CV{17, 9}, CV{17, 10}, CV{17, 11},
-				CV{17, 12}, CV{17, 13}, CV{17, 14}, CV{17, 15},
-				CV{17, 16}, CV{17, 17}, CV{17, 18}, CV{17, 19},
-				CV{17, 20}, CV{17, 21}, CV{17, 22}, CV{17, 23},
-				CV{17, 24}, CV{17, 25}, CV{17, 26}, CV{17, 27},
-				CV{17, 28}, CV{17, 29}, CV{17, 30}, CV{17, 31},
-				CV{17, 32}, CV{17, 33}, CV{17, 34}, CV{17, 35},
-				CV{17, 36}, CV{17, 37}, CV{17, 38}, CV{17, 39},
-				CV{17, 40}, CV{17, 41}, CV{17, 42}, CV{17, 43},
-				CV{17, 44}, CV{17, 45}, CV{17, 46}, CV{17, 47},
-				CV{17, 48}, CV{17, 49}, CV{17, 50}, CV{17, 51},
-				CV{17, 52}, CV{17, 53}, CV{17, 54}, CV{17, 55},
-				CV{17, 56}, CV{17, 57}, CV{17, 58}, CV{18, 1},
-				CV{18, 2}, CV{18, 3}, CV{18, 4}, CV{18, 5},
-				CV{18, 6}, CV{18, 7}, CV{18, 8}, CV{18, 9},
-				CV{18, 10}, CV{18, 11}, CV{18, 12}, CV{18, 13},
-				CV{18, 14}, CV{18, 15}, CV{18, 16}, CV{18, 17},
-				CV{18, 18}, CV{18, 19}, CV{18, 20}, CV{18, 21},
-				CV{18, 22}, CV{18, 23}, CV{18, 24}, CV{18, 25},
-				CV{18, 26}, CV{18, 27}, CV{18, 28}, CV{18, 29},
-				CV{18, 30}, CV{19, 1}, CV{19, 2}, CV{19, 3},
-				CV{19, 4}, CV{19, 5}, CV{19, 6}, CV{19, 7},
-				CV{19, 8}, CV{19, 9}, CV{19, 10}, CV{19, 11},
-				CV{19, 12}, CV{19, 13}, CV{19, 14}, CV{19, 15},
-				CV{19, 16}, CV{19, 17}, CV{19, 18}, CV{19, 19},
-				CV{19, 20}, CV{19, 21}, CV{19, 22}, CV{19, 23},
-				CV{19, 24}, CV{20, 1}, CV{20, 2}, CV{20, 3},
-				CV{20, 4}, CV{20, 5}, CV{20, 6}, CV{20, 7},
-				CV{20, 8}, CV{20, 9}, CV{20, 10}, CV{20, 11},
-				CV{20, 12}, CV{20, 13}, CV{20, 14}, CV{20, 15},
-				CV{20, 16}, CV{20, 17}, CV{20, 18}, CV{20, 19},
-				CV{20, 20}, CV{20, 21}, CV{20, 22}, CV{20, 23},
-				CV{20, 24}, CV{20, 25}, CV{20, 26}, CV{20, 27},
-				CV{20, 28}, CV{20, 29}, CV{20, 30}, CV{20, 31},
-				CV{20, 32}, CV{20, 33}, CV{20, 34}, CV{20, 35},
-				CV{20, 36}, CV{20, 37}, CV{20, 38}, CV{20, 39},
-				CV{20, 40}, CV{20, 41}, CV{20, 42}, CV{21, 1},
-				CV{21, 2}, CV{21, 3}, CV{21, 4}, CV{21, 5},
-				CV{21, 6}, CV{21, 7}, CV{21, 8}, CV{21, 9},
-				CV{21, 10}, CV{21, 11}, CV{21, 12}, CV{21, 13},
-				CV{21, 14}, CV{21, 15}, CV{22, 1}, CV{22, 2},
-				CV{22, 3}, CV{22, 4}, CV{22, 5}, CV{22, 6},
-				CV{22, 7}, CV{22, 8}, CV{22, 9}, CV{22, 10},
-				CV{22, 11}, CV{22, 12}, CV{22, 13}, CV{22, 14},
-				CV{22, 15}, CV{22, 16}, CV{22, 17}, CV{22, 18},
-				CV{22, 19}, CV{22, 20}, CV{22, 21}, CV{22, 22},
-				CV{22, 23}, CV{23, 1}, CV{23, 2}, CV{23, 3},
-				CV{23, 4}, CV{23, 5}, CV{23, 6}, CV{23, 7},
-				CV{23, 8}, CV{23, 9}, CV{23, 10}, CV{23, 11},
-				CV{23, 12}, CV{23, 13}, CV{23, 14}, CV{23, 15},
-				CV{23, 16}, CV{23, 17}, CV{23, 18}, CV{23, 19},
-				CV{23, 20}, CV{23, 21}, CV{23, 22}, CV{23, 23},
-				CV{23, 24}, CV{23, 25}, CV{23, 26}, CV{23, 27},
-				CV{23, 28}, CV{23, 29}, CV{24, 1}, CV{24, 2},
-				CV{24, 3}, CV{24, 4}, CV{24, 5}, CV{24, 6},
-				CV{24, 7}, CV{24, 8}, CV{24, 9}, CV{24, 10},
-				CV{24, 11}, CV{24, 12}, CV{24, 13}, CV{24, 14},
-				CV{24, 15}, CV{24, 16}, CV{24, 17}, CV{24, 18},
-				CV{24, 19}, CV{24, 20}, CV{24, 21}, CV{24, 22},
-				CV{25, 1}, CV{25, 2}, CV{25, 3}, CV{25, 4},
-				CV{25, 5}, CV{25, 6}, CV{25, 7}, CV{25, 8},
-				CV{25, 9}, CV{25, 10}, CV{25, 11}, CV{25, 12},
-				CV{25, 13}, CV{25, 14}, CV{25, 15}, CV{25, 16},
-				CV{25, 17}, CV{25, 18}, CV{25, 19}, CV{25, 20},
-				CV{25, 21}, CV{25, 22}, CV{25, 23}, CV{25, 24},
-				CV{25, 25}, CV{25, 26}, CV{25, 27}, CV{25, 28},
-				CV{25, 29}, CV{25, 30}, CV{25, 31}, CV{25, 32},
-				CV{25, 33}, CV{25, 34}, CV{25, 35}, CV{25, 36},
-				CV{25, 37}, CV{25, 38}, CV{25, 39}, CV{25, 40},
-				CV{25, 41}, CV{25, 42}, CV{25, 43}, CV{25, 44},
-				CV{26, 1}, CV{26, 2}, CV{26, 3}, CV{26, 4},
-				CV{26, 5}, CV{26, 6}, CV{26, 7}, CV{26, 8},
-				CV{26, 9}, CV{26, 10}, CV{26, 11}, CV{26, 12},
-				CV{26, 13}, CV{26, 14}, CV{26, 15}, CV{26, 16},
-				CV{26, 17}, CV{26, 18}, CV{26, 19}, CV{26, 20},
-				CV{26, 21}, CV{26, 22}, CV{26, 23}, CV{26, 24},
-				CV{26, 25}, CV{27, 1}, CV{27, 2}, CV{27, 3},
-				CV{27, 4}, CV{27, 5}, CV{27, 6}, CV{27, 7},
-				CV{27, 8}, CV{27, 9}, CV{27, 10}, CV{27, 11},
-				CV{27, 12}, CV{28, 1}, CV{28, 2}, CV{28, 3},
-				CV{28, 4}, CV{28, 5}, CV{28, 6}, CV{28, 7},
-				CV{28, 8}, CV{28, 9}, CV{28, 10}, CV{28, 11},
-				CV{28, 12}, CV{28, 13}, CV{28, 14}, CV{28, 15},
-				CV{28, 16}, CV{28, 17}, CV{28, 18}, CV{28, 19},
-				CV{28, 20}, CV{28, 21}, CV{28, 22}, CV{28, 23},
-				CV{28, 24}, CV{28, 25}, CV{29, 1}, CV{29, 2},
-				CV{29, 3}, CV{29, 4}, CV{29, 5}, CV{29, 6},
-				CV{29, 7}, CV{29, 8}, CV{29, 9}, CV{29, 10},
-				CV{29, 11}, CV{30, 1}, CV{30, 2}, CV{30, 3},
-				CV{30, 4}, CV{30, 5}, CV{30, 6}, CV{30, 7},
-				CV{30, 8}, CV{30, 9}, CV{30, 10}, CV{30, 11},
-				CV{30, 12}, CV{30, 13}, CV{30, 14}, CV{30, 15},
-				CV{30, 16}, CV{30, 17}, CV{30, 18}, CV{30, 19},
-				CV{30, 20}, CV{30, 21}, CV{30, 22}, CV{30, 23},
-				CV{30, 24}, CV{30, 25}, CV{30, 26}, CV{30, 27},
-				CV{30, 28}, CV{30, 29}, CV{30, 30}, CV{30, 31},
-				CV{31, 1}, CV{31, 2}, CV{31, 3}, CV{31, 4},
-				CV{31, 5}, CV{31, 6}, CV{31, 7}, CV{31, 8},
-				CV{31, 9}, CV{31, 10}, CV{31, 11}, CV{31, 12},
-				CV{31, 13},
+			Runs: []VerseRun{
+				{Chapter: 1, First: 1, Last: 28},
+				{Chapter: 2, First: 1, Last: 36},
+				{Chapter: 3, First: 1, Last: 21},
+				{Chapter: 4, First: 1, Last: 22},
+				{Chapter: 5, First: 1, Last: 12},
+				{Chapter: 6, First: 1, Last: 21},
+				{Chapter: 7, First: 1, Last: 17},
+				{Chapter: 8, First: 1, Last: 22},
+				{Chapter: 9, First: 1, Last: 27},
+				{Chapter: 10, First: 1, Last: 27},
+				{Chapter: 11, First: 1, Last: 15},
+				{Chapter: 12, First: 1, Last: 25},
+				{Chapter: 13, First: 1, Last: 23},
+				{Chapter: 14, First: 1, Last: 52},
+				{Chapter: 15, First: 1, Last: 35},
+				{Chapter: 16, First: 1, Last: 23},
+				{Chapter: 17, First: 1, Last: 58},
+				{Chapter: 18, First: 1, Last: 30},
+				{Chapter: 19, First: 1, Last: 24},
+				{Chapter: 20, First: 1, Last: 42},
+				{Chapter: 21, First: 1, Last: 15},
+				{Chapter: 22, First: 1, Last: 23},
+				{Chapter: 23, First: 1, Last: 29},
+				{Chapter: 24, First: 1, Last: 22},
+				{Chapter: 25, First: 1, Last: 44},
+				{Chapter: 26, First: 1, Last: 25},
+				{Chapter: 27, First: 1, Last: 12},
+				{Chapter: 28, First: 1, Last: 25},
+				{Chapter: 29, First: 1, Last: 11},
+				{Chapter: 30, First: 1, Last: 31},
+				{Chapter: 31, First: 1, Last: 13},
 			},
 		},
 		{
 			Name:      "2 Samuel",
+			USFM:      "2SA",
+			Testament: OldTestament,
 			JustVerse: false,
-			Verses: []Verse{CV{1, 1},
-				CV{1, 2}, CV{1, 3}, CV{1, 4}, CV{1, 5},
-				CV{1, 6}, CV{1, 7}, CV{1, 8}, CV{1, 9},
-				CV{1, 10}, CV{1, 11}, CV{1, 12}, CV{1, 13},
-				CV{1, 14}, CV{1, 15}, CV{1, 16}, CV{1, 17},
-				CV{1, 18}, CV{1, 19}, CV{1, 20}, CV{1, 21},
-				CV{1, 22}, CV{1, 23}, CV{1, 24}, CV{1, 25},
-				CV{1, 26}, CV{1, 27}, CV{2, 1}, CV{2, 2},
-				CV{2, 3}, CV{2, 4}, CV{2, 5}, CV{2, 6},
-				CV{2, 7}, CV{2, 8}, CV{2, 9}, CV{2, 10},
-				CV{2, 11}, CV{2, 12}, CV{2, 13}, CV{2, 14},
-				CV{2, 15}, CV{2, 16}, CV{2, 17}, CV{2, 18},
-				CV{2, 19}, CV{2, 20}, CV{2, 21}, CV{2, 22},
-				CV{2, 23}, CV{2, 24}, CV{2, 25}, CV{2, 26},
-				CV{2, 27}, CV{2, 28}, CV{2, 29}, CV{2, 30},
-				CV{2, 31}, CV{2, 32}, CV{3, 1}, CV{3, 2},
-				CV{3, 3}, CV{3, 4}, CV{3, 5}, CV{3, 6},
-				CV{3, 7}, CV{3, 8}, CV{3, 9}, CV{3, 10},
-				CV{3, 11}, CV{3, 12}, CV{3, 13}, CV{3, 14},
-				CV{3, 15}, CV{3, 16}, CV{3, 17}, CV{3, 18},
-				CV{3, 19}, CV{3, 20}, CV{3, 21}, CV{3, 22},
-				CV{3, 23}, CV{3, 24}, CV{3, 25}, CV{3, 26},
-				CV{3, 27}, CV{3, 28}, CV{3, 29}, CV{3, 30},
-				CV{3, 31}, CV{3, 32}, CV{3, 33}, CV{3, 34},
-				CV{3, 35}, CV{3, 36}, CV{3, 37}, CV{3, 38},
-				CV{3, 39}, CV{4, 1}, CV{4, 2}, CV{4, 3},
-				CV{4, 4}, CV{4, 5}, CV{4, 6}, CV{4, 7},
-				CV{4, 8}, CV{4, 9}, CV{4, 10}, CV{4, 11},
-				CV{4, 12}, CV{5, 1}, CV{5, 2}, CV{5, 3},
-				CV{5, 4}, CV{5, 5}, CV{5, 6}, CV{5, 7},
-				CV{5, 8}, CV{5, 9}, CV{5, 10}, CV{5, 11},
-				CV{5, 12}, CV{5, 13}, CV{5, 14}, CV{5, 15},
-				CV{5, 16}, CV{5, 17}, CV{5, 18}, CV{5, 19},
-				CV{5, 20}, CV{5, 21}, CV{5, 22}, CV{5, 23},
-				CV{5, 24}, CV{5, 25}, CV{6, 1}, CV{6, 2},
-				CV{6, 3}, CV{6, 4}, CV{6, 5}, CV{6, 6},
-				CV{6, 7}, CV{6, 8}, CV{6, 9}, CV{6, 10},
-				CV{6, 11}, CV{6, 12}, CV{6, 13}, CV{6, 14},
-				CV{6, 15}, CV{6, 16}, CV{6, 17}, CV{6, 18},
-				CV{6, 19}, CV{6, 20}, CV{6, 21}, CV{6, 22},
-				CV{6, 23}, CV{7, 1}, CV{7, 2}, CV{7, 3},
-				CV{7, 4}, CV{7, 5}, CV{7, 6}, CV{7, 7},
-				CV{7, 8}, CV{7, 9}, CV{7, 10}, CV{7, 11},
-				CV{7, 12}, CV{7, 13}, CV{7, 14}, CV{7, 15},
-				CV{7, 16}, CV{7, 17}, CV{7, 18}, CV{7, 19},
-				CV{7, 20}, CV{7, 21}, CV{7, 22}, CV{7, 23},
-				CV{7, 24}, CV{7, 25}, CV{7, 26}, CV{7, 27},
-				CV{7, 28}, CV{7, 29}, CV{8, 1}, CV{8, 2},
-				CV{8, 3}, CV{8, 4}, CV{8, 5}, CV{8, 6},
-				CV{8, 7}, CV{8, 8}, CV{8, 9}, CV{8, 10},
-				CV{8, 11}, CV{8, 12}, CV{8, 13}, CV{8, 14},
-				CV{8, 15}, CV{8, 16}, CV{8, 17}, CV{8, 18},
-				CV{9, 1}, CV{9, 2}, CV{9, 3}, CV{9, 4},
-				CV{9, 5}, CV{9, 6}, CV{9, 7}, CV{9, 8},
-				CV{9, 9}, CV{9, 10}, CV{9, 11}, CV{9, 12},
-				CV{9, 13}, CV{10, 1}, CV{10, 2}, CV{10, 3},
-				CV{10, 4}, CV{10, 5}, CV{10, 6}, CV{10, 7},
-				CV{10, 8}, CV{10, 9}, CV{10, 10}, CV{10, 11},
-				CV{10, 12}, CV{10, 13}, CV{10, 14}, CV{10, 15},
-				CV{10, 16}, CV{10, 17}, CV{10, 18}, CV{10, 19},
-				CV{11, 1}, CV{11, 2}, CV{11, 3}, CV{11, 4},
-				CV{11, 5}, CV{11, 6}, CV{11, 7}, CV{11, 8},
-				CV{11, 9}, CV{11, 10}, CV{11, 11}, CV{11, 12},
-				CV{11, 13}, CV{11, 14}, CV{11, 15}, CV{11, 16},
-				CV{11, 17}, CV{11, 18}, CV{11, 19}, CV{11, 20},
-				CV{11, 21}, CV{11, 22}, CV{11, 23}, CV{11, 24},
-				CV{11, 25}, CV{11, 26}, CV{11, 27}, CV{12, 1},
-				CV{12, 2}, CV{12, 3}, CV{12, 4}, CV{12, 5},
-				CV{12, 6}, CV{12, 7}, CV{12, 8}, CV{12, 9},
-				CV{12, 10}, CV{12, 11}, CV{12, 12}, CV{12, 13},
-				CV{12, 14}, CV{12, 15}, CV{12, 16}, CV{12, 17},
-				CV{12, 18}, CV{12, 19}, CV{12, 20}, CV{12, 21},
-				CV{12, 22}, CV{12, 23}, CV{12, 24}, CV{12, 25},
-				CV{12, 26}, CV{12, 27}, CV{12, 28}, CV{12, 29},
-				CV{12, 30}, CV{12, 31}, CV{13, 1}, CV{13, 2},
-				CV{13, 3}, CV{13, 4}, CV{13, 5}, CV{13, 6},
-				CV{13, 7}, CV{13, 8}, CV{13, 9}, CV{13, 10},
-				CV{13, 11}, CV{13, 12}, CV{13, 13}, CV{13, 14},
-				CV{13, 15}, CV{13, 16}, CV{13, 17}, CV{13, 18},
-				CV{13, 19}, CV{13, 20}, CV{13, 21}, CV{13, 22},
-				CV{13, 23}, CV{13, 24}, CV{13, 25}, CV{13, 26},
-				CV{13, 27}, CV{13, 28}, CV{13, 29}, CV{13, 30},
-				CV{13, 31}, CV{13, 32}, CV{13, 33}, CV{13, 34},
-				CV{13, 35}, CV{13, 36}, CV{13, 37}, CV{13, 38},
-				CV{13, 39}, CV{14, 1}, CV{14, 2}, CV{14, 3},
-				CV{14, 4}, CV{14, 5}, CV{14, 6}, CV{14, 7},
-				CV{14, 8}, CV{14, 9}, CV{14, 10}, CV{14, 11},
-				CV{14, 12}, CV{14, 13}, CV{14, 14}, CV{14, 15},
-				CV{14, 16}, CV{14, 17}, CV{14, 18}, CV{14, 19},
-				CV{14, 20}, CV{14, 21}, CV{14, 22}, CV{14, 23},
-				CV{14, 24}, CV{14, 25}, CV{14, 26}, CV{14, 27},
-				CV{14, 28}, CV{14, 29}, CV{14, 30}, CV{14, 31},
-				CV{14, 32}, CV{14, 33}, CV{15, 1}, CV{15, 2},
-				CV{15, 3}, CV{15, 4}, CV{15, 5}, CV{15, 6},
-				CV{15, 7}, CV{15, 8}, CV{15, 9}, CV{15, 10},
-				CV{15, 11}, CV{15, 12}, CV{15, 13}, CV{15, 14},
-				CV{15, 15}, CV{15, 16}, CV{15, 17}, CV{15, 18},
-				CV{15, 19}, CV{15, 20}, CV{15, 21}, CV{15, 22},
-				CV{15, 23}, CV{15, 24}, CV{15, 25}, CV{15, 26},
-				CV{15, 27}, CV{15, 28}, CV{15, 29}, CV{15, 30},
-				CV{15, 31}, CV{15, 32}, CV{15, 33}, CV{15, 34},
-				CV{15, 35}, CV{15, 36}, CV{15, 37}, CV{16, 1},
-				CV{16, 2}, CV{16, 3}, CV{16, 4}, CV{16, 5},
-				CV{16, 6}, CV{16, 7}, CV{16, 8}, CV{16, 9},
-				CV{16, 10}, CV{16, 11}, CV{16, 12}, CV{16, 13},
-				CV{16, 14}, CV{16, 15}, CV{16, 16}, CV{16, 17},
-				CV{16, 18}, CV{16, 19}, CV{16, 20}, CV{16, 21},
-				CV{16, 22}, CV{16, 23}, CV{17, 1}, CV{17, 2},
-				CV{17, 3}, CV{17, 4}, CV{17, 5}, CV{17, 6},
-				CV{17, 7}, CV{17, 8}, CV{17, 9}, CV{17, 10},
-				CV{17, 11}, CV{17, 12}, CV{17, 13}, CV{17, 14},
-				CV{17, 15}, CV{17, 16}, CV{17, 17}, CV{17, 18},
-				CV{17, 19}, CV{17, 20}, CV{17, 21}, CV{17, 22},
-				CV{17, 23}, CV{17, 24}, CV{17, 25}, CV{17, 26},
-				CV{17, 27}, CV{17, 28}, CV{17, 29}, CV{18, 1},
-				CV{18, 2}, CV{18, 3}, CV{18, 4}, CV{18, 5},
-				CV{18, 6}, CV{18, 7}, CV{18, 8}, CV{18, 9},
-				CV{18, 10}, CV{18, 11}, CV{18, 12}, CV{18, 13},
-				CV{18, 14}, CV{18, 15}, CV{18, 16}, CV{18, 17},
-				CV{18, 18}, CV{18, 19}, CV{18, 20}, CV{18, 21},
-				CV{18, 22}, CV{18, 23}, CV{18, 24}, CV{18, 25},
-				CV{18, 26}, CV{18, 27}, CV{18, 28}, CV{18, 29},
-				CV{18, 30}, CV{18, 31}, CV{18, 32}, CV{18, 33},
-				CV{19, 1}, CV{19, 2}, CV{19, 3}, CV{19, 4},
-				CV{19, 5}, CV{19, 6}, CV{19, 7}, CV{19, 8},
-				CV{19, 9}, CV{19, 10}, CV{19, 11}, CV{19, 12},
-				CV{19, 13}, CV{19, 14}, CV{19, 15}, CV{19, 16},
-				CV{19, 17}, CV{19, 18}, CV{19, 19}, CV{19, 20},
-				CV{19, 21}, CV{19, 22}, CV{19, 23}, CV{19, 24},
-				CV{19, 25}, CV{19, 26}, CV{19, 27}, CV{19, 28},
-				CV{19, 29}, CV{19, 30}, CV{19, 31}, CV{19, 32},
-				CV{19, 33}, CV{19, 34}, CV{19, 35}, CV{19, 36},
-				CV{19, 37}, CV{19, 38}, CV{19, 39}, CV{19, 40},
-				CV{19, 41}, CV{19, 42}, CV{19, 43}, CV{20, 1},
-				CV{20, 2}, CV{20, 3}, CV{20, 4}, CV{20, 5},
-				CV{20, 6}, CV{20, 7}, CV{20, 8}, CV{20, 9},
-				CV{20, 10}, CV{20, 11}, CV{20, 12}, CV{20, 13},
-				CV{20, 14}, CV{20, 15}, CV{20, 16}, CV{20, 17},
-				CV{20, 18}, CV{20, 19}, CV{20, 20}, CV{20, 21},
-				CV{20, 22}, CV{20, 23}, CV{20, 24}, CV{20, 25},
-				CV{20, 26}, CV{21, 1}, CV{21, 2}, CV{21, 3},
-				CV{21, 4}, CV{21, 5}, CV{21, 6}, CV{21, 7},
-				CV{21, 8}, CV{21, 9}, CV{21, 10}, CV{21, 11},
-				CV{21, 12}, CV{21, 13}, CV{21, 14}, CV{21, 15},
-				CV{21, 16}, CV{21, 17}, CV{21, 18}, CV{21, 19},
-				CV{21, 20}, CV{21, 21}, CV{21, 22}, CV{22, 1},
-				CV{22, 2}, CV{22, 3}, CV{22, 4}, CV{22, 5},
-				CV{22, 6}, CV{22, 7}, CV{22, 8}, CV{22, 9},
-				CV{22, 10}, CV{22, 11}, CV{22, 12}, CV{22, 13},
-				CV{22, 14}, CV{22, 15}, CV{22, 16}, CV{22, 17},
-				CV{22, 18}, CV{22, 19}, CV{22, 20}, CV{22, 21},
-				CV{22, 22}, CV{22, 23}, CV{22, 24}, CV{22, 25},
-				CV{22, 26}, CV{22, 27}, CV{22, 28}, CV{22, 29},
-				CV{22, 30}, CV{22, 31}, CV{22, 32}, CV{22, 33},
-				CV{22, 34}, CV{22, 35}, CV{22, 36}, CV{22, 37},
-				CV{22, 38}, CV{22, 39}, CV{22, 40}, CV{22, 41},
-				CV{22, 42}, CV{22, 43}, CV{22, 44}, CV{22, 45},
-				CV{22, 46}, CV{22, 47}, CV{22, 48}, CV{22, 49},
-				CV{22, 50}, CV{22, 51}, CV{23, 1}, CV{23, 2},
-				CV{23, 3}, CV{23, 4}, CV{23, 5}, CV{23, 6},
-				CV{23, 7}, CV{23, 8}, CV{23, 9}, CV{23, 10},
-				CV{23, 11}, CV{23, 12}, CV{23, 13}, CV{23, 14},
-				CV{23, 15}, CV{23, 16}, CV{23, 17}, CV{23, 18},
-				CV{23, 19}, CV{23, 20}, CV{23, 21}, CV{23, 22},
-				CV{23, 23}, CV{23, 24}, CV{23, 25}, CV{23, 26},
-				CV{23, 27}, CV{23, 28}, CV{23, 29}, CV{23, 30},
-				CV{23, 31}, CV{23, 32}, CV{23, 33}, CV{23, 34},
-				CV{23, 35}, CV{23, 36}, CV{23, 37}, CV{23, 38},
-				CV{23, 39}, CV{24, 1}, CV{24, 2}, CV{24, 3},
-				CV{24, 4}, CV{24, 5}, CV{24, 6}, CV{24, 7},
-				CV{24, 8}, CV{24, 9}, CV{24, 10}, CV{24, 11},
-				CV{24, 12}, CV{24, 13}, CV{24, 14}, CV{24, 15},
-				CV{24, 16}, CV{24, 17}, CV{24, 18}, CV{24, 19},
-				CV{24, 20}, CV{24, 21}, CV{24, 22}, CV{24, 23},
-				CV{24, 24}, CV{24, 25},
+			Runs: []VerseRun{
+				{Chapter: 1, First: 1, Last: 27},
+				{Chapter: 2, First: 1, Last: 32},
+				{Chapter: 3, First: 1, Last: 39},
+				{Chapter: 4, First: 1, Last: 12},
+				{Chapter: 5, First: 1, Last: 25},
+				{Chapter: 6, First: 1, Last: 23},
+				{Chapter: 7, First: 1, Last: 29},
+				{Chapter: 8, First: 1, Last: 18},
+				{Chapter: 9, First: 1, Last: 13},
+				{Chapter: 10, First: 1, Last: 19},
+				{Chapter: 11, First: 1, Last: 27},
+				{Chapter: 12, First: 1, Last: 31},
+				{Chapter: 13, First: 1, Last: 39},
+				{Chapter: 14, First: 1, Last: 33},
+				{Chapter: 15, First: 1, Last: 37},
+				{Chapter: 16, First: 1, Last: 23},
+				{Chapter: 17, First: 1, Last: 29},
+				{Chapter: 18, First: 1, Last: 33},
+				{Chapter: 19, First: 1, Last: 43},
+				{Chapter: 20, First: 1, Last: 26},
+				{Chapter: 21, First: 1, Last: 22},
+				{Chapter: 22, First: 1, Last: 51},
+				{Chapter: 23, First: 1, Last: 39},
+				{Chapter: 24, First: 1, Last: 25},
 			},
 		},
 		{
 			Name:      "1 Kings",
+			USFM:      "1KI",
+			Testament: OldTestament,
 			JustVerse: false,
-			Verses: []Verse{CV{1, 1},
-				CV{1, 2}, CV{1, 3}, CV{1, 4}, CV{1, 5},
-				CV{1, 6}, CV{1, 7}, CV{1, 8}, CV{1, 9},
-				CV{1, 10}, CV{1, 11}, CV{1, 12}, CV{1, 13},
-				CV{1, 14}, CV{1, 15}, CV{1, 16}, CV{1, 17},
-				CV{1, 18}, CV{1, 19}, CV{1, 20}, CV{1, 21},
-				CV{1, 22}, CV{1, 23}, CV{1, 24}, CV{1, 25},
-				CV{1, 26}, CV{1, 27}, CV{1, 28}, CV{1, 29},
-				CV{1, 30}, CV{1, 31}, CV{1, 32}, CV{1, 33},
-				CV{1, 34}, CV{1, 35}, CV{1, 36}, CV{1, 37},
-				CV{1, 38}, CV{1, 39}, CV{1, 40}, CV{1, 41},
-				CV{1, 42}, CV{1, 43}, CV{1, 44}, CV{1, 45},
-				CV{1, 46}, CV{1, 47}, CV{1, 48}, CV{1, 49},
-				CV{1, 50}, CV{1, 51}, CV{1, 52}, CV{1, 53},
-				CV{2, 1}, CV{2, 2}, CV{2, 3}, CV{2, 4},
-				CV{2, 5}, CV{2, 6}, CV{2, 7}, CV{2, 8},
-				CV{2, 9}, CV{2, 10}, CV{2, 11}, CV{2, 12},
-				CV{2, 13}, CV{2, 14}, CV{2, 15}, CV{2, 16},
-				CV{2, 17}, CV{2, 18}, CV{2, 19}, CV{2, 20},
-				CV{2, 21}, CV{2, 22}, CV{2, 23}, CV{2, 24},
-				CV{2, 25}, CV{2, 26}, CV{2, 27}, CV{2, 28},
-				CV{2, 29}, CV{2, 30}, CV{2, 31}, CV{2, 32},
-				CV{2, 33}, CV{2, 34}, CV{2, 35}, CV{2, 36},
-				CV{2, 37}, CV{2, 38}, CV{2, 39}, CV{2, 40},
-				CV{2, 41}, CV{2, 42}, CV{2, 43}, CV{2, 44},
-				CV{2, 45}, CV{2, 46}, CV{3, 1}, CV{3, 2},
-				CV{3, 3}, CV{3, 4}, CV{3, 5}, CV{3, 6},
-				CV{3, 7}, CV{3, 8}, CV{3, 9}, CV{3, 10},
-				CV{3, 11}, CV{3, 12}, CV{3, 13}, CV{3, 14},
-				CV{3, 15}, CV{3, 16}, CV{3, 17}, CV{3, 18},
-				CV{3, 19}, CV{3, 20}, CV{3, 21}, CV{3, 22},
-				CV{3, 23}, CV{3, 24}, CV{3, 25}, CV{3, 26},
-				CV{3, 27}, CV{3, 28}, CV{4, 1}, CV{4, 2},
-				CV{4, 3}, CV{4, 4}, CV{4, 5}, CV{4, 6},
-				CV{4, 7}, CV{4, 8}, CV{4, 9}, CV{4, 10},
-				CV{4, 11}, CV{4, 12}, CV{4, 13}, CV{4, 14},
-				CV{4, 15}, CV{4, 16}, CV{4, 17}, CV{4, 18},
-				CV{4, 19}, CV{4, 20}, CV{4, 21}, CV{4, 22},
-				CV{4, 23}, CV{4, 24}, CV{4, 25}, CV{4, 26},
-				CV{4, 27}, CV{4, 28}, CV{4, 29}, CV{4, 30},
-				CV{4, 31}, CV{4, 32}, CV{4, 33}, CV{4, 34},
-				CV{5, 1}, CV{5, 2}, CV{5, 3}, CV{5, 4},
-				CV{5, 5}, CV{5, 6}, CV{5, 7}, CV{5, 8},
-				CV{5, 9}, CV{5, 10}, CV{5, 11}, CV{5, 12},
-				CV{5, 13}, CV{5, 14}, CV{5, 15}, CV{5, 16},
-				CV{5, 17}, CV{5, 18}, CV{6, 1}, CV{6, 2},
-				CV{6, 3}, CV{6, 4}, CV{6, 5}, CV{6, 6},
-				CV{6, 7}, CV{6, 8}, CV{6, 9}, CV{6, 10},
-				CV{6, 11}, CV{6, 12}, CV{6, 13}, CV{6, 14},
-				CV{6, 15}, CV{6, 16}, CV{6, 17}, CV{6, 18},
-				CV{6, 19}, CV{6, 20}, CV{6, 21}, CV{6, 22},
-				CV{6, 23}, CV{6, 24}, CV{6, 25}, CV{6, 26},
-				CV{6, 27}, CV{6, 28}, CV{6, 29}, CV{6, 30},
-				CV{6, 31}, CV{6, 32}, CV{6, 33}, CV{6, 34},
-				CV{6, 35}, CV{6, 36}, CV{6, 37}, CV{6, 38},
-				CV{7, 1}, CV{7, 2}, CV{7, 3}, CV{7, 4},
-				CV{7, 5}, CV{7, 6}, CV{7, 7}, CV{7, 8},
-				CV{7, 9}, CV{7, 10}, CV{7, 11}, CV{7, 12},
-				CV{7, 13}, CV{7, 14}, CV{7, 15}, CV{7, 16},
-				CV{7, 17}, CV{7, 18}, CV{7, 19}, CV{7, 20},
-				CV{7, 21}, CV{7, 22}, CV{7, 23}, CV{7, 24},
-				CV{7, 25}, CV{7, 26}, CV{7, 27}, CV{7, 28},
-				CV{7, 29}, CV{7, 30}, CV{7, 31}, CV{7, 32},
-				CV{7, 33}, CV{7, 34}, CV{7, 35}, CV{7, 36},
-				CV{7, 37}, CV{7, 38}, CV{7, 39}, CV{7, 40},
-				CV{7, 41}, CV{7, 42}, CV{7, 43}, CV{7, 44},
-				CV{7, 45}, CV{7, 46}, CV{7, 47}, CV{7, 48},
-				CV{7, 49}, CV{7, 50}, CV{7, 51}, CV{8, 1},
-				CV{8, 2}, CV{8, 3}, CV{8, 4}, CV{8, 5},
-				CV{8, 6}, CV{8, 7}, CV{8, 8}, CV{8, 9},
-				CV{8, 10}, CV{8, 11}, CV{8, 12}, CV{8, 13},
-				CV{8, 14}, CV{8, 15}, CV{8, 16}, CV{8, 17},
-				CV{8, 18}, CV{8, 19}, CV{8, 20}, CV{8, 21},
-				CV{8, 22}, CV{8, 23}, CV{8, 24}, CV{8, 25},
-				CV{8, 26}, CV{8, 27}, CV{8, 28}, CV{8, 29},
-				CV{8, 30}, CV{8, 31}, CV{8, 32}, CV{8, 33},
-				CV{8, 34}, CV{8, 35}, CV{8, 36}, CV{8, 37},
-				CV{8, 38}, CV{8, 39}, CV{8, 40}, CV{8, 41},
-				CV{8, 42}, CV{8, 43}, CV{8, 44}, CV{8, 45},
-				CV{8, 46}, CV{8, 47}, CV{8, 48}, CV{8, 49},
-				CV{8, 50}, CV{8, 51}, CV{8, 52}, CV{8, 53},
-				CV{8, 54}, CV{8, 55}, CV{8, 56}, CV{8, 57},
-				CV{8, 58}, CV{8, 59}, CV{8, 60}, CV{8, 61},
-				CV{8, 62}, CV{8, 63}, CV{8, 64}, CV{8, 65},
-				CV{8, 66}, CV{9, 1}, CV{9, 2}, CV{9, 3},
-				CV{9, 4}, CV{9, 5}, CV{9, 6}, CV{9, 7},
-				CV{9, 8}, CV{9, 9}, CV{9, 10}, CV{9, 11},
-				CV{9, 12}, CV{9, 13}, CV{9, 14}, CV{9, 15},
-				CV{9, 16}, CV{9, 17}, CV{9, 18}, CV{9, 19},
-				CV{9, 20}, CV{9, 21}, CV{9, 22}, CV{9, 23},
-				CV{9, 24}, CV{9, 25}, CV{9, 26}, CV{9, 27},
-				CV{9, 28}, CV{10, 1}, CV{10, 2}, CV{10, 3},
-				CV{10, 4}, CV{10, 5}, CV{10, 6}, CV{10, 7},
-				CV{10, 8}, CV{10, 9}, CV{10, 10}, CV{10, 11},
-				CV{10, 12}, CV{10, 13}, CV{10, 14}, CV{10, 15},
-				CV{10, 16}, CV{10, 17}, CV{10, 18}, CV{10, 19},
-				CV{10, 20}, CV{10, 21}, CV{10, 22}, CV{10, 23},
-				CV{10, 24}, CV{10, 25}, CV{10, 26}, CV{10, 27},
-				CV{10, 28}, CV{10, 29}, CV{11, 1}, CV{11, 2},
-				CV{11, 3}, CV{11, 4}, CV{11, 5}, CV{11, 6},
-				CV{11, 7}, CV{11, 8}, CV{11, 9}, CV{11, 10},
-				CV{11, 11}, CV{11, 12}, CV{11, 13}, CV{11, 14},
-				CV{11, 15}, CV{11, 16}, CV{11, 17}, CV{11, 18},
-				CV{11, 19}, CV{11, 20}, CV{11, 21}, CV{11, 22},
-				CV{11, 23}, CV{11, 24}, CV{11, 25}, CV{11, 26},
-				CV{11, 27}, CV{11, 28}, CV{11, 29}, CV{11, 30},
-				CV{11, 31}, CV{11, 32}, CV{11, 33}, CV{11, 34},
-				CV{11, 35}, CV{11, 36}, CV{11, 37}, CV{11, 38},
-				CV{11, 39}, CV{11, 40}, CV{11, 41}, CV{11, 42},
-				CV{11, 43}, CV{12, 1}, CV{12, 2}, CV{12, 3},
-				CV{12, 4}, CV{12, 5}, CV{12, 6}, CV{12, 7},
-				CV{12, 8}, CV{12, 9}, CV{12, 10}, CV{12, 11},
-				CV{12, 12}, CV{12, 13}, CV{12, 14}, CV{12, 15},
-				CV{12, 16}, CV{12, 17}, CV{12, 18}, CV{12, 19},
-				CV{12, 20}, CV{12, 21}, CV{12, 22}, CV{12, 23},
-				CV{12, 24}, CV{12, 25}, CV{12, 26}, CV{12, 27},
-				CV{12, 28}, CV{12, 29}, CV{12, 30}, CV{12, 31},
-				CV{12, 32}, CV{12, 33}, CV{13, 1}, CV{13, 2},
-				CV{13, 3}, CV{13, 4}, CV{13, 5}, CV{13, 6},
-				CV{13, 7}, CV{13, 8}, CV{13, 9}, CV{13, 10},
-				CV{13, 11}, CV{13, 12}, CV{13, 13}, CV{13, 14},
-				CV{13, 15}, CV{13, 16}, CV{13, 17}, CV{13, 18},
-				CV{13, 19}, CV{13, 20}, CV{13, 21}, CV{13, 22},
-				CV{13, 23}, CV{13, 24}, CV{13, 25}, CV{13, 26},
-				CV{13, 27}, CV{13, 28}, CV{13, 29}, CV{13, 30},
-				CV{13, 31}, CV{13, 32}, CV{13, 33}, CV{13, 34},
-				CV{14, 1}, CV{14, 2}, CV{14, 3}, CV{14, 4},
-				CV{14, 5}, CV{14, 6}, CV{14, 7}, CV{14, 8},
-				CV{14, 9}, CV{14, 10}, CV{14, 11}, CV{14, 12},
-				CV{14, 13}, CV{14, 14}, CV{14, 15}, CV{14, 16},
-				CV{14, 17}, CV{14, 18}, CV{14, 19}, CV{14, 20},
-				CV{14, 21}, CV{14, 22}, CV{14, 23}, CV{14, 24},
-				CV{14, 25}, CV{14, 26}, CV{14, 27}, CV{14, 28},
-				CV{14, 29}, CV{14, 30}, CV{14, 31}, CV{15, 1},
-				CV{15, 2}, CV{15, 3}, CV{15, 4}, CV{15, 5},
-				CV{15, 6}, CV{15, 7}, CV{15, 8}, CV{15, 9},
-				CV{15, 10}, CV{15, 11}, CV{15, 12}, CV{15, 13},
-				CV{15, 14}, CV{15, 15}, CV{15, 16}, CV{15, 17},
-				CV{15, 18}, CV{15, 19}, CV{15, 20}, CV{15, 21},
-				CV{15, 22}, CV{15, 23}, CV{15, 24}, CV{15, 25},
-				CV{15, 26}, CV{15, 27}, CV{15, 28}, CV{15, 29},
-				CV{15, 30}, CV{15, 31}, CV{15, 32}, CV{15, 33},
-				CV{15, 34}, CV{16, 1}, CV{16, 2}, CV{16, 3},
-				CV{16, 4}, CV{16, 5}, CV{16, 6}, CV{16, 7},
-				CV{16, 8}, CV{16, 9}, CV{16, 10}, CV{16, 11},
-				CV{16, 12}, CV{16, 13}, CV{16, 14}, CV{16, 15},
-				CV{16, 16}, CV{16, 17}, CV{16, 18}, CV{16, 19},
-				CV{16, 20}, CV{16, 21}, CV{16, 22}, CV{16, 23},
-				CV{16, 24}, CV{16, 25}, CV{16, 26}, CV{16, 27},
-				CV{16, 28}, CV{16, 29}, CV{16, 30}, CV{16, 31},
-				CV{16, 32}, CV{16, 33}, CV{16, 34}, CV{17, 1},
-				CV{17, 2}, CV{17, 3}, CV{17, 4}, CV{17, 5},
-				CV{17, 6}, CV{17, 7}, CV{17, 8}, CV{17, 9},
-				CV{17, 10}, CV{17, 11}, CV{17, 12}, CV{17, 13},
-				CV{17, 14}, CV{17, 15}, CV{17, 16}, CV{17, 17},
-				CV{17, 18}, CV{17, 19}, CV{17, 20}, CV{17, 21},
-				CV{17, 22}, CV{17, 23}, CV{17, 24}, CV{18, 1},
-				CV{18, 2}, CV{18, 3}, CV{18, 4}, CV{18, 5},
-				CV{18, 6}, CV{18, 7}, CV{18, 8}, CV{18, 9},
-				CV{18, 10}, CV{18, 11}, CV{18, 12}, CV{18, 13},
-				CV{18, 14}, CV{18, 15}, CV{18, 16}, CV{18, 17},
-				CV{18, 18}, CV{18, 19}, CV{18, 20}, CV{18, 21},
-				CV{18, 22}, CV{18, 23}, CV{18, 24}, CV{18, 25},
-				CV{18, 26}, CV{18, 27}, CV{18, 28}, CV{18, 29},
-				CV{18, 30}, CV{18, 31}, CV{18, 32}, CV{18, 33},
-				CV{18, 34}, CV{18, 35}, CV{18, 36}, CV{18, 37},
-				CV{18, 38}, CV{18, 39}, CV{18, 40}, CV{18, 41},
-				CV{18, 42}, CV{18, 43}, CV{18, 44}, CV{18, 45},
-				CV{18, 46}, CV{19, 1}, CV{19, 2}, CV{19, 3},
-				CV{19, 4}, CV{19, 5}, CV{19, 6}, CV{19, 7},
-				CV{19, 8}, CV{19, 9}, CV{19, 10}, CV{19, 11},
-				CV{19, 12}, CV{19, 13}, CV{19, 14}, CV{19, 15},
-				CV{19, 16}, CV{19, 17}, CV{19, 18}, CV{19, 19},
-				CV{19, 20}, CV{19, 21}, CV{20, 1}, CV{20, 2},
-				CV{20, 3}, CV{20, 4}, CV{20, 5}, CV{20, 6},
-				CV{20, 7}, CV{20, 8}, CV{20, 9}, CV{20, 10},
-				CV{20, 11}, CV{20, 12}, CV{20, 13}, CV{20, 14},
-				CV{20, 15}, CV{20, 16}, CV{20, 17}, CV{20, 18},
-				CV{20, 19}, CV{20, 20}, CV{20, 21}, CV{20, 22},
-				CV{20, 23}, CV{20, 24}, CV{20, 25}, CV{20, 26},
-				CV{20, 27}, CV{20, 28}, CV{20, 29}, CV{20, 30},
-				CV{20, 31}, CV{20, 32}, CV{20, 33}, CV{20, 34},
-				CV{20, 35}, CV{20, 36}, CV{20, 37}, CV{20, 38},
-				CV{20, 39}, CV{20, 40}, CV{20, 41}, CV{20, 42},
-				CV{20, 43}, CV{21, 1}, CV{21, 2}, CV{21, 3},
-				CV{21, 4}, CV{21, 5}, CV{21, 6}, CV{21, 7},
-				CV{21, 8}, CV{21, 9}, CV{21, 10}, CV{21, 11},
-				CV{21, 12}, CV{21, 13}, CV{21, 14}, CV{21, 15},
-				CV{21, 16}, CV{21, 17}, CV{21, 18}, CV{21, 19},
-				CV{21, 20}, CV{21, 21}, CV{21, 22}, CV{21, 23},
-				CV{21, 24}, CV{21, 25}, CV{21, 26}, CV{21, 27},
-				CV{21, 28}, CV{21, 29}, CV{22, 1}, CV{22, 2},
-				CV{22, 3}, CV{22, 4}, CV{22, 5}, CV{22, 6},
-				CV{22, 7}, CV{22, 8}, CV{22, 9}, CV{22, 10},
-				CV{22, 11}, CV{22, 12}, CV{22, 13}, CV{22, 14},
-				CV{22, 15}, CV{22, 16}, CV{22, 17}, CV{22, 18},
-				CV{22, 19}, CV{22, 20}, CV{22, 21}, CV{22, 22},
-				CV{22, 23}, CV{22, 24}, CV{22, 25}, CV{22, 26},
-				CV{22, 27}, CV{22, 28}, CV{22, 29}, CV{22, 30},
-				CV{22, 31}, CV{22, 32}, CV{22, 33}, CV{22, 34},
-				CV{22, 35}, CV{22, 36}, CV{22, 37}, CV{22, 38},
-				CV{22, 39}, CV{22, 40}, CV{22, 41}, CV{22, 42},
-				CV{22, 43}, CV{22, 44}, CV{22, 45}, CV{22, 46},
-				CV{22, 47}, CV{22, 48}, CV{22, 49}, CV{22, 50},
-				CV{22, 51}, CV{22, 52}, CV{22, 53},
+			Runs: []VerseRun{
+				{Chapter: 1, First: 1, Last: 53},
+				{Chapter: 2, First: 1, Last: 46},
+				{Chapter: 3, First: 1, Last: 28},
+				{Chapter: 4, First: 1, Last: 34},
+				{Chapter: 5, First: 1, Last: 18},
+				{Chapter: 6, First: 1, Last: 38},
+				{Chapter: 7, First: 1, Last: 51},
+				{Chapter: 8, First: 1, Last: 66},
+				{Chapter: 9, First: 1, Last: 28},
+				{Chapter: 10, First: 1, Last: 29},
+				{Chapter: 11, First: 1, Last: 43},
+				{Chapter: 12, First: 1, Last: 33},
+				{Chapter: 13, First: 1, Last: 34},
+				{Chapter: 14, First: 1, Last: 31},
+				{Chapter: 15, First: 1, Last: 34},
+				{Chapter: 16, First: 1, Last: 34},
+				{Chapter: 17, First: 1, Last: 24},
+				{Chapter: 18, First: 1, Last: 46},
+				{Chapter: 19, First: 1, Last: 21},
+				{Chapter: 20, First: 1, Last: 43},
+				{Chapter: 21, First: 1, Last: 29},
+				{Chapter: 22, First: 1, Last: 53},
 			},
 		},
 		{
 			Name:      "2 Kings",
+			USFM:      "2KI",
+			Testament: OldTestament,
 			JustVerse: false,
-			Verses: []Verse{CV{1, 1},
-				CV{1, 2}, CV{1, 3}, CV{1, 4}, CV{1, 5},
-				CV{1, 6}, CV{1, 7}, CV{1, 8}, CV{1, 9},
-				CV{1, 10}, CV{1, 11}, CV{1, 12}, CV{1, 13},
-				CV{1, 14}, CV{1, 15}, CV{1, 16}, CV{1, 17},
-				CV{1, 18}, CV{2, 1}, CV{2, 2}, CV{2, 3},
-				CV{2, 4}, CV{2, 5}, CV{2, 6}, CV{2, 7},
-				CV{2, 8}, CV{2, 9}, CV{2, 10}, CV{2, 11},
-				CV{2, 12}, CV{2, 13}, CV{2, 14}, CV{2, 15},
-				CV{2, 16}, CV{2, 17}, CV{2, 18}, CV{2, 19},
-				CV{2, 20}, CV{2, 21}, CV{2, 22}, CV{2, 23},
-				CV{2, 24}, CV{2, 25}, CV{3, 1}, CV{3, 2},
-				CV{3, 3}, CV{3, 4}, CV{3, 5}, CV{3, 6},
-				CV{3, 7}, CV{3, 8}, CV{3, 9}, CV{3, 10},
-				CV{3, 11}, CV{3, 12}, CV{3, 13}, CV{3, 14},
-				CV{3, 15}, CV{3, 16}, CV{3, 17}, CV{3, 18},
-				CV{3, 19}, CV{3, 20}, CV{3, 21}, CV{3, 22},
-				CV{3, 23}, CV{3, 24}, CV{3, 25}, CV{3, 26},
-				CV{3, 27}, CV{4, 1}, CV{4, 2}, CV{4, 3},
-				CV{4, 4}, CV{4, 5}, CV{4, 6}, CV{4, 7},
-				CV{4, 8}, CV{4, 9}, CV{4, 10}, CV{4, 11},
-				CV{4, 12}, CV{4, 13}, CV{4, 14}, CV{4, 15},
-				CV{4, 16}, CV{4, 17}, CV{4, 18}, CV{4, 19},
-				CV{4, 20}, CV{4, 21}, CV{4, 22}, CV{4, 23},
-				CV{4, 24}, CV{4, 25}, CV{4, 26}, CV{4, 27},
-				CV{4, 28}, CV{4, 29}, CV{4, 30}, CV{4, 31},
-				CV{4, 32}, CV{4, 33}, CV{4, 34}, CV{4, 35},
-				CV{4, 36}, CV{4, 37}, CV{4, 38}, CV{4, 39},
-				CV{4, 40}, CV{4, 41}, CV{4, 42}, CV{4, 43},
-				CV{4, 44}, CV{5, 1}, CV{5, 2}, CV{5, 3},
-				CV{5, 4}, CV{5, 5}, CV{5, 6}, CV{5, 7},
-				CV{5, 8}, CV{5, 9}, CV{5, 10}, CV{5, 11},
-				CV{5, 12}, CV{5, 13}, CV{5, 14}, CV{5, 15},
-				CV{5, 16}, CV{5, 17}, CV{5, 18}, CV{5, 19},
-				CV{5, 20}, CV{5, 21}, CV{5, 22}, CV{5, 23},
-				CV{5, 24}, CV{5, 25}, CV{5, 26}, CV{5, 27},
-				CV{6, 1}, CV{6, 2}, CV{6, 3}, CV{6, 4},
-				CV{6, 5}, CV{6, 6}, CV{6, 7}, CV{6, 8},
-				CV{6, 9}, CV{6, 10}, CV{6, 11}, CV{6, 12},
-				CV{6, 13}, CV{6, 14}, CV{6, 15}, CV{6, 16},
-				CV{6, 17}, CV{6, 18}, CV{6, 19}, CV{6, 20},
-				CV{6, 21}, CV{6, 22}, CV{6, 23}, CV{6, 24},
-				CV{6, 25}, CV{6, 26}, CV{6, 27}, CV{6, 28},
-				CV{6, 29}, CV{6, 30}, CV{6, 31}, CV{6, 32},
-				CV{6, 33}, CV{7, 1}, CV{7, 2}, CV{7, 3},
-				CV{7, 4}, CV{7, 5}, CV{7, 6}, CV{7, 7},
-				CV{7, 8}, CV{7, 9}, CV{7, 10}, CV{7, 11},
-				CV{7, 12}, CV{7, 13}, CV{7, 14}, CV{7, 15},
-				CV{7, 16}, CV{7, 17}, CV{7, 18}, CV{7, 19},
-				CV{7, 20}, CV{8, 1}, CV{8, 2}, CV{8, 3},
-				CV{8, 4}, CV{8, 5}, CV{8, 6}, CV{8, 7},
-				CV{8, 8}, CV{8, 9}, CV{8, 10}, CV{8, 11},
-				CV{8, 12}, CV{8, 13}, CV{8, 14}, CV{8, 15},
-				CV{8, 16}, CV{8, 17}, CV{8, 18}, CV{8, 19},
-				CV{8, 20}, CV{8, 21}, CV{8, 22}, CV{8, 23},
-				CV{8, 24}, CV{8, 25}, CV{8, 26}, CV{8, 27},
-				CV{8, 28}, CV{8, 29}, CV{9, 1}, CV{9, 2},
-				CV{9, 3}, CV{9, 4}, CV{9, 5}, CV{9, 6},
-				CV{9, 7}, CV{9, 8}, CV{9, 9}, CV{9, 10},
-				CV{9, 11}, CV{9, 12}, CV{9, 13}, CV{9, 14},
-				CV{9, 15}, CV{9, 16}, CV{9, 17}, CV{9, 18},
-				CV{9, 19}, CV{9, 20}, CV{9, 21}, CV{9, 22},
-				CV{9, 23}, CV{9, 24}, CV{9, 25}, CV{9, 26},
-				CV{9, 27}, CV{9, 28}, CV{9, 29}, CV{9, 30},
-				CV{9, 31}, CV{9, 32}, CV{9, 33}, CV{9, 34},
-				CV{9, 35}, CV{9, 36}, CV{9, 37}, CV{10, 1},
-				CV{10, 2}, CV{10, 3}, CV{10, 4}, CV{10, 5},
-				CV{10, 6}, CV{10, 7}, CV{10, 8}, CV{10, 9},
-				CV{10, 10}, CV{10, 11}, CV{10, 12}, CV{10, 13},
-				CV{10, 14}, CV{10, 15}, CV{10, 16}, CV{10, 17},
-				CV{10, 18}, CV{10, 19}, CV{10, 20}, CV{10, 21},
-				CV{10, 22}, CV{10, 23}, CV{10, 24}, CV{10, 25},
-				CV{10, 26}, CV{10, 27}, CV{10, 28}, CV{10, 29},
-				CV{10, 30}, CV{10, 31}, CV{10, 32}, CV{10, 33},
-				CV{10, 34}, CV{10, 35}, CV{10, 36}, CV{11, 1},
-				CV{11, 2}, CV{11, 3}, CV{11, 4}, CV{11, 5},
-				CV{11, 6}, CV{11, 7}, CV{11, 8}, CV{11, 9},
-				CV{11, 10}, CV{11, 11}, CV{11, 12}, CV{11, 13},
-				CV{11, 14}, CV{11, 15}, CV{11, 16}, CV{11, 17},
-				CV{11, 18}, CV{11, 19}, CV{11, 20}, CV{11, 21},
-				CV{12, 1}, CV{12, 2}, CV{12, 3}, CV{12, 4},
-				CV{12, 5}, CV{12, 6}, CV{12, 7}, CV{12, 8},
-				CV{12, 9}, CV{12, 10}, CV{12, 11}, CV{12, 12},
-				CV{12, 13}, CV{12, 14}, CV{12, 15}, CV{12, 16},
-				CV{12, 17}, CV{12, 18}, CV{12, 19}, CV{12, 20},
-				CV{12, 21}, CV{13, 1}, CV{13, 2}, CV{13, 3},
-				CV{13, 4}, CV{13, 5}, CV{13, 6}, CV{13, 7},
-				CV{13, 8}, CV{13, 9}, CV{13, 10}, CV{13, 11},
-				CV{13, 12}, CV{13, 13}, CV{13, 14}, CV{13, 15},
-				CV{13, 16}, CV{13, 17}, CV{13, 18}, CV{13, 19},
-				CV{13, 20}, CV{13, 21}, CV{13, 22}, CV{13, 23},
-				CV{13, 24}, CV{13, 25}, CV{14, 1}, CV{14, 2},
-				CV{14, 3}, CV{14, 4}, CV{14, 5}, CV{14, 6},
-				CV{14, 7}, CV{14, 8}, CV{14, 9}, CV{14, 10},
-				CV{14, 11}, CV{14, 12}, CV{14, 13}, CV{14, 14},
-				CV{14, 15}, CV{14, 16}, CV{14, 17}, CV{14, 18},
-				CV{14, 19}, CV{14, 20}, CV{14, 21}, CV{14, 22},
-				CV{14, 23}, CV{14, 24}, CV{14, 25}, CV{14, 26},
-				CV{14, 27}, CV{14, 28}, CV{14, 29}, CV{15, 1},
-				CV{15, 2}, CV{15, 3}, CV{15, 4}, CV{15, 5},
-				CV{15, 6}, CV{15, 7}, CV{15, 8}, CV{15, 9},
-				CV{15, 10}, CV{15, 11}, CV{15, 12}, CV{15, 13},
-				CV{15, 14}, CV{15, 15}, CV{15, 16}, CV{15, 17},
-				CV{15, 18}, CV{15, 19}, CV{15, 20}, CV{15, 21},
-				CV{15, 22}, CV{15, 23}, CV{15, 24}, CV{15, 25},
-				CV{15, 26}, CV{15, 27}, CV{15, 28}, CV{15, 29},
-				CV{15, 30}, CV{15, 31}, CV{15, 32}, CV{15, 33},
-				CV{15, 34}, CV{15, 35}, CV{15, 36}, CV{15, 37},
-				CV{15, 38}, CV{16, 1}, CV{16, 2}, CV{16, 3},
-				CV{16, 4}, CV{16, 5}, CV{16, 6}, CV{16, 7},
-				CV{16, 8}, CV{16, 9}, CV{16, 10}, CV{16, 11},
-				CV{16, 12}, CV{16, 13}, CV{16, 14}, CV{16, 15},
-				CV{16, 16}, CV{16, 17}, CV{16, 18}, CV{16, 19},
-				CV{16, 20}, CV{17, 1}, CV{17, 2}, CV{17, 3},
-				CV{17, 4}, CV{17, 5}, CV{17, 6}, CV{17, 7},
-				CV{17, 8}, CV{17, 9}, CV{17, 10}, CV{17, 11},
-				CV{17, 12}, CV{17, 13}, CV{17, 14}, CV{17, 15},
-				CV{17, 16}, CV{17, 17}, CV{17, 18}, CV{17, 19},
-				CV{17, 20}, CV{17, 21}, CV{17, 22}, CV{17, 23},
-				CV{17, 24}, CV{17, 25}, CV{17, 26}, CV{17, 27},
-				CV{17, 28}, CV{17, 29}, CV{17, 30}, CV{17, 31},
-				CV{17, 32}, CV{17, 33}, CV{17, 34}, CV{17, 35},
-				CV{17, 36}, CV{17, 37}, CV{17, 38}, CV{17, 39},
-				CV{17, 40}, CV{17, 41}, CV{18, 1}, CV{18, 2},
-				CV{18, 3}, CV{18, 4}, CV{18, 5}, CV{18, 6},
-				CV{18, 7}, CV{18, 8}, CV{18, 9}, CV{18, 10},
-				CV{18, 11}, CV{18, 12}, CV{18, 13}, CV{18, 14},
-				CV{18, 15}, CV{18, 16}, CV{18, 17}, CV{18, 18},
-				CV{18, 19}, CV{18, 20}, CV{18, 21}, CV{18, 22},
-				CV{18, 23}, CV{18, 24}, CV{18, 25}, CV{18, 26},
-				CV{18, 27}, CV{18, 28}, CV{18, 29}, CV{18, 30},
-				CV{18, 31}, CV{18, 32}, CV{18, 33}, CV{18, 34},
-				CV{18, 35}, CV{18, 36}, CV{18, 37}, CV{19, 1},
-				CV{19, 2}, CV{19, 3}, CV{19, 4}, CV{19, 5},
-				CV{19, 6}, CV{19, 7}, CV{19, 8}, CV{19, 9},
-				CV{19, 10}, CV{19, 11}, CV{19, 12}, CV{19, 13},
-				CV{19, 14}, CV{19, 15}, CV{19, 16}, CV{19, 17},
-				CV{19, 18}, CV{19, 19}, CV{19, 20}, CV{19, 21},
-				CV{19, 22}, CV{19, 23}, CV{19, 24}, CV{19, 25},
-				CV{19, 26}, CV{19, 27}, CV{19, 28}, CV{19, 29},
-				CV{19, 30}, CV{19, 31}, CV{19, 32}, CV{19, 33},
-				CV{19, 34}, CV{19, 35}, CV{19, 36}, CV{19, 37},
-				CV{20, 1}, CV{20, 2}, CV{20, 3}, CV{20, 4},
-				CV{20, 5}, CV{20, 6}, CV{20, 7}, CV{20, 8},
-				CV{20, 9}, CV{20, 10}, CV{20, 11}, CV{20, 12},
-				CV{20, 13}, CV{20, 14}, CV{20, 15}, CV{20, 16},
-				CV{20, 17}, CV{20, 18}, CV{20, 19}, CV{20, 20},
-				CV{20, 21}, CV{21, 1}, CV{21, 2}, CV{21, 3},
-				CV{21, 4}, CV{21, 5}, CV{21, 6}, CV{21, 7},
-				CV{21, 8}, CV{21, 9}, CV{21, 10}, CV{21, 11},
-				CV{21, 12}, CV{21, 13}, CV{21, 14}, CV{21, 15},
-				CV{21, 16}, CV{21, 17}, CV{21, 18}, CV{21, 19},
-				CV{21, 20}, CV{21, 21}, CV{21, 22}, CV{21, 23},
-				CV{21, 24}, CV{21, 25}, CV{21, 26}, CV{22, 1},
-				CV{22, 2}, CV{22, 3}, CV{22, 4}, CV{22, 5},
-				CV{22, 6}, CV{22, 7}, CV{22, 8}, CV{22, 9},
-				CV{22, 10}, CV{22, 11}, CV{22, 12}, CV{22, 13},
-				CV{22, 14}, CV{22, 15}, CV{22, 16}, CV{22, 17},
-				CV{22, 18}, CV{22, 19}, CV{22, 20}, CV{23, 1},
-				CV{23, 2}, CV{23, 3}, CV{23, 4}, CV{23, 5},
-				CV{23, 6}, CV{23, 7}, CV{23, 8}, CV{23, 9},
-				CV{23, 10}, CV{23, 11}, CV{23, 12}, CV{23, 13},
-				CV{23, 14}, CV{23, 15}, CV{23, 16}, CV{23, 17},
-				CV{23, 18}, CV{23, 19}, CV{23, 20}, CV{23, 21},
-				CV{23, 22}, CV{23, 23}, CV{23, 24}, CV{23, 25},
-				CV{23, 26}, CV{23, 27}, CV{23, 28}, CV{23, 29},
-				CV{23, 30}, CV{23, 31}, CV{23, 32}, CV{23, 33},
-				CV{23, 34}, CV{23, 35}, CV{23, 36}, CV{23, 37},
-				CV{24, 1}, CV{24, 2}, CV{24, 3}, CV{24, 4},
-				CV{24, 5}, CV{24, 6}, CV{24, 7}, CV{24, 8},
-				CV{24, 9}, CV{24, 10}, CV{24, 11}, CV{24, 12},
-				CV{24, 13}, CV{24, 14}, CV{24, 15}, CV{24, 16},
-				CV{24, 17}, CV{24, 18}, CV{24, 19}, CV{24, 20},
-				CV{25, 1}, CV{25, 2}, CV{25, 3}, CV{25, 4},
-				CV{25, 5}, CV{25, 6}, CV{25, 7}, CV{25, 8},
-				CV{25, 9}, CV{25, 10}, CV{25, 11}, CV{25, 12},
-				CV{25, 13}, CV{25, 14}, CV{25, 15}, CV{25, 16},
-				CV{25, 17}, CV{25, 18}, CV{25, 19}, CV{25, 20},
-				CV{25, 21}, CV{25, 22}, CV{25, 23}, CV{25, 24},
-				CV{25, 25}, CV{25, 26}, CV{25, 27}, CV{25, 28},
-				CV{25, 29}, CV{25, 30},
+			Runs: []VerseRun{
+				{Chapter: 1, First: 1, Last: 18},
+				{Chapter: 2, First: 1, Last: 25},
+				{Chapter: 3, First: 1, Last: 27},
+				{Chapter: 4, First: 1, Last: 44},
+				{Chapter: 5, First: 1, Last: 27},
+				{Chapter: 6, First: 1, Last: 33},
+				{Chapter: 7, First: 1, Last: 20},
+				{Chapter: 8, First: 1, Last: 29},
+				{Chapter: 9, First: 1, Last: 37},
+				{Chapter: 10, First: 1, Last: 36},
+				{Chapter: 11, First: 1, Last: 21},
+				{Chapter: 12, First: 1, Last: 21},
+				{Chapter: 13, First: 1, Last: 25},
+				{Chapter: 14, First: 1, Last: 29},
+				{Chapter: 15, First: 1, Last: 38},
+				{Chapter: 16, First: 1, Last: 20},
+				{Chapter: 17, First: 1, Last: 41},
+				{Chapter: 18, First: 1, Last: 37},
+				{Chapter: 19, First: 1, Last: 37},
+				{Chapter: 20, First: 1, Last: 21},
+				{Chapter: 21, First: 1, Last: 26},
+				{Chapter: 22, First: 1, Last: 20},
+				{Chapter: 23, First: 1, Last: 37},
+				{Chapter: 24, First: 1, Last: 20},
+				{Chapter: 25, First: 1, Last: 30},
 			},
 		},
 		{
 			Name:      "1 Chronicles",
+			USFM:      "1CH",
+			Testament: OldTestament,
 			JustVerse: false,
-			Verses: []Verse{CV{1, 1},
-				CV{1, 2}, CV{1, 3}, CV{1, 4}, CV{1, 5},
-				CV{1, 6}, CV{1, 7}, CV{1, 8}, CV{1, 9},
-				CV{1, 10}, CV{1, 11}, CV{1, 12}, CV{1, 13},
-				CV{1, 14}, CV{1, 15}, CV{1, 16}, CV{1, 17},
-				CV{1, 18}, CV{1, 19}, CV{1, 20}, CV{1, 21},
-				CV{1, 22}, CV{1, 23}, CV{1, 24}, CV{1, 25},
-				CV{1, 26}, CV{1, 27}, CV{1, 28}, CV{1, 29},
-				CV{1, 30}, CV{1, 31}, CV{1, 32}, CV{1, 33},
-				CV{1, 34}, CV{1, 35}, CV{1, 36}, CV{1, 37},
-				CV{1, 38}, CV{1, 39}, CV{1, 40}, CV{1, 41},
-				CV{1, 42}, CV{1, 43}, CV{1, 44}, CV{1, 45},
-				CV{1, 46}, CV{1, 47}, CV{1, 48}, CV{1, 49},
-				CV{1, 50}, CV{1, 51}, CV{1, 52}, CV{1, 53},
-				CV{1, 54}, CV{2, 1}, CV{2, 2}, CV{2, 3},
-				CV{2, 4}, CV{2, 5}, CV{2, 6}, CV{2, 7},
-				CV{2, 8}, CV{2, 9}, CV{2, 10}, CV{2, 11},
-				CV{2, 12}, CV{2, 13}, CV{2, 14}, CV{2, 15},
-				CV{2, 16}, CV{2, 17}, CV{2, 18}, CV{2, 19},
-				CV{2, 20}, CV{2, 21}, CV{2, 22}, CV{2, 23},
-				CV{2, 24}, CV{2, 25}, CV{2, 26}, CV{2, 27},
-				CV{2, 28}, CV{2, 29}, CV{2, 30}, CV{2, 31},
-				CV{2, 32}, CV{2, 33}, CV{2, 34}, CV{2, 35},
-				CV{2, 36}, CV{2, 37}, CV{2, 38}, CV{2, 39},
-				CV{2, 40}, CV{2, 41}, CV{2, 42}, CV{2, 43},
-				CV{2, 44}, CV{2, 45}, CV{2, 46}, CV{2, 47},
-				CV{2, 48}, CV{2, 49}, CV{2, 50}, CV{2, 51},
-				CV{2, 52}, CV{2, 53}, CV{2, 54}, CV{2, 55},
-				CV{3, 1}, CV{3, 2}, CV{3, 3}, CV{3, 4},
-				CV{3, 5}, CV{3, 6}, CV{3, 7}, CV{3, 8},
-				CV{3, 9}, CV{3, 10}, CV{3, 11}, CV{3, 12},
-				CV{3, 13}, CV{3, 14}, CV{3, 15}, CV{3, 16},
-				CV{3, 17}, CV{3, 18}, CV{3, 19}, CV{3, 20},
-				CV{3, 21}, CV{3, 22}, CV{3, 23}, CV{3, 24},
-				CV{4, 1}, CV{4, 2}, CV{4, 3}, CV{4, 4},
-				CV{4, 5}, CV{4, 6}, CV{4, 7}, CV{4, 8},
-				CV{4, 9}, CV{4, 10}, CV{4, 11}, CV{4, 12},
-				CV{4, 13}, CV{4, 14}, CV{4, 15}, CV{4, 16},
-				CV{4, 17}, CV{4, 18}, CV{4, 19}, CV{4, 20},
-				CV{4, 21}, CV{4, 22}, CV{4, 23}, CV{4, 24},
-				CV{4, 25}, CV{4, 26}, CV{4, 27}, CV{4, 28},
-				CV{4, 29}, CV{4, 30}, CV{4, 31}, CV{4, 32},
-				CV{4, 33}, CV{4, 34}, CV{4, 35}, CV{4, 36},
-				CV{4, 37}, CV{4, 38}, CV{4, 39}, CV{4, 40},
-				CV{4, 41}, CV{4, 42}, CV{4, 43}, CV{5, 1},
-				CV{5, 2}, CV{5, 3}, CV{5, 4}, CV{5, 5},
-				CV{5, 6}, CV{5, 7}, CV{5, 8}, CV{5, 9},
-				CV{5, 10}, CV{5, 11}, CV{5, 12}, CV{5, 13},
-				CV{5, 14}, CV{5, 15}, CV{5, 16}, CV{5, 17},
-				CV{5, 18}, CV{5, 19}, CV{5, 20}, CV{5, 21},
-				CV{5, 22}, CV{5, 23}, CV{5, 24}, CV{5, 25},
-				CV{5, 26}, CV{6, 1}, CV{6, 2}, CV{6, 3},
-				CV{6, 4}, CV{6, 5}, CV{6, 6}, CV{6, 7},
-				CV{6, 8}, CV{6, 9}, CV{6, 10}, CV{6, 11},
-				CV{6, 12}, CV{6, 13}, CV{6, 14}, CV{6, 15},
-				CV{6, 16}, CV{6, 17}, CV{6, 18}, CV{6, 19},
-				CV{6, 20}, CV{6, 21}, CV{6, 22}, CV{6, 23},
-				CV{6, 24}, CV{6, 25}, CV{6, 26}, CV{6, 27},
-				CV{6, 28}, CV{6, 29}, CV{6, 30}, CV{6, 31},
-				CV{6, 32}, CV{6, 33}, CV{6, 34}, CV{6, 35},
-				CV{6, 36}, CV{6, 37}, CV{6, 38}, CV{6, 39},
-				CV{6, 40}, CV{6, 41}, CV{6, 42}, CV{6, 43},
-				CV{6, 44}, CV{6, 45}, CV{6, 46}, CV{6, 47},
-				CV{6, 48}, CV{6, 49}, CV{6, 50}, CV{6, 51},
-				CV{6, 52}, CV{6, 53}, CV{6, 54}, CV{6, 55},
-				CV{6, 56}, CV{6, 57}, CV{6, 58}, CV{6, 59},
-				CV{6, 60}, CV{6, 61}, CV{6, 62}, CV{6, 63},
-				CV{6, 64}, CV{6, 65}, CV{6, 66}, CV{6, 67},
-				CV{6, 68}, CV{6, 69}, CV{6, 70}, CV{6, 71},
-				CV{6, 72}, CV{6, 73}, CV{6, 74}, CV{6, 75},
-				CV{6, 76}, CV{6, 77}, CV{6, 78}, CV{6, 79},
-				CV{6, 80}, CV{6, 81}, CV{7, 1}, CV{7, 2},
-				CV{7, 3}, CV{7, 4}, CV{7, 5}, CV{7, 6},
-				CV{7, 7}, CV{7, 8}, CV{7, 9}, CV{7, 10},
-				CV{7, 11}, CV{7, 12}, CV{7, 13}, CV{7, 14},
-				CV{7, 15}, CV{7, 16}, CV{7, 17}, CV{7, 18},
-				CV{7, 19}, CV{7, 20}, CV{7, 21}, CV{7, 22},
-				CV{7, 23}, CV{7, 24}, CV{7, 25}, CV{7, 26},
-				CV{7, 27}, CV{7, 28}, CV{7, 29}, CV{7, 30},
-				CV{7, 31}, CV{7, 32}, CV{7, 33}, CV{7, 34},
-				CV{7, 35}, CV{7, 36}, CV{7, 37}, CV{7, 38},
-				CV{7, 39}, CV{7, 40}, CV{8, 1}, CV{8, 2},
-				CV{8, 3}, CV{8, 4}, CV{8, 5}, CV{8, 6},
-				CV{8, 7}, CV{8, 8}, CV{8, 9}, CV{8, 10},
-				CV{8, 11}, CV{8, 12}, CV{8, 13}, CV{8, 14},
-				CV{8, 15}, CV{8, 16}, CV{8, 17}, CV{8, 18},
-				CV{8, 19}, CV{8, 20}, CV{8, 21}, CV{8, 22},
-				CV{8, 23}, CV{8, 24}, CV{8, 25}, CV{8, 26},
-				CV{8, 27}, CV{8, 28}, CV{8, 29}, CV{8, 30},
-				CV{8, 31}, CV{8, 32}, CV{8, 33}, CV{8, 34},
-				CV{8, 35}, CV{8, 36}, CV{8, 37}, CV{8, 38},
-				CV{8, 39}, CV{8, 40}, CV{9, 1}, CV{9, 2},
-				CV{9, 3}, CV{9, 4}, CV{9, 5}, CV{9, 6},
-				CV{9, 7}, CV{9, 8}, CV{9, 9}, CV{9, 10},
-				CV{9, 11}, CV{9, 12}, CV{9, 13}, CV{9, 14},
-				CV{9, 15}, CV{9, 16}, CV{9, 17}, CV{9, 18},
-				CV{9, 19}, CV{9, 20}, CV{9, 21}, CV{9, 22},
-				CV{9, 23}, CV{9, 24}, CV{9, 25}, CV{9, 26},
-				CV{9, 27}, CV{9, 28}, CV{9, 29}, CV{9, 30},
-				CV{9, 31}, CV{9, 32}, CV{9, 33}, CV{9, 34},
-				CV{9, 35}, CV{9, 36}, CV{9, 37}, CV{9, 38},
-				CV{9, 39}, CV{9, 40}, CV{9, 41}, CV{9, 42},
-				CV{9, 43}, CV{9, 44}, CV{10, 1}, CV{10, 2},
-				CV{10, 3}, CV{10, 4}, CV{10, 5}, CV{10, 6},
-				CV{10, 7}, CV{10, 8}, CV{10, 9}, CV{10, 10},
-				CV{10, 11}, CV{10, 12}, CV{10, 13}, CV{10, 14},
-				CV{11, 1}, CV{11, 2}, CV{11, 3}, CV{11, 4},
-				CV{11, 5}, CV{11, 6}, CV{11, 7}, CV{11, 8},
-				CV{11, 9}, CV{11, 10}, CV{11, 11}, CV{11, 12},
-				CV{11, 13}, CV{11, 14}, CV{11, 15}, CV{11, 16},
-				CV{11, 17}, CV{11, 18}, CV{11, 19}, CV{11, 20},
-				CV{11, 21}, CV{11, 22}, CV{11, 23}, CV{11, 24},
-				CV{11, 25}, CV{11, 26}, CV{11, 27}, CV{11, 28},
-				CV{11, 29}, CV{11, 30}, CV{11, 31}, CV{11, 32},
-				CV{11, 33}, CV{11, 34}, CV{11, 35}, CV{11, 36},
-				CV{11, 37}, CV{11, 38}, CV{11, 39}, CV{11, 40},
-				CV{11, 41}, CV{11, 42}, CV{11, 43}, CV{11, 44},
-				CV{11, 45}, CV{11, 46}, CV{11, 47}, CV{12, 1},
-				CV{12, 2}, CV{12, 3}, CV{12, 4}, CV{12, 5},
-				CV{12, 6}, CV{12, 7}, CV{12, 8}, CV{12, 9},
-				CV{12, 10}, CV{12, 11}, CV{12, 12}, CV{12, 13},
-				CV{12, 14}, CV{12, 15}, CV{12, 16}, CV{12, 17},
-				CV{12, 18}, CV{12, 19}, CV{12, 20}, CV{12, 21},
-				CV{12, 22}, CV{12, 23}, CV{12, 24}, CV{12, 25},
-				CV{12, 26}, CV{12, 27}, CV{12, 28}, CV{12, 29},
-				CV{12, 30}, CV{12, 31}, CV{12, 32}, CV{12, 33},
-				CV{12, 34}, CV{12, 35}, CV{12, 36}, CV{12, 37},
-				CV{12, 38}, CV{12, 39}, CV{12, 40}, CV{13, 1},
-				CV{13, 2}, CV{13, 3}, CV{13, 4}, CV{13, 5},
-				CV{13, 6}, CV{13, 7}, CV{13, 8}, CV{13, 9},
-				CV{13, 10}, CV{13, 11}, CV{13, 12}, CV{13, 13},
-				CV{13, 14}, CV{14, 1}, CV{14, 2}, CV{14, 3},
-				CV{14, 4}, CV{14, 5}, CV{14, 6}, CV{14, 7},
-				CV{14, 8}, CV{14, 9}, CV{14, 10}, CV{14, 11},
-				CV{14, 12}, CV{14, 13}, CV{14, 14}, CV{14, 15},
-				CV{14, 16}, CV{14, 17}, CV{15, 1}, CV{15, 2},
-				CV{15, 3}, CV{15, 4}, CV{15, 5}, CV{15, 6},
-				CV{15, 7}, CV{15, 8}, CV{15, 9}, CV{15, 10},
-				CV{15, 11}, CV{15, 12}, CV{15, 13}, CV{15, 14},
-				CV{15, 15}, CV{15, 16}, CV{15, 17}, CV{15, 18},
-				CV{15, 19}, CV{15, 20}, CV{15, 21}, CV{15, 22},
-				CV{15, 23}, CV{15, 24}, CV{15, 25}, CV{15, 26},
-				CV{15, 27}, CV{15, 28}, CV{15, 29}, CV{16, 1},
-				CV{16, 2}, CV{16, 3}, CV{16, 4}, CV{16, 5},
-				CV{16, 6}, CV{16, 7}, CV{16, 8}, CV{16, 9},
-				CV{16, 10}, CV{16, 11}, CV{16, 12}, CV{16, 13},
-				CV{16, 14}, CV{16, 15}, CV{16, 16}, CV{16, 17},
-				CV{16, 18}, CV{16, 19}, CV{16, 20}, CV{16, 21},
-				CV{16, 22}, CV{16, 23}, CV{16, 24}, CV{16, 25},
-				CV{16, 26}, CV{16, 27}, CV{16, 28}, CV{16, 29},
-				CV{16, 30}, CV{16, 31}, CV{16, 32}, CV{16, 33},
-				CV{16, 34}, CV{16, 35}, CV{16, 36}, CV{16, 37},
-				CV{16, 38}, CV{16, 39}, CV{16, 40}, CV{16, 41},
-				CV{16, 42}, CV{16, 43}, CV{17, 1}, CV{17, 2},
-				CV{17, 3}, CV{17, 4}, CV{17, 5}, CV{17, 6},
-				CV{17, 7}, CV{17, 8}, CV{17, 9}, CV{17, 10},
-				CV{17, 11}, CV{17, 12}, CV{17, 13}, CV{17, 14},
-				CV{17, 15}, CV{17, 16}, CV{17, 17}, CV{17, 18},
-				CV{17, 19}, CV{17, 20}, CV{17, 21}, CV{17, 22},
-				CV{17, 23}, CV{17, 24}, CV{17, 25}, CV{17, 26},
-				CV{17, 27}, CV{18, 1}, CV{18, 2}, CV{18, 3},
-				CV{18, 4}, CV{18, 5}, CV{18, 6}, CV{18, 7},
-				CV{18, 8}, CV{18, 9}, CV{18, 10}, CV{18, 11},
-				CV{18, 12}, CV{18, 13}, CV{18, 14}, CV{18, 15},
-				CV{18, 16}, CV{18, 17}, CV{19, 1}, CV{19, 2},
-				CV{19, 3}, CV{19, 4}, CV{19, 5}, CV{19, 6},
-				CV{19, 7}, CV{19, 8}, CV{19, 9}, CV{19, 10},
-				CV{19, 11}, CV{19, 12}, CV{19, 13}, CV{19, 14},
-				CV{19, 15}, CV{19, 16}, CV{19, 17}, CV{19, 18},
-				CV{19, 19}, CV{20, 1}, CV{20, 2}, CV{20, 3},
-				CV{20, 4}, CV{20, 5}, CV{20, 6}, CV{20, 7},
-				CV{20, 8}, CV{21, 1}, CV{21, 2}, CV{21, 3},
-				CV{21, 4}, CV{21, 5}, CV{21, 6}, CV{21, 7},
-				CV{21, 8}, CV{21, 9}, CV{21, 10}, CV{21, 11},
-				CV{21, 12}, CV{21, 13}, CV{21, 14}, CV{21, 15},
-				CV{21, 16}, CV{21, 17}, CV{21, 18}, CV{21, 19},
-				CV{21, 20}, CV{21, 21}, CV{21, 22}, CV{21, 23},
-				CV{21, 24}, CV{21, 25}, CV{21, 26}, CV{21, 27},
-				CV{21, 28}, CV{21, 29}, CV{21, 30}, CV{22, 1},
-				CV{22, 2}, CV{22, 3}, CV{22, 4}, CV{22, 5},
-				CV{22, 6}, CV{22, 7}, CV{22, 8}, CV{22, 9},
-				CV{22, 10}, CV{22, 11}, CV{22, 12}, CV{22, 13},
-				CV{22, 14}, CV{22, 15}, CV{22, 16}, CV{22, 17},
-				CV{22, 18}, CV{22, 19}, CV{23, 1}, CV{23, 2},
-				CV{23, 3}, CV{23, 4}, CV{23, 5}, CV{23, 6},
-				CV{23, 7}, CV{23, 8}, CV{23, 9}, CV{23, 10},
-				CV{23, 11}, CV{23, 12}, CV{23, 13}, CV{23, 14},
-				CV{23, 15}, CV{23, 16}, CV{23, 17}, CV{23, 18},
-				CV{23, 19}, CV{23, 20}, CV{23, 21}, CV{23, 22},
-				CV{23, 23}, CV{23, 24}, CV{23, 25}, CV{23, 26},
-				CV{23, 27}, CV{23, 28}, CV{23, 29}, CV{23, 30},
-				CV{23, 31}, CV{23, 32}, CV{24, 1}, CV{24, 2},
-				CV{24, 3}, CV{24, 4}, CV{24, 5}, CV{24, 6},
-				CV{24, 7}, CV{24, 8}, CV{24, 9}, CV{24, 10},
-				CV{24, 11}, CV{24, 12}, CV{24, 13}, CV{24, 14},
-				CV{24, 15}, CV{24, 16}, CV{24, 17}, CV{24, 18},
-				CV{24, 19}, CV{24, 20}, CV{24, 21}, CV{24, 22},
-				CV{24, 23}, CV{24, 24}, CV{24, 25}, CV{24, 26},
-				CV{24, 27}, CV{24, 28}, CV{24, 29}, CV{24, 30},
-				CV{24, 31}, CV{25, 1}, CV{25, 2}, CV{25, 3},
-				CV{25, 4}, CV{25, 5}, CV{25, 6}, CV{25, 7},
-				CV{25, 8}, CV{25, 9}, CV{25, 10}, CV{25, 11},
-				CV{25, 12}, CV{25, 13}, CV{25, 14}, CV{25, 15},
-				CV{25, 16}, CV{25, 17}, CV{25, 18}, CV{25, 19},
-				CV{25, 20}, CV{25, 21}, CV{25, 22}, CV{25, 23},
-				CV{25, 24}, CV{25, 25}, CV{25, 26}, CV{25, 27},
-				CV{25, 28}, CV{25, 29}, CV{25, 30}, CV{25, 31},
-				CV{26, 1}, CV{26, 2}, CV{26, 3}, CV{26, 4},
-				CV{26, 5}, CV{26, 6}, CV{26, 7}, CV{26, 8},
-				CV{26, 9}, CV{26, 10}, CV{26, 11}, CV{26, 12},
-				CV{26, 13}, CV{26, 14}, CV{26, 15}, CV{26, 16},
-				CV{26, 17}, CV{26, 18}, CV{26, 19}, CV{26, 20},
-				CV{26, 21}, CV{26, 22}, CV{26, 23}, CV{26, 24},
-				CV{26, 25}, CV{26, 26}, CV{26, 27}, CV{26, 28},
-				CV{26, 29}, CV{26, 30}, CV{26, 31}, CV{26, 32},
-				CV{27, 1}, CV{27, 2}, CV{27, 3}, CV{27, 4},
-				CV{27, 5}, CV{27, 6}, CV{27, 7}, CV{27, 8},
-				CV{27, 9}, CV{27, 10}, CV{27, 11}, CV{27, 12},
-				CV{27, 13}, CV{27, 14}, CV{27, 15}, CV{27, 16},
-				CV{27, 17}, CV{27, 18}, CV{27, 19}, CV{27, 20},
-				CV{27, 21}, CV{27, 22}, CV{27, 23}, CV{27, 24},
-				CV{27, 25}, CV{27, 26}, CV{27, 27}, CV{27, 28},
-				CV{27, 29}, CV{27, 30}, CV{27, 31}, CV{27, 32},
-				CV{27, 33}, CV{27, 34}, CV{28, 1}, CV{28, 2},
-				CV{28, 3}, CV{28, 4}, CV{28, 5}, CV{28, 6},
-				CV{28, 7}, CV{28, 8}, CV{28, 9}, CV{28, 10},
-				CV{28, 11}, CV{28, 12}, CV{28, 13}, CV{28, 14},
-				CV{28, 15}, CV{28, 16}, CV{28, 17}, CV{28, 18},
-				CV{28, 19}, CV{28, 20}, CV{28, 21}, CV{29, 1},
-				CV{29, 2}, CV{29, 3}, CV{29, 4}, CV{29, 5},
-				CV{29, 6}, CV{29, 7}, CV{29, 8}, CV{29, 9},
-				CV{29, 10}, CV{29, 11}, CV{29, 12}, CV{29, 13},
-				CV{29, 14}, CV{29, 15}, CV{29, 16}, CV{29, 17},
-				CV{29, 18}, CV{29, 19}, CV{29, 20}, CV{29, 21},
-				CV{29, 22}, CV{29, 23}, CV{29, 24}, CV{29, 25},
-				CV{29, 26}, CV{29, 27}, CV{29, 28}, CV{29, 29},
-				CV{29, 30},
+			Runs: []VerseRun{
+				{Chapter: 1, First: 1, Last: 54},
+				{Chapter: 2, First: 1, Last: 55},
+				{Chapter: 3, First: 1, Last: 24},
+				{Chapter: 4, First: 1, Last: 43},
+				{Chapter: 5, First: 1, Last: 26},
+				{Chapter: 6, First: 1, Last: 81},
+				{Chapter: 7, First: 1, Last: 40},
+				{Chapter: 8, First: 1, Last: 40},
+				{Chapter: 9, First: 1, Last: 44},
+				{Chapter: 10, First: 1, Last: 14},
+				{Chapter: 11, First: 1, Last: 47},
+				{Chapter: 12, First: 1, Last: 40},
+				{Chapter: 13, First: 1, Last: 14},
+				{Chapter: 14, First: 1, Last: 17},
+				{Chapter: 15, First: 1, Last: 29},
+				{Chapter: 16, First: 1, Last: 43},
+				{Chapter: 17, First: 1, Last: 27},
+				{Chapter: 18, First: 1, Last: 17},
+				{Chapter: 19, First: 1, Last: 19},
+				{Chapter: 20, First: 1, Last: 8},
+				{Chapter: 21, First: 1, Last: 30},
+				{Chapter: 22, First: 1, Last: 19},
+				{Chapter: 23, First: 1, Last: 32},
+				{Chapter: 24, First: 1, Last: 31},
+				{Chapter: 25, First: 1, Last: 31},
+				{Chapter: 26, First: 1, Last: 32},
+				{Chapter: 27, First: 1, Last: 34},
+				{Chapter: 28, First: 1, Last: 21},
+				{Chapter: 29, First: 1, Last: 30},
 			},
 		},
 		{
 			Name:      "2 Chronicles",
+			USFM:      "2CH",
+			Testament: OldTestament,
 			JustVerse: false,
-			Verses: []Verse{CV{1, 1},
-				CV{1, 2}, CV{1, 3}, CV{1, 4}, CV{1, 5},
-				CV{1, 6}, CV{1, 7}, CV{1, 8}, CV{1, 9},
-				CV{1, 10}, CV{1, 11}, CV{1, 12}, CV{1, 13},
-				CV{1, 14}, CV{1, 15}, CV{1, 16}, CV{1, 17},
-				CV{2, 1}, CV{2, 2}, CV{2, 3}, CV{2, 4},
-				CV{2, 5}, CV{2, 6}, CV{2, 7}, CV{2, 8},
-				CV{2, 9}, CV{2, 10}, CV{2, 11}, CV{2, 12},
-				CV{2, 13}, CV{2, 14}, CV{2, 15}, CV{2, 16},
-				CV{2, 17}, CV{2, 18}, CV{3, 1}, CV{3, 2},
-				CV{3, 3}, CV{3, 4}, CV{3, 5}, CV{3, 6},
-				CV{3, 7}, CV{3, 8}, CV{3, 9}, CV{3, 10},
-				CV{3, 11}, CV{3, 12}, CV{3, 13}, CV{3, 14},
-				CV{3, 15}, CV{3, 16}, CV{3, 17}, CV{4, 1},
-				CV{4, 2}, CV{4, 3}, CV{4, 4}, CV{4, 5},
-				CV{4, 6}, CV{4, 7}, CV{4, 8}, CV{4, 9},
-				CV{4, 10}, CV{4, 11}, CV{4, 12}, CV{4, 13},
-				CV{4, 14}, CV{4, 15}, CV{4, 16}, CV{4, 17},
-				CV{4, 18}, CV{4, 19}, CV{4, 20}, CV{4, 21},
-				CV{4, 22}, CV{5, 1}, CV{5, 2}, CV{5, 3},
-				CV{5, 4}, CV{5, 5}, CV{5, 6}, CV{5, 7},
-				CV{5, 8}, CV{5, 9}, CV{5, 10}, CV{5, 11},
-				CV{5, 12}, CV{5, 13}, CV{5, 14}, CV{6, 1},
-				CV{6, 2}, CV{6, 3}, CV{6, 4}, CV{6, 5},
-				CV{6, 6}, CV{6, 7}, CV{6, 8}, CV{6, 9},
-				CV{6, 10}, CV{6, 11}, CV{6, 12}, CV{6, 13},
-				CV{6, 14}, CV{6, 15}, CV{6, 16}, CV{6, 17},
-				CV{6, 18}, CV{6, 19}, CV{6, 20}, CV{6, 21},
-				CV{6, 22}, CV{6, 23}, CV{6, 24}, CV{6, 25},
-				CV{6, 26}, CV{6, 27}, CV{6, 28}, CV{6, 29},
-				CV{6, 30}, CV{6, 31}, CV{6, 32}, CV{6, 33},
-				CV{6, 34}, CV{6, 35}, CV{6, 36}, CV{6, 37},
-				CV{6, 38}, CV{6, 39}, CV{6, 40}, CV{6, 41},
-				CV{6, 42}, CV{7, 1}, CV{7, 2}, CV{7, 3},
-				CV{7, 4}, CV{7, 5}, CV{7, 6}, CV{7, 7},
-				CV{7, 8}, CV{7, 9}, CV{7, 10}, CV{7, 11},
-				CV{7, 12}, CV{7, 13}, CV{7, 14}, CV{7, 15},
-				CV{7, 16}, CV{7, 17}, CV{7, 18}, CV{7, 19},
-				CV{7, 20}, CV{7, 21}, CV{7, 22}, CV{8, 1},
-				CV{8, 2}, CV{8, 3}, CV{8, 4}, CV{8, 5},
-				CV{8, 6}, CV{8, 7}, CV{8, 8}, CV{8, 9},
-				CV{8, 10}, CV{8, 11}, CV{8, 12}, CV{8, 13},
-				CV{8, 14}, CV{8, 15}, CV{8, 16}, CV{8, 17},
-				CV{8, 18}, CV{9, 1}, CV{9, 2}, CV{9, 3},
-				CV{9, 4}, CV{9, 5}, CV{9, 6}, CV{9, 7},
-				CV{9, 8}, CV{9, 9}, CV{9, 10}, CV{9, 11},
-				CV{9, 12}, CV{9, 13}, CV{9, 14}, CV{9, 15},
-				CV{9, 16}, CV{9, 17}, CV{9, 18}, CV{9, 19},
-				CV{9, 20}, CV{9, 21}, CV{9, 22}, CV{9, 23},
-				CV{9, 24}, CV{9, 25}, CV{9, 26}, CV{9, 27},
-				CV{9, 28}, CV{9, 29}, CV{9, 30}, CV{9, 31},
-				CV{10, 1}, CV{10, 2}, CV{10, 3}, CV{10, 4},
-				CV{10, 5}, CV{10, 6}, CV{10, 7}, CV{10, 8},
-				CV{10, 9}, CV{10, 10}, CV{10, 11}, CV{10, 12},
-				CV{10, 13}, CV{10, 14}, CV{10, 15}, CV{10, 16},
-				CV{10, 17}, CV{10, 18}, CV{10, 19}, CV{11, 1},
-				CV{11, 2}, CV{11, 3}, CV{11, 4}, CV{11, 5},
-				CV{11, 6}, CV{11, 7}, CV{11, 8}, CV{11, 9},
-				CV{11, 10}, CV{11, 11}, CV{11, 12}, CV{11, 13},
-				CV{11, 14}, CV{11, 15}, CV{11, 16}, CV{11, 17},
-				CV{11, 18}, CV{11, 19}, CV{11, 20}, CV{11, 21},
-				CV{11, 22}, CV{11, 23}, CV{12, 1}, CV{12, 2},
-				CV{12, 3}, CV{12, 4}, CV{12, 5}, CV{12, 6},
-				CV{12, 7}, CV{12, 8}, CV{12, 9}, CV{12, 10},
-				CV{12, 11}, CV{12, 12}, CV{12, 13}, CV{12, 14},
-				CV{12, 15}, CV{12, 16}, CV{13, 1}, CV{13, 2},
-				CV{13, 3}, CV{13, 4}, CV{13, 5}, CV{13, 6},
-				CV{13, 7}, CV{13, 8}, CV{13, 9}, CV{13, 10},
-				CV{13, 11}, CV{13, 12}, CV{13, 13}, CV{13, 14},
-				CV{13, 15}, CV{13, 16}, CV{13, 17}, CV{13, 18},
-				CV{13, 19}, CV{13, 20}, CV{13, 21}, CV{13, 22},
-				CV{14, 1}, CV{14, 2}, CV{14, 3}, CV{14, 4},
-				CV{14, 5}, CV{14, 6}, CV{14, 7}, CV{14, 8},
-				CV{14, 9}, CV{14, 10}, CV{14, 11}, CV{14, 12},
-				CV{14, 13}, CV{14, 14}, CV{14, 15}, CV{15, 1},
-				CV{15, 2}, CV{15, 3}, CV{15, 4}, CV{15, 5},
-				CV{15, 6}, CV{15, 7}, CV{15, 8}, CV{15, 9},
-				CV{15, 10}, CV{15, 11}, CV{15, 12}, CV{15, 13},
-				CV{15, 14}, CV{15, 15}, CV{15, 16}, CV{15, 17},
-				CV{15, 18}, CV{15, 19}, CV{16, 1}, CV{16, 2},
-				CV{16, 3}, CV{16, 4}, CV{16, 5}, CV{16, 6},
-				CV{16, 7}, CV{16, 8}, CV{16, 9}, CV{16, 10},
-				CV{16, 11}, CV{16, 12}, CV{16, 13}, CV{16, 14},
-				CV{17, 1}, CV{17, 2}, CV{17, 3}, CV{17, 4},
-				CV{17, 5}, CV{17, 6}, CV{17, 7}, CV{17, 8},
-				CV{17, 9}, CV{17, 10}, CV{17, 11}, CV{17, 12},
-				CV{17, 13}, CV{17, 14}, CV{17, 15}, CV{17, 16},
-				CV{17, 17}, CV{17, 18}, CV{17, 19}, CV{18, 1},
-				CV{18, 2}, CV{18, 3}, CV{18, 4}, CV{18, 5},
-				CV{18, 6}, CV{18, 7}, CV{18, 8}, CV{18, 9},
-				CV{18, 10}, CV{18, 11}, CV{18, 12}, CV{18, 13},
-				CV{18, 14}, CV{18, 15}, CV{18, 16}, CV{18, 17},
-				CV{18, 18}, CV{18, 19}, CV{18, 20}, CV{18, 21},
-				CV{18, 22}, CV{18, 23}, CV{18, 24}, CV{18, 25},
-				CV{18, 26}, CV{18, 27}, CV{18, 28}, CV{18, 29},
-				CV{18, 30}, CV{18, 31}, CV{18, 32}, CV{18, 33},
-				CV{18, 34}, CV{19, 1}, CV{19, 2}, CV{19, 3},
-				CV{19, 4}, CV{19, 5}, CV{19, 6}, CV{19, 7},
-				CV{19, 8}, CV{19, 9}, CV{19, 10}, CV{19, 11},
-				CV{20, 1}, CV{20, 2}, CV{20, 3}, CV{20, 4},
-				CV{20, 5}, CV{20, 6}, CV{20, 7}, CV{20, 8},
-				CV{20, 9}, CV{20, 10}, CV{20, 11}, CV{20, 12},
-				CV{20, 13}, CV{20, 14}, CV{20, 15}, CV{20, 16},
-				CV{20, 17}, CV{20, 18}, CV{20, 19}, CV{20, 20},
-				CV{20, 21}, CV{20, 22}, CV{20, 23}, CV{20, 24},
-				CV{20, 25}, CV{20, 26}, CV{20, 27}, CV{20, 28},
-				CV{20, 29}, CV{20, 30}, CV{20, 31}, CV{20, 32},
-				CV{20, 33}, CV{20, 34}, CV{20, 35}, CV{20, 36},
-				CV{20, 37}, CV{21, 1}, CV{21, 2}, CV{21, 3},
-				CV{21, 4}, CV{21, 5}, CV{21, 6}, CV{21, 7},
-				CV{21, 8}, CV{21, 9}, CV{21, 10}, CV{21, 11},
-				CV{21, 12}, CV{21, 13}, CV{21, 14}, CV{21, 15},
-				CV{21, 16}, CV{21, 17}, CV{21, 18}, CV{21, 19},
-				CV{21, 20}, CV{22, 1}, CV{22, 2}, CV{22, 3},
-				CV{22, 4}, CV{22, 5}, CV{22, 6}, CV{22, 7},
-				CV{22, 8}, CV{22, 9}, CV{22, 10}, CV{22, 11},
-				CV{22, 12}, CV{23, 1}, CV{23, 2}, CV{23, 3},
-				CV{23, 4}, CV{23, 5}, CV{23, 6}, CV{23, 7},
-				CV{23, 8}, CV{23, 9}, CV{23, 10}, CV{23, 11},
-				CV{23, 12}, CV{23, 13}, CV{23, 14}, CV{23, 15},
-				CV{23, 16}, CV{23, 17}, CV{23, 18}, CV{23, 19},
-				CV{23, 20}, CV{23, 21}, CV{24, 1}, CV{24, 2},
-				CV{24, 3}, CV{24, 4}, CV{24, 5}, CV{24, 6},
-				CV{24, 7}, CV{24, 8}, CV{24, 9}, CV{24, 10},
-				CV{24, 11}, CV{24, 12}, CV{24, 13}, CV{24, 14},
-				CV{24, 15}, CV{24, 16}, CV{24, 17}, CV{24, 18},
-				CV{24, 19}, CV{24, 20}, CV{24, 21}, CV{24, 22},
-				CV{24, 23}, CV{24, 24}, CV{24, 25}, CV{24, 26},
-				CV{24, 27}, CV{25, 1}, CV{25, 2}, CV{25, 3},
-				CV{25, 4}, CV{25, 5}, CV{25, 6}, CV{25, 7},
-				CV{25, 8}, CV{25, 9}, CV{25, 10}, CV{25, 11},
-				CV{25, 12}, CV{25, 13}, CV{25, 14}, CV{25, 15},
-				CV{25, 16}, CV{25, 17}, CV{25, 18}, CV{25, 19},
-				CV{25, 20}, CV{25, 21}, CV{25, 22}, CV{25, 23},
-				CV{25, 24}, CV{25, 25}, CV{25, 26}, CV{25, 27},
-				CV{25, 28}, CV{26, 1}, CV{26, 2}, CV{26, 3},
-				CV{26, 4}, CV{26, 5}, CV{26, 6}, CV{26, 7},
-				CV{26, 8}, CV{26, 9}, CV{26, 10}, CV{26, 11},
-				CV{26, 12}, CV{26, 13}, CV{26, 14}, CV{26, 15},
-				CV{26, 16}, CV{26, 17}, CV{26, 18}, CV{26, 19},
-				CV{26, 20}, CV{26, 21}, CV{26, 22}, CV{26, 23},
-				CV{27, 1}, CV{27, 2}, CV{27, 3}, CV{27, 4},
-				CV{27, 5}, CV{27, 6}, CV{27, 7}, CV{27, 8},
-				CV{27, 9}, CV{28, 1}, CV{28, 2}, CV{28, 3},
-				CV{28, 4}, CV{28, 5}, CV{28, 6}, CV{28, 7},
-				CV{28, 8}, CV{28, 9}, CV{28, 10}, CV{28, 11},
-				CV{28, 12}, CV{28, 13}, CV{28, 14}, CV{28, 15},
-				CV{28, 16}, CV{28, 17}, CV{28, 18}, CV{28, 19},
-				CV{28, 20}, CV{28, 21}, CV{28, 22}, CV{28, 23},
-				CV{28, 24}, CV{28, 25}, CV{28, 26}, CV{28, 27},
-				CV{29, 1}, CV{29, 2}, CV{29, 3}, CV{29, 4},
-				CV{29, 5}, CV{29, 6}, CV{29, 7}, CV{29, 8},
-				CV{29, 9}, CV{29, 10}, CV{29, 11}, CV{29, 12},
-				CV{29, 13}, CV{29, 14}, CV{29, 15}, CV{29, 16},
-				CV{29, 17}, CV{29, 18}, CV{29, 19}, CV{29, 20},
-				CV{29, 21}, CV{29, 22}, CV{29, 23}, CV{29, 24},
-				CV{29, 25}, CV{29, 26}, CV{29, 27}, CV{29, 28},
-				CV{29, 29}, CV{29, 30}, CV{29, 31}, CV{29, 32},
-				CV{29, 33}, CV{29, 34}, CV{29, 35}, CV{29, 36},
-				CV{30, 1}, CV{30, 2}, CV{30, 3}, CV{30, 4},
-				CV{30, 5}, CV{30, 6}, CV{30, 7}, CV{30, 8},
-				CV{30, 9}, CV{30, 10}, CV{30, 11}, CV{30, 12},
-				CV{30, 13}, CV{30, 14}, CV{30, 15}, CV{30, 16},
-				CV{30, 17}, CV{30, 18}, CV{30, 19}, CV{30, 20},
-				CV{30, 21}, CV{30, 22}, CV{30, 23}, CV{30, 24},
-				CV{30, 25}, CV{30, 26}, CV{30, 27}, CV{31, 1},
-				CV{31, 2}, CV{31, 3}, CV{31, 4}, CV{31, 5},
-				CV{31, 6}, CV{31, 7}, CV{31, 8}, CV{31, 9},
-				CV{31, 10}, CV{31, 11}, CV{31, 12}, CV{31, 13},
-				CV{31, 14}, CV{31, 15}, CV{31, 16}, CV{31, 17},
-				CV{31, 18}, CV{31, 19}, CV{31, 20}, CV{31, 21},
-				CV{32, 1}, CV{32, 2}, CV{32, 3}, CV{32, 4},
-				CV{32, 5}, CV{32, 6}, CV{32, 7}, CV{32, 8},
-				CV{32, 9}, CV{32, 10}, CV{32, 11}, CV{32, 12},
-				CV{32, 13}, CV{32, 14}, CV{32, 15}, CV{32, 16},
-				CV{32, 17}, CV{32, 18}, CV{32, 19}, CV{32, 20},
-				CV{32, 21}, CV{32, 22}, CV{32, 23}, CV{32, 24},
-				CV{32, 25}, CV{32, 26}, CV{32, 27}, CV{32, 28},
-				CV{32, 29}, CV{32, 30}, CV{32, 31}, CV{32, 32},
-				CV{32, 33}, CV{33, 1}, CV{33, 2}, CV{33, 3},
-				CV{33, 4}, CV{33, 5}, CV{33, 6}, CV{33, 7},
-				CV{33, 8}, CV{33, 9}, CV{33, 10}, CV{33, 11},
-				CV{33, 12}, CV{33, 13}, CV{33, 14}, CV{33, 15},
-				CV{33, 16}, CV{33, 17}, CV{33, 18}, CV{33, 19},
-				CV{33, 20}, CV{33, 21}, CV{33, 22}, CV{33, 23},
-				CV{33, 24}, CV{33, 25}, CV{34, 1}, CV{34, 2},
-				CV{34, 3}, CV{34, 4}, CV{34, 5}, CV{34, 6},
-				CV{34, 7}, CV{34, 8}, CV{34, 9}, CV{34, 10},
-				CV{34, 11}, CV{34, 12}, CV{34, 13}, CV{34, 14},
-				CV{34, 15}, CV{34, 16}, CV{34, 17}, CV{34, 18},
-				CV{34, 19}, CV{34, 20}, CV{34, 21}, CV{34, 22},
-				CV{34, 23}, CV{34, 24}, CV{34, 25}, CV{34, 26},
-				CV{34, 27}, CV{34, 28}, CV{34, 29}, CV{34, 30},
-				CV{34, 31}, CV{34, 32}, CV{34, 33}, CV{35, 1},
-				CV{35, 2}, CV{35, 3}, CV{35, 4}, CV{35, 5},
-				CV{35, 6}, CV{35, 7}, CV{35, 8}, CV{35, 9},
-				CV{35, 10}, CV{35, 11}, CV{35, 12}, CV{35, 13},
-				CV{35, 14}, CV{35, 15}, CV{35, 16}, CV{35, 17},
-				CV{35, 18}, CV{35, 19}, CV{35, 20}, CV{35, 21},
-				CV{35, 22}, CV{35, 23}, CV{35, 24}, CV{35, 25},
-				CV{35, 26}, CV{35, 27}, CV{36, 1}, CV{36, 2},
-				CV{36, 3}, CV{36, 4}, CV{36, 5}, CV{36, 6},
-				CV{36, 7}, CV{36, 8}, CV{36, 9}, CV{36, 10},
-				CV{36, 11}, CV{36, 12}, CV{36, 13}, CV{36, 14},
-				CV{36, 15}, CV{36, 16}, CV{36, 17}, CV{36, 18},
-				CV{36, 19}, CV{36, 20}, CV{36, 21}, CV{36, 22},
-				CV{36, 23},
+			Runs: []VerseRun{
+				{Chapter: 1, First: 1, Last: 17},
+				{Chapter: 2, First: 1, Last: 18},
+				{Chapter: 3, First: 1, Last: 17},
+				{Chapter: 4, First: 1, Last: 22},
+				{Chapter: 5, First: 1, Last: 14},
+				{Chapter: 6, First: 1, Last: 42},
+				{Chapter: 7, First: 1, Last: 22},
+				{Chapter: 8, First: 1, Last: 18},
+				{Chapter: 9, First: 1, Last: 31},
+				{Chapter: 10, First: 1, Last: 19},
+				{Chapter: 11, First: 1, Last: 23},
+				{Chapter: 12, First: 1, Last: 16},
+				{Chapter: 13, First: 1, Last: 22},
+				{Chapter: 14, First: 1, Last: 15},
+				{Chapter: 15, First: 1, Last: 19},
+				{Chapter: 16, First: 1, Last: 14},
+				{Chapter: 17, First: 1, Last: 19},
+				{Chapter: 18, First: 1, Last: 34},
+				{Chapter: 19, First: 1, Last: 11},
+				{Chapter: 20, First: 1, Last: 37},
+				{Chapter: 21, First: 1, Last: 20},
+				{Chapter: 22, First: 1, Last: 12},
+				{Chapter: 23, First: 1, Last: 21},
+				{Chapter: 24, First: 1, Last: 27},
+				{Chapter: 25, First: 1, Last: 28},
+				{Chapter: 26, First: 1, Last: 23},
+				{Chapter: 27, First: 1, Last: 9},
+				{Chapter: 28, First: 1, Last: 27},
+				{Chapter: 29, First: 1, Last: 36},
+				{Chapter: 30, First: 1, Last: 27},
+				{Chapter: 31, First: 1, Last: 21},
+				{Chapter: 32, First: 1, Last: 33},
+				{Chapter: 33, First: 1, Last: 25},
+				{Chapter: 34, First: 1, Last: 33},
+				{Chapter: 35, First: 1, Last: 27},
+				{Chapter: 36, First: 1, Last: 23},
 			},
 		},
 		{
 			Name:      "Ezra",
+			USFM:      "EZR",
+			Testament: OldTestament,
 			JustVerse: false,
-			Verses: []Verse{CV{1, 1},
-				CV{1, 2}, CV{1, 3}, CV{1, 4}, CV{1, 5},
-				CV{1, 6}, CV{1, 7}, CV{1, 8}, CV{1, 9},
-				CV{1, 10}, CV{1, 11}, CV{2, 1}, CV{2, 2},
-				CV{2, 3}, CV{2, 4}, CV{2, 5}, CV{2, 6},
-				CV{2, 7}, CV{2, 8}, CV{2, 9}, CV{2, 10},
-				CV{2, 11}, CV{2, 12}, CV{2, 13}, CV{2, 14},
-				CV{2, 15}, CV{2, 16}, CV{2, 17}, CV{2, 18},
-				CV{2, 19}, CV{2, 20}, CV{2, 21}, CV{2, 22},
-				CV{2, 23}, CV{2, 24}, CV{2, 25}, CV{2, 26},
-				CV{2, 27}, CV{2, 28}, CV{2, 29}, CV{2, 30},
-				CV{2, 31}, CV{2, 32}, CV{2, 33}, CV{2, 34},
-				CV{2, 35}, CV{2, 36}, CV{2, 37}, CV{2, 38},
-				CV{2, 39}, CV{2, 40}, CV{2, 41}, CV{2, 42},
-				CV{2, 43}, CV{2, 44}, CV{2, 45}, CV{2, 46},
-				CV{2, 47}, CV{2, 48}, CV{2, 49}, CV{2, 50},
-				CV{2, 51}, CV{2, 52}, CV{2, 53}, CV{2, 54},
-				CV{2, 55}, CV{2, 56}, CV{2, 57}, CV{2, 58},
-				CV{2, 59}, CV{2, 60}, CV{2, 61}, CV{2, 62},
-				CV{2, 63}, CV{2, 64}, CV{2, 65}, CV{2, 66},
-				CV{2, 67}, CV{2, 68}, CV{2, 69}, CV{2, 70},
-				CV{3, 1}, CV{3, 2}, CV{3, 3}, CV{3, 4},
-				CV{3, 5}, CV{3, 6}, CV{3, 7}, CV{3, 8},
-				CV{3, 9}, CV{3, 10}, CV{3, 11}, CV{3, 12},
-				CV{3, 13}, CV{4, 1}, CV{4, 2}, CV{4, 3},
-				CV{4, 4}, CV{4, 5}, CV{4, 6}, CV{4, 7},
-				CV{4, 8}, CV{4, 9}, CV{4, 10}, CV{4, 11},
-				CV{4, 12}, CV{4, 13}, CV{4, 14}, CV{4, 15},
-				CV{4, 16}, CV{4, 17}, CV{4, 18}, CV{4, 19},
-				CV{4, 20}, CV{4, 21}, CV{4, 22}, CV{4, 23},
-				CV{4, 24}, CV{5, 1}, CV{5, 2}, CV{5, 3},
-				CV{5, 4}, CV{5, 5}, CV{5, 6}, CV{5, 7},
-				CV{5, 8}, CV{5, 9}, CV{5, 10}, CV{5, 11},
-				CV{5, 12}, CV{5, 13}, CV{5, 14}, CV{5, 15},
-				CV{5, 16}, CV{5, 17}, CV{6, 1}, CV{6, 2},
-				CV{6, 3}, CV{6, 4}, CV{6, 5}, CV{6, 6},
-				CV{6, 7}, CV{6, 8}, CV{6, 9}, CV{6, 10},
-				CV{6, 11}, CV{6, 12}, CV{6, 13}, CV{6, 14},
-				CV{6, 15}, CV{6, 16}, CV{6, 17}, CV{6, 18},
-				CV{6, 19}, CV{6, 20}, CV{6, 21}, CV{6, 22},
-				CV{7, 1}, CV{7, 2}, CV{7, 3}, CV{7, 4},
-				CV{7, 5}, CV{7, 6}, CV{7, 7}, CV{7, 8},
-				CV{7, 9}, CV{7, 10}, CV{7, 11}, CV{7, 12},
-				CV{7, 13}, CV{7, 14}, CV{7, 15}, CV{7, 16},
-				CV{7, 17}, CV{7, 18}, CV{7, 19}, CV{7, 20},
-				CV{7, 21}, CV{7, 22}, CV{7, 23}, CV{7, 24},
-				CV{7, 25}, CV{7, 26}, CV{7, 27}, CV{7, 28},
-				CV{8, 1}, CV{8, 2}, CV{8, 3}, CV{8, 4},
-				CV{8, 5}, CV{8, 6}, CV{8, 7}, CV{8, 8},
-				CV{8, 9}, CV{8, 10}, CV{8, 11}, CV{8, 12},
-				CV{8, 13}, CV{8, 14}, CV{8, 15}, CV{8, 16},
-				CV{8, 17}, CV{8, 18}, CV{8, 19}, CV{8, 20},
-				CV{8, 21}, CV{8, 22}, CV{8, 23}, CV{8, 24},
-				CV{8, 25}, CV{8, 26}, CV{8, 27}, CV{8, 28},
-				CV{8, 29}, CV{8, 30}, CV{8, 31}, CV{8, 32},
-				CV{8, 33}, CV{8, 34}, CV{8, 35}, CV{8, 36},
-				CV{9, 1}, CV{9, 2}, CV{9, 3}, CV{9, 4},
-				CV{9, 5}, CV{9, 6}, CV{9, 7}, CV{9, 8},
-				CV{9, 9}, CV{9, 10}, CV{9, 11}, CV{9, 12},
-				CV{9, 13}, CV{9, 14}, CV{9, 15}, CV{10, 1},
-				CV{10, 2}, CV{10, 3}, CV{10, 4}, CV{10, 5},
-				CV{10, 6}, CV{10, 7}, CV{10, 8}, CV{10, 9},
-				CV{10, 10}, CV{10, 11}, CV{10, 12}, CV{10, 13},
-				CV{10, 14}, CV{10, 15}, CV{10, 16}, CV{10, 17},
-				CV{10, 18}, CV{10, 19}, CV{10, 20}, CV{10, 21},
-				CV{10, 22}, CV{10, 23}, CV{10, 24}, CV{10, 25},
-				CV{10, 26}, CV{10, 27}, CV{10, 28}, CV{10, 29},
-				CV{10, 30}, CV{10, 31}, CV{10, 32}, CV{10, 33},
-				CV{10, 34}, CV{10, 35}, CV{10, 36}, CV{10, 37},
-				CV{10, 38}, CV{10, 39}, CV{10, 40}, CV{10, 41},
-				CV{10, 42}, CV{10, 43}, CV{10, 44},
+			Runs: []VerseRun{
+				{Chapter: 1, First: 1, Last: 11},
+				{Chapter: 2, First: 1, Last: 70},
+				{Chapter: 3, First: 1, Last: 13},
+				{Chapter: 4, First: 1, Last: 24},
+				{Chapter: 5, First: 1, Last: 17},
+				{Chapter: 6, First: 1, Last: 22},
+				{Chapter: 7, First: 1, Last: 28},
+				{Chapter: 8, First: 1, Last: 36},
+				{Chapter: 9, First: 1, Last: 15},
+				{Chapter: 10, First: 1, Last: 44},
 			},
 		},
 		{
 			Name:      "Nehemiah",
+			USFM:      "NEH",
+			Testament: OldTestament,
 			JustVerse: false,
-			Verses: []Verse{CV{1, 1},
-				CV{1, 2}, CV{1, 3}, CV{1, 4}, CV{1, 5},
-				CV{1, 6}, CV{1, 7}, CV{1, 8}, CV{1, 9},
-				CV{1, 10}, CV{1, 11}, CV{2, 1}, CV{2, 2},
-				CV{2, 3}, CV{2, 4}, CV{2, 5}, CV{2, 6},
-				CV{2, 7}, CV{2, 8}, CV{2, 9}, CV{2, 10},
-				CV{2, 11}, CV{2, 12}, CV{2, 13}, CV{2, 14},
-				CV{2, 15}, CV{2, 16}, CV{2, 17}, CV{2, 18},
-				CV{2, 19}, CV{2, 20}, CV{3, 1}, CV{3, 2},
-				CV{3, 3}, CV{3, 4}, CV{3, 5}, CV{3, 6},
-				CV{3, 7}, CV{3, 8}, CV{3, 9}, CV{3, 10},
-				CV{3, 11}, CV{3, 12}, CV{3, 13}, CV{3, 14},
-				CV{3, 15}, CV{3, 16}, CV{3, 17}, CV{3, 18},
-				CV{3, 19}, CV{3, 20}, CV{3, 21}, CV{3, 22},
-				CV{3, 23}, CV{3, 24}, CV{3, 25}, CV{3, 26},
-				CV{3, 27}, CV{3, 28}, CV{3, 29}, CV{3, 30},
-				CV{3, 31}, CV{3, 32}, CV{4, 1}, CV{4, 2},
-				CV{4, 3}, CV{4, 4}, CV{4, 5}, CV{4, 6},
-				CV{4, 7}, CV{4, 8}, CV{4, 9}, CV{4, 10},
-				CV{4, 11}, CV{4, 12}, CV{4, 13}, CV{4, 14},
-				CV{4, 15}, CV{4, 16}, CV{4, 17}, CV{4, 18},
-				CV{4, 19}, CV{4, 20}, CV{4, 21}, CV{4, 22},
-				CV{4, 23}, CV{5, 1}, CV{5, 2}, CV{5, 3},
-				CV{5, 4}, CV{5, 5}, CV{5, 6}, CV{5, 7},
-				CV{5, 8}, CV{5, 9}, CV{5, 10}, CV{5, 11},
-				CV{5, 12}, CV{5, 13}, CV{5, 14}, CV{5, 15},
-				CV{5, 16}, CV{5, 17}, CV{5, 18}, CV{5, 19},
-				CV{6, 1}, CV{6, 2}, CV{6, 3}, CV{6, 4},
-				CV{6, 5}, CV{6, 6}, CV{6, 7}, CV{6, 8},
-				CV{6, 9}, CV{6, 10}, CV{6, 11}, CV{6, 12},
-				CV{6, 13}, CV{6, 14}, CV{6, 15}, CV{6, 16},
-				CV{6, 17}, CV{6, 18}, CV{6, 19}, CV{7, 1},
-				CV{7, 2}, CV{7, 3}, CV{7, 4}, CV{7, 5},
-				CV{7, 6}, CV{7, 7}, CV{7, 8}, CV{7, 9},
-				CV{7, 10}, CV{7, 11}, CV{7, 12}, CV{7, 13},
-				CV{7, 14}, CV{7, 15}, CV{7, 16}, CV{7, 17},
-				CV{7, 18}, CV{7, 19}, CV{7, 20}, CV{7, 21},
-				CV{7, 22}, CV{7, 23}, CV{7, 24}, CV{7, 25},
-				CV{7, 26}, CV{7, 27}, CV{7, 28}, CV{7, 29},
-				CV{7, 30}, CV{7, 31}, CV{7, 32}, CV{7, 33},
-				CV{7, 34}, CV{7, 35}, CV{7, 36}, CV{7, 37},
-				CV{7, 38}, CV{7, 39}, CV{7, 40}, CV{7, 41},
-				CV{7, 42}, CV{7, 43}, CV{7, 44}, CV{7, 45},
-				CV{7, 46}, CV{7, 47}, CV{7, 48}, CV{7, 49},
-				CV{7, 50}, CV{7, 51}, CV{7, 52}, CV{7, 53},
-				CV{7, 54}, CV{7, 55}, CV{7, 56}, CV{7, 57},
-				CV{7, 58}, CV{7, 59}, CV{7, 60}, CV{7, 61},
-				CV{7, 62}, CV{7, 63}, CV{7, 64}, CV{7, 65},
-				CV{7, 66}, CV{7, 67}, CV{7, 68}, CV{7, 69},
-				CV{7, 70}, CV{7, 71}, CV{7, 72}, CV{7, 73},
-				CV{8, 1}, CV{8, 2}, CV{8, 3}, CV{8, 4},
-				CV{8, 5}, CV{8, 6}, CV{8, 7}, CV{8, 8},
-				CV{8, 9}, CV{8, 10}, CV{8, 11}, CV{8, 12},
-				CV{8, 13}, CV{8, 14}, CV{8, 15}, CV{8, 16},
-				CV{8, 17}, CV{8, 18}, CV{9, 1}, CV{9, 2},
-				CV{9, 3}, CV{9, 4}, CV{9, 5}, CV{9, 6},
-				CV{9, 7}, CV{9, 8}, CV{9, 9}, CV{9, 10},
-				CV{9, 11}, CV{9, 12}, CV{9, 13}, CV{9, 14},
-				CV{9, 15}, CV{9, 16}, CV{9, 17}, CV{9, 18},
-				CV{9, 19}, CV{9, 20}, CV{9, 21}, CV{9, 22},
-				CV{9, 23}, CV{9, 24}, CV{9, 25}, CV{9, 26},
-				CV{9, 27}, CV{9, 28}, CV{9, 29}, CV{9, 30},
-				CV{9, 31}, CV{9, 32}, CV{9, 33}, CV{9, 34},
-				CV{9, 35}, CV{9, 36}, CV{9, 37}, CV{9, 38},
-				CV{10, 1}, CV{10, 2}, CV{10, 3}, CV{10, 4},
-				CV{10, 5}, CV{10, 6}, CV{10, 7}, CV{10, 8},
-				CV{10, 9}, CV{10, 10}, CV{10, 11}, CV{10, 12},
-				CV{10, 13}, CV{10, 14}, CV{10, 15}, CV{10, 16},
-				CV{10, 17}, CV{10, 18}, CV{10, 19}, CV{10, 20},
-				CV{10, 21}, CV{10, 22}, CV{10, 23}, CV{10, 24},
-				CV{10, 25}, CV{10, 26}, CV{10, 27}, CV{10, 28},
-				CV{10, 29}, CV{10, 30}, CV{10, 31}, CV{10, 32},
-				CV{10, 33}, CV{10, 34}, CV{10, 35}, CV{10, 36},
-				CV{10, 37}, CV{10, 38}, CV{10, 39}, CV{11, 1},
-				CV{11, 2}, CV{11, 3}, CV{11, 4}, CV{11, 5},
-				CV{11, 6}, CV{11, 7}, CV{11, 8}, CV{11, 9},
-				CV{11, 10}, CV{11, 11}, CV{11, 12}, CV{11, 13},
-				CV{11, 14}, CV{11, 15}, CV{11, 16}, CV{11, 17},
-				CV{11, 18}, CV{11, 19}, CV{11, 20}, CV{11, 21},
-				CV{11, 22}, CV{11, 23}, CV{11, 24}, CV{11, 25},
-				CV{11, 26}, CV{11, 27}, CV{11, 28}, CV{11, 29},
-				CV{11, 30}, CV{11, 31}, CV{11, 32}, CV{11, 33},
-				CV{11, 34}, CV{11, 35}, CV{11, 36}, CV{12, 1},
-				CV{12, 2}, CV{12, 3}, CV{12, 4}, CV{12, 5},
-				CV{12, 6}, CV{12, 7}, CV{12, 8}, CV{12, 9},
-				CV{12, 10}, CV{12, 11}, CV{12, 12}, CV{12, 13},
-				CV{12, 14}, CV{12, 15}, CV{12, 16}, CV{12, 17},
-				CV{12, 18}, CV{12, 19}, CV{12, 20}, CV{12, 21},
-				CV{12, 22}, CV{12, 23}, CV{12, 24}, CV{12, 25},
-				CV{12, 26}, CV{12, 27}, CV{12, 28}, CV{12, 29},
-				CV{12, 30}, CV{12, 31}, CV{12, 32}, CV{12, 33},
-				CV{12, 34}, CV{12, 35}, CV{12, 36}, CV{12, 37},
-				CV{12, 38}, CV{12, 39}, CV{12, 40}, CV{12, 41},
-				CV{12, 42}, CV{12, 43}, CV{12, 44}, CV{12, 45},
-				CV{12, 46}, CV{12, 47}, CV{13, 1}, CV{13, 2},
-				CV{13, 3}, CV{13, 4}, CV{13, 5}, CV{13, 6},
-				CV{13, 7}, CV{13, 8}, CV{13, 9}, CV{13, 10},
-				CV{13, 11}, CV{13, 12}, CV{13, 13}, CV{13, 14},
-				CV{13, 15}, CV{13, 16}, CV{13, 17}, CV{13, 18},
-				CV{13, 19}, CV{13, 20}, CV{13, 21}, CV{13, 22},
-				CV{13, 23}, CV{13, 24}, CV{13, 25}, CV{13, 26},
-				CV{13, 27}, CV{13, 28}, CV{13, 29}, CV{13, 30},
-				CV{13, 31},
+			Runs: []VerseRun{
+				{Chapter: 1, First: 1, Last: 11},
+				{Chapter: 2, First: 1, Last: 20},
+				{Chapter: 3, First: 1, Last: 32},
+				{Chapter: 4, First: 1, Last: 23},
+				{Chapter: 5, First: 1, Last: 19},
+				{Chapter: 6, First: 1, Last: 19},
+				{Chapter: 7, First: 1, Last: 73},
+				{Chapter: 8, First: 1, Last: 18},
+				{Chapter: 9, First: 1, Last: 38},
+				{Chapter: 10, First: 1, Last: 39},
+				{Chapter: 11, First: 1, Last: 36},
+				{Chapter: 12, First: 1, Last: 47},
+				{Chapter: 13, First: 1, Last: 31},
 			},
 		},
 		{
 			Name:      "Esther",
+			USFM:      "EST",
+			Testament: OldTestament,
 			JustVerse: false,
-			Verses: []Verse{CV{1, 1},
-				CV{1, 2}, CV{1, 3}, CV{1, 4}, CV{1, 5},
-				CV{1, 6}, CV{1, 7}, CV{1, 8}, CV{1, 9},
-				CV{1, 10}, CV{1, 11}, CV{1, 12}, CV{1, 13},
-				CV{1, 14}, CV{1, 15}, CV{1, 16}, CV{1, 17},
-				CV{1, 18}, CV{1, 19}, CV{1, 20}, CV{1, 21},
-				CV{1, 22}, CV{2, 1}, CV{2, 2}, CV{2, 3},
-				CV{2, 4}, CV{2, 5}, CV{2, 6}, CV{2, 7},
-				CV{2, 8}, CV{2, 9}, CV{2, 10}, CV{2, 11},
-				CV{2, 12}, CV{2, 13}, CV{2, 14}, CV{2, 15},
-				CV{2, 16}, CV{2, 17}, CV{2, 18}, CV{2, 19},
-				CV{2, 20}, CV{2, 21}, CV{2, 22}, CV{2, 23},
-				CV{3, 1}, CV{3, 2}, CV{3, 3}, CV{3, 4},
-				CV{3, 5}, CV{3, 6}, CV{3, 7}, CV{3, 8},
-				CV{3, 9}, CV{3, 10}, CV{3, 11}, CV{3, 12},
-				CV{3, 13}, CV{3, 14}, CV{3, 15}, CV{4, 1},
-				CV{4, 2}, CV{4, 3}, CV{4, 4}, CV{4, 5},
-				CV{4, 6}, CV{4, 7}, CV{4, 8}, CV{4, 9},
-				CV{4, 10}, CV{4, 11}, CV{4, 12}, CV{4, 13},
-				CV{4, 14}, CV{4, 15}, CV{4, 16}, CV{4, 17},
-				CV{5, 1}, CV{5, 2}, CV{5, 3}, CV{5, 4},
-				CV{5, 5}, CV{5, 6}, CV{5, 7}, CV{5, 8},
-				CV{5, 9}, CV{5, 10}, CV{5, 11}, CV{5, 12},
-				CV{5, 13}, CV{5, 14}, CV{6, 1}, CV{6, 2},
-				CV{6, 3}, CV{6, 4}, CV{6, 5}, CV{6, 6},
-				CV{6, 7}, CV{6, 8}, CV{6, 9}, CV{6, 10},
-				CV{6, 11}, CV{6, 12}, CV{6, 13}, CV{6, 14},
-				CV{7, 1}, CV{7, 2}, CV{7, 3}, CV{7, 4},
-				CV{7, 5}, CV{7, 6}, CV{7, 7}, CV{7, 8},
-				CV{7, 9}, CV{7, 10}, CV{8, 1}, CV{8, 2},
-				CV{8, 3}, CV{8, 4}, CV{8, 5}, CV{8, 6},
-				CV{8, 7}, CV{8, 8}, CV{8, 9}, CV{8, 10},
-				CV{8, 11}, CV{8, 12}, CV{8, 13}, CV{8, 14},
-				CV{8, 15}, CV{8, 16}, CV{8, 17}, CV{9, 1},
-				CV{9, 2}, CV{9, 3}, CV{9, 4}, CV{9, 5},
-				CV{9, 6}, CV{9, 7}, CV{9, 8}, CV{9, 9},
-				CV{9, 10}, CV{9, 11}, CV{9, 12}, CV{9, 13},
-				CV{9, 14}, CV{9, 15}, CV{9, 16}, CV{9, 17},
-				CV{9, 18}, CV{9, 19}, CV{9, 20}, CV{9, 21},
-				CV{9, 22}, CV{9, 23}, CV{9, 24}, CV{9, 25},
-				CV{9, 26}, CV{9, 27}, CV{9, 28}, CV{9, 29},
-				CV{9, 30}, CV{9, 31}, CV{9, 32}, CV{10, 1},
-				CV{10, 2}, CV{10, 3},
+			Runs: []VerseRun{
+				{Chapter: 1, First: 1, Last: 22},
+				{Chapter: 2, First: 1, Last: 23},
+				{Chapter: 3, First: 1, Last: 15},
+				{Chapter: 4, First: 1, Last: 17},
+				{Chapter: 5, First: 1, Last: 14},
+				{Chapter: 6, First: 1, Last: 14},
+				{Chapter: 7, First: 1, Last: 10},
+				{Chapter: 8, First: 1, Last: 17},
+				{Chapter: 9, First: 1, Last: 32},
+				{Chapter: 10, First: 1, Last: 3},
 			},
 		},
 		{
 			Name:      "Job",
+			USFM:      "JOB",
+			Testament: OldTestament,
 			JustVerse: false,
-			Verses: []Verse{CV{1, 1},
-				CV{1, 2}, CV{1, 3}, CV{1, 4}, CV{1, 5},
-				CV{1, 6}, CV{1, 7}, CV{1, 8}, CV{1, 9},
-				CV{1, 10}, CV{1, 11}, CV{1, 12}, CV{1, 13},
-				CV{1, 14}, CV{1, 15}, CV{1, 16}, CV{1, 17},
-				CV{1, 18}, CV{1, 19}, CV{1, 20}, CV{1, 21},
-				CV{1, 22}, CV{2, 1}, CV{2, 2}, CV{2, 3},
-				CV{2, 4}, CV{2, 5}, CV{2, 6}, CV{2, 7},
-				CV{2, 8}, CV{2, 9}, CV{2, 10}, CV{2, 11},
-				CV{2, 12}, CV{2, 13}, CV{3, 1}, CV{3, 2},
-				CV{3, 3}, CV{3, 4}, CV{3, 5}, CV{3, 6},
-				CV{3, 7}, CV{3, 8}, CV{3, 9}, CV{3, 10},
-				CV{3, 11}, CV{3, 12}, CV{3, 13}, CV{3, 14},
-				CV{3, 15}, CV{3, 16}, CV{3, 17}, CV{3, 18},
-				CV{3, 19}, CV{3, 20}, CV{3, 21}, CV{3, 22},
-				CV{3, 23}, CV{3, 24}, CV{3, 25}, CV{3, 26},
-				CV{4, 1}, CV{4, 2}, CV{4, 3}, CV{4, 4},
-				CV{4, 5}, CV{4, 6}, CV{4, 7}, CV{4, 8},
-				CV{4, 9}, CV{4, 10}, CV{4, 11}, CV{4, 12},
-				CV{4, 13}, CV{4, 14}, CV{4, 15}, CV{4, 16},
-				CV{4, 17}, CV{4, 18}, CV{4, 19}, CV{4, 20},
-				CV{4, 21}, CV{5, 1}, CV{5, 2}, CV{5, 3},
-				CV{5, 4}, CV{5, 5}, CV{5, 6}, CV{5, 7},
-				CV{5, 8}, CV{5, 9}, CV{5, 10}, CV{5, 11},
-				CV{5, 12}, CV{5, 13}, CV{5, 14}, CV{5, 15},
-				CV{5, 16}, CV{5, 17}, CV{5, 18}, CV{5, 19},
-				CV{5, 20}, CV{5, 21}, CV{5, 22}, CV{5, 23},
-				CV{5, 24}, CV{5, 25}, CV{5, 26}, CV{5, 27},
-				CV{6, 1}, CV{6, 2}, CV{6, 3}, CV{6, 4},
-				CV{6, 5}, CV{6, 6}, CV{6, 7}, CV{6, 8},
-				CV{6, 9}, CV{6, 10}, CV{6, 11}, CV{6, 12},
-				CV{6, 13}, CV{6, 14}, CV{6, 15}, CV{6, 16},
-				CV{6, 17}, CV{6, 18}, CV{6, 19}, CV{6, 20},
-				CV{6, 21}, CV{6, 22}, CV{6, 23}, CV{6, 24},
-				CV{6, 25}, CV{6, 26}, CV{6, 27}, CV{6, 28},
-				CV{6, 29}, CV{6, 30}, CV{7, 1}, CV{7, 2},
-				CV{7, 3}, CV{7, 4}, CV{7, 5}, CV{7, 6},
-				CV{7, 7}, CV{7, 8}, CV{7, 9}, CV{7, 10},
-				CV{7, 11}, CV{7, 12}, CV{7, 13}, CV{7, 14},
-				CV{7, 15}, CV{7, 16}, CV{7, 17}, CV{7, 18},
-				CV{7, 19}, CV{7, 20}, CV{7, 21}, CV{8, 1},
-				CV{8, 2}, CV{8, 3}, CV{8, 4}, CV{8, 5},
-				CV{8, 6}, CV{8, 7}, CV{8, 8}, CV{8, 9},
-				CV{8, 10}, CV{8, 11}, CV{8, 12}, CV{8, 13},
-				CV{8, 14}, CV{8, 15}, CV{8, 16}, CV{8, 17},
-				CV{8, 18}, CV{8, 19}, CV{8, 20}, CV{8, 21},
-				CV{8, 22}, CV{9, 1}, CV{9, 2}, CV{9, 3},
-				CV{9, 4}, CV{9, 5}, CV{9, 6}, CV{9, 7},
-				CV{9, 8}, CV{9, 9}, CV{9, 10}, CV{9, 11},
-				CV{9, 12}, CV{9, 13}, CV{9, 14}, CV{9, 15},
-				CV{9, 16}, CV{9, 17}, CV{9, 18}, CV{9, 19},
-				CV{9, 20}, CV{9, 21}, CV{9, 22}, CV{9, 23},
-				CV{9, 24}, CV{9, 25}, CV{9, 26}, CV{9, 27},
-				CV{9, 28}, CV{9, 29}, CV{9, 30}, CV{9, 31},
-				CV{9, 32}, CV{9, 33}, CV{9, 34}, CV{9, 35},
-				CV{10, 1}, CV{10, 2}, CV{10, 3}, CV{10, 4},
-				CV{10, 5}, CV{10, 6}, CV{10, 7}, CV{10, 8},
-				CV{10, 9}, CV{10, 10}, CV{10, 11}, CV{10, 12},
-				CV{10, 13}, CV{10, 14}, CV{10, 15}, CV{10, 16},
-				CV{10, 17}, CV{10, 18}, CV{10, 19}, CV{10, 20},
-				CV{10, 21}, CV{10, 22}, CV{11, 1}, CV{11, 2},
-				CV{11, 3}, CV{11, 4}, CV{11, 5}, CV{11, 6},
-				CV{11, 7}, CV{11, 8}, CV{11, 9}, CV{11, 10},
-				CV{11, 11}, CV{11, 12}, CV{11, 13}, CV{11, 14},
-				CV{11, 15}, CV{11, 16}, CV{11, 17}, CV{11, 18},
-				CV{11, 19}, CV{11, 20}, CV{12, 1}, CV{12, 2},
-				CV{12, 3}, CV{12, 4}, CV{12, 5}, CV{12, 6},
-				CV{12, 7}, CV{12, 8}, CV{12, 9}, CV{12, 10},
-				CV{12, 11}, CV{12, 12}, CV{12, 13}, CV{12, 14},
-				CV{12, 15}, CV{12, 16}, CV{12, 17}, CV{12, 18},
-				CV{12, 19}, CV{12, 20}, CV{12, 21}, CV{12, 22},
-				CV{12, 23}, CV{12, 24}, CV{12, 25}, CV{13, 1},
-				CV{13, 2}, CV{13, 3}, CV{13, 4}, CV{13, 5},
-				CV{13, 6}, CV{13, 7}, CV{13, 8}, CV{13, 9},
-				CV{13, 10}, CV{13, 11}, CV{13, 12}, CV{13, 13},
-				CV{13, 14}, CV{13, 15}, CV{13, 16}, CV{13, 17},
-				CV{13, 18}, CV{13, 19}, CV{13, 20}, CV{13, 21},
-				CV{13, 22}, CV{13, 23}, CV{13, 24}, CV{13, 25},
-				CV{13, 26}, CV{13, 27}, CV{13, 28}, CV{14, 1},
-				CV{14, 2}, CV{14, 3}, CV{14, 4}, CV{14, 5},
-				CV{14, 6}, CV{14, 7}, CV{14, 8}, CV{14, 9},
-				CV{14, 10}, CV{14, 11}, CV{14, 12}, CV{14, 13},
-				CV{14, 14}, CV{14, 15}, CV{14, 16}, CV{14, 17},
-				CV{14, 18}, CV{14, 19}, CV{14, 20}, CV{14, 21},
-				CV{14, 22}, CV{15, 1}, CV{15, 2}, CV{15, 3},
-				CV{15, 4}, CV{15, 5}, CV{15, 6}, CV{15, 7},
-				CV{15, 8}, CV{15, 9}, CV{15, 10}, CV{15, 11},
-				CV{15, 12}, CV{15, 13}, CV{15, 14}, CV{15, 15},
-				CV{15, 16}, CV{15, 17}, CV{15, 18}, CV{15, 19},
-				CV{15, 20}, CV{15, 21}, CV{15, 22}, CV{15, 23},
-				CV{15, 24}, CV{15, 25}, CV{15, 26}, CV{15, 27},
-				CV{15, 28}, CV{15, 29}, CV{15, 30}, CV{15, 31},
-				CV{15, 32}, CV{15, 33}, CV{15, 34}, CV{15, 35},
-				CV{16, 1}, CV{16, 2}, CV{16, 3}, CV{16, 4},
-				CV{16, 5}, CV{16, 6}, CV{16, 7}, CV{16, 8},
-				CV{16, 9}, CV{16, 10}, CV{16, 11}, CV{16, 12},
-				CV{16, 13}, CV{16, 14}, CV{16, 15}, CV{16, 16},
-				CV{16, 17}, CV{16, 18}, CV{16, 19}, CV{16, 20},
-				CV{16, 21}, CV{16, 22}, CV{17, 1}, CV{17, 2},
-				CV{17, 3}, CV{17, 4}, CV{17, 5}, CV{17, 6},
-				CV{17, 7}, CV{17, 8}, CV{17, 9}, CV{17, 10},
-				CV{17, 11}, CV{17, 12}, CV{17, 13}, CV{17, 14},
-				CV{17, 15}, CV{17, 16}, CV{18, 1}, CV{18, 2},
-				CV{18, 3}, CV{18, 4}, CV{18, 5}, CV{18, 6},
-				CV{18, 7}, CV{18, 8}, CV{18, 9}, CV{18, 10},
-				CV{18, 11}, CV{18, 12}, CV{18, 13}, CV{18, 14},
-				CV{18, 15}, CV{18, 16}, CV{18, 17}, CV{18, 18},
-				CV{18, 19}, CV{18, 20}, CV{18, 21}, CV{19, 1},
-				CV{19, 2}, CV{19, 3}, CV{19, 4}, CV{19, 5},
-				CV{19, 6}, CV{19, 7}, CV{19, 8}, CV{19, 9},
-				CV{19, 10}, CV{19, 11}, CV{19, 12}, CV{19, 13},
-				CV{19, 14}, CV{19, 15}, CV{19, 16}, CV{19, 17},
-				CV{19, 18}, CV{19, 19}, CV{19, 20}, CV{19, 21},
-				CV{19, 22}, CV{19, 23}, CV{19, 24}, CV{19, 25},
-				CV{19, 26}, CV{19, 27}, CV{19, 28}, CV{19, 29},
-				CV{20, 1}, CV{20, 2}, CV{20, 3}, CV{20, 4},
-				CV{20, 5}, CV{20, 6}, CV{20, 7}, CV{20, 8},
-				CV{20, 9}, CV{20, 10}, CV{20, 11}, CV{20, 12},
-				CV{20, 13}, CV{20, 14}, CV{20, 15}, CV{20, 16},
-				CV{20, 17}, CV{20, 18}, CV{20, 19}, CV{20, 20},
-				CV{20, 21}, CV{20, 22}, CV{20, 23}, CV{20, 24},
-				CV{20, 25}, CV{20, 26}, CV{20, 27}, CV{20, 28},
-				CV{20, 29}, CV{21, 1}, CV{21, 2}, CV{21, 3},
-				CV{21, 4}, CV{21, 5}, CV{21, 6}, CV{21, 7},
-				CV{21, 8}, CV{21, 9}, CV{21, 10}, CV{21, 11},
-				CV{21, 12}, CV{21, 13}, CV{21, 14}, CV{21, 15},
-				CV{21, 16}, CV{21, 17}, CV{21, 18}, CV{21, 19},
-				CV{21, 20}, CV{21, 21}, CV{21, 22}, CV{21, 23},
-				CV{21, 24}, CV{21, 25}, CV{21, 26}, CV{21, 27},
-				CV{21, 28}, CV{21, 29}, CV{21, 30}, CV{21, 31},
-				CV{21, 32}, CV{21, 33}, CV{21, 34}, CV{22, 1},
-				CV{22, 2}, CV{22, 3}, CV{22, 4}, CV{22, 5},
-				CV{22, 6}, CV{22, 7}, CV{22, 8}, CV{22, 9},
-				CV{22, 10}, CV{22, 11}, CV{22, 12}, CV{22, 13},
-				CV{22, 14}, CV{22, 15}, CV{22, 16}, CV{22, 17},
-				CV{22, 18}, CV{22, 19}, CV{22, 20}, CV{22, 21},
-				CV{22, 22}, CV{22, 23}, CV{22, 24}, CV{22, 25},
-				CV{22, 26}, CV{22, 27}, CV{22, 28}, CV{22, 29},
-				CV{22, 30}, CV{23, 1}, CV{23, 2}, CV{23, 3},
-				CV{23, 4}, CV{23, 5}, CV{23, 6}, CV{23, 7},
-				CV{23, 8}, CV{23, 9}, CV{23, 10}, CV{23, 11},
-				CV{23, 12}, CV{23, 13}, CV{23, 14}, CV{23, 15},
-				CV{23, 16}, CV{23, 17}, CV{24, 1}, CV{24, 2},
-				CV{24, 3}, CV{24, 4}, CV{24, 5}, CV{24, 6},
-				CV{24, 7}, CV{24, 8}, CV{24, 9}, CV{24, 10},
-				CV{24, 11}, CV{24, 12}, CV{24, 13}, CV{24, 14},
-				CV{24, 15}, CV{24, 16}, CV{24, 17}, CV{24, 18},
-				CV{24, 19}, CV{24, 20}, CV{24, 21}, CV{24, 22},
-				CV{24, 23}, CV{24, 24}, CV{24, 25}, CV{25, 1},
-				CV{25, 2}, CV{25, 3}, CV{25, 4}, CV{25, 5},
-				CV{25, 6}, CV{26, 1}, CV{26, 2}, CV{26, 3},
-				CV{26, 4}, CV{26, 5}, CV{26, 6}, CV{26, 7},
-				CV{26, 8}, CV{26, 9}, CV{26, 10}, CV{26, 11},
-				CV{26, 12}, CV{26, 13}, CV{26, 14}, CV{27, 1},
-				CV{27, 2}, CV{27, 3}, CV{27, 4}, CV{27, 5},
-				CV{27, 6}, CV{27, 7}, CV{27, 8}, CV{27, 9},
-				CV{27, 10}, CV{27, 11}, CV{27, 12}, CV{27, 13},
-				CV{27, 14}, CV{27, 15}, CV{27, 16}, CV{27, 17},
-				CV{27, 18}, CV{27, 19}, CV{27, 20}, CV{27, 21},
-				CV{27, 22}, CV{27, 23}, CV{28, 1}, CV{28, 2},
-				CV{28, 3}, CV{28, 4}, CV{28, 5}, CV{28, 6},
-				CV{28, 7}, CV{28, 8}, CV{28, 9}, CV{28, 10},
-				CV{28, 11}, CV{28, 12}, CV{28, 13}, CV{28, 14},
-				CV{28, 15}, CV{28, 16}, CV{28, 17}, CV{28, 18},
-				CV{28, 19}, CV{28, 20}, CV{28, 21}, CV{28, 22},
-				CV{28, 23}, CV{28, 24}, CV{28, 25}, CV{28, 26},
-				CV{28, 27}, CV{28, 28}, CV{29, 1}, CV{29, 2},
-				CV{29, 3}, CV{29, 4}, CV{29, 5}, CV{29, 6},
-				CV{29, 7}, CV{29, 8}, CV{29, 9}, CV{29, 10},
-				CV{29, 11}, CV{29, 12}, CV{29, 13}, CV{29, 14},
-				CV{29, 15}, CV{29, 16}, CV{29, 17}, CV{29, 18},
-				CV{29, 19}, CV{29, 20}, CV{29, 21}, CV{29, 22},
-				CV{29, 23}, CV{29, 24}, CV{29, 25}, CV{30, 1},
-				CV{30, 2}, CV{30, 3}, CV{30, 4}, CV{30, 5},
-				CV{30, 6}, CV{30, 7}, CV{30, 8}, CV{30, 9},
-				CV{30, 10}, CV{30, 11}, CV{30, 12}, CV{30, 13},
-				CV{30, 14}, CV{30, 15}, CV{30, 16}, CV{30, 17},
-				CV{30, 18}, CV{30, 19}, CV{30, 20}, CV{30, 21},
-				CV{30, 22}, CV{30, 23}, CV{30, 24}, CV{30, 25},
-				CV{30, 26}, CV{30, 27}, CV{30, 28}, CV{30, 29},
-				CV{30, 30}, CV{30, 31}, CV{31, 1}, CV{31, 2},
-				CV{31, 3}, CV{31, 4}, CV{31, 5}, CV{31, 6},
-				CV{31, 7}, CV{31, 8}, CV{31, 9}, CV{31, 10},
-				CV{31, 11}, CV{31, 12}, CV{31, 13}, CV{31, 14},
-				CV{31, 15}, CV{31, 16}, CV{31, 17}, CV{31, 18},
-				CV{31, 19}, CV{31, 20}, CV{31, 21}, CV{31, 22},
-				CV{31, 23}, CV{31, 24}, CV{31, 25}, CV{31, 26},
-				CV{31, 27}, CV{31, 28}, CV{31, 29}, CV{31, 30},
-				CV{31, 31}, CV{31, 32}, CV{31, 33}, CV{31, 34},
-				CV{31, 35}, CV{31, 36}, CV{31, 37}, CV{31, 38},
-				CV{31, 39}, CV{31, 40}, CV{32, 1}, CV{32, 2},
-				CV{32, 3}, CV{32, 4}, CV{32, 5}, CV{32, 6},
-				CV{32, 7}, CV{32, 8}, CV{32, 9}, CV{32, 10},
-				CV{32, 11}, CV{32, 12}, CV{32, 13}, CV{32, 14},
-				CV{32, 15}, CV{32, 16}, CV{32, 17}, CV{32, 18},
-				CV{32, 19}, CV{32, 20}, CV{32, 21}, CV{32, 22},
-				CV{33, 1}, CV{33, 2}, CV{33, 3}, CV{33, 4},
-				CV{33, 5}, CV{33, 6}, CV{33, 7}, CV{33, 8},
-				CV{33, 9}, CV{33, 10}, CV{33, 11}, CV{33, 12},
-				CV{33, 13}, CV{33, 14}, CV{33, 15}, CV{33, 16},
-				CV{33, 17}, CV{33, 18}, CV{33, 19}, CV{33, 20},
-				CV{33, 21}, CV{33, 22}, CV{33, 23}, CV{33, 24},
-				CV{33, 25}, CV{33, 26}, CV{33, 27}, CV{33, 28},
-				CV{33, 29}, CV{33, 30}, CV{33, 31}, CV{33, 32},
-				CV{33, 33}, CV{34, 1}, CV{34, 2}, CV{34, 3},
-				CV{34, 4}, CV{34, 5}, CV{34, 6}, CV{34, 7},
-				CV{34, 8}, CV{34, 9}, CV{34, 10}, CV{34, 11},
-				CV{34, 12}, CV{34, 13}, CV{34, 14}, CV{34, 15},
-				CV{34, 16}, CV{34, 17}, CV{34, 18}, CV{34, 19},
-				CV{34, 20}, CV{34, 21}, CV{34, 22}, CV{34, 23},
-				CV{34, 24}, CV{34, 25}, CV{34, 26}, CV{34, 27},
-				CV{34, 28}, CV{34, 29}, CV{34, 30}, CV{34, 31},
-				CV{34, 32}, CV{34, 33}, CV{34, 34}, CV{34, 35},
-				CV{34, 36}, CV{34, 37}, CV{35, 1}, CV{35, 2},
-				CV{35, 3}, CV{35, 4}, CV{35, 5}, CV{35, 6},
-				CV{35, 7}, CV{35, 8}, CV{35, 9}, CV{35, 10},
-				CV{35, 11}, CV{35, 12}, CV{35, 13}, CV{35, 14},
-				CV{35, 15}, CV{35, 16}, CV{36, 1}, CV{36, 2},
-				CV{36, 3}, CV{36, 4}, CV{36, 5}, CV{36, 6},
-				CV{36, 7}, CV{36, 8}, CV{36, 9}, CV{36, 10},
-				CV{36, 11}, CV{36, 12}, CV{36, 13}, CV{36, 14},
-				CV{36, 15}, CV{36, 16}, CV{36, 17}, CV{36, 18},
-				CV{36, 19}, CV{36, 20}, CV{36, 21}, CV{36, 22},
-				CV{36, 23}, CV{36, 24}, CV{36, 25}, CV{36, 26},
-				CV{36, 27}, CV{36, 28}, CV{36, 29}, CV{36, 30},
-				CV{36, 31}, CV{36, 32}, CV{36, 33}, CV{37, 1},
-				CV{37, 2}, CV{37, 3}, CV{37, 4}, CV{37, 5},
-				CV{37, 6}, CV{37, 7}, CV{37, 8}, CV{37, 9},
-				CV{37, 10}, CV{37, 11}, CV{37, 12}, CV{37, 13},
-				CV{37, 14}, CV{37, 15}, CV{37, 16}, CV{37, 17},
-				CV{37, 18}, CV{37, 19}, CV{37, 20}, CV{37, 21},
-				CV{37, 22}, CV{37, 23}, CV{37, 24}, CV{38, 1},
-				CV{38, 2}, CV{38, 3}, CV{38, 4}, CV{38, 5},
-				CV{38, 6}, CV{38, 7}, CV{38, 8}, CV{38, 9},
-				CV{38, 10}, CV{38, 11}, CV{38, 12}, CV{38, 13},
-				CV{38, 14}, CV{38, 15}, CV{38, 16}, CV{38, 17},
-				CV{38, 18}, CV{38, 19}, CV{38, 20}, CV{38, 21},
-				CV{38, 22}, CV{38, 23}, CV{38, 24}, CV{38, 25},
-				CV{38, 26}, CV{38, 27}, CV{38, 28}, CV{38, 29},
-				CV{38, 30}, CV{38, 31}, CV{38, 32}, CV{38, 33},
-				CV{38, 34}, CV{38, 35}, CV{38, 36}, CV{38, 37},
-				CV{38, 38}, CV{38, 39}, CV{38, 40}, CV{38, 41},
-				CV{39, 1}, CV{39, 2}, CV{39, 3}, CV{39, 4},
-				CV{39, 5}, CV{39, 6}, CV{39, 7}, CV{39, 8},
-				CV{39, 9}, CV{39, 10}, CV{39, 11}, CV{39, 12},
-				CV{39, 13}, CV{39, 14}, CV{39, 15}, CV{39, 16},
-				CV{39, 17}, CV{39, 18}, CV{39, 19}, CV{39, 20},
-				CV{39, 21}, CV{39, 22}, CV{39, 23}, CV{39, 24},
-				CV{39, 25}, CV{39, 26}, CV{39, 27}, CV{39, 28},
-				CV{39, 29}, CV{39, 30}, CV{40, 1}, CV{40, 2},
-				CV{40, 3}, CV{40, 4}, CV{40, 5}, CV{40, 6},
-				CV{40, 7}, CV{40, 8}, CV{40, 9}, CV{40, 10},
-				CV{40, 11}, CV{40, 12}, CV{40, 13}, CV{40, 14},
-				CV{40, 15}, CV{40, 16}, CV{40, 17}, CV{40, 18},
-				CV{40, 19}, CV{40, 20}, CV{40, 21}, CV{40, 22},
-				CV{40, 23}, CV{40, 24}, CV{41, 1}, CV{41, 2},
-				CV{41, 3}, CV{41, 4}, CV{41, 5}, CV{41, 6},
-				CV{41, 7}, CV{41, 8}, CV{41, 9}, CV{41, 10},
-				CV{41, 11}, CV{41, 12}, CV{41, 13}, CV{41, 14},
-				CV{41, 15}, CV{41, 16}, CV{41, 17}, CV{41, 18},
-				CV{41, 19}, CV{41, 20}, CV{41, 21}, CV{41, 22},
-				CV{41, 23}, CV{41, 24}, CV{41, 25}, CV{41, 26},
-				CV{41, 27}, CV{41, 28}, CV{41, 29}, CV{41, 30},
-				CV{41, 31}, CV{41, 32}, CV{41, 33}, CV{41, 34},
-				CV{42, 1}, CV{42, 2}, CV{42, 3}, CV{42, 4},
-				CV{42, 5}, CV{42, 6}, CV{42, 7}, CV{42, 8},
-				CV{42, 9}, CV{42, 10}, CV{42, 11}, CV{42, 12},
-				CV{42, 13}, CV{42, 14}, CV{42, 15}, CV{42, 16},
-				CV{42, 17},
+			Runs: []VerseRun{
+				{Chapter: 1, First: 1, Last: 22},
+				{Chapter: 2, First: 1, Last: 13},
+				{Chapter: 3, First: 1, Last: 26},
+				{Chapter: 4, First: 1, Last: 21},
+				{Chapter: 5, First: 1, Last: 27},
+				{Chapter: 6, First: 1, Last: 30},
+				{Chapter: 7, First: 1, Last: 21},
+				{Chapter: 8, First: 1, Last: 22},
+				{Chapter: 9, First: 1, Last: 35},
+				{Chapter: 10, First: 1, Last: 22},
+				{Chapter: 11, First: 1, Last: 20},
+				{Chapter: 12, First: 1, Last: 25},
+				{Chapter: 13, First: 1, Last: 28},
+				{Chapter: 14, First: 1, Last: 22},
+				{Chapter: 15, First: 1, Last: 35},
+				{Chapter: 16, First: 1, Last: 22},
+				{Chapter: 17, First: 1, Last: 16},
+				{Chapter: 18, First: 1, Last: 21},
+				{Chapter: 19, First: 1, Last: 29},
+				{Chapter: 20, First: 1, Last: 29},
+				{Chapter: 21, First: 1, Last: 34},
+				{Chapter: 22, First: 1, Last: 30},
+				{Chapter: 23, First: 1, Last: 17},
+				{Chapter: 24, First: 1, Last: 25},
+				{Chapter: 25, First: 1, Last: 6},
+				{Chapter: 26, First: 1, Last: 14},
+				{Chapter: 27, First: 1, Last: 23},
+				{Chapter: 28, First: 1, Last: 28},
+				{Chapter: 29, First: 1, Last: 25},
+				{Chapter: 30, First: 1, Last: 31},
+				{Chapter: 31, First: 1, Last: 40},
+				{Chapter: 32, First: 1, Last: 22},
+				{Chapter: 33, First: 1, Last: 33},
+				{Chapter: 34, First: 1, Last: 37},
+				{Chapter: 35, First: 1, Last: 16},
+				{Chapter: 36, First: 1, Last: 33},
+				{Chapter: 37, First: 1, Last: 24},
+				{Chapter: 38, First: 1, Last: 41},
+				{Chapter: 39, First: 1, Last: 30},
+				{Chapter: 40, First: 1, Last: 24},
+				{Chapter: 41, First: 1, Last: 34},
+				{Chapter: 42, First: 1, Last: 17},
 			},
 		},
 		{
 			Name:      "Psalms",
+			USFM:      "PSA",
+			Testament: OldTestament,
 			JustVerse: false,
-			Verses: []Verse{CV{1, 1},
-				CV{1, 2}, CV{1, 3}, CV{1, 4}, CV{1, 5},
-				CV{1, 6}, CV{2, 1}, CV{2, 2}, CV{2, 3},
-				CV{2, 4}, CV{2, 5}, CV{2, 6}, CV{2, 7},
-				CV{2, 8}, CV{2, 9}, CV{2, 10}, CV{2, 11},
-				CV{2, 12}, CV{3, 1}, CV{3, 2}, CV{3, 3},
-				CV{3, 4}, CV{3, 5}, CV{3, 6}, CV{3, 7},
-				CV{3, 8}, CV{4, 1}, CV{4, 2}, CV{4, 3},
-				CV{4, 4}, CV{4, 5}, CV{4, 6}, CV{4, 7},
-				CV{4, 8}, CV{5, 1}, CV{5, 2}, CV{5, 3},
-				CV{5, 4}, CV{5, 5}, CV{5, 6}, CV{5, 7},
-				CV{5, 8}, CV{5, 9}, CV{5, 10}, CV{5, 11},
-				CV{5, 12}, CV{6, 1}, CV{6, 2}, CV{6, 3},
-				CV{6, 4}, CV{6, 5}, CV{6, 6}, CV{6, 7},
-				CV{6, 8}, CV{6, 9}, CV{6, 10}, CV{7, 1},
-				CV{7, 2}, CV{7, 3}, CV{7, 4}, CV{7, 5},
-				CV{7, 6}, CV{7, 7}, CV{7, 8}, CV{7, 9},
-				CV{7, 10}, CV{7, 11}, CV{7, 12}, CV{7, 13},
-				CV{7, 14}, CV{7, 15}, CV{7, 16}, CV{7, 17},
-				CV{8, 1}, CV{8, 2}, CV{8, 3}, CV{8, 4},
-				CV{8, 5}, CV{8, 6}, CV{8, 7}, CV{8, 8},
-				CV{8, 9}, CV{9, 1}, CV{9, 2}, CV{9, 3},
-				CV{9, 4}, CV{9, 5}, CV{9, 6}, CV{9, 7},
-				CV{9, 8}, CV{9, 9}, CV{9, 10}, CV{9, 11},
-				CV{9, 12}, CV{9, 13}, CV{9, 14}, CV{9, 15},
-				CV{9, 16}, CV{9, 17}, CV{9, 18}, CV{9, 19},
-				CV{9, 20}, CV{10, 1}, CV{10, 2}, CV{10, 3},
-				CV{10, 4}, CV{10, 5}, CV{10, 6}, CV{10, 7},
-				CV{10, 8}, CV{10, 9}, CV{10, 10}, CV{10, 11},
-				CV{10, 12}, CV{10, 13}, CV{10, 14}, CV{10, 15},
-				CV{10, 16}, CV{10, 17}, CV{10, 18}, CV{11, 1},
-				CV{11, 2}, CV{11, 3}, CV{11, 4}, CV{11, 5},
-				CV{11, 6}, CV{11, 7}, CV{12, 1}, CV{12, 2},
-				CV{12, 3}, CV{12, 4}, CV{12, 5}, CV{12, 6},
-				CV{12, 7}, CV{12, 8}, CV{13, 1}, CV{13, 2},
-				CV{13, 3}, CV{13, 4}, CV{13, 5}, CV{13, 6},
-				CV{14, 1}, CV{14, 2}, CV{14, 3}, CV{14, 4},
-				CV{14, 5}, CV{14, 6}, CV{14, 7}, CV{15, 1},
-				CV{15, 2}, CV{15, 3}, CV{15, 4}, CV{15, 5},
-				CV{16, 1}, CV{16, 2}, CV{16, 3}, CV{16, 4},
-				CV{16, 5}, CV{16, 6}, CV{16, 7}, CV{16, 8},
-				CV{16, 9}, CV{16, 10}, CV{16, 11}, CV{17, 1},
-				CV{17, 2}, CV{17, 3}, CV{17, 4}, CV{17, 5},
-				CV{17, 6}, CV{17, 7}, CV{17, 8}, CV{17, 9},
-				CV{17, 10}, CV{17, 11}, CV{17, 12}, CV{17, 13},
-				CV{17, 14}, CV{17, 15}, CV{18, 1}, CV{18, 2},
-				CV{18, 3}, CV{18, 4}, CV{18, 5}, CV{18, 6},
-				CV{18, 7}, CV{18, 8}, CV{18, 9}, CV{18, 10},
-				CV{18, 11}, CV{18, 12}, CV{18, 13}, CV{18, 14},
-				CV{18, 15}, CV{18, 16}, CV{18, 17}, CV{18, 18},
-				CV{18, 19}, CV{18, 20}, CV{18, 21}, CV{18, 22},
-				CV{18, 23}, CV{18, 24}, CV{18, 25}, CV{18, 26},
-				CV{18, 27}, CV{18, 28}, CV{18, 29}, CV{18, 30},
-				CV{18, 31}, CV{18, 32}, CV{18, 33}, CV{18, 34},
-				CV{18, 35}, CV{18, 36}, CV{18, 37}, CV{18, 38},
-				CV{18, 39}, CV{18, 40}, CV{18, 41}, CV{18, 42},
-				CV{18, 43}, CV{18, 44}, CV{18, 45}, CV{18, 46},
-				CV{18, 47}, CV{18, 48}, CV{18, 49}, CV{18, 50},
-				CV{19, 1}, CV{19, 2}, CV{19, 3}, CV{19, 4},
-				CV{19, 5}, CV{19, 6}, CV{19, 7}, CV{19, 8},
-				CV{19, 9}, CV{19, 10}, CV{19, 11}, CV{19, 12},
-				CV{19, 13}, CV{19, 14}, CV{20, 1}, CV{20, 2},
-				CV{20, 3}, CV{20, 4}, CV{20, 5}, CV{20, 6},
-				CV{20, 7}, CV{20, 8}, CV{20, 9}, CV{21, 1},
-				CV{21, 2}, CV{21, 3}, CV{21, 4}, CV{21, 5},
-				CV{21, 6}, CV{21, 7}, CV{21, 8}, CV{21, 9},
-				CV{21, 10}, CV{21, 11}, CV{21, 12}, CV{21, 13},
-				CV{22, 1}, CV{22, 2}, CV{22, 3}, CV{22, 4},
-				CV{22, 5}, CV{22, 6}, CV{22, 7}, CV{22, 8},
-				CV{22, 9}, CV{22, 10}, CV{22, 11}, CV{22, 12},
-				CV{22, 13}, CV{22, 14}, CV{22, 15}, CV{22, 16},
-				CV{22, 17}, CV{22, 18}, CV{22, 19}, CV{22, 20},
-				CV{22, 21}, CV{22, 22}, CV{22, 23}, CV{22, 24},
-				CV{22, 25}, CV{22, 26}, CV{22, 27}, CV{22, 28},
-				CV{22, 29}, CV{22, 30}, CV{22, 31}, CV{23, 1},
-				CV{23, 2}, CV{23, 3}, CV{23, 4}, CV{23, 5},
-				CV{23, 6}, CV{24, 1}, CV{24, 2}, CV{24, 3},
-				CV{24, 4}, CV{24, 5}, CV{24, 6}, CV{24, 7},
-				CV{24, 8}, CV{24, 9}, CV{24, 10}, CV{25, 1},
-				CV{25, 2}, CV{25, 3}, CV{25, 4}, CV{25, 5},
-				CV{25, 6}, CV{25, 7}, CV{25, 8}, CV{25, 9},
-				CV{25, 10}, CV{25, 11}, CV{25, 12}, CV{25, 13},
-				CV{25, 14}, CV{25, 15}, CV{25, 16}, CV{25, 17},
-				CV{25, 18}, CV{25, 19}, CV{25, 20}, CV{25, 21},
-				CV{25, 22}, CV{26, 1}, CV{26, 2}, CV{26, 3},
-				CV{26, 4}, CV{26, 5}, CV{26, 6}, CV{26, 7},
-				CV{26, 8}, CV{26, 9}, CV{26, 10}, CV{26, 11},
-				CV{26, 12}, CV{27, 1}, CV{27, 2}, CV{27, 3},
-				CV{27, 4}, CV{27, 5}, CV{27, 6}, CV{27, 7},
-				CV{27, 8}, CV{27, 9}, CV{27, 10}, CV{27, 11},
-				CV{27, 12}, CV{27, 13}, CV{27, 14}, CV{28, 1},
-				CV{28, 2}, CV{28, 3}, CV{28, 4}, CV{28, 5},
-				CV{28, 6}, CV{28, 7}, CV{28, 8}, CV{28, 9},
-				CV{29, 1}, CV{29, 2}, CV{29, 3}, CV{29, 4},
-				CV{29, 5}, CV{29, 6}, CV{29, 7}, CV{29, 8},
-				CV{29, 9}, CV{29, 10}, CV{29, 11}, CV{30, 1},
-				CV{30, 2}, CV{30, 3}, CV{30, 4}, CV{30, 5},
-				CV{30, 6}, CV{30, 7}, CV{30, 8}, CV{30, 9},
-				CV{30, 10}, CV{30, 11}, CV{30, 12}, CV{31, 1},
-				CV{31, 2}, CV{31, 3}, CV{31, 4}, CV{31, 5},
-				CV{31, 6}, CV{31, 7}, CV{31, 8}, CV{31, 9},
-				CV{31, 10}, CV{31, 11}, CV{31, 12}, CV{31, 13},
-				CV{31, 14}, CV{31, 15}, CV{31, 16}, CV{31, 17},
-				CV{31, 18}, CV{31, 19}, CV{31, 20}, CV{31, 21},
-				CV{31, 22}, CV{31, 23}, CV{31, 24}, CV{32, 1},
-				CV{32, 2}, CV{32, 3}, CV{32, 4}, CV{32, 5},
-				CV{32, 6}, CV{32, 7}, CV{32, 8}, CV{32, 9},
-				CV{32, 10}, CV{32, 11}, CV{33, 1}, CV{33, 2},
-				CV{33, 3}, CV{33, 4}, CV{33, 5}, CV{33, 6},
-				CV{33, 7}, CV{33, 8}, CV{33, 9}, CV{33, 10},
-				CV{33, 11}, CV{33, 12}, CV{33, 13}, CV{33, 14},
-				CV{33, 15}, CV{33, 16}, CV{33, 17}, CV{33, 18},
-				CV{33, 19}, CV{33, 20}, CV{33, 21}, CV{33, 22},
-				CV{34, 1}, CV{34, 2}, CV{34, 3}, CV{34, 4},
-				CV{34, 5}, CV{34, 6}, CV{34, 7}, CV{34, 8},
-				CV{34, 9}, CV{34, 10}, CV{34, 11}, CV{34, 12},
-				CV{34, 13}, CV{34, 14}, CV{34, 15}, CV{34, 16},
-				CV{34, 17}, CV{34, 18}, CV{34, 19}, CV{34, 20},
-				CV{34, 21}, CV{34, 22}, CV{35, 1}, CV{35, 2},
-				CV{35, 3}, CV{35, 4}, CV{35, 5}, CV{35, 6},
-				CV{35, 7}, CV{35, 8}, CV{35, 9}, CV{35, 10},
-				CV{35, 11}, CV{35, 12}, CV{35, 13}, CV{35, 14},
-				CV{35, 15}, CV{35, 16}, CV{35, 17}, CV{35, 18},
-				CV{35, 19}, CV{35, 20}, CV{35, 21}, CV{35, 22},
-				CV{35, 23}, CV{35, 24}, CV{35, 25}, CV{35, 26},
-				CV{35, 27}, CV{35, 28}, CV{36, 1}, CV{36, 2},
-				CV{36, 3}, CV{36, 4}, CV{36, 5}, CV{36, 6},
-				CV{36, 7}, CV{36, 8}, CV{36, 9}, CV{36, 10},
-				CV{36, 11}, CV{36, 12}, CV{37, 1}, CV{37, 2},
-				CV{37, 3}, CV{37, 4}, CV{37, 5}, CV{37, 6},
-				CV{37, 7}, CV{37, 8}, CV{37, 9}, CV{37, 10},
-				CV{37, 11}, CV{37, 12}, CV{37, 13}, CV{37, 14},
-				CV{37, 15}, CV{37, 16}, CV{37, 17}, CV{37, 18},
-				CV{37, 19}, CV{37, 20}, CV{37, 21}, CV{37, 22},
-				CV{37, 23}, CV{37, 24}, CV{37, 25}, CV{37, 26},
-				CV{37, 27}, CV{37, 28}, CV{37, 29}, CV{37, 30},
-				CV{37, 31}, CV{37, 32}, CV{37, 33}, CV{37, 34},
-				CV{37, 35}, CV{37, 36}, CV{37, 37}, CV{37, 38},
-				CV{37, 39}, CV{37, 40}, CV{38, 1}, CV{38, 2},
-				CV{38, 3}, CV{38, 4}, CV{38, 5}, CV{38, 6},
-				CV{38, 7}, CV{38, 8}, CV{38, 9}, CV{38, 10},
-				CV{38, 11}, CV{38, 12}, CV{38, 13}, CV{38, 14},
-				CV{38, 15}, CV{38, 16}, CV{38, 17}, CV{38, 18},
-				CV{38, 19}, CV{38, 20}, CV{38, 21}, CV{38, 22},
-				CV{39, 1}, CV{39, 2}, CV{39, 3}, CV{39, 4},
-				CV{39, 5}, CV{39, 6}, CV{39, 7}, CV{39, 8},
-				CV{39, 9}, CV{39, 10}, CV{39, 11}, CV{39, 12},
-				CV{39, 13}, CV{40, 1}, CV{40, 2}, CV{40, 3},
-				CV{40, 4}, CV{40, 5}, CV{40, 6}, CV{40, 7},
-				CV{40, 8}, CV{40, 9}, CV{40, 10}, CV{40, 11},
-				CV{40, 12}, CV{40, 13}, CV{40, 14}, CV{40, 15},
-				CV{40, 16}, CV{40, 17}, CV{41, 1}, CV{41, 2},
-				CV{41, 3}, CV{41, 4}, CV{41, 5}, CV{41, 6},
-				CV{41, 7}, CV{41, 8}, CV{41, 9}, CV{41, 10},
-				CV{41, 11}, CV{41, 12}, CV{41, 13}, CV{42, 1},
-				CV{42, 2}, CV{42, 3}, CV{42, 4}, CV{42, 5},
-				CV{42, 6}, CV{42, 7}, CV{42, 8}, CV{42, 9},
-				CV{42, 10}, CV{42, 11}, CV{43, 1}, CV{43, 2},
-				CV{43, 3}, CV{43, 4}, CV{43, 5}, CV{44, 1},
-				CV{44, 2}, CV{44, 3}, CV{44, 4}, CV{44, 5},
-				CV{44, 6}, CV{44, 7}, CV{44, 8}, CV{44, 9},
-				CV{44, 10}, CV{44, 11}, CV{44, 12}, CV{44, 13},
-				CV{44, 14}, CV{44, 15}, CV{44, 16}, CV{44, 17},
-				CV{44, 18}, CV{44, 19}, CV{44, 20}, CV{44, 21},
-				CV{44, 22}, CV{44, 23}, CV{44, 24}, CV{44, 25},
-				CV{44, 26}, CV{45, 1}, CV{45, 2}, CV{45, 3},
-				CV{45, 4}, CV{45, 5}, CV{45, 6}, CV{45, 7},
-				CV{45, 8}, CV{45, 9}, CV{45, 10}, CV{45, 11},
-				CV{45, 12}, CV{45, 13}, CV{45, 14}, CV{45, 15},
-				CV{45, 16}, CV{45, 17}, CV{46, 1}, CV{46, 2},
-				CV{46, 3}, CV{46, 4}, CV{46, 5}, CV{46, 6},
-				CV{46, 7}, CV{46, 8}, CV{46, 9}, CV{46, 10},
-				CV{46, 11}, CV{47, 1}, CV{47, 2}, CV{47, 3},
-				CV{47, 4}, CV{47, 5}, CV{47, 6}, CV{47, 7},
-				CV{47, 8}, CV{47, 9}, CV{48, 1}, CV{48, 2},
-				CV{48, 3}, CV{48, 4}, CV{48, 5}, CV{48, 6},
-				CV{48, 7}, CV{48, 8}, CV{48, 9}, CV{48, 10},
-				CV{48, 11}, CV{48, 12}, CV{48, 13}, CV{48, 14},
-				CV{49, 1}, CV{49, 2}, CV{49, 3}, CV{49, 4},
-				CV{49, 5}, CV{49, 6}, CV{49, 7}, CV{49, 8},
-				CV{49, 9}, CV{49, 10}, CV{49, 11}, CV{49, 12},
-				CV{49, 13}, CV{49, 14}, CV{49, 15}, CV{49, 16},
-				CV{49, 17}, CV{49, 18}, CV{49, 19}, CV{49, 20},
-				CV{50, 1}, CV{50, 2}, CV{50, 3}, CV{50, 4},
-				CV{50, 5}, CV{50, 6}, CV{50, 7}, CV{50, 8},
-				CV{50, 9}, CV{50, 10}, CV{50, 11}, CV{50, 12},
-				CV{50, 13}, CV{50, 14}, CV{50, 15}, CV{50, 16},
-				CV{50, 17}, CV{50, 18}, CV{50, 19}, CV{50, 20},
-				CV{50, 21}, CV{50, 22}, CV{50, 23}, CV{51, 1},
-				CV{51, 2}, CV{51, 3}, CV{51, 4}, CV{51, 5},
-				CV{51, 6}, CV{51, 7}, CV{51, 8}, CV{51, 9},
-				CV{51, 10}, CV{51, 11}, CV{51, 12}, CV{51, 13},
-				CV{51, 14}, CV{51, 15}, CV{51, 16}, CV{51, 17},
-				CV{51, 18}, CV{51, 19}, CV{52, 1}, CV{52, 2},
-				CV{52, 3}, CV{52, 4}, CV{52, 5}, CV{52, 6},
-				CV{52, 7}, CV{52, 8}, CV{52, 9}, CV{53, 1},
-				CV{53, 2}, CV{53, 3}, CV{53, 4}, CV{53, 5},
-				CV{53, 6}, CV{54, 1}, CV{54, 2}, CV{54, 3},
-				CV{54, 4}, CV{54, 5}, CV{54, 6}, CV{54, 7},
-				CV{55, 1}, CV{55, 2}, CV{55, 3}, CV{55, 4},
-				CV{55, 5}, CV{55, 6}, CV{55, 7}, CV{55, 8},
-				CV{55, 9}, CV{55, 10}, CV{55, 11}, CV{55, 12},
-				CV{55, 13}, CV{55, 14}, CV{55, 15}, CV{55, 16},
-				CV{55, 17}, CV{55, 18}, CV{55, 19}, CV{55, 20},
-				CV{55, 21}, CV{55, 22}, CV{55, 23}, CV{56, 1},
-				CV{56, 2}, CV{56, 3}, CV{56, 4}, CV{56, 5},
-				CV{56, 6}, CV{56, 7}, CV{56, 8}, CV{56, 9},
-				CV{56, 10}, CV{56, 11}, CV{56, 12}, CV{56, 13},
-				CV{57, 1}, CV{57, 2}, CV{57, 3}, CV{57, 4},
-				CV{57, 5}, CV{57, 6}, CV{57, 7}, CV{57, 8},
-				CV{57, 9}, CV{57, 10}, CV{57, 11}, CV{58, 1},
-				CV{58, 2}, CV{58, 3}, CV{58, 4}, CV{58, 5},
-				CV{58, 6}, CV{58, 7}, CV{58, 8}, CV{58, 9},
-				CV{58, 10}, CV{58, 11}, CV{59, 1}, CV{59, 2},
-				CV{59, 3}, CV{59, 4}, CV{59, 5}, CV{59, 6},
-				CV{59, 7}, CV{59, 8}, CV{59, 9}, CV{59, 10},
-				CV{59, 11}, CV{59, 12}, CV{59, 13}, CV{59, 14},
-				CV{59, 15}, CV{59, 16}, CV{59, 17}, CV{60, 1},
-				CV{60, 2}, CV{60, 3}, CV{60, 4}, CV{60, 5},
-				CV{60, 6}, CV{60, 7}, CV{60, 8}, CV{60, 9},
-				CV{60, 10}, CV{60, 11}, CV{60, 12}, CV{61, 1},
-				CV{61, 2}, CV{61, 3}, CV{61, 4}, CV{61, 5},
-				CV{61, 6}, CV{61, 7}, CV{61, 8}, CV{62, 1},
-				CV{62, 2}, CV{62, 3}, CV{62, 4}, CV{62, 5},
-				CV{62, 6}, CV{62, 7}, CV{62, 8}, CV{62, 9},
-				CV{62, 10}, CV{62, 11}, CV{62, 12}, CV{63, 1},
-				CV{63, 2}, CV{63, 3}, CV{63, 4}, CV{63, 5},
-				CV{63, 6}, CV{63, 7}, CV{63, 8}, CV{63, 9},
-				CV{63, 10}, CV{63, 11}, CV{64, 1}, CV{64, 2},
-				CV{64, 3}, CV{64, 4}, CV{64, 5}, CV{64, 6},
-				CV{64, 7}, CV{64, 8}, CV{64, 9}, CV{64, 10},
-				CV{65, 1}, CV{65, 2}, CV{65, 3}, CV{65, 4},
-				CV{65, 5}, CV{65, 6}, CV{65, 7}, CV{65, 8},
-				CV{65, 9}, CV{65, 10}, CV{65, 11}, CV{65, 12},
-				CV{65, 13}, CV{66, 1}, CV{66, 2}, CV{66, 3},
-				CV{66, 4}, CV{66, 5}, CV{66, 6}, CV{66, 7},
-				CV{66, 8}, CV{66, 9}, CV{66, 10}, CV{66, 11},
-				CV{66, 12}, CV{66, 13}, CV{66, 14}, CV{66, 15},
-				CV{66, 16}, CV{66, 17}, CV{66, 18}, CV{66, 19},
-				CV{66, 20}, CV{67, 1}, CV{67, 2}, CV{67, 3},
-				CV{67, 4}, CV{67, 5}, CV{67, 6}, CV{67, 7},
-				CV{68, 1}, CV{68, 2}, CV{68, 3}, CV{68, 4},
-				CV{68, 5}, CV{68, 6}, CV{68, 7}, CV{68, 8},
-				CV{68, 9}, CV{68, 10}, CV{68, 11}, CV{68, 12},
-				CV{68, 13}, CV{68, 14}, CV{68, 15}, CV{68, 16},
-				CV{68, 17}, CV{68, 18}, CV{68, 19}, CV{68, 20},
-				CV{68, 21}, CV{68, 22}, CV{68, 23}, CV{68, 24},
-				CV{68, 25}, CV{68, 26}, CV{68, 27}, CV{68, 28},
-				CV{68, 29}, CV{68, 30}, CV{68, 31}, CV{68, 32},
-				CV{68, 33}, CV{68, 34}, CV{68, 35}, CV{69, 1},
-				CV{69, 2}, CV{69, 3}, CV{69, 4}, CV{69, 5},
-				CV{69, 6}, CV{69, 7}, CV{69, 8}, CV{69, 9},
-				CV{69, 10}, CV{69, 11}, CV{69, 12}, CV{69, 13},
-				CV{69, 14}, CV{69, 15}, CV{69, 16}, CV{69, 17},
-				CV{69, 18}, CV{69, 19}, CV{69, 20}, CV{69, 21},
-				CV{69, 22}, CV{69, 23}, CV{69, 24}, CV{69, 25},
-				CV{69, 26}, CV{69, 27}, CV{69, 28}, CV{69, 29},
-				CV{69, 30}, CV{69, 31}, CV{69, 32}, CV{69, 33},
-				CV{69, 34}, CV{69, 35}, CV{69, 36}, CV{70, 1},
-				CV{70, 2}, CV{70, 3}, CV{70, 4}, CV{70, 5},
-				CV{71, 1}, CV{71, 2}, CV{71, 3}, CV{71, 4},
-				CV{71, 5}, CV{71, 6}, CV{71, 7}, CV{71, 8},
-				CV{71, 9}, CV{71, 10}, CV{71, 11}, CV{71, 12},
-				CV{71, 13}, CV{71, 14}, CV{71, 15}, CV{71, 16},
-				CV{71, 17}, CV{71, 18}, CV{71, 19}, CV{71, 20},
-				CV{71, 21}, CV{71, 22}, CV{71, 23}, CV{71, 24},
-				CV{72, 1}, CV{72, 2}, CV{72, 3}, CV{72, 4},
-				CV{72, 5}, CV{72, 6}, CV{72, 7}, CV{72, 8},
-				CV{72, 9}, CV{72, 10}, CV{72, 11}, CV{72, 12},
-				CV{72, 13}, CV{72, 14}, CV{72, 15}, CV{72, 16},
-				CV{72, 17}, CV{72, 18}, CV{72, 19}, CV{72, 20},
-				CV{73, 1}, CV{73, 2}, CV{73, 3}, CV{73, 4},
-				CV{73, 5}, CV{73, 6}, CV{73, 7}, CV{73, 8},
-				CV{73, 9}, CV{73, 10}, CV{73, 11}, CV{73, 12},
-				CV{73, 13}, CV{73, 14}, CV{73, 15}, CV{73, 16},
-				CV{73, 17}, CV{73, 18}, CV{73, 19}, CV{73, 20},
-				CV{73, 21}, CV{73, 22}, CV{73, 23}, CV{73, 24},
-				CV{73, 25}, CV{73, 26}, CV{73, 27}, CV{73, 28},
-				CV{74, 1}, CV{74, 2}, CV{74, 3}, CV{74, 4},
-				CV{74, 5}, CV{74, 6}, CV{74, 7}, CV{74, 8},
-				CV{74, 9}, CV{74, 10}, CV{74, 11}, CV{74, 12},
-				CV{74, 13}, CV{74, 14}, CV{74, 15}, CV{74, 16},
-				CV{74, 17}, CV{74, 18}, CV{74, 19}, CV{74, 20},
-				CV{74, 21}, CV{74, 22}, CV{74, 23}, CV{75, 1},
-				CV{75, 2}, CV{75, 3}, CV{75, 4}, CV{75, 5},
-				CV{75, 6}, CV{75, 7}, CV{75, 8}, CV{75, 9},
-				CV{75, 10}, CV{76, 1}, CV{76, 2}, CV{76, 3},
-				CV{76, 4}, CV{76, 5}, CV{76, 6}, CV{76, 7},
-				CV{76, 8}, CV{76, 9}, CV{76, 10}, CV{76, 11},
-				CV{76, 12}, CV{77, 1}, CV{77, 2}, CV{77, 3},
-				CV{77, 4}, CV{77, 5}, CV{77, 6}, CV{77, 7},
-				CV{77, 8}, CV{77, 9}, CV{77, 10}, CV{77, 11},
-				CV{77, 12}, CV{77, 13}, CV{77, 14}, CV{77, 15},
-				CV{77, 16}, CV{77, 17}, CV{77, 18}, CV{77, 19},
-				CV{77, 20}, CV{78, 1}, CV{78, 2}, CV{78, 3},
-				CV{78, 4}, CV{78, 5}, CV{78, 6}, CV{78, 7},
-				CV{78, 8}, CV{78, 9}, CV{78, 10}, CV{78, 11},
-				CV{78, 12}, CV{78, 13}, CV{78, 14}, CV{78, 15},
-				CV{78, 16}, CV{78, 17}, CV{78, 18}, CV{78, 19},
-				CV{78, 20}, CV{78, 21}, CV{78, 22}, CV{78, 23},
-				CV{78, 24}, CV{78, 25}, CV{78, 26}, CV{78, 27},
-				CV{78, 28}, CV{78, 29}, CV{78, 30}, CV{78, 31},
-				CV{78, 32}, CV{78, 33}, CV{78, 34}, CV{78, 35},
-				CV{78, 36}, CV{78, 37}, CV{78, 38}, CV{78, 39},
-				CV{78, 40}, CV{78, 41}, CV{78, 42}, CV{78, 43},
-				CV{78, 44}, CV{78, 45}, CV{78, 46}, CV{78, 47},
-				CV{78, 48}, CV{78, 49}, CV{78, 50}, CV{78, 51},
-				CV{78, 52}, CV{78, 53}, CV{78, 54}, CV{78, 55},
-				CV{78, 56}, CV{78, 57}, CV{78, 58}, CV{78, 59},
-				CV{78, 60}, CV{78, 61}, CV{78, 62}, CV{78, 63},
-				CV{78, 64}, CV{78, 65}, CV{78, 66}, CV{78, 67},
-				CV{78, 68}, CV{78, 69}, CV{78, 70}, CV{78, 71},
-				CV{78, 72}, CV{79, 1}, CV{79, 2}, CV{79, 3},
-				CV{79, 4}, CV{79, 5}, CV{79, 6}, CV{79, 7},
-				CV{79, 8}, CV{79, 9}, CV{79, 10}, CV{79, 11},
-				CV{79, 12}, CV{79, 13}, CV{80, 1}, CV{80, 2},
-				CV{80, 3}, CV{80, 4}, CV{80, 5}, CV{80, 6},
-				CV{80, 7}, CV{80, 8}, CV{80, 9}, CV{80, 10},
-				CV{80, 11}, CV{80, 12}, CV{80, 13}, CV{80, 14},
-				CV{80, 15}, CV{80, 16}, CV{80, 17}, CV{80, 18},
-				CV{80, 19}, CV{81, 1}, CV{81, 2}, CV{81, 3},
-				CV{81, 4}, CV{81, 5}, CV{81, 6}, CV{81, 7},
-				CV{81, 8}, CV{81, 9}, CV{81, 10}, CV{81, 11},
-				CV{81, 12}, CV{81, 13}, CV{81, 14}, CV{81, 15},
-				CV{81, 16}, CV{82, 1}, CV{82, 2}, CV{82, 3},
-				CV{82, 4}, CV{82, 5}, CV{82, 6}, CV{82, 7},
-				CV{82, 8}, CV{83, 1}, CV{83, 2}, CV{83, 3},
-				CV{83, 4}, CV{83, 5}, CV{83, 6}, CV{83, 7},
-				CV{83, 8}, CV{83, 9}, CV{83, 10}, CV{83, 11},
-				CV{83, 12}, CV{83, 13}, CV{83, 14}, CV{83, 15},
-				CV{83, 16}, CV{83, 17}, CV{83, 18}, CV{84, 1},
-				CV{84, 2}, CV{84, 3}, CV{84, 4}, CV{84, 5},
-				CV{84, 6}, CV{84, 7}, CV{84, 8}, CV{84, 9},
-				CV{84, 10}, CV{84, 11}, CV{84, 12}, CV{85, 1},
-				CV{85, 2}, CV{85, 3}, CV{85, 4}, CV{85, 5},
-				CV{85, 6}, CV{85, 7}, CV{85, 8}, CV{85, 9},
-				CV{85, 10}, CV{85, 11}, CV{85, 12}, CV{85, 13},
-				CV{86, 1}, CV{86, 2}, CV{86, 3}, CV{86, 4},
-				CV{86, 5}, CV{86, 6}, CV{86, 7}, CV{86, 8},
-				CV{86, 9}, CV{86, 10}, CV{86, 11}, CV{86, 12},
-				CV{86, 13}, CV{86, 14}, CV{86, 15}, CV{86, 16},
-				CV{86, 17}, CV{87, 1}, CV{87, 2}, CV{87, 3},
-				CV{87, 4}, CV{87, 5}, CV{87, 6}, CV{87, 7},
-				CV{88, 1}, CV{88, 2}, CV{88, 3}, CV{88, 4},
-				CV{88, 5}, CV{88, 6}, CV{88, 7}, CV{88, 8},
-				CV{88, 9}, CV{88, 10}, CV{88, 11}, CV{88, 12},
-				CV{88, 13}, CV{88, 14}, CV{88, 15}, CV{88, 16},
-				CV{88, 17}, CV{88, 18}, CV{89, 1}, CV{89, 2},
-				CV{89, 3}, CV{89, 4}, CV{89, 5}, CV{89, 6},
-				CV{89, 7}, CV{89, 8}, CV{89, 9}, CV{89, 10},
-				CV{89, 11}, CV{89, 12}, CV{89, 13}, CV{89, 14},
-				CV{89, 15}, CV{89, 16}, CV{89, 17}, CV{89, 18},
-				CV{89, 19}, CV{89, 20}, CV{89, 21}, CV{89, 22},
-				CV{89, 23}, CV{89, 24}, CV{89, 25}, CV{89, 26},
-				CV{89, 27}, CV{89, 28}, CV{89, 29}, CV{89, 30},
-				CV{89, 31}, CV{89, 32}, CV{89, 33}, CV{89, 34},
-				CV{89, 35}, CV{89, 36}, CV{89, 37}, CV{89, 38},
-				CV{89, 39}, CV{89, 40}, CV{89, 41}, CV{89, 42},
-				CV{89, 43}, CV{89, 44}, CV{89, 45}, CV{89, 46},
-				CV{89, 47}, CV{89, 48}, CV{89, 49}, CV{89, 50},
-				CV{89, 51}, CV{89, 52}, CV{90, 1}, CV{90, 2},
-				CV{90, 3}, CV{90, 4}, CV{90, 5}, CV{90, 6},
-				CV{90, 7}, CV{90, 8}, CV{90, 9}, CV{90, 10},
-				CV{90, 11}, CV{90, 12}, CV{90, 13}, CV{90, 14},
-				CV{90, 15}, CV{90, 16}, CV{90, 17}, CV{91, 1},
-				CV{91, 2}, CV{91, 3}, CV{91, 4}, CV{91, 5},
-				CV{91, 6}, CV{91, 7}, CV{91, 8}, CV{91, 9},
-				CV{91, 10}, CV{91, 11}, CV{91, 12}, CV{91, 13},
-				CV{91, 14}, CV{91, 15}, CV{91, 16}, CV{92, 1},
-				CV{92, 2}, CV{92, 3}, CV{92, 4}, CV{92, 5},
-				CV{92, 6}, CV{92, 7}, CV{92, 8}, CV{92, 9},
-				CV{92, 10}, CV{92, 11}, CV{92, 12}, CV{92, 13},
-				CV{92, 14}, CV{92, 15}, CV{93, 1}, CV{93, 2},
-				CV{93, 3}, CV{93, 4}, CV{93, 5}, CV{94, 1},
-				CV{94, 2}, CV{94, 3}, CV{94, 4}, CV{94, 5},
-				CV{94, 6}, CV{94, 7}, CV{94, 8}, CV{94, 9},
-				CV{94, 10}, CV{94, 11}, CV{94, 12}, CV{94, 13},
-				CV{94, 14}, CV{94, 15}, CV{94, 16}, CV{94, 17},
-				CV{94, 18}, CV{94, 19}, CV{94, 20}, CV{94, 21},
-				CV{94, 22}, CV{94, 23}, CV{95, 1}, CV{95, 2},
-				CV{95, 3}, CV{95, 4}, CV{95, 5}, CV{95, 6},
-				CV{95, 7}, CV{95, 8}, CV{95, 9}, CV{95, 10},
-				CV{95, 11}, CV{96, 1}, CV{96, 2}, CV{96, 3},
-				CV{96, 4}, CV{96, 5}, CV{96, 6}, CV{96, 7},
-				CV{96, 8}, CV{96, 9}, CV{96, 10}, CV{96, 11},
-				CV{96, 12}, CV{96, 13}, CV{97, 1}, CV{97, 2},
-				CV{97, 3}, CV{97, 4}, CV{97, 5}, CV{97, 6},
-				CV{97, 7}, CV{97, 8}, CV{97, 9}, CV{97, 10},
-				CV{97, 11}, CV{97, 12}, CV{98, 1}, CV{98, 2},
-				CV{98, 3}, CV{98, 4}, CV{98, 5}, CV{98, 6},
-				CV{98, 7}, CV{98, 8}, CV{98, 9}, CV{99, 1},
-				CV{99, 2}, CV{99, 3}, CV{99, 4}, CV{99, 5},
-				CV{99, 6}, CV{99, 7}, CV{99, 8}, CV{99, 9},
-				CV{100, 1}, CV{100, 2}, CV{100, 3}, CV{100, 4},
-				CV{100, 5}, CV{101, 1}, CV{101, 2}, CV{101, 3},
-				CV{101, 4}, CV{101, 5}, CV{101, 6}, CV{101, 7},
-				CV{101, 8}, CV{102, 1}, CV{102, 2}, CV{102, 3},
-				CV{102, 4}, CV{102, 5}, CV{102, 6}, CV{102, 7},
-				CV{102, 8}, CV{102, 9}, CV{102, 10}, CV{102, 11},
-				CV{102, 12}, CV{102, 13}, CV{102, 14}, CV{102, 15},
-				CV{102, 16}, CV{102, 17}, CV{102, 18}, CV{102, 19},
-				CV{102, 20}, CV{102, 21}, CV{102, 22}, CV{102, 23},
-				CV{102, 24}, CV{102, 25}, CV{102, 26}, CV{102, 27},
-				CV{102, 28}, CV{103, 1}, CV{103, 2}, CV{103, 3},
-				CV{103, 4}, CV{103, 5}, CV{103, 6}, CV{103, 7},
-				CV{103, 8}, CV{103, 9}, CV{103, 10}, CV{103, 11},
-				CV{103, 12}, CV{103, 13}, CV{103, 14}, CV{103, 15},
-				CV{103, 16}, CV{103, 17}, CV{103, 18}, CV{103, 19},
-				CV{103, 20}, CV{103, 21}, CV{103, 22}, CV{104, 1},
-				CV{104, 2}, CV{104, 3}, CV{104, 4}, CV{104, 5},
-				CV{104, 6}, CV{104, 7}, CV{104, 8}, CV{104, 9},
-				CV{104, 10}, CV{104, 11}, CV{104, 12}, CV{104, 13},
-				CV{104, 14}, CV{104, 15}, CV{104, 16}, CV{104, 17},
-				CV{104, 18}, CV{104, 19}, CV{104, 20}, CV{104, 21},
-				CV{104, 22}, CV{104, 23}, CV{104, 24}, CV{104, 25},
-				CV{104, 26}, CV{104, 27}, CV{104, 28}, CV{104, 29},
-				CV{104, 30}, CV{104, 31}, CV{104, 32}, CV{104, 33},
-				CV{104, 34}, CV{104, 35}, CV{105, 1}, CV{105, 2},
-				CV{105, 3}, CV{105, 4}, CV{105, 5}, CV{105, 6},
-				CV{105, 7}, CV{105, 8}, CV{105, 9}, CV{105, 10},
-				CV{105, 11}, CV{105, 12}, CV{105, 13}, CV{105, 14},
-				CV{105, 15}, CV{105, 16}, CV{105, 17}, CV{105, 18},
-				CV{105, 19}, CV{105, 20}, CV{105, 21}, CV{105, 22},
-				CV{105, 23}, CV{105, 24}, CV{105, 25}, CV{105, 26},
-				CV{105, 27}, CV{105, 28}, CV{105, 29}, CV{105, 30},
-				CV{105, 31}, CV{105, 32}, CV{105, 33}, CV{105, 34},
-				CV{105, 35}, CV{105, 36}, CV{105, 37}, CV{105, 38},
-				CV{105, 39}, CV{105, 40}, CV{105, 41}, CV{105, 42},
-				CV{105, 43}, CV{105, 44}, CV{105, 45}, CV{106, 1},
-				CV{106, 2}, CV{106, 3}, CV{106, 4}, CV{106, 5},
-				CV{106, 6}, CV{106, 7}, CV{106, 8}, CV{106, 9},
-				CV{106, 10}, CV{106, 11}, CV{106, 12}, CV{106, 13},
-				CV{106, 14}, CV{106, 15}, CV{106, 16}, CV{106, 17},
-				CV{106, 18}, CV{106, 19}, CV{106, 20}, CV{106, 21},
-				CV{106, 22}, CV{106, 23}, CV{106, 24}, CV{106, 25},
-				CV{106, 26}, CV{106, 27}, CV{106, 28}, CV{106, 29},
-				CV{106, 30}, CV{106, 31}, CV{106, 32}, CV{106, 33},
-				CV{106, 34}, CV{106, 35}, CV{106, 36}, CV{106, 37},
-				CV{106, 38}, CV{106, 39}, CV{106, 40}, CV{106, 41},
-				CV{106, 42}, CV{106, 43}, CV{106, 44}, CV{106, 45},
-				CV{106, 46}, CV{106, 47}, CV{106, 48}, CV{107, 1},
-				CV{107, 2}, CV{107, 3}, CV{107, 4}, CV{107, 5},
-				CV{107, 6}, CV{107, 7}, CV{107, 8}, CV{107, 9},
-				CV{107, 10}, CV{107, 11}, CV{107, 12}, CV{107, 13},
-				CV{107, 14}, CV{107, 15}, CV{107, 16}, CV{107, 17},
-				CV{107, 18}, CV{107, 19}, CV{107, 20}, CV{107, 21},
-				CV{107, 22}, CV{107, 23}, CV{107, 24}, CV{107, 25},
-				CV{107, 26}, CV{107, 27}, CV{107, 28}, CV{107, 29},
-				CV{107, 30}, CV{107, 31}, CV{107, 32}, CV{107, 33},
-				CV{107, 34}, CV{107, 35}, CV{107, 36}, CV{107, 37},
-				CV{107, 38}, CV{107, 39}, CV{107, 40}, CV{107, 41},
-				CV{107, 42}, CV{107, 43}, CV{108, 1}, CV{108, 2},
-				CV{108, 3}, CV{108, 4}, CV{108, 5}, CV{108, 6},
-				CV{108, 7}, CV{108, 8}, CV{108, 9}, CV{108, 10},
-				CV{108, 11}, CV{108, 12}, CV{108, 13}, CV{109, 1},
-				CV{109, 2}, CV{109, 3}, CV{109, 4}, CV{109, 5},
-				CV{109, 6}, CV{109, 7}, CV{109, 8}, CV{109, 9},
-				CV{109, 10}, CV{109, 11}, CV{109, 12}, CV{109, 13},
-				CV{109, 14}, CV{109, 15}, CV{109, 16}, CV{109, 17},
-				CV{109, 18}, CV{109, 19}, CV{109, 20}, CV{109, 21},
-				CV{109, 22}, CV{109, 23}, CV{109, 24}, CV{109, 25},
-				CV{109, 26}, CV{109, 27}, CV{109, 28}, CV{109, 29},
-				CV{109, 30}, CV{109, 31}, CV{110, 1}, CV{110, 2},
-				CV{110, 3}, CV{110, 4}, CV{110, 5}, CV{110, 6},
-				CV{110, 7}, CV{111, 1}, CV{111, 2}, CV{111, 3},
-				CV{111, 4}, CV{111, 5}, CV{111, 6}, CV{111, 7},
-				CV{111, 8}, CV{111, 9}, CV{111, 10}, CV{112, 1},
-				CV{112, 2}, CV{112, 3}, CV{112, 4}, CV{112, 5},
-				CV{112, 6}, CV{112, 7}, CV{112, 8}, CV{112, 9},
-				CV{112, 10}, CV{113, 1}, CV{113, 2}, CV{113, 3},
-				CV{113, 4}, CV{113, 5}, CV{113, 6}, CV{113, 7},
-				CV{113, 8}, CV{113, 9}, CV{114, 1}, CV{114, 2},
-				CV{114, 3}, CV{114, 4}, CV{114, 5}, CV{114, 6},
-				CV{114, 7}, CV{114, 8}, CV{115, 1}, CV{115, 2},
-				CV{115, 3}, CV{115, 4}, CV{115, 5}, CV{115, 6},
-				CV{115, 7}, CV{115, 8}, CV{115, 9}, CV{115, 10},
-				CV{115, 11}, CV{115, 12}, CV{115, 13}, CV{115, 14},
-				CV{115, 15}, CV{115, 16}, CV{115, 17}, CV{115, 18},
-				CV{116, 1}, CV{116, 2}, CV{116, 3}, CV{116, 4},
-				CV{116, 5}, CV{116, 6}, CV{116, 7}, CV{116, 8},
-				CV{116, 9}, CV{116, 10}, CV{116, 11}, CV{116, 12},
-				CV{116, 13}, CV{116, 14}, CV{116, 15}, CV{116, 16},
-				CV{116, 17}, CV{116, 18}, CV{116, 19}, CV{117, 1},
-				CV{117, 2}, CV{118, 1}, CV{118, 2}, CV{118, 3},
-				CV{118, 4}, CV{118, 5}, CV{118, 6}, CV{118, 7},
-				CV{118, 8}, CV{118, 9}, CV{118, 10}, CV{118, 11},
-				CV{118, 12}, CV{118, 13}, CV{118, 14}, CV{118, 15},
-				CV{118, 16}, CV{118, 17}, CV{118, 18}, CV{118, 19},
-				CV{118, 20}, CV{118, 21}, CV{118, 22}, CV{118, 23},
-				CV{118, 24}, CV{118, 25}, CV{118, 26}, CV{118, 27},
-				CV{118, 28}, CV{118, 29}, CV{119, 1}, CV{119, 2},
-				CV{119, 3}, CV{119, 4}, CV{119, 5}, CV{119, 6},
-				CV{119, 7}, CV{119, 8}, CV{119, 9}, CV{119, 10},
-				CV{119, 11}, CV{119, 12}, CV{119, 13}, CV{119, 14},
-				CV{119, 15}, CV{119, 16}, CV{119, 17}, CV{119, 18},
-				CV{119, 19}, CV{119, 20}, CV{119, 21}, CV{119, 22},
-				CV{119, 23}, CV{119, 24}, CV{119, 25}, CV{119, 26},
-				CV{119, 27}, CV{119, 28}, CV{119, 29}, CV{119, 30},
-				CV{119, 31}, CV{119, 32}, CV{119, 33}, CV{119, 34},
-				CV{119, 35}, CV{119, 36}, CV{119, 37}, CV{119, 38},
-				CV{119, 39}, CV{119, 40}, CV{119, 41}, CV{119, 42},
-				CV{119, 43}, CV{119, 44}, CV{119, 45}, CV{119, 46},
-				CV{119, 47}, CV{119, 48}, CV{119, 49}, CV{119, 50},
-				CV{119, 51}, CV{119, 52}, CV{119, 53}, CV{119, 54},
-				CV{119, 55}, CV{119, 56}, CV{119, 57}, CV{119, 58},
-				CV{119, 59}, CV{119, 60}, CV{119, 61}, CV{119, 62},
-				CV{119, 63}, CV{119, 64}, CV{119, 65}, CV{119, 66},
-				CV{119, 67}, CV{119, 68}, CV{119, 69}, CV{119, 70},
-				CV{119, 71}, CV{119, 72}, CV{119, 73}, CV{119, 74},
-				CV{119, 75}, CV{119, 76}, CV{119, 77}, CV{119, 78},
-				CV{119, 79}, CV{119, 80}, CV{119, 81}, CV{119, 82},
-				CV{119, 83}, CV{119, 84}, CV{119, 85}, CV{119, 86},
-				CV{119, 87}, CV{119, 88}, CV{119, 89}, CV{119, 90},
-				CV{119, 91}, CV{119, 92}, CV{119, 93}, CV{119, 94},
-				CV{119, 95}, CV{119, 96}, CV{119, 97}, CV{119, 98},
-				CV{119, 99}, CV{119, 100}, CV{119, 101}, CV{119, 102},
-				CV{119, 103}, CV{119, 104}, CV{119, 105}, CV{119, 106},
-				CV{119, 107}, CV{119, 108}, CV{119, 109}, CV{119, 110},
-				CV{119, 111}, CV{119, 112}, CV{119, 113}, CV{119, 114},
-				CV{119, 115}, CV{119, 116}, CV{119, 117}, CV{119, 118},
-				CV{119, 119}, CV{119, 120}, CV{119, 121}, CV{119, 122},
-				CV{119, 123}, CV{119, 124}, CV{119, 125}, CV{119, 126},
-				CV{119, 127}, CV{119, 128}, CV{119, 129}, CV{119, 130},
-				CV{119, 131}, CV{119, 132}, CV{119, 133}, CV{119, 134},
-				CV{119, 135}, CV{119, 136}, CV{119, 137}, CV{119, 138},
-				CV{119, 139}, CV{119, 140}, CV{119, 141}, CV{119, 142},
-				CV{119, 143}, CV{119, 144}, CV{119, 145}, CV{119, 146},
-				CV{119, 147}, CV{119, 148}, CV{119, 149}, CV{119, 150},
-				CV{119, 151}, CV{119, 152}, CV{119, 153}, CV{119, 154},
-				CV{119, 155}, CV{119, 156}, CV{119, 157}, CV{119, 158},
-				CV{119, 159}, CV{119, 160}, CV{119, 161}, CV{119, 162},
-				CV{119, 163}, CV{119, 164}, CV{119, 165}, CV{119, 166},
-				CV{119, 167}, CV{119, 168}, CV{119, 169}, CV{119, 170},
-				CV{119, 171}, CV{119, 172}, CV{119, 173}, CV{119, 174},
-				CV{119, 175}, CV{119, 176}, CV{120, 1}, CV{120, 2},
-				CV{120, 3}, CV{120, 4}, CV{120, 5}, CV{120, 6},
-				CV{120, 7}, CV{121, 1}, CV{121, 2}, CV{121, 3},
-				CV{121, 4}, CV{121, 5}, CV{121, 6}, CV{121, 7},
-				CV{121, 8}, CV{122, 1}, CV{122, 2}, CV{122, 3},
-				CV{122, 4}, CV{122, 5}, CV{122, 6}, CV{122, 7},
-				CV{122, 8}, CV{122, 9}, CV{123, 1}, CV{123, 2},
-				CV{123, 3}, CV{123, 4}, CV{124, 1}, CV{124, 2},
-				CV{124, 3}, CV{124, 4}, CV{124, 5}, CV{124, 6},
-				CV{124, 7}, CV{124, 8}, CV{125, 1}, CV{125, 2},
-				CV{125, 3}, CV{125, 4}, CV{125, 5}, CV{126, 1},
-				CV{126, 2}, CV{126, 3}, CV{126, 4}, CV{126, 5},
-				CV{126, 6}, CV{127, 1}, CV{127, 2}, CV{127, 3},
-				CV{127, 4}, CV{127, 5}, CV{128, 1}, CV{128, 2},
-				CV{128, 3}, CV{128, 4}, CV{128, 5}, CV{128, 6},
-				CV{129, 1}, CV{129, 2}, CV{129, 3}, CV{129, 4},
-				CV{129, 5}, CV{129, 6}, CV{129, 7}, CV{129, 8},
-				CV{130, 1}, CV{130, 2}, CV{130, 3}, CV{130, 4},
-				CV{130, 5}, CV{130, 6}, CV{130, 7}, CV{130, 8},
-				CV{131, 1}, CV{131, 2}, CV{131, 3}, CV{132, 1},
-				CV{132, 2}, CV{132, 3}, CV{132, 4}, CV{132, 5},
-				CV{132, 6}, CV{132, 7}, CV{132, 8}, CV{132, 9},
-				CV{132, 10}, CV{132, 11}, CV{132, 12}, CV{132, 13},
-				CV{132, 14}, CV{132, 15}, CV{132, 16}, CV{132, 17},
-				CV{132, 18}, CV{133, 1}, CV{133, 2}, CV{133, 3},
-				CV{134, 1}, CV{134, 2}, CV{134, 3}, CV{135, 1},
-				CV{135, 2}, CV{135, 3}, CV{135, 4}, CV{135, 5},
-				CV{135, 6}, CV{135, 7}, CV{135, 8}, CV{135, 9},
-				CV{135, 10}, CV{135, 11}, CV{135, 12}, CV{135, 13},
-				CV{135, 14}, CV{135, 15}, CV{135, 16}, CV{135, 17},
-				CV{135, 18}, CV{135, 19}, CV{135, 20}, CV{135, 21},
-				CV{136, 1}, CV{136, 2}, CV{136, 3}, CV{136, 4},
-				CV{136, 5}, CV{136, 6}, CV{136, 7}, CV{136, 8},
-				CV{136, 9}, CV{136, 10}, CV{136, 11}, CV{136, 12},
-				CV{136, 13}, CV{136, 14}, CV{136, 15}, CV{136, 16},
-				CV{136, 17}, CV{136, 18}, CV{136, 19}, CV{136, 20},
-				CV{136, 21}, CV{136, 22}, CV{136, 23}, CV{136, 24},
-				CV{136, 25}, CV{136, 26}, CV{137, 1}, CV{137, 2},
-				CV{137, 3}, CV{137, 4}, CV{137, 5}, CV{137, 6},
-				CV{137, 7}, CV{137, 8}, CV{137, 9}, CV{138, 1},
-				CV{138, 2}, CV{138, 3}, CV{138, 4}, CV{138, 5},
-				CV{138, 6}, CV{138, 7}, CV{138, 8}, CV{139, 1},
-				CV{139, 2}, CV{139, 3}, CV{139, 4}, CV{139, 5},
-				CV{139, 6}, CV{139, 7}, CV{139, 8}, CV{139, 9},
-				CV{139, 10}, CV{139, 11}, CV{139, 12}, CV{139, 13},
-				CV{139, 14}, CV{139, 15}, CV{139, 16}, CV{139, 17},
-				CV{139, 18}, CV{139, 19}, CV{139, 20}, CV{139, 21},
-				CV{139, 22}, CV{139, 23}, CV{139, 24}, CV{140, 1},
-				CV{140, 2}, CV{140, 3}, CV{140, 4}, CV{140, 5},
-				CV{140, 6}, CV{140, 7}, CV{140, 8}, CV{140, 9},
-				CV{140, 10}, CV{140, 11}, CV{140, 12}, CV{140, 13},
-				CV{141, 1}, CV{141, 2}, CV{141, 3}, CV{141, 4},
-				CV{141, 5}, CV{141, 6}, CV{141, 7}, CV{141, 8},
-				CV{141, 9}, CV{141, 10}, CV{142, 1}, CV{142, 2},
-				CV{142, 3}, CV{142, 4}, CV{142, 5}, CV{142, 6},
-				CV{142, 7}, CV{143, 1}, CV{143, 2}, CV{143, 3},
-				CV{143, 4}, CV{143, 5}, CV{143, 6}, CV{143, 7},
-				CV{143, 8}, CV{143, 9}, CV{143, 10}, CV{143, 11},
-				CV{143, 12}, CV{144, 1}, CV{144, 2}, CV{144, 3},
-				CV{144, 4}, CV{144, 5}, CV{144, 6}, CV{144, 7},
-				CV{144, 8}, CV{144, 9}, CV{144, 10}, CV{144, 11},
-				CV{144, 12}, CV{144, 13}, CV{144, 14}, CV{144, 15},
-				CV{145, 1}, CV{145, 2}, CV{145, 3}, CV{145, 4},
-				CV{145, 5}, CV{145, 6}, CV{145, 7}, CV{145, 8},
-				CV{145, 9}, CV{145, 10}, CV{145, 11}, CV{145, 12},
-				CV{145, 13}, CV{145, 14}, CV{145, 15}, CV{145, 16},
-				CV{145, 17}, CV{145, 18}, CV{145, 19}, CV{145, 20},
-				CV{145, 21}, CV{146, 1}, CV{146, 2}, CV{146, 3},
-				CV{146, 4}, CV{146, 5}, CV{146, 6}, CV{146, 7},
-				CV{146, 8}, CV{146, 9}, CV{146, 10}, CV{147, 1},
-				CV{147, 2}, CV{147, 3}, CV{147, 4}, CV{147, 5},
-				CV{147, 6}, CV{147, 7}, CV{147, 8}, CV{147, 9},
-				CV{147, 10}, CV{147, 11}, CV{147, 12}, CV{147, 13},
-				CV{147, 14}, CV{147, 15}, CV{147, 16}, CV{147, 17},
-				CV{147, 18}, CV{147, 19}, CV{147, 20}, CV{148, 1},
-				CV{148, 2}, CV{148, 3}, CV{148, 4}, CV{148, 5},
-				CV{148, 6}, CV{148, 7}, CV{148, 8}, CV{148, 9},
-				CV{148, 10}, CV{148, 11}, CV{148, 12}, CV{148, 13},
-				CV{148, 14}, CV{149, 1}, CV{149, 2}, CV{149, 3},
-				CV{149, 4}, CV{149, 5}, CV{149, 6}, CV{149, 7},
-				CV{149, 8}, CV{149, 9}, CV{150, 1}, CV{150, 2},
-				CV{150, 3}, CV{150, 4}, CV{150, 5}, CV{150, 6},
+			Runs: []VerseRun{
+				{Chapter: 1, First: 1, Last: 6},
+				{Chapter: 2, First: 1, Last: 12},
+				{Chapter: 3, First: 1, Last: 8},
+				{Chapter: 4, First: 1, Last: 8},
+				{Chapter: 5, First: 1, Last: 12},
+				{Chapter: 6, First: 1, Last: 10},
+				{Chapter: 7, First: 1, Last: 17},
+				{Chapter: 8, First: 1, Last: 9},
+				{Chapter: 9, First: 1, Last: 20},
+				{Chapter: 10, First: 1, Last: 18},
+				{Chapter: 11, First: 1, Last: 7},
+				{Chapter: 12, First: 1, Last: 8},
+				{Chapter: 13, First: 1, Last: 6},
+				{Chapter: 14, First: 1, Last: 7},
+				{Chapter: 15, First: 1, Last: 5},
+				{Chapter: 16, First: 1, Last: 11},
+				{Chapter: 17, First: 1, Last: 15},
+				{Chapter: 18, First: 1, Last: 50},
+				{Chapter: 19, First: 1, Last: 14},
+				{Chapter: 20, First: 1, Last: 9},
+				{Chapter: 21, First: 1, Last: 13},
+				{Chapter: 22, First: 1, Last: 31},
+				{Chapter: 23, First: 1, Last: 6},
+				{Chapter: 24, First: 1, Last: 10},
+				{Chapter: 25, First: 1, Last: 22},
+				{Chapter: 26, First: 1, Last: 12},
+				{Chapter: 27, First: 1, Last: 14},
+				{Chapter: 28, First: 1, Last: 9},
+				{Chapter: 29, First: 1, Last: 11},
+				{Chapter: 30, First: 1, Last: 12},
+				{Chapter: 31, First: 1, Last: 24},
+				{Chapter: 32, First: 1, Last: 11},
+				{Chapter: 33, First: 1, Last: 22},
+				{Chapter: 34, First: 1, Last: 22},
+				{Chapter: 35, First: 1, Last: 28},
+				{Chapter: 36, First: 1, Last: 12},
+				{Chapter: 37, First: 1, Last: 40},
+				{Chapter: 38, First: 1, Last: 22},
+				{Chapter: 39, First: 1, Last: 13},
+				{Chapter: 40, First: 1, Last: 17},
+				{Chapter: 41, First: 1, Last: 13},
+				{Chapter: 42, First: 1, Last: 11},
+				{Chapter: 43, First: 1, Last: 5},
+				{Chapter: 44, First: 1, Last: 26},
+				{Chapter: 45, First: 1, Last: 17},
+				{Chapter: 46, First: 1, Last: 11},
+				{Chapter: 47, First: 1, Last: 9},
+				{Chapter: 48, First: 1, Last: 14},
+				{Chapter: 49, First: 1, Last: 20},
+				{Chapter: 50, First: 1, Last: 23},
+				{Chapter: 51, First: 1, Last: 19},
+				{Chapter: 52, First: 1, Last: 9},
+				{Chapter: 53, First: 1, Last: 6},
+				{Chapter: 54, First: 1, Last: 7},
+				{Chapter: 55, First: 1, Last: 23},
+				{Chapter: 56, First: 1, Last: 13},
+				{Chapter: 57, First: 1, Last: 11},
+				{Chapter: 58, First: 1, Last: 11},
+				{Chapter: 59, First: 1, Last: 17},
+				{Chapter: 60, First: 1, Last: 12},
+				{Chapter: 61, First: 1, Last: 8},
+				{Chapter: 62, First: 1, Last: 12},
+				{Chapter: 63, First: 1, Last: 11},
+				{Chapter: 64, First: 1, Last: 10},
+				{Chapter: 65, First: 1, Last: 13},
+				{Chapter: 66, First: 1, Last: 20},
+				{Chapter: 67, First: 1, Last: 7},
+				{Chapter: 68, First: 1, Last: 35},
+				{Chapter: 69, First: 1, Last: 36},
+				{Chapter: 70, First: 1, Last: 5},
+				{Chapter: 71, First: 1, Last: 24},
+				{Chapter: 72, First: 1, Last: 20},
+				{Chapter: 73, First: 1, Last: 28},
+				{Chapter: 74, First: 1, Last: 23},
+				{Chapter: 75, First: 1, Last: 10},
+				{Chapter: 76, First: 1, Last: 12},
+				{Chapter: 77, First: 1, Last: 20},
+				{Chapter: 78, First: 1, Last: 72},
+				{Chapter: 79, First: 1, Last: 13},
+				{Chapter: 80, First: 1, Last: 19},
+				{Chapter: 81, First: 1, Last: 16},
+				{Chapter: 82, First: 1, Last: 8},
+				{Chapter: 83, First: 1, Last: 18},
+				{Chapter: 84, First: 1, Last: 12},
+				{Chapter: 85, First: 1, Last: 13},
+				{Chapter: 86, First: 1, Last: 17},
+				{Chapter: 87, First: 1, Last: 7},
+				{Chapter: 88, First: 1, Last: 18},
+				{Chapter: 89, First: 1, Last: 52},
+				{Chapter: 90, First: 1, Last: 17},
+				{Chapter: 91, First: 1, Last: 16},
+				{Chapter: 92, First: 1, Last: 15},
+				{Chapter: 93, First: 1, Last: 5},
+				{Chapter: 94, First: 1, Last: 23},
+				{Chapter: 95, First: 1, Last: 11},
+				{Chapter: 96, First: 1, Last: 13},
+				{Chapter: 97, First: 1, Last: 12},
+				{Chapter: 98, First: 1, Last: 9},
+				{Chapter: 99, First: 1, Last: 9},
+				{Chapter: 100, First: 1, Last: 5},
+				{Chapter: 101, First: 1, Last: 8},
+				{Chapter: 102, First: 1, Last: 28},
+				{Chapter: 103, First: 1, Last: 22},
+				{Chapter: 104, First: 1, Last: 35},
+				{Chapter: 105, First: 1, Last: 45},
+				{Chapter: 106, First: 1, Last: 48},
+				{Chapter: 107, First: 1, Last: 43},
+				{Chapter: 108, First: 1, Last: 13},
+				{Chapter: 109, First: 1, Last: 31},
+				{Chapter: 110, First: 1, Last: 7},
+				{Chapter: 111, First: 1, Last: 10},
+				{Chapter: 112, First: 1, Last: 10},
+				{Chapter: 113, First: 1, Last: 9},
+				{Chapter: 114, First: 1, Last: 8},
+				{Chapter: 115, First: 1, Last: 18},
+				{Chapter: 116, First: 1, Last: 19},
+				{Chapter: 117, First: 1, Last: 2},
+				{Chapter: 118, First: 1, Last: 29},
+				{Chapter: 119, First: 1, Last: 176},
+				{Chapter: 120, First: 1, Last: 7},
+				{Chapter: 121, First: 1, Last: 8},
+				{Chapter: 122, First: 1, Last: 9},
+				{Chapter: 123, First: 1, Last: 4},
+				{Chapter: 124, First: 1, Last: 8},
+				{Chapter: 125, First: 1, Last: 5},
+				{Chapter: 126, First: 1, Last: 6},
+				{Chapter: 127, First: 1, Last: 5},
+				{Chapter: 128, First: 1, Last: 6},
+				{Chapter: 129, First: 1, Last: 8},
+				{Chapter: 130, First: 1, Last: 8},
+				{Chapter: 131, First: 1, Last: 3},
+				{Chapter: 132, First: 1, Last: 18},
+				{Chapter: 133, First: 1, Last: 3},
+				{Chapter: 134, First: 1, Last: 3},
+				{Chapter: 135, First: 1, Last: 21},
+				{Chapter: 136, First: 1, Last: 26},
+				{Chapter: 137, First: 1, Last: 9},
+				{Chapter: 138, First: 1, Last: 8},
+				{Chapter: 139, First: 1, Last: 24},
+				{Chapter: 140, First: 1, Last: 13},
+				{Chapter: 141, First: 1, Last: 10},
+				{Chapter: 142, First: 1, Last: 7},
+				{Chapter: 143, First: 1, Last: 12},
+				{Chapter: 144, First: 1, Last: 15},
+				{Chapter: 145, First: 1, Last: 21},
+				{Chapter: 146, First: 1, Last: 10},
+				{Chapter: 147, First: 1, Last: 20},
+				{Chapter: 148, First: 1, Last: 14},
+				{Chapter: 149, First: 1, Last: 9},
+				{Chapter: 150, First: 1, Last: 6},
 			},
 		},
 		{
 			Name:      "Proverbs",
+			USFM:      "PRO",
+			Testament: OldTestament,
 			JustVerse: false,
-			Verses: []Verse{CV{1, 1},
-				CV{1, 2}, CV{1, 3}, CV{1, 4}, CV{1, 5},
-				CV{1, 6}, CV{1, 7}, CV{1, 8}, CV{1, 9},
-				CV{1, 10}, CV{1, 11}, CV{1, 12}, CV{1, 13},
-				CV{1, 14}, CV{1, 15}, CV{1, 16}, CV{1, 17},
-				CV{1, 18}, CV{1, 19}, CV{1, 20}, CV{1, 21},
-				CV{1, 22}, CV{1, 23}, CV{1, 24}, CV{1, 25},
-				CV{1, 26}, CV{1, 27}, CV{1, 28}, CV{1, 29},
-				CV{1, 30}, CV{1, 31}, CV{1, 32}, CV{1, 33},
-				CV{2, 1}, CV{2, 2}, CV{2, 3}, CV{2, 4},
-				CV{2, 5}, CV{2, 6}, CV{2, 7}, CV{2, 8},
-				CV{2, 9}, CV{2, 10}, CV{2, 11}, CV{2, 12},
-				CV{2, 13}, CV{2, 14}, CV{2, 15}, CV{2, 16},
-				CV{2, 17}, CV{2, 18}, CV{2, 19}, CV{2, 20},
-				CV{2, 21}, CV{2, 22}, CV{3, 1}, CV{3, 2},
-				CV{3, 3}, CV{3, 4}, CV{3, 5}, CV{3, 6},
-				CV{3, 7}, CV{3, 8}, CV{3, 9}, CV{3, 10},
-				CV{3, 11}, CV{3, 12}, CV{3, 13}, CV{3, 14},
-				CV{3, 15}, CV{3, 16}, CV{3, 17}, CV{3, 18},
-				CV{3, 19}, CV{3, 20}, CV{3, 21}, CV{3, 22},
-				CV{3, 23}, CV{3, 24}, CV{3, 25}, CV{3, 26},
-				CV{3, 27}, CV{3, 28}, CV{3, 29}, CV{3, 30},
-				CV{3, 31}, CV{3, 32}, CV{3, 33}, CV{3, 34},
-				CV{3, 35}, CV{4, 1}, CV{4, 2}, CV{4, 3},
-				CV{4, 4}, CV{4, 5}, CV{4, 6}, CV{4, 7},
-				CV{4, 8}, CV{4, 9}, CV{4, 10}, CV{4, 11},
-				CV{4, 12}, CV{4, 13}, CV{4, 14}, CV{4, 15},
-				CV{4, 16}, CV{4, 17}, CV{4, 18}, CV{4, 19},
-				CV{4, 20}, CV{4, 21}, CV{4, 22}, CV{4, 23},
-				CV{4, 24}, CV{4, 25}, CV{4, 26}, CV{4, 27},
-				CV{5, 1}, CV{5, 2}, CV{5, 3}, CV{5, 4},
-				CV{5, 5}, CV{5, 6}, CV{5, 7}, CV{5, 8},
-				CV{5, 9}, CV{5, 10}, CV{5, 11}, CV{5, 12},
-				CV{5, 13}, CV{5, 14}, CV{5, 15}, CV{5, 16},
-				CV{5, 17}, CV{5, 18}, CV{5, 19}, CV{5, 20},
-				CV{5, 21}, CV{5, 22}, CV{5, 23}, CV{6, 1},
-				CV{6, 2}, CV{6, 3}, CV{6, 4}, CV{6, 5},
-				CV{6, 6}, CV{6, 7}, CV{6, 8}, CV{6, 9},
-				CV{6, 10}, CV{6, 11}, CV{6, 12}, CV{6, 13},
-				CV{6, 14}, CV{6, 15}, CV{6, 16}, CV{6, 17},
-				CV{6, 18}, CV{6, 19}, CV{6, 20}, CV{6, 21},
-				CV{6, 22}, CV{6, 23}, CV{6, 24}, CV{6, 25},
-				CV{6, 26}, CV{6, 27}, CV{6, 28}, CV{6, 29},
-				CV{6, 30}, CV{6, 31}, CV{6, 32}, CV{6, 33},
-				CV{6, 34}, CV{6, 35}, CV{7, 1}, CV{7, 2},
-				CV{7, 3}, CV{7, 4}, CV{7, 5}, CV{7, 6},
-				CV{7, 7}, CV{7, 8}, CV{7, 9}, CV{7, 10},
-				CV{7, 11}, CV{7, 12}, CV{7, 13}, CV{7, 14},
-				CV{7, 15}, CV{7, 16}, CV{7, 17}, CV{7, 18},
-				CV{7, 19}, CV{7, 20}, CV{7, 21}, CV{7, 22},
-				CV{7, 23}, CV{7, 24}, CV{7, 25}, CV{7, 26},
-				CV{7, 27}, CV{8, 1}, CV{8, 2}, CV{8, 3},
-				CV{8, 4}, CV{8, 5}, CV{8, 6}, CV{8, 7},
-				CV{8, 8}, CV{8, 9}, CV{8, 10}, CV{8, 11},
-				CV{8, 12}, CV{8, 13}, CV{8, 14}, CV{8, 15},
-				CV{8, 16}, CV{8, 17}, CV{8, 18}, CV{8, 19},
-				CV{8, 20}, CV{8, 21}, CV{8, 22}, CV{8, 23},
-				CV{8, 24}, CV{8, 25}, CV{8, 26}, CV{8, 27},
-				CV{8, 28}, CV{8, 29}, CV{8, 30}, CV{8, 31},
-				CV{8, 32}, CV{8, 33}, CV{8, 34}, CV{8, 35},
-				CV{8, 36}, CV{9, 1}, CV{9, 2}, CV{9, 3},
-				CV{9, 4}, CV{9, 5}, CV{9, 6}, CV{9, 7},
-				CV{9, 8}, CV{9, 9}, CV{9, 10}, CV{9, 11},
-				CV{9, 12}, CV{9, 13}, CV{9, 14}, CV{9, 15},
-				CV{9, 16}, CV{9, 17}, CV{9, 18}, CV{10, 1},
-				CV{10, 2}, CV{10, 3}, CV{10, 4}, CV{10, 5},
-				CV{10, 6}, CV{10, 7}, CV{10, 8}, CV{10, 9},
-				CV{10, 10}, CV{10, 11}, CV{10, 12}, CV{10, 13},
-				CV{10, 14}, CV{10, 15}, CV{10, 16}, CV{10, 17},
-				CV{10, 18}, CV{10, 19}, CV{10, 20}, CV{10, 21},
-				CV{10, 22}, CV{10, 23}, CV{10, 24}, CV{10, 25},
-				CV{10, 26}, CV{10, 27}, CV{10, 28}, CV{10, 29},
-				CV{10, 30}, CV{10, 31}, CV{10, 32}, CV{11, 1},
-				CV{11, 2}, CV{11, 3}, CV{11, 4}, CV{11, 5},
-				CV{11, 6}, CV{11, 7}, CV{11, 8}, CV{11, 9},
-				CV{11, 10}, CV{11, 11}, CV{11, 12}, CV{11, 13},
-				CV{11, 14}, CV{11, 15}, CV{11, 16}, CV{11, 17},
-				CV{11, 18}, CV{11, 19}, CV{11, 20}, CV{11, 21},
-				CV{11, 22}, CV{11, 23}, CV{11, 24}, CV{11, 25},
-				CV{11, 26}, CV{11, 27}, CV{11, 28}, CV{11, 29},
-				CV{11, 30}, CV{11, 31}, CV{12, 1}, CV{12, 2},
-				CV{12, 3}, CV{12, 4}, CV{12, 5}, CV{12, 6},
-				CV{12, 7}, CV{12, 8}, CV{12, 9}, CV{12, 10},
-				CV{12, 11}, CV{12, 12}, CV{12, 13}, CV{12, 14},
-				CV{12, 15}, CV{12, 16}, CV{12, 17}, CV{12, 18},
-				CV{12, 19}, CV{12, 20}, CV{12, 21}, CV{12, 22},
-				CV{12, 23}, CV{12, 24}, CV{12, 25}, CV{12, 26},
-				CV{12, 27}, CV{12, 28}, CV{13, 1}, CV{13, 2},
-				CV{13, 3}, CV{13, 4}, CV{13, 5}, CV{13, 6},
-				CV{13, 7}, CV{13, 8}, CV{13, 9}, CV{13, 10},
-				CV{13, 11}, CV{13, 12}, CV{13, 13}, CV{13, 14},
-				CV{13, 15}, CV{13, 16}, CV{13, 17}, CV{13, 18},
-				CV{13, 19}, CV{13, 20}, CV{13, 21}, CV{13, 22},
-				CV{13, 23}, CV{13, 24}, CV{13, 25}, CV{14, 1},
-				CV{14, 2}, CV{14, 3}, CV{14, 4}, CV{14, 5},
-				CV{14, 6}, CV{14, 7}, CV{14, 8}, CV{14, 9},
-				CV{14, 10}, CV{14, 11}, CV{14, 12}, CV{14, 13},
-				CV{14, 14}, CV{14, 15}, CV{14, 16}, CV{14, 17},
-				CV{14, 18}, CV{14, 19}, CV{14, 20}, CV{14, 21},
-				CV{14, 22}, CV{14, 23}, CV{14, 24}, CV{14, 25},
-				CV{14, 26}, CV{14, 27}, CV{14, 28}, CV{14, 29},
-				CV{14, 30}, CV{14, 31}, CV{14, 32}, CV{14, 33},
-				CV{14, 34}, CV{14, 35}, CV{15, 1}, CV{15, 2},
-				CV{15, 3}, CV{15, 4}, CV{15, 5}, CV{15, 6},
-				CV{15, 7}, CV{15, 8}, CV{15, 9}, CV{15, 10},
-				CV{15, 11}, CV{15, 12}, CV{15, 13}, CV{15, 14},
-				CV{15, 15}, CV{15, 16}, CV{15, 17}, CV{15, 18},
-				CV{15, 19}, CV{15, 20}, CV{15, 21}, CV{15, 22},
-				CV{15, 23}, CV{15, 24}, CV{15, 25}, CV{15, 26},
-				CV{15, 27}, CV{15, 28}, CV{15, 29}, CV{15, 30},
-				CV{15, 31}, CV{15, 32}, CV{15, 33}, CV{16, 1},
-				CV{16, 2}, CV{16, 3}, CV{16, 4}, CV{16, 5},
-				CV{16, 6}, CV{16, 7}, CV{16, 8}, CV{16, 9},
-				CV{16, 10}, CV{16, 11}, CV{16, 12}, CV{16, 13},
-				CV{16, 14}, CV{16, 15}, CV{16, 16}, CV{16, 17},
-				CV{16, 18}, CV{16, 19}, CV{16, 20}, CV{16, 21},
-				CV{16, 22}, CV{16, 23}, CV{16, 24}, CV{16, 25},
-				CV{16, 26}, CV{16, 27}, CV{16, 28}, CV{16, 29},
-				CV{16, 30}, CV{16, 31}, CV{16, 32}, CV{16, 33},
-				CV{17, 1}, CV{17, 2}, CV{17, 3}, CV{17, 4},
-				CV{17, 5}, CV{17, 6}, CV{17, 7}, CV{17, 8},
-				CV{17, 9}, CV{17, 10}, CV{17, 11}, CV{17, 12},
-				CV{17, 13}, CV{17, 14}, CV{17, 15}, CV{17, 16},
-				CV{17, 17}, CV{17, 18}, CV{17, 19}, CV{17, 20},
-				CV{17, 21}, CV{17, 22}, CV{17, 23}, CV{17, 24},
-				CV{17, 25}, CV{17, 26}, CV{17, 27}, CV{17, 28},
-				CV{18, 1}, CV{18, 2}, CV{18, 3}, CV{18, 4},
-				CV{18, 5}, CV{18, 6}, CV{18, 7}, CV{18, 8},
-				CV{18, 9}, CV{18, 10}, CV{18, 11}, CV{18, 12},
-				CV{18, 13}, CV{18, 14}, CV{18, 15}, CV{18, 16},
-				CV{18, 17}, CV{18, 18}, CV{18, 19}, CV{18, 20},
-				CV{18, 21}, CV{18, 22}, CV{18, 23}, CV{18, 24},
-				CV{19, 1}, CV{19, 2}, CV{19, 3}, CV{19, 4},
-				CV{19, 5}, CV{19, 6}, CV{19, 7}, CV{19, 8},
-				CV{19, 9}, CV{19, 10}, CV{19, 11}, CV{19, 12},
-				CV{19, 13}, CV{19, 14}, CV{19, 15}, CV{19, 16},
-				CV{19, 17}, CV{19, 18}, CV{19, 19}, CV{19, 20},
-				CV{19, 21}, CV{19, 22}, CV{19, 23}, CV{19, 24},
-				CV{19, 25}, CV{19, 26}, CV{19, 27}, CV{19, 28},
-				CV{19, 29}, CV{20, 1}, CV{20, 2}, CV{20, 3},
-				CV{20, 4}, CV{20, 5}, CV{20, 6}, CV{20, 7},
-				CV{20, 8}, CV{20, 9}, CV{20, 10}, CV{20, 11},
-				CV{20, 12}, CV{20, 13}, CV{20, 14}, CV{20, 15},
-				CV{20, 16}, CV{20, 17}, CV{20, 18}, CV{20, 19},
-				CV{20, 20}, CV{20, 21}, CV{20, 22}, CV{20, 23},
-				CV{20, 24}, CV{20, 25}, CV{20, 26}, CV{20, 27},
-				CV{20, 28}, CV{20, 29}, CV{20, 30}, CV{21, 1},
-				CV{21, 2}, CV{21, 3}, CV{21, 4}, CV{21, 5},
-				CV{21, 6}, CV{21, 7}, CV{21, 8}, CV{21, 9},
-				CV{21, 10}, CV{21, 11}, CV{21, 12}, CV{21, 13},
-				CV{21, 14}, CV{21, 15}, CV{21, 16}, CV{21, 17},
-				CV{21, 18}, CV{21, 19}, CV{21, 20}, CV{21, 21},
-				CV{21, 22}, CV{21, 23}, CV{21, 24}, CV{21, 25},
-				CV{21, 26}, CV{21, 27}, CV{21, 28}, CV{21, 29},
-				CV{21, 30}, CV{21, 31}, CV{22, 1}, CV{22, 2},
-				CV{22, 3}, CV{22, 4}, CV{22, 5}, CV{22, 6},
-				CV{22, 7}, CV{22, 8}, CV{22, 9}, CV{22, 10},
-				CV{22, 11}, CV{22, 12}, CV{22, 13}, CV{22, 14},
-				CV{22, 15}, CV{22, 16}, CV{22, 17}, CV{22, 18},
-				CV{22, 19}, CV{22, 20}, CV{22, 21}, CV{22, 22},
-				CV{22, 23}, CV{22, 24}, CV{22, 25}, CV{22, 26},
-				CV{22, 27}, CV{22, 28}, CV{22, 29}, CV{23, 1},
-				CV{23, 2}, CV{23, 3}, CV{23, 4}, CV{23, 5},
-				CV{23, 6}, CV{23, 7}, CV{23, 8}, CV{23, 9},
-				CV{23, 10}, CV{23, 11}, CV{23, 12}, CV{23, 13},
-				CV{23, 14}, CV{23, 15}, CV{23, 16}, CV{23, 17},
-				CV{23, 18}, CV{23, 19}, CV{23, 20}, CV{23, 21},
-				CV{23, 22}, CV{23, 23}, CV{23, 24}, CV{23, 25},
-				CV{23, 26}, CV{23, 27}, CV{23, 28}, CV{23, 29},
-				CV{23, 30}, CV{23, 31}, CV{23, 32}, CV{23, 33},
-				CV{23, 34}, CV{23, 35}, CV{24, 1}, CV{24, 2},
-				CV{24, 3}, CV{24, 4}, CV{24, 5}, CV{24, 6},
-				CV{24, 7}, CV{24, 8}, CV{24, 9}, CV{24, 10},
-				CV{24, 11}, CV{24, 12}, CV{24, 13}, CV{24, 14},
-				CV{24, 15}, CV{24, 16}, CV{24, 17}, CV{24, 18},
-				CV{24, 19}, CV{24, 20}, CV{24, 21}, CV{24, 22},
-				CV{24, 23}, CV{24, 24}, CV{24, 25}, CV{24, 26},
-				CV{24, 27}, CV{24, 28}, CV{24, 29}, CV{24, 30},
-				CV{24, 31}, CV{24, 32}, CV{24, 33}, CV{24, 34},
-				CV{25, 1}, CV{25, 2}, CV{25, 3}, CV{25, 4},
-				CV{25, 5}, CV{25, 6}, CV{25, 7}, CV{25, 8},
-				CV{25, 9}, CV{25, 10}, CV{25, 11}, CV{25, 12},
-				CV{25, 13}, CV{25, 14}, CV{25, 15}, CV{25, 16},
-				CV{25, 17}, CV{25, 18}, CV{25, 19}, CV{25, 20},
-				CV{25, 21}, CV{25, 22}, CV{25, 23}, CV{25, 24},
-				CV{25, 25}, CV{25, 26}, CV{25, 27}, CV{25, 28},
-				CV{26, 1}, CV{26, 2}, CV{26, 3}, CV{26, 4},
-				CV{26, 5}, CV{26, 6}, CV{26, 7}, CV{26, 8},
-				CV{26, 9}, CV{26, 10}, CV{26, 11}, CV{26, 12},
-				CV{26, 13}, CV{26, 14}, CV{26, 15}, CV{26, 16},
-				CV{26, 17}, CV{26, 18}, CV{26, 19}, CV{26, 20},
-				CV{26, 21}, CV{26, 22}, CV{26, 23}, CV{26, 24},
-				CV{26, 25}, CV{26, 26}, CV{26, 27}, CV{26, 28},
-				CV{27, 1}, CV{27, 2}, CV{27, 3}, CV{27, 4},
-				CV{27, 5}, CV{27, 6}, CV{27, 7}, CV{27, 8},
-				CV{27, 9}, CV{27, 10}, CV{27, 11}, CV{27, 12},
-				CV{27, 13}, CV{27, 14}, CV{27, 15}, CV{27, 16},
-				CV{27, 17}, CV{27, 18}, CV{27, 19}, CV{27, 20},
-				CV{27, 21}, CV{27, 22}, CV{27, 23}, CV{27, 24},
-				CV{27, 25}, CV{27, 26}, CV{27, 27}, CV{28, 1},
-				CV{28, 2}, CV{28, 3}, CV{28, 4}, CV{28, 5},
-				CV{28, 6}, CV{28, 7}, CV{28, 8}, CV{28, 9},
-				CV{28, 10}, CV{28, 11}, CV{28, 12}, CV{28, 13},
-				CV{28, 14}, CV{28, 15}, CV{28, 16}, CV{28, 17},
-				CV{28, 18}, CV{28, 19}, CV{28, 20}, CV{28, 21},
-				CV{28, 22}, CV{28, 23}, CV{28, 24}, CV{28, 25},
-				CV{28, 26}, CV{28, 27}, CV{28, 28}, CV{29, 1},
-				CV{29, 2}, CV{29, 3}, CV{29, 4}, CV{29, 5},
-				CV{29, 6}, CV{29, 7}, CV{29, 8}, CV{29, 9},
-				CV{29, 10}, CV{29, 11}, CV{29, 12}, CV{29, 13},
-				CV{29, 14}, CV{29, 15}, CV{29, 16}, CV{29, 17},
-				CV{29, 18}, CV{29, 19}, CV{29, 20}, CV{29, 21},
-				CV{29, 22}, CV{29, 23}, CV{29, 24}, CV{29, 25},
-				CV{29, 26}, CV{29, 27}, CV{30, 1}, CV{30, 2},
-				CV{30, 3}, CV{30, 4}, CV{30, 5}, CV{30, 6},
-				CV{30, 7}, CV{30, 8}, CV{30, 9}, CV{30, 10},
-				CV{30, 11}, CV{30, 12}, CV{30, 13}, CV{30, 14},
-				CV{30, 15}, CV{30, 16}, CV{30, 17}, CV{30, 18},
-				CV{30, 19}, CV{30, 20}, CV{30, 21}, CV{30, 22},
-				CV{30, 23}, CV{30, 24}, CV{30, 25}, CV{30, 26},
-				CV{30, 27}, CV{30, 28}, CV{30, 29}, CV{30, 30},
-				CV{30, 31}, CV{30, 32}, CV{30, 33}, CV{31, 1},
-				CV{31, 2}, CV{31, 3}, CV{31, 4}, CV{31, 5},
-				CV{31, 6}, CV{31, 7}, CV{31, 8}, CV{31, 9},
-				CV{31, 10}, CV{31, 11}, CV{31, 12}, CV{31, 13},
-				CV{31, 14}, CV{31, 15}, CV{31, 16}, CV{31, 17},
-				CV{31, 18}, CV{31, 19}, CV{31, 20}, CV{31, 21},
-				CV{31, 22}, CV{31, 23}, CV{31, 24}, CV{31, 25},
-				CV{31, 26}, CV{31, 27}, CV{31, 28}, CV{31, 29},
-				CV{31, 30}, CV{31, 31},
+			Runs: []VerseRun{
+				{Chapter: 1, First: 1, Last: 33},
+				{Chapter: 2, First: 1, Last: 22},
+				{Chapter: 3, First: 1, Last: 35},
+				{Chapter: 4, First: 1, Last: 27},
+				{Chapter: 5, First: 1, Last: 23},
+				{Chapter: 6, First: 1, Last: 35},
+				{Chapter: 7, First: 1, Last: 27},
+				{Chapter: 8, First: 1, Last: 36},
+				{Chapter: 9, First: 1, Last: 18},
+				{Chapter: 10, First: 1, Last: 32},
+				{Chapter: 11, First: 1, Last: 31},
+				{Chapter: 12, First: 1, Last: 28},
+				{Chapter: 13, First: 1, Last: 25},
+				{Chapter: 14, First: 1, Last: 35},
+				{Chapter: 15, First: 1, Last: 33},
+				{Chapter: 16, First: 1, Last: 33},
+				{Chapter: 17, First: 1, Last: 28},
+				{Chapter: 18, First: 1, Last: 24},
+				{Chapter: 19, First: 1, Last: 29},
+				{Chapter: 20, First: 1, Last: 30},
+				{Chapter: 21, First: 1, Last: 31},
+				{Chapter: 22, First: 1, Last: 29},
+				{Chapter: 23, First: 1, Last: 35},
+				{Chapter: 24, First: 1, Last: 34},
+				{Chapter: 25, First: 1, Last: 28},
+				{Chapter: 26, First: 1, Last: 28},
+				{Chapter: 27, First: 1, Last: 27},
+				{Chapter: 28, First: 1, Last: 28},
+				{Chapter: 29, First: 1, Last: 27},
+				{Chapter: 30, First: 1, Last: 33},
+				{Chapter: 31, First: 1, Last: 31},
 			},
 		},
 		{
 			Name:      "Ecclesiastes",
+			USFM:      "ECC",
+			Testament: OldTestament,
+			AlternateNames: []string{
+				"Qoheleth",
+			},
 			JustVerse: false,
-			Verses: []Verse{CV{1, 1},
-				CV{1, 2}, CV{1, 3}, CV{1, 4}, CV{1, 5},
-				CV{1, 6}, CV{1, 7}, CV{1, 8}, CV{1, 9},
-				CV{1, 10}, CV{1, 11}, CV{1, 12}, CV{1, 13},
-				CV{1, 14}, CV{1, 15}, CV{1, 16}, CV{1, 17},
-				CV{1, 18}, CV{2, 1}, CV{2, 2}, CV{2, 3},
-				CV{2, 4}, CV{2, 5}, CV{2, 6}, CV{2, 7},
-				CV{2, 8}, CV{2, 9}, CV{2, 10}, CV{2, 11},
-				CV{2, 12}, CV{2, 13}, CV{2, 14}, CV{2, 15},
-				CV{2, 16}, CV{2, 17}, CV{2, 18}, CV{2, 19},
-				CV{2, 20}, CV{2, 21}, CV{2, 22}, CV{2, 23},
-				CV{2, 24}, CV{2, 25}, CV{2, 26}, CV{3, 1},
-				CV{3, 2}, CV{3, 3}, CV{3, 4}, CV{3, 5},
-				CV{3, 6}, CV{3, 7}, CV{3, 8}, CV{3, 9},
-				CV{3, 10}, CV{3, 11}, CV{3, 12}, CV{3, 13},
-				CV{3, 14}, CV{3, 15}, CV{3, 16}, CV{3, 17},
-				CV{3, 18}, CV{3, 19}, CV{3, 20}, CV{3, 21},
-				CV{3, 22}, CV{4, 1}, CV{4, 2}, CV{4, 3},
-				CV{4, 4}, CV{4, 5}, CV{4, 6}, CV{4, 7},
-				CV{4, 8}, CV{4, 9}, CV{4, 10}, CV{4, 11},
-				CV{4, 12}, CV{4, 13}, CV{4, 14}, CV{4, 15},
-				CV{4, 16}, CV{5, 1}, CV{5, 2}, CV{5, 3},
-				CV{5, 4}, CV{5, 5}, CV{5, 6}, CV{5, 7},
-				CV{5, 8}, CV{5, 9}, CV{5, 10}, CV{5, 11},
-				CV{5, 12}, CV{5, 13}, CV{5, 14}, CV{5, 15},
-				CV{5, 16}, CV{5, 17}, CV{5, 18}, CV{5, 19},
-				CV{5, 20}, CV{6, 1}, CV{6, 2}, CV{6, 3},
-				CV{6, 4}, CV{6, 5}, CV{6, 6}, CV{6, 7},
-				CV{6, 8}, CV{6, 9}, CV{6, 10}, CV{6, 11},
-				CV{6, 12}, CV{7, 1}, CV{7, 2}, CV{7, 3},
-				CV{7, 4}, CV{7, 5}, CV{7, 6}, CV{7, 7},
-				CV{7, 8}, CV{7, 9}, CV{7, 10}, CV{7, 11},
-				CV{7, 12}, CV{7, 13}, CV{7, 14}, CV{7, 15},
-				CV{7, 16}, CV{7, 17}, CV{7, 18}, CV{7, 19},
-				CV{7, 20}, CV{7, 21}, CV{7, 22}, CV{7, 23},
-				CV{7, 24}, CV{7, 25}, CV{7, 26}, CV{7, 27},
-				CV{7, 28}, CV{7, 29}, CV{8, 1}, CV{8, 2},
-				CV{8, 3}, CV{8, 4}, CV{8, 5}, CV{8, 6},
-				CV{8, 7}, CV{8, 8}, CV{8, 9}, CV{8, 10},
-				CV{8, 11}, CV{8, 12}, CV{8, 13}, CV{8, 14},
-				CV{8, 15}, CV{8, 16}, CV{8, 17}, CV{9, 1},
-				CV{9, 2}, CV{9, 3}, CV{9, 4}, CV{9, 5},
-				CV{9, 6}, CV{9, 7}, CV{9, 8}, CV{9, 9},
-				CV{9, 10}, CV{9, 11}, CV{9, 12}, CV{9, 13},
-				CV{9, 14}, CV{9, 15}, CV{9, 16}, CV{9, 17},
-				CV{9, 18}, CV{10, 1}, CV{10, 2}, CV{10, 3},
-				CV{10, 4}, CV{10, 5}, CV{10, 6}, CV{10, 7},
-				CV{10, 8}, CV{10, 9}, CV{10, 10}, CV{10, 11},
-				CV{10, 12}, CV{10, 13}, CV{10, 14}, CV{10, 15},
-				CV{10, 16}, CV{10, 17}, CV{10, 18}, CV{10, 19},
-				CV{10, 20}, CV{11, 1}, CV{11, 2}, CV{11, 3},
-				CV{11, 4}, CV{11, 5}, CV{11, 6}, CV{11, 7},
-				CV{11, 8}, CV{11, 9}, CV{11, 10}, CV{12, 1},
-				CV{12, 2}, CV{12, 3}, CV{12, 4}, CV{12, 5},
-				CV{12, 6}, CV{12, 7}, CV{12, 8}, CV{12, 9},
-				CV{12, 10}, CV{12, 11}, CV{12, 12}, CV{12, 13},
-				CV{12, 14},
+			Runs: []VerseRun{
+				{Chapter: 1, First: 1, Last: 18},
+				{Chapter: 2, First: 1, Last: 26},
+				{Chapter: 3, First: 1, Last: 22},
+				{Chapter: 4, First: 1, Last: 16},
+				{Chapter: 5, First: 1, Last: 20},
+				{Chapter: 6, First: 1, Last: 12},
+				{Chapter: 7, First: 1, Last: 29},
+				{Chapter: 8, First: 1, Last: 17},
+				{Chapter: 9, First: 1, Last: 18},
+				{Chapter: 10, First: 1, Last: 20},
+				{Chapter: 11, First: 1, Last: 10},
+				{Chapter: 12, First: 1, Last: 14},
 			},
 		},
 		{
 			Name:      "Song of Solomon",
+			USFM:      "SNG",
+			Testament: OldTestament,
+			AlternateNames: []string{
+				"Song of Songs",
+				"Canticle of Canticles",
+				"Canticles",
+			},
 			JustVerse: false,
-			Verses: []Verse{CV{1, 1},
-				CV{1, 2}, CV{1, 3}, CV{1, 4}, CV{1, 5},
-				CV{1, 6}, CV{1, 7}, CV{1, 8}, CV{1, 9},
-				CV{1, 10}, CV{1, 11}, CV{1, 12}, CV{1, 13},
-				CV{1, 14}, CV{1, 15}, CV{1, 16}, CV{1, 17},
-				CV{2, 1}, CV{2, 2}, CV{2, 3}, CV{2, 4},
-				CV{2, 5}, CV{2, 6}, CV{2, 7}, CV{2, 8},
-				CV{2, 9}, CV{2, 10}, CV{2, 11}, CV{2, 12},
-				CV{2, 13}, CV{2, 14}, CV{2, 15}, CV{2, 16},
-				CV{2, 17}, CV{3, 1}, CV{3, 2}, CV{3, 3},
-				CV{3, 4}, CV{3, 5}, CV{3, 6}, CV{3, 7},
-				CV{3, 8}, CV{3, 9}, CV{3, 10}, CV{3, 11},
-				CV{4, 1}, CV{4, 2}, CV{4, 3}, CV{4, 4},
-				CV{4, 5}, CV{4, 6}, CV{4, 7}, CV{4, 8},
-				CV{4, 9}, CV{4, 10}, CV{4, 11}, CV{4, 12},
-				CV{4, 13}, CV{4, 14}, CV{4, 15}, CV{4, 16},
-				CV{5, 1}, CV{5, 2}, CV{5, 3}, CV{5, 4},
-				CV{5, 5}, CV{5, 6}, CV{5, 7}, CV{5, 8},
-				CV{5, 9}, CV{5, 10}, CV{5, 11}, CV{5, 12},
-				CV{5, 13}, CV{5, 14}, CV{5, 15}, CV{5, 16},
-				CV{6, 1}, CV{6, 2}, CV{6, 3}, CV{6, 4},
-				CV{6, 5}, CV{6, 6}, CV{6, 7}, CV{6, 8},
-				CV{6, 9}, CV{6, 10}, CV{6, 11}, CV{6, 12},
-				CV{6, 13}, CV{7, 1}, CV{7, 2}, CV{7, 3},
-				CV{7, 4}, CV{7, 5}, CV{7, 6}, CV{7, 7},
-				CV{7, 8}, CV{7, 9}, CV{7, 10}, CV{7, 11},
-				CV{7, 12}, CV{7, 13}, CV{8, 1}, CV{8, 2},
-				CV{8, 3}, CV{8, 4}, CV{8, 5}, CV{8, 6},
-				CV{8, 7}, CV{8, 8}, CV{8, 9}, CV{8, 10},
-				CV{8, 11}, CV{8, 12}, CV{8, 13}, CV{8, 14},
+			Runs: []VerseRun{
+				{Chapter: 1, First: 1, Last: 17},
+				{Chapter: 2, First: 1, Last: 17},
+				{Chapter: 3, First: 1, Last: 11},
+				{Chapter: 4, First: 1, Last: 16},
+				{Chapter: 5, First: 1, Last: 16},
+				{Chapter: 6, First: 1, Last: 13},
+				{Chapter: 7, First: 1, Last: 13},
+				{Chapter: 8, First: 1, Last: 14},
 			},
 		},
 		{
 			Name:      "Isaiah",
+			USFM:      "ISA",
+			Testament: OldTestament,
 			JustVerse: false,
-			Verses: []Verse{CV{1, 1},
-				CV{1, 2}, CV{1, 3}, CV{1, 4}, CV{1, 5},
-				CV{1, 6}, CV{1, 7}, CV{1, 8}, CV{1, 9},
-				CV{1, 10}, CV{1, 11}, CV{1, 12}, CV{1, 13},
-				CV{1, 14}, CV{1, 15}, CV{1, 16}, CV{1, 17},
-				CV{1, 18}, CV{1, 19}, CV{1, 20}, CV{1, 21},
-				CV{1, 22}, CV{1, 23}, CV{1, 24}, CV{1, 25},
-				CV{1, 26}, CV{1, 27}, CV{1, 28}, CV{1, 29},
-				CV{1, 30}, CV{1, 31}, CV{2, 1}, CV{2, 2},
-				CV{2, 3}, CV{2, 4}, CV{2, 5}, CV{2, 6},
-				CV{2, 7}, CV{2, 8}, CV{2, 9}, CV{2, 10},
-				CV{2, 11}, CV{2, 12}, CV{2, 13}, CV{2, 14},
-				CV{2, 15}, CV{2, 16}, CV{2, 17}, CV{2, 18},
-				CV{2, 19}, CV{2, 20}, CV{2, 21}, CV{2, 22},
-				CV{3, 1}, CV{3, 2}, CV{3, 3}, CV{3, 4},
-				CV{3, 5}, CV{3, 6}, CV{3, 7}, CV{3, 8},
-				CV{3, 9}, CV{3, 10}, CV{3, 11}, CV{3, 12},
-				CV{3, 13}, CV{3, 14}, CV{3, 15}, CV{3, 16},
-				CV{3, 17}, CV{3, 18}, CV{3, 19}, CV{3, 20},
-				CV{3, 21}, CV{3, 22}, CV{3, 23}, CV{3, 24},
-				CV{3, 25}, CV{3, 26}, CV{4, 1}, CV{4, 2},
-				CV{4, 3}, CV{4, 4}, CV{4, 5}, CV{4, 6},
-				CV{5, 1}, CV{5, 2}, CV{5, 3}, CV{5, 4},
-				CV{5, 5}, CV{5, 6}, CV{5, 7}, CV{5, 8},
-				CV{5, 9}, CV{5, 10}, CV{5, 11}, CV{5, 12},
-				CV{5, 13}, CV{5, 14}, CV{5, 15}, CV{5, 16},
-				CV{5, 17}, CV{5, 18}, CV{5, 19}, CV{5, 20},
-				CV{5, 21}, CV{5, 22}, CV{5, 23}, CV{5, 24},
-				CV{5, 25}, CV{5, 26}, CV{5, 27}, CV{5, 28},
-				CV{5, 29}, CV{5, 30}, CV{6, 1}, CV{6, 2},
-				CV{6, 3}, CV{6, 4}, CV{6, 5}, CV{6, 6},
-				CV{6, 7}, CV{6, 8}, CV{6, 9}, CV{6, 10},
-				CV{6, 11}, CV{6, 12}, CV{6, 13}, CV{7, 1},
-				CV{7, 2}, CV{7, 3}, CV{7, 4}, CV{7, 5},
-				CV{7, 6}, CV{7, 7}, CV{7, 8}, CV{7, 9},
-				CV{7, 10}, CV{7, 11}, CV{7, 12}, CV{7, 13},
-				CV{7, 14}, CV{7, 15}, CV{7, 16}, CV{7, 17},
-				CV{7, 18}, CV{7, 19}, CV{7, 20}, CV{7, 21},
-				CV{7, 22}, CV{7, 23}, CV{7, 24}, CV{7, 25},
-				CV{8, 1}, CV{8, 2}, CV{8, 3}, CV{8, 4},
-				CV{8, 5}, CV{8, 6}, CV{8, 7}, CV{8, 8},
-				CV{8, 9}, CV{8, 10}, CV{8, 11}, CV{8, 12},
-				CV{8, 13}, CV{8, 14}, CV{8, 15}, CV{8, 16},
-				CV{8, 17}, CV{8, 18}, CV{8, 19}, CV{8, 20},
-				CV{8, 21}, CV{8, 22}, CV{9, 1}, CV{9, 2},
-				CV{9, 3}, CV{9, 4}, CV{9, 5}, CV{9, 6},
-				CV{9, 7}, CV{9, 8}, CV{9, 9}, CV{9, 10},
-				CV{9, 11}, CV{9, 12}, CV{9, 13}, CV{9, 14},
-				CV{9, 15}, CV{9, 16}, CV{9, 17}, CV{9, 18},
-				CV{9, 19}, CV{9, 20}, CV{9, 21}, CV{10, 1},
-				CV{10, 2}, CV{10, 3}, CV{10, 4}, CV{10, 5},
-				CV{10, 6}, CV{10, 7}, CV{10, 8}, CV{10, 9},
-				CV{10, 10}, CV{10, 11}, CV{10, 12}, CV{10, 13},
-				CV{10, 14}, CV{10, 15}, CV{10, 16}, CV{10, 17},
-				CV{10, 18}, CV{10, 19}, CV{10, 20}, CV{10, 21},
-				CV{10, 22}, CV{10, 23}, CV{10, 24}, CV{10, 25},
-				CV{10, 26}, CV{10, 27}, CV{10, 28}, CV{10, 29},
-				CV{10, 30}, CV{10, 31}, CV{10, 32}, CV{10, 33},
-				CV{10, 34}, CV{11, 1}, CV{11, 2}, CV{11, 3},
-				CV{11, 4}, CV{11, 5}, CV{11, 6}, CV{11, 7},
-				CV{11, 8}, CV{11, 9}, CV{11, 10}, CV{11, 11},
-				CV{11, 12}, CV{11, 13}, CV{11, 14}, CV{11, 15},
-				CV{11, 16}, CV{12, 1}, CV{12, 2}, CV{12, 3},
-				CV{12, 4}, CV{12, 5}, CV{12, 6}, CV{13, 1},
-				CV{13, 2}, CV{13, 3}, CV{13, 4}, CV{13, 5},
-				CV{13, 6}, CV{13, 7}, CV{13, 8}, CV{13, 9},
-				CV{13, 10}, CV{13, 11}, CV{13, 12}, CV{13, 13},
-				CV{13, 14}, CV{13, 15}, CV{13, 16}, CV{13, 17},
-				CV{13, 18}, CV{13, 19}, CV{13, 20}, CV{13, 21},
-				CV{13, 22}, CV{14, 1}, CV{14, 2}, CV{14, 3},
-				CV{14, 4}, CV{14, 5}, CV{14, 6}, CV{14, 7},
-				CV{14, 8}, CV{14, 9}, CV{14, 10}, CV{14, 11},
-				CV{14, 12}, CV{14, 13}, CV{14, 14}, CV{14, 15},
-				CV{14, 16}, CV{14, 17}, CV{14, 18}, CV{14, 19},
-				CV{14, 20}, CV{14, 21}, CV{14, 22}, CV{14, 23},
-				CV{14, 24}, CV{14, 25}, CV{14, 26}, CV{14, 27},
-				CV{14, 28}, CV{14, 29}, CV{14, 30}, CV{14, 31},
-				CV{14, 32}, CV{15, 1}, CV{15, 2}, CV{15, 3},
-				CV{15, 4}, CV{15, 5}, CV{15, 6}, CV{15, 7},
-				CV{15, 8}, CV{15, 9}, CV{16, 1}, CV{16, 2},
-				CV{16, 3}, CV{16, 4}, CV{16, 5}, CV{16, 6},
-				CV{16, 7}, CV{16, 8}, CV{16, 9}, CV{16, 10},
-				CV{16, 11}, CV{16, 12}, CV{16, 13}, CV{16, 14},
-				CV{17, 1}, CV{17, 2}, CV{17, 3}, CV{17, 4},
-				CV{17, 5}, CV{17, 6}, CV{17, 7}, CV{17, 8},
-				CV{17, 9}, CV{17, 10}, CV{17, 11}, CV{17, 12},
-				CV{17, 13}, CV{17, 14}, CV{18, 1}, CV{18, 2},
-				CV{18, 3}, CV{18, 4}, CV{18, 5}, CV{18, 6},
-				CV{18, 7}, CV{19, 1}, CV{19, 2}, CV{19, 3},
-				CV{19, 4}, CV{19, 5}, CV{19, 6}, CV{19, 7},
-				CV{19, 8}, CV{19, 9}, CV{19, 10}, CV{19, 11},
-				CV{19, 12}, CV{19, 13}, CV{19, 14}, CV{19, 15},
-				CV{19, 16}, CV{19, 17}, CV{19, 18}, CV{19, 19},
-				CV{19, 20}, CV{19, 21}, CV{19, 22}, CV{19, 23},
-				CV{19, 24}, CV{19, 25}, CV{20, 1}, CV{20, 2},
-				CV{20, 3}, CV{20, 4}, CV{20, 5}, CV{20, 6},
-				CV{21, 1}, CV{21, 2}, CV{21, 3}, CV{21, 4},
-				CV{21, 5}, CV{21, 6}, CV{21, 7}, CV{21, 8},
-				CV{21, 9}, CV{21, 10}, CV{21, 11}, CV{21, 12},
-				CV{21, 13}, CV{21, 14}, CV{21, 15}, CV{21, 16},
-				CV{21, 17}, CV{22, 1}, CV{22, 2}, CV{22, 3},
-				CV{22, 4}, CV{22, 5}, CV{22, 6}, CV{22, 7},
-				CV{22, 8}, CV{22, 9}, CV{22, 10}, CV{22, 11},
-				CV{22, 12}, CV{22, 13}, CV{22, 14}, CV{22, 15},
-				CV{22, 16}, CV{22, 17}, CV{22, 18}, CV{22, 19},
-				CV{22, 20}, CV{22, 21}, CV{22, 22}, CV{22, 23},
-				CV{22, 24}, CV{22, 25}, CV{23, 1}, CV{23, 2},
-				CV{23, 3}, CV{23, 4}, CV{23, 5}, CV{23, 6},
-				CV{23, 7}, CV{23, 8}, CV{23, 9}, CV{23, 10},
-				CV{23, 11}, CV{23, 12}, CV{23, 13}, CV{23, 14},
-				CV{23, 15}, CV{23, 16}, CV{23, 17}, CV{23, 18},
-				CV{24, 1}, CV{24, 2}, CV{24, 3}, CV{24, 4},
-				CV{24, 5}, CV{24, 6}, CV{24, 7}, CV{24, 8},
-				CV{24, 9}, CV{24, 10}, CV{24, 11}, CV{24, 12},
-				CV{24, 13}, CV{24, 14}, CV{24, 15}, CV{24, 16},
-				CV{24, 17}, CV{24, 18}, CV{24, 19}, CV{24, 20},
-				CV{24, 21}, CV{24, 22}, CV{24, 23}, CV{25, 1},
-				CV{25, 2}, CV{25, 3}, CV{25, 4}, CV{25, 5},
-				CV{25, 6}, CV{25, 7}, CV{25, 8}, CV{25, 9},
-				CV{25, 10}, CV{25, 11}, CV{25, 12}, CV{26, 1},
-				CV{26, 2}, CV{26, 3}, CV{26, 4}, CV{26, 5},
-				CV{26, 6}, CV{26, 7}, CV{26, 8}, CV{26, 9},
-				CV{26, 10}, CV{26, 11}, CV{26, 12}, CV{26, 13},
-				CV{26, 14}, CV{26, 15}, CV{26, 16}, CV{26, 17},
-				CV{26, 18}, CV{26, 19}, CV{26, 20}, CV{26, 21},
-				CV{27, 1}, CV{27, 2}, CV{27, 3}, CV{27, 4},
-				CV{27, 5}, CV{27, 6}, CV{27, 7}, CV{27, 8},
-				CV{27, 9}, CV{27, 10}, CV{27, 11}, CV{27, 12},
-				CV{27, 13}, CV{28, 1}, CV{28, 2}, CV{28, 3},
-				CV{28, 4}, CV{28, 5}, CV{28, 6}, CV{28, 7},
-				CV{28, 8}, CV{28, 9}, CV{28, 10}, CV{28, 11},
-				CV{28, 12}, CV{28, 13}, CV{28, 14}, CV{28, 15},
-				CV{28, 16}, CV{28, 17}, CV{28, 18}, CV{28, 19},
-				CV{28, 20}, CV{28, 21}, CV{28, 22}, CV{28, 23},
-				CV{28, 24}, CV{28, 25}, CV{28, 26}, CV{28, 27},
-				CV{28, 28}, CV{28, 29}, CV{29, 1}, CV{29, 2},
-				CV{29, 3}, CV{29, 4}, CV{29, 5}, CV{29, 6},
-				CV{29, 7}, CV{29, 8}, CV{29, 9}, CV{29, 10},
-				CV{29, 11}, CV{29, 12}, CV{29, 13}, CV{29, 14},
-				CV{29, 15}, CV{29, 16}, CV{29, 17}, CV{29, 18},
-				CV{29, 19}, CV{29, 20}, CV{29, 21}, CV{29, 22},
-				CV{29, 23}, CV{29, 24}, CV{30, 1}, CV{30, 2},
-				CV{30, 3}, CV{30, 4}, CV{30, 5}, CV{30, 6},
-				CV{30, 7}, CV{30, 8}, CV{30, 9}, CV{30, 10},
-				CV{30, 11}, CV{30, 12}, CV{30, 13}, CV{30, 14},
-				CV{30, 15}, CV{30, 16}, CV{30, 17}, CV{30, 18},
-				CV{30, 19}, CV{30, 20}, CV{30, 21}, CV{30, 22},
-				CV{30, 23}, CV{30, 24}, CV{30, 25}, CV{30, 26},
-				CV{30, 27}, CV{30, 28}, CV{30, 29}, CV{30, 30},
-				CV{30, 31}, CV{30, 32}, CV{30, 33}, CV{31, 1},
-				CV{31, 2}, CV{31, 3}, CV{31, 4}, CV{31, 5},
-				CV{31, 6}, CV{31, 7}, CV{31, 8}, CV{31, 9},
-				CV{32, 1}, CV{32, 2}, CV{32, 3}, CV{32, 4},
-				CV{32, 5}, CV{32, 6}, CV{32, 7}, CV{32, 8},
-				CV{32, 9}, CV{32, 10}, CV{32, 11}, CV{32, 12},
-				CV{32, 13}, CV{32, 14}, CV{32, 15}, CV{32, 16},
-				CV{32, 17}, CV{32, 18}, CV{32, 19}, CV{32, 20},
-				CV{33, 1}, CV{33, 2}, CV{33, 3}, CV{33, 4},
-				CV{33, 5}, CV{33, 6}, CV{33, 7}, CV{33, 8},
-				CV{33, 9}, CV{33, 10}, CV{33, 11}, CV{33, 12},
-				CV{33, 13}, CV{33, 14}, CV{33, 15}, CV{33, 16},
-				CV{33, 17}, CV{33, 18}, CV{33, 19}, CV{33, 20},
-				CV{33, 21}, CV{33, 22}, CV{33, 23}, CV{33, 24},
-				CV{34, 1}, CV{34, 2}, CV{34, 3}, CV{34, 4},
-				CV{34, 5}, CV{34, 6}, CV{34, 7}, CV{34, 8},
-				CV{34, 9}, CV{34, 10}, CV{34, 11}, CV{34, 12},
-				CV{34, 13}, CV{34, 14}, CV{34, 15}, CV{34, 16},
-				CV{34, 17}, CV{35, 1}, CV{35, 2}, CV{35, 3},
-				CV{35, 4}, CV{35, 5}, CV{35, 6}, CV{35, 7},
-				CV{35, 8}, CV{35, 9}, CV{35, 10}, CV{36, 1},
-				CV{36, 2}, CV{36, 3}, CV{36, 4}, CV{36, 5},
-				CV{36, 6}, CV{36, 7}, CV{36, 8}, CV{36, 9},
-				CV{36, 10}, CV{36, 11}, CV{36, 12}, CV{36, 13},
-				CV{36, 14}, CV{36, 15}, CV{36, 16}, CV{36, 17},
-				CV{36, 18}, CV{36, 19}, CV{36, 20}, CV{36, 21},
-				CV{36, 22}, CV{37, 1}, CV{37, 2}, CV{37, 3},
-				CV{37, 4}, CV{37, 5}, CV{37, 6}, CV{37, 7},
-				CV{37, 8}, CV{37, 9}, CV{37, 10}, CV{37, 11},
-				CV{37, 12}, CV{37, 13}, CV{37, 14}, CV{37, 15},
-				CV{37, 16}, CV{37, 17}, CV{37, 18}, CV{37, 19},
-				CV{37, 20}, CV{37, 21}, CV{37, 22}, CV{37, 23},
-				CV{37, 24}, CV{37, 25}, CV{37, 26}, CV{37, 27},
-				CV{37, 28}, CV{37, 29}, CV{37, 30}, CV{37, 31},
-				CV{37, 32}, CV{37, 33}, CV{37, 34}, CV{37, 35},
-				CV{37, 36}, CV{37, 37}, CV{37, 38}, CV{38, 1},
-				CV{38, 2}, CV{38, 3}, CV{38, 4}, CV{38, 5},
-				CV{38, 6}, CV{38, 7}, CV{38, 8}, CV{38, 9},
-				CV{38, 10}, CV{38, 11}, CV{38, 12}, CV{38, 13},
-				CV{38, 14}, CV{38, 15}, CV{38, 16}, CV{38, 17},
-				CV{38, 18}, CV{38, 19}, CV{38, 20}, CV{38, 21},
-				CV{38, 22}, CV{39, 1}, CV{39, 2}, CV{39, 3},
-				CV{39, 4}, CV{39, 5}, CV{39, 6}, CV{39, 7},
-				CV{39, 8}, CV{40, 1}, CV{40, 2}, CV{40, 3},
-				CV{40, 4}, CV{40, 5}, CV{40, 6}, CV{40, 7},
-				CV{40, 8}, CV{40, 9}, CV{40, 10}, CV{40, 11},
-				CV{40, 12}, CV{40, 13}, CV{40, 14}, CV{40, 15},
-				CV{40, 16}, CV{40, 17}, CV{40, 18}, CV{40, 19},
-				CV{40, 20}, CV{40, 21}, CV{40, 22}, CV{40, 23},
-				CV{40, 24}, CV{40, 25}, CV{40, 26}, CV{40, 27},
-				CV{40, 28}, CV{40, 29}, CV{40, 30}, CV{40, 31},
-				CV{41, 1}, CV{41, 2}, CV{41, 3}, CV{41, 4},
-				CV{41, 5}, CV{41, 6}, CV{41, 7}, CV{41, 8},
-				CV{41, 9}, CV{41, 10}, CV{41, 11}, CV{41, 12},
-				CV{41, 13}, CV{41, 14}, CV{41, 15}, CV{41, 16},
-				CV{41, 17}, CV{41, 18}, CV{41, 19}, CV{41, 20},
-				CV{41, 21}, CV{41, 22}, CV{41, 23}, CV{41, 24},
-				CV{41, 25}, CV{41, 26}, CV{41, 27}, CV{41, 28},
-				CV{41, 29}, CV{42, 1}, CV{42, 2}, CV{42, 3},
-				CV{42, 4}, CV{42, 5}, CV{42, 6}, CV{42, 7},
-				CV{42, 8}, CV{42, 9}, CV{42, 10}, CV{42, 11},
-				CV{42, 12}, CV{42, 13}, CV{42, 14}, CV{42, 15},
-				CV{42, 16}, CV{42, 17}, CV{42, 18}, CV{42, 19},
-				CV{42, 20}, CV{42, 21}, CV{42, 22}, CV{42, 23},
-				CV{42, 24}, CV{42, 25}, CV{43, 1}, CV{43, 2},
-				CV{43, 3}, CV{43, 4}, CV{43, 5}, CV{43, 6},
-				CV{43, 7}, CV{43, 8}, CV{43, 9}, CV{43, 10},
-				CV{43, 11}, CV{43, 12}, CV{43, 13}, CV{43, 14},
-				CV{43, 15}, CV{43, 16}, CV{43, 17}, CV{43, 18},
-				CV{43, 19}, CV{43, 20}, CV{43, 21}, CV{43, 22},
-				CV{43, 23}, CV{43, 24}, CV{43, 25}, CV{43, 26},
-				CV{43, 27}, CV{43, 28}, CV{44, 1}, CV{44, 2},
-				CV{44, 3}, CV{44, 4}, CV{44, 5}, CV{44, 6},
-				CV{44, 7}, CV{44, 8}, CV{44, 9}, CV{44, 10},
-				CV{44, 11}, CV{44, 12}, CV{44, 13}, CV{44, 14},
-				CV{44, 15}, CV{44, 16}, CV{44, 17}, CV{44, 18},
-				CV{44, 19}, CV{44, 20}, CV{44, 21}, CV{44, 22},
-				CV{44, 23}, CV{44, 24}, CV{44, 25}, CV{44, 26},
-				CV{44, 27}, CV{44, 28}, CV{45, 1}, CV{45, 2},
-				CV{45, 3}, CV{45, 4}, CV{45, 5}, CV{45, 6},
-				CV{45, 7}, CV{45, 8}, CV{45, 9}, CV{45, 10},
-				CV{45, 11}, CV{45, 12}, CV{45, 13}, CV{45, 14},
-				CV{45, 15}, CV{45, 16}, CV{45, 17}, CV{45, 18},
-				CV{45, 19}, CV{45, 20}, CV{45, 21}, CV{45, 22},
-				CV{45, 23}, CV{45, 24}, CV{45, 25}, CV{46, 1},
-				CV{46, 2}, CV{46, 3}, CV{46, 4}, CV{46, 5},
-				CV{46, 6}, CV{46, 7}, CV{46, 8}, CV{46, 9},
-				CV{46, 10}, CV{46, 11}, CV{46, 12}, CV{46, 13},
-				CV{47, 1}, CV{47, 2}, CV{47, 3}, CV{47, 4},
-				CV{47, 5}, CV{47, 6}, CV{47, 7}, CV{47, 8},
-				CV{47, 9}, CV{47, 10}, CV{47, 11}, CV{47, 12},
-				CV{47, 13}, CV{47, 14}, CV{47, 15}, CV{48, 1},
-				CV{48, 2}, CV{48, 3}, CV{48, 4}, CV{48, 5},
-				CV{48, 6}, CV{48, 7}, CV{48, 8}, CV{48, 9},
-				CV{48, 10}, CV{48, 11}, CV{48, 12}, CV{48, 13},
-				CV{48, 14}, CV{48, 15}, CV{48, 16}, CV{48, 17},
-				CV{48, 18}, CV{48, 19}, CV{48, 20}, CV{48, 21},
-				CV{48, 22}, CV{49, 1}, CV{49, 2}, CV{49, 3},
-				CV{49, 4}, CV{49, 5}, CV{49, 6}, CV{49, 7},
-				CV{49, 8}, CV{49, 9}, CV{49, 10}, CV{49, 11},
-				CV{49, 12}, CV{49, 13}, CV{49, 14}, CV{49, 15},
-				CV{49, 16}, CV{49, 17}, CV{49, 18}, CV{49, 19},
-				CV{49, 20}, CV{49, 21}, CV{49, 22}, CV{49, 23},
-				CV{49, 24}, CV{49, 25}, CV{49, 26}, CV{50, 1},
-				CV{50, 2}, CV{50, 3}, CV{50, 4}, CV{50, 5},
-				CV{50, 6}, CV{50, 7}, CV{50, 8}, CV{50, 9},
-				CV{50, 10}, CV{50, 11}, CV{51, 1}, CV{51, 2},
-				CV{51, 3}, CV{51, 4}, CV{51, 5}, CV{51, 6},
-				CV{51, 7}, CV{51, 8}, CV{51, 9}, CV{51, 10},
-				CV{51, 11}, CV{51, 12}, CV{51, 13}, CV{51, 14},
-				CV{51, 15}, CV{51, 16}, CV{51, 17}, CV{51, 18},
-				CV{51, 19}, CV{51, 20}, CV{51, 21}, CV{51, 22},
-				CV{51, 23}, CV{52, 1}, CV{52, 2}, CV{52, 3},
-				CV{52, 4}, CV{52, 5}, CV{52, 6}, CV{52, 7},
-				CV{52, 8}, CV{52, 9}, CV{52, 10}, CV{52, 11},
-				CV{52, 12}, CV{52, 13}, CV{52, 14}, CV{52, 15},
-				CV{53, 1}, CV{53, 2}, CV{53, 3}, CV{53, 4},
-				CV{53, 5}, CV{53, 6}, CV{53, 7}, CV{53, 8},
-				CV{53, 9}, CV{53, 10}, CV{53, 11}, CV{53, 12},
-				CV{54, 1}, CV{54, 2}, CV{54, 3}, CV{54, 4},
-				CV{54, 5}, CV{54, 6}, CV{54, 7}, CV{54, 8},
-				CV{54, 9}, CV{54, 10}, CV{54, 11}, CV{54, 12},
-				CV{54, 13}, CV{54, 14}, CV{54, 15}, CV{54, 16},
-				CV{54, 17}, CV{55, 1}, CV{55, 2}, CV{55, 3},
-				CV{55, 4}, CV{55, 5}, CV{55, 6}, CV{55, 7},
-				CV{55, 8}, CV{55, 9}, CV{55, 10}, CV{55, 11},
-				CV{55, 12}, CV{55, 13}, CV{56, 1}, CV{56, 2},
-				CV{56, 3}, CV{56, 4}, CV{56, 5}, CV{56, 6},
-				CV{56, 7}, CV{56, 8}, CV{56, 9}, CV{56, 10},
-				CV{56, 11}, CV{56, 12}, CV{57, 1}, CV{57, 2},
-				CV{57, 3}, CV{57, 4}, CV{57, 5}, CV{57, 6},
-				CV{57, 7}, CV{57, 8}, CV{57, 9}, CV{57, 10},
-				CV{57, 11}, CV{57, 12}, CV{57, 13}, CV{57, 14},
-				CV{57, 15}, CV{57, 16}, CV{57, 17}, CV{57, 18},
-				CV{57, 19}, CV{57, 20}, CV{57, 21}, CV{58, 1},
-				CV{58, 2}, CV{58, 3}, CV{58, 4}, CV{58, 5},
-				CV{58, 6}, CV{58, 7}, CV{58, 8}, CV{58, 9},
-				CV{58, 10}, CV{58, 11}, CV{58, 12}, CV{58, 13},
-				CV{58, 14}, CV{59, 1}, CV{59, 2}, CV{59, 3},
-				CV{59, 4}, CV{59, 5}, CV{59, 6}, CV{59, 7},
-				CV{59, 8}, CV{59, 9}, CV{59, 10}, CV{59, 11},
-				CV{59, 12}, CV{59, 13}, CV{59, 14}, CV{59, 15},
-				CV{59, 16}, CV{59, 17}, CV{59, 18}, CV{59, 19},
-				CV{59, 20}, CV{59, 21}, CV{60, 1}, CV{60, 2},
-				CV{60, 3}, CV{60, 4}, CV{60, 5}, CV{60, 6},
-				CV{60, 7}, CV{60, 8}, CV{60, 9}, CV{60, 10},
-				CV{60, 11}, CV{60, 12}, CV{60, 13}, CV{60, 14},
-				CV{60, 15}, CV{60, 16}, CV{60, 17}, CV{60, 18},
-				CV{60, 19}, CV{60, 20}, CV{60, 21}, CV{60, 22},
-				CV{61, 1}, CV{61, 2}, CV{61, 3}, CV{61, 4},
-				CV{61, 5}, CV{61, 6}, CV{61, 7}, CV{61, 8},
-				CV{61, 9}, CV{61, 10}, CV{61, 11}, CV{62, 1},
-				CV{62, 2}, CV{62, 3}, CV{62, 4}, CV{62, 5},
-				CV{62, 6}, CV{62, 7}, CV{62, 8}, CV{62, 9},
-				CV{62, 10}, CV{62, 11}, CV{62, 12}, CV{63, 1},
-				CV{63, 2}, CV{63, 3}, CV{63, 4}, CV{63, 5},
-				CV{63, 6}, CV{63, 7}, CV{63, 8}, CV{63, 9},
-				CV{63, 10}, CV{63, 11}, CV{63, 12}, CV{63, 13},
-				CV{63, 14}, CV{63, 15}, CV{63, 16}, CV{63, 17},
-				CV{63, 18}, CV{63, 19}, CV{64, 1}, CV{64, 2},
-				CV{64, 3}, CV{64, 4}, CV{64, 5}, CV{64, 6},
-				CV{64, 7}, CV{64, 8}, CV{64, 9}, CV{64, 10},
-				CV{64, 11}, CV{64, 12}, CV{65, 1}, CV{65, 2},
-				CV{65, 3}, CV{65, 4}, CV{65, 5}, CV{65, 6},
-				CV{65, 7}, CV{65, 8}, CV{65, 9}, CV{65, 10},
-				CV{65, 11}, CV{65, 12}, CV{65, 13}, CV{65, 14},
-				CV{65, 15}, CV{65, 16}, CV{65, 17}, CV{65, 18},
-				CV{65, 19}, CV{65, 20}, CV{65, 21}, CV{65, 22},
-				CV{65, 23}, CV{65, 24}, CV{65, 25}, CV{66, 1},
-				CV{66, 2}, CV{66, 3}, CV{66, 4}, CV{66, 5},
-				CV{66, 6}, CV{66, 7}, CV{66, 8}, CV{66, 9},
-				CV{66, 10}, CV{66, 11}, CV{66, 12}, CV{66, 13},
-				CV{66, 14}, CV{66, 15}, CV{66, 16}, CV{66, 17},
-				CV{66, 18}, CV{66, 19}, CV{66, 20}, CV{66, 21},
-				CV{66, 22}, CV{66, 23}, CV{66, 24},
+			Runs: []VerseRun{
+				{Chapter: 1, First: 1, Last: 31},
+				{Chapter: 2, First: 1, Last: 22},
+				{Chapter: 3, First: 1, Last: 26},
+				{Chapter: 4, First: 1, Last: 6},
+				{Chapter: 5, First: 1, Last: 30},
+				{Chapter: 6, First: 1, Last: 13},
+				{Chapter: 7, First: 1, Last: 25},
+				{Chapter: 8, First: 1, Last: 22},
+				{Chapter: 9, First: 1, Last: 21},
+				{Chapter: 10, First: 1, Last: 34},
+				{Chapter: 11, First: 1, Last: 16},
+				{Chapter: 12, First: 1, Last: 6},
+				{Chapter: 13, First: 1, Last: 22},
+				{Chapter: 14, First: 1, Last: 32},
+				{Chapter: 15, First: 1, Last: 9},
+				{Chapter: 16, First: 1, Last: 14},
+				{Chapter: 17, First: 1, Last: 14},
+				{Chapter: 18, First: 1, Last: 7},
+				{Chapter: 19, First: 1, Last: 25},
+				{Chapter: 20, First: 1, Last: 6},
+				{Chapter: 21, First: 1, Last: 17},
+				{Chapter: 22, First: 1, Last: 25},
+				{Chapter: 23, First: 1, Last: 18},
+				{Chapter: 24, First: 1, Last: 23},
+				{Chapter: 25, First: 1, Last: 12},
+				{Chapter: 26, First: 1, Last: 21},
+				{Chapter: 27, First: 1, Last: 13},
+				{Chapter: 28, First: 1, Last: 29},
+				{Chapter: 29, First: 1, Last: 24},
+				{Chapter: 30, First: 1, Last: 33},
+				{Chapter: 31, First: 1, Last: 9},
+				{Chapter: 32, First: 1, Last: 20},
+				{Chapter: 33, First: 1, Last: 24},
+				{Chapter: 34, First: 1, Last: 17},
+				{Chapter: 35, First: 1, Last: 10},
+				{Chapter: 36, First: 1, Last: 22},
+				{Chapter: 37, First: 1, Last: 38},
+				{Chapter: 38, First: 1, Last: 22},
+				{Chapter: 39, First: 1, Last: 8},
+				{Chapter: 40, First: 1, Last: 31},
+				{Chapter: 41, First: 1, Last: 29},
+				{Chapter: 42, First: 1, Last: 25},
+				{Chapter: 43, First: 1, Last: 28},
+				{Chapter: 44, First: 1, Last: 28},
+				{Chapter: 45, First: 1, Last: 25},
+				{Chapter: 46, First: 1, Last: 13},
+				{Chapter: 47, First: 1, Last: 15},
+				{Chapter: 48, First: 1, Last: 22},
+				{Chapter: 49, First: 1, Last: 26},
+				{Chapter: 50, First: 1, Last: 11},
+				{Chapter: 51, First: 1, Last: 23},
+				{Chapter: 52, First: 1, Last: 15},
+				{Chapter: 53, First: 1, Last: 12},
+				{Chapter: 54, First: 1, Last: 17},
+				{Chapter: 55, First: 1, Last: 13},
+				{Chapter: 56, First: 1, Last: 12},
+				{Chapter: 57, First: 1, Last: 21},
+				{Chapter: 58, First: 1, Last: 14},
+				{Chapter: 59, First: 1, Last: 21},
+				{Chapter: 60, First: 1, Last: 22},
+				{Chapter: 61, First: 1, Last: 11},
+				{Chapter: 62, First: 1, Last: 12},
+				{Chapter: 63, First: 1, Last: 19},
+				{Chapter: 64, First: 1, Last: 12},
+				{Chapter: 65, First: 1, Last: 25},
+				{Chapter: 66, First: 1, Last: 24},
 			},
 		},
 		{
 			Name:      "Jeremiah",
+			USFM:      "JER",
+			Testament: OldTestament,
 			JustVerse: false,
-			Verses: []Verse{CV{1, 1},
-				CV{1, 2}, CV{1, 3}, CV{1, 4}, CV{1, 5},
-				CV{1, 6}, CV{1, 7}, CV{1, 8}, CV{1, 9},
-				CV{1, 10}, CV{1, 11}, CV{1, 12}, CV{1, 13},
-				CV{1, 14}, CV{1, 15}, CV{1, 16}, CV{1, 17},
-				CV{1, 18}, CV{1, 19}, CV{2, 1}, CV{2, 2},
-				CV{2, 3}, CV{2, 4}, CV{2, 5}, CV{2, 6},
-				CV{2, 7}, CV{2, 8}, CV{2, 9}, CV{2, 10},
-				CV{2, 11}, CV{2, 12}, CV{2, 13}, CV{2, 14},
-				CV{2, 15}, CV{2, 16}, CV{2, 17}, CV{2, 18},
-				CV{2, 19}, CV{2, 20}, CV{2, 21}, CV{2, 22},
-				CV{2, 23}, CV{2, 24}, CV{2, 25}, CV{2, 26},
-				CV{2, 27}, CV{2, 28}, CV{2, 29}, CV{2, 30},
-				CV{2, 31}, CV{2, 32}, CV{2, 33}, CV{2, 34},
-				CV{2, 35}, CV{2, 36}, CV{2, 37}, CV{3, 1},
-				CV{3, 2}, CV{3, 3}, CV{3, 4}, CV{3, 5},
-				CV{3, 6}, CV{3, 7}, CV{3, 8}, CV{3, 9},
-				CV{3, 10}, CV{3, 11}, CV{3, 12}, CV{3, 13},
-				CV{3, 14}, CV{3, 15}, CV{3, 16}, CV{3, 17},
-				CV{3, 18}, CV{3, 19}, CV{3, 20}, CV{3, 21},
-				CV{3, 22}, CV{3, 23}, CV{3, 24}, CV{3, 25},
-				CV{4, 1}, CV{4, 2}, CV{4, 3}, CV{4, 4},
-				CV{4, 5}, CV{4, 6}, CV{4, 7}, CV{4, 8},
-				CV{4, 9}, CV{4, 10}, CV{4, 11}, CV{4, 12},
-				CV{4, 13}, CV{4, 14}, CV{4, 15}, CV{4, 16},
-				CV{4, 17}, CV{4, 18}, CV{4, 19}, CV{4, 20},
-				CV{4, 21}, CV{4, 22}, CV{4, 23}, CV{4, 24},
-				CV{4, 25}, CV{4, 26}, CV{4, 27}, CV{4, 28},
-				CV{4, 29}, CV{4, 30}, CV{4, 31}, CV{5, 1},
-				CV{5, 2}, CV{5, 3}, CV{5, 4}, CV{5, 5},
-				CV{5, 6}, CV{5, 7}, CV{5, 8}, CV{5, 9},
-				CV{5, 10}, CV{5, 11}, CV{5, 12}, CV{5, 13},
-				CV{5, 14}, CV{5, 15}, CV{5, 16}, CV{5, 17},
-				CV{5, 18}, CV{5, 19}, CV{5, 20}, CV{5, 21},
-				CV{5, 22}, CV{5, 23}, CV{5, 24}, CV{5, 25},
-				CV{5, 26}, CV{5, 27}, CV{5, 28}, CV{5, 29},
-				CV{5, 30}, CV{5, 31}, CV{6, 1}, CV{6, 2},
-				CV{6, 3}, CV{6, 4}, CV{6, 5}, CV{6, 6},
-				CV{6, 7}, CV{6, 8}, CV{6, 9}, CV{6, 10},
-				CV{6, 11}, CV{6, 12}, CV{6, 13}, CV{6, 14},
-				CV{6, 15}, CV{6, 16}, CV{6, 17}, CV{6, 18},
-				CV{6, 19}, CV{6, 20}, CV{6, 21}, CV{6, 22},
-				CV{6, 23}, CV{6, 24}, CV{6, 25}, CV{6, 26},
-				CV{6, 27}, CV{6, 28}, CV{6, 29}, CV{6, 30},
-				CV{7, 1}, CV{7, 2}, CV{7, 3}, CV{7, 4},
-				CV{7, 5}, CV{7, 6}, CV{7, 7}, CV{7, 8},
-				CV{7, 9}, CV{7, 10}, CV{7, 11}, CV{7, 12},
-				CV{7, 13}, CV{7, 14}, CV{7, 15}, CV{7, 16},
-				CV{7, 17}, CV{7, 18}, CV{7, 19}, CV{7, 20},
-				CV{7, 21}, CV{7, 22}, CV{7, 23}, CV{7, 24},
-				CV{7, 25}, CV{7, 26}, CV{7, 27}, CV{7, 28},
-				CV{7, 29}, CV{7, 30}, CV{7, 31}, CV{7, 32},
-				CV{7, 33}, CV{7, 34}, CV{8, 1}, CV{8, 2},
-				CV{8, 3}, CV{8, 4}, CV{8, 5}, CV{8, 6},
-				CV{8, 7}, CV{8, 8}, CV{8, 9}, CV{8, 10},
-				CV{8, 11}, CV{8, 12}, CV{8, 13}, CV{8, 14},
-				CV{8, 15}, CV{8, 16}, CV{8, 17}, CV{8, 18},
-				CV{8, 19}, CV{8, 20}, CV{8, 21}, CV{8, 22},
-				CV{9, 1}, CV{9, 2}, CV{9, 3}, CV{9, 4},
-				CV{9, 5}, CV{9, 6}, CV{9, 7}, CV{9, 8},
-				CV{9, 9}, CV{9, 10}, CV{9, 11}, CV{9, 12},
-				CV{9, 13}, CV{9, 14}, CV{9, 15}, CV{9, 16},
-				CV{9, 17}, CV{9, 18}, CV{9, 19}, CV{9, 20},
-				CV{9, 21}, CV{9, 22}, CV{9, 23}, CV{9, 24},
-				CV{9, 25}, CV{9, 26}, CV{10, 1}, CV{10, 2},
-				CV{10, 3}, CV{10, 4}, CV{10, 5}, CV{10, 6},
-				CV{10, 7}, CV{10, 8}, CV{10, 9}, CV{10, 10},
-				CV{10, 11}, CV{10, 12}, CV{10, 13}, CV{10, 14},
-				CV{10, 15}, CV{10, 16}, CV{10, 17}, CV{10, 18},
-				CV{10, 19}, CV{10, 20}, CV{10, 21}, CV{10, 22},
-				CV{10, 23}, CV{10, 24}, CV{10, 25}, CV{11, 1},
-				CV{11, 2}, CV{11, 3}, CV{11, 4}, CV{11, 5},
-				CV{11, 6}, CV{11, 7}, CV{11, 8}, CV{11, 9},
-				CV{11, 10}, CV{11, 11}, CV{11, 12}, CV{11, 13},
-				CV{11, 14}, CV{11, 15}, CV{11, 16}, CV{11, 17},
-				CV{11, 18}, CV{11, 19}, CV{11, 20}, CV{11, 21},
-				CV{11, 22}, CV{11, 23}, CV{12, 1}, CV{12, 2},
-				CV{12, 3}, CV{12, 4}, CV{12, 5}, CV{12, 6},
-				CV{12, 7}, CV{12, 8}, CV{12, 9}, CV{12, 10},
-				CV{12, 11}, CV{12, 12}, CV{12, 13}, CV{12, 14},
-				CV{12, 15}, CV{12, 16}, CV{12, 17}, CV{13, 1},
-				CV{13, 2}, CV{13, 3}, CV{13, 4}, CV{13, 5},
-				CV{13, 6}, CV{13, 7}, CV{13, 8}, CV{13, 9},
-				CV{13, 10}, CV{13, 11}, CV{13, 12}, CV{13, 13},
-				CV{13, 14}, CV{13, 15}, CV{13, 16}, CV{13, 17},
-				CV{13, 18}, CV{13, 19}, CV{13, 20}, CV{13, 21},
-				CV{13, 22}, CV{13, 23}, CV{13, 24}, CV{13, 25},
-				CV{13, 26}, CV{13, 27}, CV{14, 1}, CV{14, 2},
-				CV{14, 3}, CV{14, 4}, CV{14, 5}, CV{14, 6},
-				CV{14, 7}, CV{14, 8}, CV{14, 9}, CV{14, 10},
-				CV{14, 11}, CV{14, 12}, CV{14, 13}, CV{14, 14},
-				CV{14, 15}, CV{14, 16}, CV{14, 17}, CV{14, 18},
-				CV{14, 19}, CV{14, 20}, CV{14, 21}, CV{14, 22},
-				CV{15, 1}, CV{15, 2}, CV{15, 3}, CV{15, 4},
-				CV{15, 5}, CV{15, 6}, CV{15, 7}, CV{15, 8},
-				CV{15, 9}, CV{15, 10}, CV{15, 11}, CV{15, 12},
-				CV{15, 13}, CV{15, 14}, CV{15, 15}, CV{15, 16},
-				CV{15, 17}, CV{15, 18}, CV{15, 19}, CV{15, 20},
-				CV{15, 21}, CV{16, 1}, CV{16, 2}, CV{16, 3},
-				CV{16, 4}, CV{16, 5}, CV{16, 6}, CV{16, 7},
-				CV{16, 8}, CV{16, 9}, CV{16, 10}, CV{16, 11},
-				CV{16, 12}, CV{16, 13}, CV{16, 14}, CV{16, 15},
-				CV{16, 16}, CV{16, 17}, CV{16, 18}, CV{16, 19},
-				CV{16, 20}, CV{16, 21}, CV{17, 1}, CV{17, 2},
-				CV{17, 3}, CV{17, 4}, CV{17, 5}, CV{17, 6},
-				CV{17, 7}, CV{17, 8}, CV{17, 9}, CV{17, 10},
-				CV{17, 11}, CV{17, 12}, CV{17, 13}, CV{17, 14},
-				CV{17, 15}, CV{17, 16}, CV{17, 17}, CV{17, 18},
-				CV{17, 19}, CV{17, 20}, CV{17, 21}, CV{17, 22},
-				CV{17, 23}, CV{17, 24}, CV{17, 25}, CV{17, 26},
-				CV{17, 27}, CV{18, 1}, CV{18, 2}, CV{18, 3},
-				CV{18, 4}, CV{18, 5}, CV{18, 6}, CV{18, 7},
-				CV{18, 8}, CV{18, 9}, CV{18, 10}, CV{18, 11},
-				CV{18, 12}, CV{18, 13}, CV{18, 14}, CV{18, 15},
-				CV{18, 16}, CV{18, 17}, CV{18, 18}, CV{18, 19},
-				CV{18, 20}, CV{18, 21}, CV{18, 22}, CV{18, 23},
-				CV{19, 1}, CV{19, 2}, CV{19, 3}, CV{19, 4},
-				CV{19, 5}, CV{19, 6}, CV{19, 7}, CV{19, 8},
-				CV{19, 9}, CV{19, 10}, CV{19, 11}, CV{19, 12},
-				CV{19, 13}, CV{19, 14}, CV{19, 15}, CV{20, 1},
-				CV{20, 2}, CV{20, 3}, CV{20, 4}, CV{20, 5},
-				CV{20, 6}, CV{20, 7}, CV{20, 8}, CV{20, 9},
-				CV{20, 10}, CV{20, 11}, CV{20, 12}, CV{20, 13},
-				CV{20, 14}, CV{20, 15}, CV{20, 16}, CV{20, 17},
-				CV{20, 18}, CV{21, 1}, CV{21, 2}, CV{21, 3},
-				CV{21, 4}, CV{21, 5}, CV{21, 6}, CV{21, 7},
-				CV{21, 8}, CV{21, 9}, CV{21, 10}, CV{21, 11},
-				CV{21, 12}, CV{21, 13}, CV{21, 14}, CV{22, 1},
-				CV{22, 2}, CV{22, 3}, CV{22, 4}, CV{22, 5},
-				CV{22, 6}, CV{22, 7}, CV{22, 8}, CV{22, 9},
-				CV{22, 10}, CV{22, 11}, CV{22, 12}, CV{22, 13},
-				CV{22, 14}, CV{22, 15}, CV{22, 16}, CV{22, 17},
-				CV{22, 18}, CV{22, 19}, CV{22, 20}, CV{22, 21},
-				CV{22, 22}, CV{22, 23}, CV{22, 24}, CV{22, 25},
-				CV{22, 26}, CV{22, 27}, CV{22, 28}, CV{22, 29},
-				CV{22, 30}, CV{23, 1}, CV{23, 2}, CV{23, 3},
-				CV{23, 4}, CV{23, 5}, CV{23, 6}, CV{23, 7},
-				CV{23, 8}, CV{23, 9}, CV{23, 10}, CV{23, 11},
-				CV{23, 12}, CV{23, 13}, CV{23, 14}, CV{23, 15},
-				CV{23, 16}, CV{23, 17}, CV{23, 18}, CV{23, 19},
-				CV{23, 20}, CV{23, 21}, CV{23, 22}, CV{23, 23},
-				CV{23, 24}, CV{23, 25}, CV{23, 26}, CV{23, 27},
-				CV{23, 28}, CV{23, 29}, CV{23, 30}, CV{23, 31},
-				CV{23, 32}, CV{23, 33}, CV{23, 34}, CV{23, 35},
-				CV{23, 36}, CV{23, 37}, CV{23, 38}, CV{23, 39},
-				CV{23, 40}, CV{24, 1}, CV{24, 2}, CV{24, 3},
-				CV{24, 4}, CV{24, 5}, CV{24, 6}, CV{24, 7},
-				CV{24, 8}, CV{24, 9}, CV{24, 10}, CV{25, 1},
-				CV{25, 2}, CV{25, 3}, CV{25, 4}, CV{25, 5},
-				CV{25, 6}, CV{25, 7}, CV{25, 8}, CV{25, 9},
-				CV{25, 10}, CV{25, 11}, CV{25, 12}, CV{25, 13},
-				CV{25, 14}, CV{25, 15}, CV{25, 16}, CV{25, 17},
-				CV{25, 18}, CV{25, 19}, CV{25, 20}, CV{25, 21},
-				CV{25, 22}, CV{25, 23}, CV{25, 24}, CV{25, 25},
-				CV{25, 26}, CV{25, 27}, CV{25, 28}, CV{25, 29},
-				CV{25, 30}, CV{25, 31}, CV{25, 32}, CV{25, 33},
-				CV{25, 34}, CV{25, 35}, CV{25, 36}, CV{25, 37},
-				CV{25, 38}, CV{26, 1}, CV{26, 2}, CV{26, 3},
-				CV{26, 4}, CV{26, 5}, CV{26, 6}, CV{26, 7},
-				CV{26, 8}, CV{26, 9}, CV{26, 10}, CV{26, 11},
-				CV{26, 12}, CV{26, 13}, CV{26, 14}, CV{26, 15},
-				CV{26, 16}, CV{26, 17}, CV{26, 18}, CV{26, 19},
-				CV{26, 20}, CV{26, 21}, CV{26, 22}, CV{26, 23},
-				CV{26, 24}, CV{27, 1}, CV{27, 2}, CV{27, 3},
-				CV{27, 4}, CV{27, 5}, CV{27, 6}, CV{27, 7},
-				CV{27, 8}, CV{27, 9}, CV{27, 10}, CV{27, 11},
-				CV{27, 12}, CV{27, 13}, CV{27, 14}, CV{27, 15},
-				CV{27, 16}, CV{27, 17}, CV{27, 18}, CV{27, 19},
-				CV{27, 20}, CV{27, 21}, CV{27, 22}, CV{28, 1},
-				CV{28, 2}, CV{28, 3}, CV{28, 4}, CV{28, 5},
-				CV{28, 6}, CV{28, 7}, CV{28, 8}, CV{28, 9},
-				CV{28, 10}, CV{28, 11}, CV{28, 12}, CV{28, 13},
-				CV{28, 14}, CV{28, 15}, CV{28, 16}, CV{28, 17},
-				CV{29, 1}, CV{29, 2}, CV{29, 3}, CV{29, 4},
-				CV{29, 5}, CV{29, 6}, CV{29, 7}, CV{29, 8},
-				CV{29, 9}, CV{29, 10}, CV{29, 11}, CV{29, 12},
-				CV{29, 13}, CV{29, 14}, CV{29, 15}, CV{29, 16},
-				CV{29, 17}, CV{29, 18}, CV{29, 19}, CV{29, 20},
-				CV{29, 21}, CV{29, 22}, CV{29, 23}, CV{29, 24},
-				CV{29, 25}, CV{29, 26}, CV{29, 27}, CV{29, 28},
-				CV{29, 29}, CV{29, 30}, CV{29, 31}, CV{29, 32},
-				CV{30, 1}, CV{30, 2}, CV{30, 3}, CV{30, 4},
-				CV{30, 5}, CV{30, 6}, CV{30, 7}, CV{30, 8},
-				CV{30, 9}, CV{30, 10}, CV{30, 11}, CV{30, 12},
-				CV{30, 13}, CV{30, 14}, CV{30, 15}, CV{30, 16},
-				CV{30, 17}, CV{30, 18}, CV{30, 19}, CV{30, 20},
-				CV{30, 21}, CV{30, 22}, CV{30, 23}, CV{30, 24},
-				CV{31, 1}, CV{31, 2}, CV{31, 3}, CV{31, 4},
-				CV{31, 5}, CV{31, 6}, CV{31, 7}, CV{31, 8},
-				CV{31, 9}, CV{31, 10}, CV{31, 11}, CV{31, 12},
-				CV{31, 13}, CV{31, 14}, CV{31, 15}, CV{31, 16},
-				CV{31, 17}, CV{31, 18}, CV{31, 19}, CV{31, 20},
-				CV{31, 21}, CV{31, 22}, CV{31, 23}, CV{31, 24},
-				CV{31, 25}, CV{31, 26}, CV{31, 27}, CV{31, 28},
-				CV{31, 29}, CV{31, 30}, CV{31, 31}, CV{31, 32},
-				CV{31, 33}, CV{31, 34}, CV{31, 35}, CV{31, 36},
-				CV{31, 37}, CV{31, 38}, CV{31, 39}, CV{31, 40},
-				CV{32, 1}, CV{32, 2}, CV{32, 3}, CV{32, 4},
-				CV{32, 5}, CV{32, 6}, CV{32, 7}, CV{32, 8},
-				CV{32, 9}, CV{32, 10}, CV{32, 11}, CV{32, 12},
-				CV{32, 13}, CV{32, 14}, CV{32, 15}, CV{32, 16},
-				CV{32, 17}, CV{32, 18}, CV{32, 19}, CV{32, 20},
-				CV{32, 21}, CV{32, 22}, CV{32, 23}, CV{32, 24},
-				CV{32, 25}, CV{32, 26}, CV{32, 27}, CV{32, 28},
-				CV{32, 29}, CV{32, 30}, CV{32, 31}, CV{32, 32},
-				CV{32, 33}, CV{32, 34}, CV{32, 35}, CV{32, 36},
-				CV{32, 37}, CV{32, 38}, CV{32, 39}, CV{32, 40},
-				CV{32, 41}, CV{32, 42}, CV{32, 43}, CV{32, 44},
-				CV{33, 1}, CV{33, 2}, CV{33, 3}, CV{33, 4},
-				CV{33, 5}, CV{33, 6}, CV{33, 7}, CV{33, 8},
-				CV{33, 9}, CV{33, 10}, CV{33, 11}, CV{33, 12},
-				CV{33, 13}, CV{33, 14}, CV{33, 15}, CV{33, 16},
-				CV{33, 17}, CV{33, 18}, CV{33, 19}, CV{33, 20},
-				CV{33, 21}, CV{33, 22}, CV{33, 23}, CV{33, 24},
-				CV{33, 25}, CV{33, 26}, CV{34, 1}, CV{34, 2},
-				CV{34, 3}, CV{34, 4}, CV{34, 5}, CV{34, 6},
-				CV{34, 7}, CV{34, 8}, CV{34, 9}, CV{34, 10},
-				CV{34, 11}, CV{34, 12}, CV{34, 13}, CV{34, 14},
-				CV{34, 15}, CV{34, 16}, CV{34, 17}, CV{34, 18},
-				CV{34, 19}, CV{34, 20}, CV{34, 21}, CV{34, 22},
-				CV{35, 1}, CV{35, 2}, CV{35, 3}, CV{35, 4},
-				CV{35, 5}, CV{35, 6}, CV{35, 7}, CV{35, 8},
-				CV{35, 9}, CV{35, 10}, CV{35, 11}, CV{35, 12},
-				CV{35, 13}, CV{35, 14}, CV{35, 15}, CV{35, 16},
-				CV{35, 17}, CV{35, 18}, CV{35, 19}, CV{36, 1},
-				CV{36, 2}, CV{36, 3}, CV{36, 4}, CV{36, 5},
-				CV{36, 6}, CV{36, 7}, CV{36, 8}, CV{36, 9},
-				CV{36, 10}, CV{36, 11}, CV{36, 12}, CV{36, 13},
-				CV{36, 14}, CV{36, 15}, CV{36, 16}, CV{36, 17},
-				CV{36, 18}, CV{36, 19}, CV{36, 20}, CV{36, 21},
-				CV{36, 22}, CV{36, 23}, CV{36, 24}, CV{36, 25},
-				CV{36, 26}, CV{36, 27}, CV{36, 28}, CV{36, 29},
-				CV{36, 30}, CV{36, 31}, CV{36, 32}, CV{37, 1},
-				CV{37, 2}, CV{37, 3}, CV{37, 4}, CV{37, 5},
-				CV{37, 6}, CV{37, 7}, CV{37, 8}, CV{37, 9},
-				CV{37, 10}, CV{37, 11}, CV{37, 12}, CV{37, 13},
-				CV{37, 14}, CV{37, 15}, CV{37, 16}, CV{37, 17},
-				CV{37, 18}, CV{37, 19}, CV{37, 20}, CV{37, 21},
-				CV{38, 1}, CV{38, 2}, CV{38, 3}, CV{38, 4},
-				CV{38, 5}, CV{38, 6}, CV{38, 7}, CV{38, 8},
-				CV{38, 9}, CV{38, 10}, CV{38, 11}, CV{38, 12},
-				CV{38, 13}, CV{38, 14}, CV{38, 15}, CV{38, 16},
-				CV{38, 17}, CV{38, 18}, CV{38, 19}, CV{38, 20},
-				CV{38, 21}, CV{38, 22}, CV{38, 23}, CV{38, 24},
-				CV{38, 25}, CV{38, 26}, CV{38, 27}, CV{38, 28},
-				CV{39, 1}, CV{39, 2}, CV{39, 3}, CV{39, 4},
-				CV{39, 5}, CV{39, 6}, CV{39, 7}, CV{39, 8},
-				CV{39, 9}, CV{39, 10}, CV{39, 11}, CV{39, 12},
-				CV{39, 13}, CV{39, 14}, CV{39, 15}, CV{39, 16},
-				CV{39, 17}, CV{39, 18}, CV{40, 1}, CV{40, 2},
-				CV{40, 3}, CV{40, 4}, CV{40, 5}, CV{40, 6},
-				CV{40, 7}, CV{40, 8}, CV{40, 9}, CV{40, 10},
-				CV{40, 11}, CV{40, 12}, CV{40, 13}, CV{40, 14},
-				CV{40, 15}, CV{40, 16}, CV{41, 1}, CV{41, 2},
-				CV{41, 3}, CV{41, 4}, CV{41, 5}, CV{41, 6},
-				CV{41, 7}, CV{41, 8}, CV{41, 9}, CV{41, 10},
-				CV{41, 11}, CV{41, 12}, CV{41, 13}, CV{41, 14},
-				CV{41, 15}, CV{41, 16}, CV{41, 17}, CV{41, 18},
-				CV{42, 1}, CV{42, 2}, CV{42, 3}, CV{42, 4},
-				CV{42, 5}, CV{42, 6}, CV{42, 7}, CV{42, 8},
-				CV{42, 9}, CV{42, 10}, CV{42, 11}, CV{42, 12},
-				CV{42, 13}, CV{42, 14}, CV{42, 15}, CV{42, 16},
-				CV{42, 17}, CV{42, 18}, CV{42, 19}, CV{42, 20},
-				CV{42, 21}, CV{42, 22}, CV{43, 1}, CV{43, 2},
-				CV{43, 3}, CV{43, 4}, CV{43, 5}, CV{43, 6},
-				CV{43, 7}, CV{43, 8}, CV{43, 9}, CV{43, 10},
-				CV{43, 11}, CV{43, 12}, CV{43, 13}, CV{44, 1},
-				CV{44, 2}, CV{44, 3}, CV{44, 4}, CV{44, 5},
-				CV{44, 6}, CV{44, 7}, CV{44, 8}, CV{44, 9},
-				CV{44, 10}, CV{44, 11}, CV{44, 12}, CV{44, 13},
-				CV{44, 14}, CV{44, 15}, CV{44, 16}, CV{44, 17},
-				CV{44, 18}, CV{44, 19}, CV{44, 20}, CV{44, 21},
-				CV{44, 22}, CV{44, 23}, CV{44, 24}, CV{44, 25},
-				CV{44, 26}, CV{44, 27}, CV{44, 28}, CV{44, 29},
-				CV{44, 30}, CV{45, 1}, CV{45, 2}, CV{45, 3},
-				CV{45, 4}, CV{45, 5}, CV{46, 1}, CV{46, 2},
-				CV{46, 3}, CV{46, 4}, CV{46, 5}, CV{46, 6},
-				CV{46, 7}, CV{46, 8}, CV{46, 9}, CV{46, 10},
-				CV{46, 11}, CV{46, 12}, CV{46, 13}, CV{46, 14},
-				CV{46, 15}, CV{46, 16}, CV{46, 17}, CV{46, 18},
-				CV{46, 19}, CV{46, 20}, CV{46, 21}, CV{46, 22},
-				CV{46, 23}, CV{46, 24}, CV{46, 25}, CV{46, 26},
-				CV{46, 27}, CV{46, 28}, CV{47, 1}, CV{47, 2},
-				CV{47, 3}, CV{47, 4}, CV{47, 5}, CV{47, 6},
-				CV{47, 7}, CV{48, 1}, CV{48, 2}, CV{48, 3},
-				CV{48, 4}, CV{48, 5}, CV{48, 6}, CV{48, 7},
-				CV{48, 8}, CV{48, 9}, CV{48, 10}, CV{48, 11},
-				CV{48, 12}, CV{48, 13}, CV{48, 14}, CV{48, 15},
-				CV{48, 16}, CV{48, 17}, CV{48, 18}, CV{48, 19},
-				CV{48, 20}, CV{48, 21}, CV{48, 22}, CV{48, 23},
-				CV{48, 24}, CV{48, 25}, CV{48, 26}, CV{48, 27},
-				CV{48, 28}, CV{48, 29}, CV{48, 30}, CV{48, 31},
-				CV{48, 32}, CV{48, 33}, CV{48, 34}, CV{48, 35},
-				CV{48, 36}, CV{48, 37}, CV{48, 38}, CV{48, 39},
-				CV{48, 40}, CV{48, 41}, CV{48, 42}, CV{48, 43},
-				CV{48, 44}, CV{48, 45}, CV{48, 46}, CV{48, 47},
-				CV{49, 1}, CV{49, 2}, CV{49, 3}, CV{49, 4},
-				CV{49, 5}, CV{49, 6}, CV{49, 7}, CV{49, 8},
-				CV{49, 9}, CV{49, 10}, CV{49, 11}, CV{49, 12},
-				CV{49, 13}, CV{49, 14}, CV{49, 15}, CV{49, 16},
-				CV{49, 17}, CV{49, 18}, CV{49, 19}, CV{49, 20},
-				CV{49, 21}, CV{49, 22}, CV{49, 23}, CV{49, 24},
-				CV{49, 25}, CV{49, 26}, CV{49, 27}, CV{49, 28},
-				CV{49, 29}, CV{49, 30}, CV{49, 31}, CV{49, 32},
-				CV{49, 33}, CV{49, 34}, CV{49, 35}, CV{49, 36},
-				CV{49, 37}, CV{49, 38}, CV{49, 39}, CV{50, 1},
-				CV{50, 2}, CV{50, 3}, CV{50, 4}, CV{50, 5},
-				CV{50, 6}, CV{50, 7}, CV{50, 8}, CV{50, 9},
-				CV{50, 10}, CV{50, 11}, CV{50, 12}, CV{50, 13},
-				CV{50, 14}, CV{50, 15}, CV{50, 16}, CV{50, 17},
-				CV{50, 18}, CV{50, 19}, CV{50, 20}, CV{50, 21},
-				CV{50, 22}, CV{50, 23}, CV{50, 24}, CV{50, 25},
-				CV{50, 26}, CV{50, 27}, CV{50, 28}, CV{50, 29},
-				CV{50, 30}, CV{50, 31}, CV{50, 32}, CV{50, 33},
-				CV{50, 34}, CV{50, 35}, CV{50, 36}, CV{50, 37},
-				CV{50, 38}, CV{50, 39}, CV{50, 40}, CV{50, 41},
-				CV{50, 42}, CV{50, 43}, CV{50, 44}, CV{50, 45},
-				CV{50, 46}, CV{51, 1}, CV{51, 2}, CV{51, 3},
-				CV{51, 4}, CV{51, 5}, CV{51, 6}, CV{51, 7},
-				CV{51, 8}, CV{51, 9}, CV{51, 10}, CV{51, 11},
-				CV{51, 12}, CV{51, 13}, CV{51, 14}, CV{51, 15},
-				CV{51, 16}, CV{51, 17}, CV{51, 18}, CV{51, 19},
-				CV{51, 20}, CV{51, 21}, CV{51, 22}, CV{51, 23},
-				CV{51, 24}, CV{51, 25}, CV{51, 26}, CV{51, 27},
-				CV{51, 28}, CV{51, 29}, CV{51, 30}, CV{51, 31},
-				CV{51, 32}, CV{51, 33}, CV{51, 34}, CV{51, 35},
-				CV{51, 36}, CV{51, 37}, CV{51, 38}, CV{51, 39},
-				CV{51, 40}, CV{51, 41}, CV{51, 42}, CV{51, 43},
-				CV{51, 44}, CV{51, 45}, CV{51, 46}, CV{51, 47},
-				CV{51, 48}, CV{51, 49}, CV{51, 50}, CV{51, 51},
-				CV{51, 52}, CV{51, 53}, CV{51, 54}, CV{51, 55},
-				CV{51, 56}, CV{51, 57}, CV{51, 58}, CV{51, 59},
-				CV{51, 60}, CV{51, 61}, CV{51, 62}, CV{51, 63},
-				CV{51, 64}, CV{52, 1}, CV{52, 2}, CV{52, 3},
-				CV{52, 4}, CV{52, 5}, CV{52, 6}, CV{52, 7},
-				CV{52, 8}, CV{52, 9}, CV{52, 10}, CV{52, 11},
-				CV{52, 12}, CV{52, 13}, CV{52, 14}, CV{52, 15},
-				CV{52, 16}, CV{52, 17}, CV{52, 18}, CV{52, 19},
-				CV{52, 20}, CV{52, 21}, CV{52, 22}, CV{52, 23},
-				CV{52, 24}, CV{52, 25}, CV{52, 26}, CV{52, 27},
-				CV{52, 28}, CV{52, 29}, CV{52, 30}, CV{52, 31},
-				CV{52, 32}, CV{52, 33}, CV{52, 34},
+			Runs: []VerseRun{
+				{Chapter: 1, First: 1, Last: 19},
+				{Chapter: 2, First: 1, Last: 37},
+				{Chapter: 3, First: 1, Last: 25},
+				{Chapter: 4, First: 1, Last: 31},
+				{Chapter: 5, First: 1, Last: 31},
+				{Chapter: 6, First: 1, Last: 30},
+				{Chapter: 7, First: 1, Last: 34},
+				{Chapter: 8, First: 1, Last: 22},
+				{Chapter: 9, First: 1, Last: 26},
+				{Chapter: 10, First: 1, Last: 25},
+				{Chapter: 11, First: 1, Last: 23},
+				{Chapter: 12, First: 1, Last: 17},
+				{Chapter: 13, First: 1, Last: 27},
+				{Chapter: 14, First: 1, Last: 22},
+				{Chapter: 15, First: 1, Last: 21},
+				{Chapter: 16, First: 1, Last: 21},
+				{Chapter: 17, First: 1, Last: 27},
+				{Chapter: 18, First: 1, Last: 23},
+				{Chapter: 19, First: 1, Last: 15},
+				{Chapter: 20, First: 1, Last: 18},
+				{Chapter: 21, First: 1, Last: 14},
+				{Chapter: 22, First: 1, Last: 30},
+				{Chapter: 23, First: 1, Last: 40},
+				{Chapter: 24, First: 1, Last: 10},
+				{Chapter: 25, First: 1, Last: 38},
+				{Chapter: 26, First: 1, Last: 24},
+				{Chapter: 27, First: 1, Last: 22},
+				{Chapter: 28, First: 1, Last: 17},
+				{Chapter: 29, First: 1, Last: 32},
+				{Chapter: 30, First: 1, Last: 24},
+				{Chapter: 31, First: 1, Last: 40},
+				{Chapter: 32, First: 1, Last: 44},
+				{Chapter: 33, First: 1, Last: 26},
+				{Chapter: 34, First: 1, Last: 22},
+				{Chapter: 35, First: 1, Last: 19},
+				{Chapter: 36, First: 1, Last: 32},
+				{Chapter: 37, First: 1, Last: 21},
+				{Chapter: 38, First: 1, Last: 28},
+				{Chapter: 39, First: 1, Last: 18},
+				{Chapter: 40, First: 1, Last: 16},
+				{Chapter: 41, First: 1, Last: 18},
+				{Chapter: 42, First: 1, Last: 22},
+				{Chapter: 43, First: 1, Last: 13},
+				{Chapter: 44, First: 1, Last: 30},
+				{Chapter: 45, First: 1, Last: 5},
+				{Chapter: 46, First: 1, Last: 28},
+				{Chapter: 47, First: 1, Last: 7},
+				{Chapter: 48, First: 1, Last: 47},
+				{Chapter: 49, First: 1, Last: 39},
+				{Chapter: 50, First: 1, Last: 46},
+				{Chapter: 51, First: 1, Last: 64},
+				{Chapter: 52, First: 1, Last: 34},
 			},
 		},
 		{
 			Name:      "Lamentations",
+			USFM:      "LAM",
+			Testament: OldTestament,
 			JustVerse: false,
-			Verses: []Verse{CV{1, 1},
-				CV{1, 2}, CV{1, 3}, CV{1, 4}, CV{1, 5},
-				CV{1, 6}, CV{1, 7}, CV{1, 8}, CV{1, 9},
-				CV{1, 10}, CV{1, 11}, CV{1, 12}, CV{1, 13},
-				CV{1, 14}, CV{1, 15}, CV{1, 16}, CV{1, 17},
-				CV{1, 18}, CV{1, 19}, CV{1, 20}, CV{1, 21},
-				CV{1, 22}, CV{2, 1}, CV{2, 2}, CV{2, 3},
-				CV{2, 4}, CV{2, 5}, CV{2, 6}, CV{2, 7},
-				CV{2, 8}, CV{2, 9}, CV{2, 10}, CV{2, 11},
-				CV{2, 12}, CV{2, 13}, CV{2, 14}, CV{2, 15},
-				CV{2, 16}, CV{2, 17}, CV{2, 18}, CV{2, 19},
-				CV{2, 20}, CV{2, 21}, CV{2, 22}, CV{3, 1},
-				CV{3, 2}, CV{3, 3}, CV{3, 4}, CV{3, 5},
-				CV{3, 6}, CV{3, 7}, CV{3, 8}, CV{3, 9},
-				CV{3, 10}, CV{3, 11}, CV{3, 12}, CV{3, 13},
-				CV{3, 14}, CV{3, 15}, CV{3, 16}, CV{3, 17},
-				CV{3, 18}, CV{3, 19}, CV{3, 20}, CV{3, 21},
-				CV{3, 22}, CV{3, 23}, CV{3, 24}, CV{3, 25},
-				CV{3, 26}, CV{3, 27}, CV{3, 28}, CV{3, 29},
-				CV{3, 30}, CV{3, 31}, CV{3, 32}, CV{3, 33},
-				CV{3, 34}, CV{3, 35}, CV{3, 36}, CV{3, 37},
-				CV{3, 38}, CV{3, 39}, CV{3, 40}, CV{3, 41},
-				CV{3, 42}, CV{3, 43}, CV{3, 44}, CV{3, 45},
-				CV{3, 46}, CV{3, 47}, CV{3, 48}, CV{3, 49},
-				CV{3, 50}, CV{3, 51}, CV{3, 52}, CV{3, 53},
-				CV{3, 54}, CV{3, 55}, CV{3, 56}, CV{3, 57},
-				CV{3, 58}, CV{3, 59}, CV{3, 60}, CV{3, 61},
-				CV{3, 62}, CV{3, 63}, CV{3, 64}, CV{3, 65},
-				CV{3, 66}, CV{4, 1}, CV{4, 2}, CV{4, 3},
-				CV{4, 4}, CV{4, 5}, CV{4, 6}, CV{4, 7},
-				CV{4, 8}, CV{4, 9}, CV{4, 10}, CV{4, 11},
-				CV{4, 12}, CV{4, 13}, CV{4, 14}, CV{4, 15},
-				CV{4, 16}, CV{4, 17}, CV{4, 18}, CV{4, 19},
-				CV{4, 20}, CV{4, 21}, CV{4, 22}, CV{5, 1},
-				CV{5, 2}, CV{5, 3}, CV{5, 4}, CV{5, 5},
-				CV{5, 6}, CV{5, 7}, CV{5, 8}, CV{5, 9},
-				CV{5, 10}, CV{5, 11}, CV{5, 12}, CV{5, 13},
-				CV{5, 14}, CV{5, 15}, CV{5, 16}, CV{5, 17},
-				CV{5, 18}, CV{5, 19}, CV{5, 20}, CV{5, 21},
-				CV{5, 22},
+			Runs: []VerseRun{
+				{Chapter: 1, First: 1, Last: 22},
+				{Chapter: 2, First: 1, Last: 22},
+				{Chapter: 3, First: 1, Last: 66},
+				{Chapter: 4, First: 1, Last: 22},
+				{Chapter: 5, First: 1, Last: 22},
 			},
 		},
 		{
 			Name:      "Ezekiel",
+			USFM:      "EZK",
+			Testament: OldTestament,
 			JustVerse: false,
-			Verses: []Verse{CV{1, 1},
-				CV{1, 2}, CV{1, 3}, CV{1, 4}, CV{1, 5},
-				CV{1, 6}, CV{1, 7}, CV{1, 8}, CV{1, 9},
-				CV{1, 10}, CV{1, 11}, CV{1, 12}, CV{1, 13},
-				CV{1, 14}, CV{1, 15}, CV{1, 16}, CV{1, 17},
-				CV{1, 18}, CV{1, 19}, CV{1, 20}, CV{1, 21},
-				CV{1, 22}, CV{1, 23}, CV{1, 24}, CV{1, 25},
-				CV{1, 26}, CV{1, 27}, CV{1, 28}, CV{2, 1},
-				CV{2, 2}, CV{2, 3}, CV{2, 4}, CV{2, 5},
-				CV{2, 6}, CV{2, 7}, CV{2, 8}, CV{2, 9},
-				CV{2, 10}, CV{3, 1}, CV{3, 2}, CV{3, 3},
-				CV{3, 4}, CV{3, 5}, CV{3, 6}, CV{3, 7},
-				CV{3, 8}, CV{3, 9}, CV{3, 10}, CV{3, 11},
-				CV{3, 12}, CV{3, 13}, CV{3, 14}, CV{3, 15},
-				CV{3, 16}, CV{3, 17}, CV{3, 18}, CV{3, 19},
-				CV{3, 20}, CV{3, 21}, CV{3, 22}, CV{3, 23},
-				CV{3, 24}, CV{3, 25}, CV{3, 26}, CV{3, 27},
-				CV{4, 1}, CV{4, 2}, CV{4, 3}, CV{4, 4},
-				CV{4, 5}, CV{4, 6}, CV{4, 7}, CV{4, 8},
-				CV{4, 9}, CV{4, 10}, CV{4, 11}, CV{4, 12},
-				CV{4, 13}, CV{4, 14}, CV{4, 15}, CV{4, 16},
-				CV{4, 17}, CV{5, 1}, CV{5, 2}, CV{5, 3},
-				CV{5, 4}, CV{5, 5}, CV{5, 6}, CV{5, 7},
-				CV{5, 8}, CV{5, 9}, CV{5, 10}, CV{5, 11},
-				CV{5, 12}, CV{5, 13}, CV{5, 14}, CV{5, 15},
-				CV{5, 16}, CV{5, 17}, CV{6, 1}, CV{6, 2},
-				CV{6, 3}, CV{6, 4}, CV{6, 5}, CV{6, 6},
-				CV{6, 7}, CV{6, 8}, CV{6, 9}, CV{6, 10},
-				CV{6, 11}, CV{6, 12}, CV{6, 13}, CV{6, 14},
-				CV{7, 1}, CV{7, 2}, CV{7, 3}, CV{7, 4},
-				CV{7, 5}, CV{7, 6}, CV{7, 7}, CV{7, 8},
-				CV{7, 9}, CV{7, 10}, CV{7, 11}, CV{7, 12},
-				CV{7, 13}, CV{7, 14}, CV{7, 15}, CV{7, 16},
-				CV{7, 17}, CV{7, 18}, CV{7, 19}, CV{7, 20},
-				CV{7, 21}, CV{7, 22}, CV{7, 23}, CV{7, 24},
-				CV{7, 25}, CV{7, 26}, CV{7, 27}, CV{8, 1},
-				CV{8, 2}, CV{8, 3}, CV{8, 4}, CV{8, 5},
-				CV{8, 6}, CV{8, 7}, CV{8, 8}, CV{8, 9},
-				CV{8, 10}, CV{8, 11}, CV{8, 12}, CV{8, 13},
-				CV{8, 14}, CV{8, 15}, CV{8, 16}, CV{8, 17},
-				CV{8, 18}, CV{9, 1}, CV{9, 2}, CV{9, 3},
-				CV{9, 4}, CV{9, 5}, CV{9, 6}, CV{9, 7},
-				CV{9, 8}, CV{9, 9}, CV{9, 10}, CV{9, 11},
-				CV{10, 1}, CV{10, 2}, CV{10, 3}, CV{10, 4},
-				CV{10, 5}, CV{10, 6}, CV{10, 7}, CV{10, 8},
-				CV{10, 9}, CV{10, 10}, CV{10, 11}, CV{10, 12},
-				CV{10, 13}, CV{10, 14}, CV{10, 15}, CV{10, 16},
-				CV{10, 17}, CV{10, 18}, CV{10, 19}, CV{10, 20},
-				CV{10, 21}, CV{10, 22}, CV{11, 1}, CV{11, 2},
-				CV{11, 3}, CV{11, 4}, CV{11, 5}, CV{11, 6},
-				CV{11, 7}, CV{11, 8}, CV{11, 9}, CV{11, 10},
-				CV{11, 11}, CV{11, 12}, CV{11, 13}, CV{11, 14},
-				CV{11, 15}, CV{11, 16}, CV{11, 17}, CV{11, 18},
-				CV{11, 19}, CV{11, 20}, CV{11, 21}, CV{11, 22},
-				CV{11, 23}, CV{11, 24}, CV{11, 25}, CV{12, 1},
-				CV{12, 2}, CV{12, 3}, CV{12, 4}, CV{12, 5},
-				CV{12, 6}, CV{12, 7}, CV{12, 8}, CV{12, 9},
-				CV{12, 10}, CV{12, 11}, CV{12, 12}, CV{12, 13},
-				CV{12, 14}, CV{12, 15}, CV{12, 16}, CV{12, 17},
-				CV{12, 18}, CV{12, 19}, CV{12, 20}, CV{12, 21},
-				CV{12, 22}, CV{12, 23}, CV{12, 24}, CV{12, 25},
-				CV{12, 26}, CV{12, 27}, CV{12, 28}, CV{13, 1},
-				CV{13, 2}, CV{13, 3}, CV{13, 4}, CV{13, 5},
-				CV{13, 6}, CV{13, 7}, CV{13, 8}, CV{13, 9},
-				CV{13, 10}, CV{13, 11}, CV{13, 12}, CV{13, 13},
-				CV{13, 14}, CV{13, 15}, CV{13, 16}, CV{13, 17},
-				CV{13, 18}, CV{13, 19}, CV{13, 20}, CV{13, 21},
-				CV{13, 22}, CV{13, 23}, CV{14, 1}, CV{14, 2},
-				CV{14, 3}, CV{14, 4}, CV{14, 5}, CV{14, 6},
-				CV{14, 7}, CV{14, 8}, CV{14, 9}, CV{14, 10},
-				CV{14, 11}, CV{14, 12}, CV{14, 13}, CV{14, 14},
-				CV{14, 15}, CV{14, 16}, CV{14, 17}, CV{14, 18},
-				CV{14, 19}, CV{14, 20}, CV{14, 21}, CV{14, 22},
-				CV{14, 23}, CV{15, 1}, CV{15, 2}, CV{15, 3},
-				CV{15, 4}, CV{15, 5}, CV{15, 6}, CV{15, 7},
-				CV{15, 8}, CV{16, 1}, CV{16, 2}, CV{16, 3},
-				CV{16, 4}, CV{16, 5}, CV{16, 6}, CV{16, 7},
-				CV{16, 8}, CV{16, 9}, CV{16, 10}, CV{16, 11},
-				CV{16, 12}, CV{16, 13}, CV{16, 14}, CV{16, 15},
-				CV{16, 16}, CV{16, 17}, CV{16, 18}, CV{16, 19},
-				CV{16, 20}, CV{16, 21}, CV{16, 22}, CV{16, 23},
-				CV{16, 24}, CV{16, 25}, CV{16, 26}, CV{16, 27},
-				CV{16, 28}, CV{16, 29}, CV{16, 30}, CV{16, 31},
-				CV{16, 32}, CV{16, 33}, CV{16, 34}, CV{16, 35},
-				CV{16, 36}, CV{16, 37}, CV{16, 38}, CV{16, 39},
-				CV{16, 40}, CV{16, 41}, CV{16, 42}, CV{16, 43},
-				CV{16, 44}, CV{16, 45}, CV{16, 46}, CV{16, 47},
-				CV{16, 48}, CV{16, 49}, CV{16, 50}, CV{16, 51},
-				CV{16, 52}, CV{16, 53}, CV{16, 54}, CV{16, 55},
-				CV{16, 56}, CV{16, 57}, CV{16, 58}, CV{16, 59},
-				CV{16, 60}, CV{16, 61}, CV{16, 62}, CV{16, 63},
-				CV{17, 1}, CV{17, 2}, CV{17, 3}, CV{17, 4},
-				CV{17, 5}, CV{17, 6}, CV{17, 7}, CV{17, 8},
-				CV{17, 9}, CV{17, 10}, CV{17, 11}, CV{17, 12},
-				CV{17, 13}, CV{17, 14}, CV{17, 15}, CV{17, 16},
-				CV{17, 17}, CV{17, 18}, CV{17, 19}, CV{17, 20},
-				CV{17, 21}, CV{17, 22}, CV{17, 23}, CV{17, 24},
-				CV{18, 1}, CV{18, 2}, CV{18, 3}, CV{18, 4},
-				CV{18, 5}, CV{18, 6}, CV{18, 7}, CV{18, 8},
-				CV{18, 9}, CV{18, 10}, CV{18, 11}, CV{18, 12},
-				CV{18, 13}, CV{18, 14}, CV{18, 15}, CV{18, 16},
-				CV{18, 17}, CV{18, 18}, CV{18, 19}, CV{18, 20},
-				CV{18, 21}, CV{18, 22}, CV{18, 23}, CV{18, 24},
-				CV{18, 25}, CV{18, 26}, CV{18, 27}, CV{18, 28},
-				CV{18, 29}, CV{18, 30}, CV{18, 31}, CV{18, 32},
-				CV{19, 1}, CV{19, 2}, CV{19, 3}, CV{19, 4},
-				CV{19, 5}, CV{19, 6}, CV{19, 7}, CV{19, 8},
-				CV{19, 9}, CV{19, 10}, CV{19, 11}, CV{19, 12},
-				CV{19, 13}, CV{19, 14}, CV{20, 1}, CV{20, 2},
-				CV{20, 3}, CV{20, 4}, CV{20, 5}, CV{20, 6},
-				CV{20, 7}, CV{20, 8}, CV{20, 9}, CV{20, 10},
-				CV{20, 11}, CV{20, 12}, CV{20, 13}, CV{20, 14},
-				CV{20, 15}, CV{20, 16}, CV{20, 17}, CV{20, 18},
-				CV{20, 19}, CV{20, 20}, CV{20, 21}, CV{20, 22},
-				CV{20, 23}, CV{20, 24}, CV{20, 25}, CV{20, 26},
-				CV{20, 27}, CV{20, 28}, CV{20, 29}, CV{20, 30},
-				CV{20, 31}, CV{20, 32}, CV{20, 33}, CV{20, 34},
-				CV{20, 35}, CV{20, 36}, CV{20, 37}, CV{20, 38},
-				CV{20, 39}, CV{20, 40}, CV{20, 41}, CV{20, 42},
-				CV{20, 43}, CV{20, 44}, CV{20, 45}, CV{20, 46},
-				CV{20, 47}, CV{20, 48}, CV{20, 49}, CV{21, 1},
-				CV{21, 2}, CV{21, 3}, CV{21, 4}, CV{21, 5},
-				CV{21, 6}, CV{21, 7}, CV{21, 8}, CV{21, 9},
-				CV{21, 10}, CV{21, 11}, CV{21, 12}, CV{21, 13},
-				CV{21, 14}, CV{21, 15}, CV{21, 16}, CV{21, 17},
-				CV{21, 18}, CV{21, 19}, CV{21, 20}, CV{21, 21},
-				CV{21, 22}, CV{21, 23}, CV{21, 24}, CV{21, 25},
-				CV{21, 26}, CV{21, 27}, CV{21, 28}, CV{21, 29},
-				CV{21, 30}, CV{21, 31}, CV{21, 32}, CV{22, 1},
-				CV{22, 2}, CV{22, 3}, CV{22, 4}, CV{22, 5},
-				CV{22, 6}, CV{22, 7}, CV{22, 8}, CV{22, 9},
-				CV{22, 10}, CV{22, 11}, CV{22, 12}, CV{22, 13},
-				CV{22, 14}, CV{22, 15}, CV{22, 16}, CV{22, 17},
-				CV{22, 18}, CV{22, 19}, CV{22, 20}, CV{22, 21},
-				CV{22, 22}, CV{22, 23}, CV{22, 24}, CV{22, 25},
-				CV{22, 26}, CV{22, 27}, CV{22, 28}, CV{22, 29},
-				CV{22, 30}, CV{22, 31}, CV{23, 1}, CV{23, 2},
-				CV{23, 3}, CV{23, 4}, CV{23, 5}, CV{23, 6},
-				CV{23, 7}, CV{23, 8}, CV{23, 9}, CV{23, 10},
-				CV{23, 11}, CV{23, 12}, CV{23, 13}, CV{23, 14},
-				CV{23, 15}, CV{23, 16}, CV{23, 17}, CV{23, 18},
-				CV{23, 19}, CV{23, 20}, CV{23, 21}, CV{23, 22},
-				CV{23, 23}, CV{23, 24}, CV{23, 25}, CV{23, 26},
-				CV{23, 27}, CV{23, 28}, CV{23, 29}, CV{23, 30},
-				CV{23, 31}, CV{23, 32}, CV{23, 33}, CV{23, 34},
-				CV{23, 35}, CV{23, 36}, CV{23, 37}, CV{23, 38},
-				CV{23, 39}, CV{23, 40}, CV{23, 41}, CV{23, 42},
-				CV{23, 43}, CV{23, 44}, CV{23, 45}, CV{23, 46},
-				CV{23, 47}, CV{23, 48}, CV{23, 49}, CV{24, 1},
-				CV{24, 2}, CV{24, 3}, CV{24, 4}, CV{24, 5},
-				CV{24, 6}, CV{24, 7}, CV{24, 8}, CV{24, 9},
-				CV{24, 10}, CV{24, 11}, CV{24, 12}, CV{24, 13},
-				CV{24, 14}, CV{24, 15}, CV{24, 16}, CV{24, 17},
-				CV{24, 18}, CV{24, 19}, CV{24, 20}, CV{24, 21},
-				CV{24, 22}, CV{24, 23}, CV{24, 24}, CV{24, 25},
-				CV{24, 26}, CV{24, 27}, CV{25, 1}, CV{25, 2},
-				CV{25, 3}, CV{25, 4}, CV{25, 5}, CV{25, 6},
-				CV{25, 7}, CV{25, 8}, CV{25, 9}, CV{25, 10},
-				CV{25, 11}, CV{25, 12}, CV{25, 13}, CV{25, 14},
-				CV{25, 15}, CV{25, 16}, CV{25, 17}, CV{26, 1},
-				CV{26, 2}, CV{26, 3}, CV{26, 4}, CV{26, 5},
-				CV{26, 6}, CV{26, 7}, CV{26, 8}, CV{26, 9},
-				CV{26, 10}, CV{26, 11}, CV{26, 12}, CV{26, 13},
-				CV{26, 14}, CV{26, 15}, CV{26, 16}, CV{26, 17},
-				CV{26, 18}, CV{26, 19}, CV{26, 20}, CV{26, 21},
-				CV{27, 1}, CV{27, 2}, CV{27, 3}, CV{27, 4},
-				CV{27, 5}, CV{27, 6}, CV{27, 7}, CV{27, 8},
-				CV{27, 9}, CV{27, 10}, CV{27, 11}, CV{27, 12},
-				CV{27, 13}, CV{27, 14}, CV{27, 15}, CV{27, 16},
-				CV{27, 17}, CV{27, 18}, CV{27, 19}, CV{27, 20},
-				CV{27, 21}, CV{27, 22}, CV{27, 23}, CV{27, 24},
-				CV{27, 25}, CV{27, 26}, CV{27, 27}, CV{27, 28},
-				CV{27, 29}, CV{27, 30}, CV{27, 31}, CV{27, 32},
-				CV{27, 33}, CV{27, 34}, CV{27, 35}, CV{27, 36},
-				CV{28, 1}, CV{28, 2}, CV{28, 3}, CV{28, 4},
-				CV{28, 5}, CV{28, 6}, CV{28, 7}, CV{28, 8},
-				CV{28, 9}, CV{28, 10}, CV{28, 11}, CV{28, 12},
-				CV{28, 13}, CV{28, 14}, CV{28, 15}, CV{28, 16},
-				CV{28, 17}, CV{28, 18}, CV{28, 19}, CV{28, 20},
-				CV{28, 21}, CV{28, 22}, CV{28, 23}, CV{28, 24},
-				CV{28, 25}, CV{28, 26}, CV{29, 1}, CV{29, 2},
-				CV{29, 3}, CV{29, 4}, CV{29, 5}, CV{29, 6},
-				CV{29, 7}, CV{29, 8}, CV{29, 9}, CV{29, 10},
-				CV{29, 11}, CV{29, 12}, CV{29, 13}, CV{29, 14},
-				CV{29, 15}, CV{29, 16}, CV{29, 17}, CV{29, 18},
-				CV{29, 19}, CV{29, 20}, CV{29, 21}, CV{30, 1},
-				CV{30, 2}, CV{30, 3}, CV{30, 4}, CV{30, 5},
-				CV{30, 6}, CV{30, 7}, CV{30, 8}, CV{30, 9},
-				CV{30, 10}, CV{30, 11}, CV{30, 12}, CV{30, 13},
-				CV{30, 14}, CV{30, 15}, CV{30, 16}, CV{30, 17},
-				CV{30, 18}, CV{30, 19}, CV{30, 20}, CV{30, 21},
-				CV{30, 22}, CV{30, 23}, CV{30, 24}, CV{30, 25},
-				CV{30, 26}, CV{31, 1}, CV{31, 2}, CV{31, 3},
-				CV{31, 4}, CV{31, 5}, CV{31, 6}, CV{31, 7},
-				CV{31, 8}, CV{31, 9}, CV{31, 10}, CV{31, 11},
-				CV{31, 12}, CV{31, 13}, CV{31, 14}, CV{31, 15},
-				CV{31, 16}, CV{31, 17}, CV{31, 18}, CV{32, 1},
-				CV{32, 2}, CV{32, 3}, CV{32, 4}, CV{32, 5},
-				CV{32, 6}, CV{32, 7}, CV{32, 8}, CV{32, 9},
-				CV{32, 10}, CV{32, 11}, CV{32, 12}, CV{32, 13},
-				CV{32, 14}, CV{32, 15}, CV{32, 16}, CV{32, 17},
-				CV{32, 18}, CV{32, 19}, CV{32, 20}, CV{32, 21},
-				CV{32, 22}, CV{32, 23}, CV{32, 24}, CV{32, 25},
-				CV{32, 26}, CV{32, 27}, CV{32, 28}, CV{32, 29},
-				CV{32, 30}, CV{32, 31}, CV{32, 32}, CV{33, 1},
-				CV{33, 2}, CV{33, 3}, CV{33, 4}, CV{33, 5},
-				CV{33, 6}, CV{33, 7}, CV{33, 8}, CV{33, 9},
-				CV{33, 10}, CV{33, 11}, CV{33, 12}, CV{33, 13},
-				CV{33, 14}, CV{33, 15}, CV{33, 16}, CV{33, 17},
-				CV{33, 18}, CV{33, 19}, CV{33, 20}, CV{33, 21},
-				CV{33, 22}, CV{33, 23}, CV{33, 24}, CV{33, 25},
-				CV{33, 26}, CV{33, 27}, CV{33, 28}, CV{33, 29},
-				CV{33, 30}, CV{33, 31}, CV{33, 32}, CV{33, 33},
-				CV{34, 1}, CV{34, 2}, CV{34, 3}, CV{34, 4},
-				CV{34, 5}, CV{34, 6}, CV{34, 7}, CV{34, 8},
-				CV{34, 9}, CV{34, 10}, CV{34, 11}, CV{34, 12},
-				CV{34, 13}, CV{34, 14}, CV{34, 15}, CV{34, 16},
-				CV{34, 17}, CV{34, 18}, CV{34, 19}, CV{34, 20},
-				CV{34, 21}, CV{34, 22}, CV{34, 23}, CV{34, 24},
-				CV{34, 25}, CV{34, 26}, CV{34, 27}, CV{34, 28},
-				CV{34, 29}, CV{34, 30}, CV{34, 31}, CV{35, 1},
-				CV{35, 2}, CV{35, 3}, CV{35, 4}, CV{35, 5},
-				CV{35, 6}, CV{35, 7}, CV{35, 8}, CV{35, 9},
-				CV{35, 10}, CV{35, 11}, CV{35, 12}, CV{35, 13},
-				CV{35, 14}, CV{35, 15}, CV{36, 1}, CV{36, 2},
-				CV{36, 3}, CV{36, 4}, CV{36, 5}, CV{36, 6},
-				CV{36, 7}, CV{36, 8}, CV{36, 9}, CV{36, 10},
-				CV{36, 11}, CV{36, 12}, CV{36, 13}, CV{36, 14},
-				CV{36, 15}, CV{36, 16}, CV{36, 17}, CV{36, 18},
-				CV{36, 19}, CV{36, 20}, CV{36, 21}, CV{36, 22},
-				CV{36, 23}, CV{36, 24}, CV{36, 25}, CV{36, 26},
-				CV{36, 27}, CV{36, 28}, CV{36, 29}, CV{36, 30},
-				CV{36, 31}, CV{36, 32}, CV{36, 33}, CV{36, 34},
-				CV{36, 35}, CV{36, 36}, CV{36, 37}, CV{36, 38},
-				CV{37, 1}, CV{37, 2}, CV{37, 3}, CV{37, 4},
-				CV{37, 5}, CV{37, 6}, CV{37, 7}, CV{37, 8},
-				CV{37, 9}, CV{37, 10}, CV{37, 11}, CV{37, 12},
-				CV{37, 13}, CV{37, 14}, CV{37, 15}, CV{37, 16},
-				CV{37, 17}, CV{37, 18}, CV{37, 19}, CV{37, 20},
-				CV{37, 21}, CV{37, 22}, CV{37, 23}, CV{37, 24},
-				CV{37, 25}, CV{37, 26}, CV{37, 27}, CV{37, 28},
-				CV{38, 1}, CV{38, 2}, CV{38, 3}, CV{38, 4},
-				CV{38, 5}, CV{38, 6}, CV{38, 7}, CV{38, 8},
-				CV{38, 9}, CV{38, 10}, CV{38, 11}, CV{38, 12},
-				CV{38, 13}, CV{38, 14}, CV{38, 15}, CV{38, 16},
-				CV{38, 17}, CV{38, 18}, CV{38, 19}, CV{38, 20},
-				CV{38, 21}, CV{38, 22}, CV{38, 23}, CV{39, 1},
-				CV{39, 2}, CV{39, 3}, CV{39, 4}, CV{39, 5},
-				CV{39, 6}, CV{39, 7}, CV{39, 8}, CV{39, 9},
-				CV{39, 10}, CV{39, 11}, CV{39, 12}, CV{39, 13},
-				CV{39, 14}, CV{39, 15}, CV{39, 16}, CV{39, 17},
-				CV{39, 18}, CV{39, 19}, CV{39, 20}, CV{39, 21},
-				CV{39, 22}, CV{39, 23}, CV{39, 24}, CV{39, 25},
-				CV{39, 26}, CV{39, 27}, CV{39, 28}, CV{39, 29},
-				CV{40, 1}, CV{40, 2}, CV{40, 3}, CV{40, 4},
-				CV{40, 5}, CV{40, 6}, CV{40, 7}, CV{40, 8},
-				CV{40, 9}, CV{40, 10}, CV{40, 11}, CV{40, 12},
-				CV{40, 13}, CV{40, 14}, CV{40, 15}, CV{40, 16},
-				CV{40, 17}, CV{40, 18}, CV{40, 19}, CV{40, 20},
-				CV{40, 21}, CV{40, 22}, CV{40, 23}, CV{40, 24},
-				CV{40, 25}, CV{40, 26}, CV{40, 27}, CV{40, 28},
-				CV{40, 29}, CV{40, 30}, CV{40, 31}, CV{40, 32},
-				CV{40, 33}, CV{40, 34}, CV{40, 35}, CV{40, 36},
-				CV{40, 37}, CV{40, 38}, CV{40, 39}, CV{40, 40},
-				CV{40, 41}, CV{40, 42}, CV{40, 43}, CV{40, 44},
-				CV{40, 45}, CV{40, 46}, CV{40, 47}, CV{40, 48},
-				CV{40, 49}, CV{41, 1}, CV{41, 2}, CV{41, 3},
-				CV{41, 4}, CV{41, 5}, CV{41, 6}, CV{41, 7},
-				CV{41, 8}, CV{41, 9}, CV{41, 10}, CV{41, 11},
-				CV{41, 12}, CV{41, 13}, CV{41, 14}, CV{41, 15},
-				CV{41, 16}, CV{41, 17}, CV{41, 18}, CV{41, 19},
-				CV{41, 20}, CV{41, 21}, CV{41, 22}, CV{41, 23},
-				CV{41, 24}, CV{41, 25}, CV{41, 26}, CV{42, 1},
-				CV{42, 2}, CV{42, 3}, CV{42, 4}, CV{42, 5},
-				CV{42, 6}, CV{42, 7}, CV{42, 8}, CV{42, 9},
-				CV{42, 10}, CV{42, 11}, CV{42, 12}, CV{42, 13},
-				CV{42, 14}, CV{42, 15}, CV{42, 16}, CV{42, 17},
-				CV{42, 18}, CV{42, 19}, CV{42, 20}, CV{43, 1},
-				CV{43, 2}, CV{43, 3}, CV{43, 4}, CV{43, 5},
-				CV{43, 6}, CV{43, 7}, CV{43, 8}, CV{43, 9},
-				CV{43, 10}, CV{43, 11}, CV{43, 12}, CV{43, 13},
-				CV{43, 14}, CV{43, 15}, CV{43, 16}, CV{43, 17},
-				CV{43, 18}, CV{43, 19}, CV{43, 20}, CV{43, 21},
-				CV{43, 22}, CV{43, 23}, CV{43, 24}, CV{43, 25},
-				CV{43, 26}, CV{43, 27}, CV{44, 1}, CV{44, 2},
-				CV{44, 3}, CV{44, 4}, CV{44, 5}, CV{44, 6},
-				CV{44, 7}, CV{44, 8}, CV{44, 9}, CV{44, 10},
-				CV{44, 11}, CV{44, 12}, CV{44, 13}, CV{44, 14},
-				CV{44, 15}, CV{44, 16}, CV{44, 17}, CV{44, 18},
-				CV{44, 19}, CV{44, 20}, CV{44, 21}, CV{44, 22},
-				CV{44, 23}, CV{44, 24}, CV{44, 25}, CV{44, 26},
-				CV{44, 27}, CV{44, 28}, CV{44, 29}, CV{44, 30},
-				CV{44, 31}, CV{45, 1}, CV{45, 2}, CV{45, 3},
-				CV{45, 4}, CV{45, 5}, CV{45, 6}, CV{45, 7},
-				CV{45, 8}, CV{45, 9}, CV{45, 10}, CV{45, 11},
-				CV{45, 12}, CV{45, 13}, CV{45, 14}, CV{45, 15},
-				CV{45, 16}, CV{45, 17}, CV{45, 18}, CV{45, 19},
-				CV{45, 20}, CV{45, 21}, CV{45, 22}, CV{45, 23},
-				CV{45, 24}, CV{45, 25}, CV{46, 1}, CV{46, 2},
-				CV{46, 3}, CV{46, 4}, CV{46, 5}, CV{46, 6},
-				CV{46, 7}, CV{46, 8}, CV{46, 9}, CV{46, 10},
-				CV{46, 11}, CV{46, 12}, CV{46, 13}, CV{46, 14},
-				CV{46, 15}, CV{46, 16}, CV{46, 17}, CV{46, 18},
-				CV{46, 19}, CV{46, 20}, CV{46, 21}, CV{46, 22},
-				CV{46, 23}, CV{46, 24}, CV{47, 1}, CV{47, 2},
-				CV{47, 3}, CV{47, 4}, CV{47, 5}, CV{47, 6},
-				CV{47, 7}, CV{47, 8}, CV{47, 9}, CV{47, 10},
-				CV{47, 11}, CV{47, 12}, CV{47, 13}, CV{47, 14},
-				CV{47, 15}, CV{47, 16}, CV{47, 17}, CV{47, 18},
-				CV{47, 19}, CV{47, 20}, CV{47, 21}, CV{47, 22},
-				CV{47, 23}, CV{48, 1}, CV{48, 2}, CV{48, 3},
-				CV{48, 4}, CV{48, 5}, CV{48, 6}, CV{48, 7},
-				CV{48, 8}, CV{48, 9}, CV{48, 10}, CV{48, 11},
-				CV{48, 12}, CV{48, 13}, CV{48, 14}, CV{48, 15},
-				CV{48, 16}, CV{48, 17}, CV{48, 18}, CV{48, 19},
-				CV{48, 20}, CV{48, 21}, CV{48, 22}, CV{48, 23},
-				CV{48, 24}, CV{48, 25}, CV{48, 26}, CV{48, 27},
-				CV{48, 28}, CV{48, 29}, CV{48, 30}, CV{48, 31},
-				CV{48, 32}, CV{48, 33}, CV{48, 34}, CV{48, 35},
+			Runs: []VerseRun{
+				{Chapter: 1, First: 1, Last: 28},
+				{Chapter: 2, First: 1, Last: 10},
+				{Chapter: 3, First: 1, Last: 27},
+				{Chapter: 4, First: 1, Last: 17},
+				{Chapter: 5, First: 1, Last: 17},
+				{Chapter: 6, First: 1, Last: 14},
+				{Chapter: 7, First: 1, Last: 27},
+				{Chapter: 8, First: 1, Last: 18},
+				{Chapter: 9, First: 1, Last: 11},
+				{Chapter: 10, First: 1, Last: 22},
+				{Chapter: 11, First: 1, Last: 25},
+				{Chapter: 12, First: 1, Last: 28},
+				{Chapter: 13, First: 1, Last: 23},
+				{Chapter: 14, First: 1, Last: 23},
+				{Chapter: 15, First: 1, Last: 8},
+				{Chapter: 16, First: 1, Last: 63},
+				{Chapter: 17, First: 1, Last: 24},
+				{Chapter: 18, First: 1, Last: 32},
+				{Chapter: 19, First: 1, Last: 14},
+				{Chapter: 20, First: 1, Last: 49},
+				{Chapter: 21, First: 1, Last: 32},
+				{Chapter: 22, First: 1, Last: 31},
+				{Chapter: 23, First: 1, Last: 49},
+				{Chapter: 24, First: 1, Last: 27},
+				{Chapter: 25, First: 1, Last: 17},
+				{Chapter: 26, First: 1, Last: 21},
+				{Chapter: 27, First: 1, Last: 36},
+				{Chapter: 28, First: 1, Last: 26},
+				{Chapter: 29, First: 1, Last: 21},
+				{Chapter: 30, First: 1, Last: 26},
+				{Chapter: 31, First: 1, Last: 18},
+				{Chapter: 32, First: 1, Last: 32},
+				{Chapter: 33, First: 1, Last: 33},
+				{Chapter: 34, First: 1, Last: 31},
+				{Chapter: 35, First: 1, Last: 15},
+				{Chapter: 36, First: 1, Last: 38},
+				{Chapter: 37, First: 1, Last: 28},
+				{Chapter: 38, First: 1, Last: 23},
+				{Chapter: 39, First: 1, Last: 29},
+				{Chapter: 40, First: 1, Last: 49},
+				{Chapter: 41, First: 1, Last: 26},
+				{Chapter: 42, First: 1, Last: 20},
+				{Chapter: 43, First: 1, Last: 27},
+				{Chapter: 44, First: 1, Last: 31},
+				{Chapter: 45, First: 1, Last: 25},
+				{Chapter: 46, First: 1, Last: 24},
+				{Chapter: 47, First: 1, Last: 23},
+				{Chapter: 48, First: 1, Last: 35},
 			},
 		},
 		{
 			Name:      "Daniel",
+			USFM:      "DAN",
+			Testament: OldTestament,
 			JustVerse: false,
-			Verses: []Verse{CV{1, 1},
-				CV{1, 2}, CV{1, 3}, CV{1, 4}, CV{1, 5},
-				CV{1, 6}, CV{1, 7}, CV{1, 8}, CV{1, 9},
-				CV{1, 10}, CV{1, 11}, CV{1, 12}, CV{1, 13},
-				CV{1, 14}, CV{1, 15}, CV{1, 16}, CV{1, 17},
-				CV{1, 18}, CV{1, 19}, CV{1, 20}, CV{1, 21},
-				CV{2, 1}, CV{2, 2}, CV{2, 3}, CV{2, 4},
-				CV{2, 5}, CV{2, 6}, CV{2, 7}, CV{2, 8},
-				CV{2, 9}, CV{2, 10}, CV{2, 11}, CV{2, 12},
-				CV{2, 13}, CV{2, 14}, CV{2, 15}, CV{2, 16},
-				CV{2, 17}, CV{2, 18}, CV{2, 19}, CV{2, 20},
-				CV{2, 21}, CV{2, 22}, CV{2, 23}, CV{2, 24},
-				CV{2, 25}, CV{2, 26}, CV{2, 27}, CV{2, 28},
-				CV{2, 29}, CV{2, 30}, CV{2, 31}, CV{2, 32},
-				CV{2, 33}, CV{2, 34}, CV{2, 35}, CV{2, 36},
-				CV{2, 37}, CV{2, 38}, CV{2, 39}, CV{2, 40},
-				CV{2, 41}, CV{2, 42}, CV{2, 43}, CV{2, 44},
-				CV{2, 45}, CV{2, 46}, CV{2, 47}, CV{2, 48},
-				CV{2, 49}, CV{3, 1}, CV{3, 2}, CV{3, 3},
-				CV{3, 4}, CV{3, 5}, CV{3, 6}, CV{3, 7},
-				CV{3, 8}, CV{3, 9}, CV{3, 10}, CV{3, 11},
-				CV{3, 12}, CV{3, 13}, CV{3, 14}, CV{3, 15},
-				CV{3, 16}, CV{3, 17}, CV{3, 18}, CV{3, 19},
-				CV{3, 20}, CV{3, 21}, CV{3, 22}, CV{3, 23},
-				CV{3, 24}, CV{3, 25}, CV{3, 26}, CV{3, 27},
-				CV{3, 28}, CV{3, 29}, CV{3, 30}, CV{4, 1},
-				CV{4, 2}, CV{4, 3}, CV{4, 4}, CV{4, 5},
-				CV{4, 6}, CV{4, 7}, CV{4, 8}, CV{4, 9},
-				CV{4, 10}, CV{4, 11}, CV{4, 12}, CV{4, 13},
-				CV{4, 14}, CV{4, 15}, CV{4, 16}, CV{4, 17},
-				CV{4, 18}, CV{4, 19}, CV{4, 20}, CV{4, 21},
-				CV{4, 22}, CV{4, 23}, CV{4, 24}, CV{4, 25},
-				CV{4, 26}, CV{4, 27}, CV{4, 28}, CV{4, 29},
-				CV{4, 30}, CV{4, 31}, CV{4, 32}, CV{4, 33},
-				CV{4, 34}, CV{4, 35}, CV{4, 36}, CV{4, 37},
-				CV{5, 1}, CV{5, 2}, CV{5, 3}, CV{5, 4},
-				CV{5, 5}, CV{5, 6}, CV{5, 7}, CV{5, 8},
-				CV{5, 9}, CV{5, 10}, CV{5, 11}, CV{5, 12},
-				CV{5, 13}, CV{5, 14}, CV{5, 15}, CV{5, 16},
-				CV{5, 17}, CV{5, 18}, CV{5, 19}, CV{5, 20},
-				CV{5, 21}, CV{5, 22}, CV{5, 23}, CV{5, 24},
-				CV{5, 25}, CV{5, 26}, CV{5, 27}, CV{5, 28},
-				CV{5, 29}, CV{5, 30}, CV{5, 31}, CV{6, 1},
-				CV{6, 2}, CV{6, 3}, CV{6, 4}, CV{6, 5},
-				CV{6, 6}, CV{6, 7}, CV{6, 8}, CV{6, 9},
-				CV{6, 10}, CV{6, 11}, CV{6, 12}, CV{6, 13},
-				CV{6, 14}, CV{6, 15}, CV{6, 16}, CV{6, 17},
-				CV{6, 18}, CV{6, 19}, CV{6, 20}, CV{6, 21},
-				CV{6, 22}, CV{6, 23}, CV{6, 24}, CV{6, 25},
-				CV{6, 26}, CV{6, 27}, CV{6, 28}, CV{7, 1},
-				CV{7, 2}, CV{7, 3}, CV{7, 4}, CV{7, 5},
-				CV{7, 6}, CV{7, 7}, CV{7, 8}, CV{7, 9},
-				CV{7, 10}, CV{7, 11}, CV{7, 12}, CV{7, 13},
-				CV{7, 14}, CV{7, 15}, CV{7, 16}, CV{7, 17},
-				CV{7, 18}, CV{7, 19}, CV{7, 20}, CV{7, 21},
-				CV{7, 22}, CV{7, 23}, CV{7, 24}, CV{7, 25},
-				CV{7, 26}, CV{7, 27}, CV{7, 28}, CV{8, 1},
-				CV{8, 2}, CV{8, 3}, CV{8, 4}, CV{8, 5},
-				CV{8, 6}, CV{8, 7}, CV{8, 8}, CV{8, 9},
-				CV{8, 10}, CV{8, 11}, CV{8, 12}, CV{8, 13},
-				CV{8, 14}, CV{8, 15}, CV{8, 16}, CV{8, 17},
-				CV{8, 18}, CV{8, 19}, CV{8, 20}, CV{8, 21},
-				CV{8, 22}, CV{8, 23}, CV{8, 24}, CV{8, 25},
-				CV{8, 26}, CV{8, 27}, CV{9, 1}, CV{9, 2},
-				CV{9, 3}, CV{9, 4}, CV{9, 5}, CV{9, 6},
-				CV{9, 7}, CV{9, 8}, CV{9, 9}, CV{9, 10},
-				CV{9, 11}, CV{9, 12}, CV{9, 13}, CV{9, 14},
-				CV{9, 15}, CV{9, 16}, CV{9, 17}, CV{9, 18},
-				CV{9, 19}, CV{9, 20}, CV{9, 21}, CV{9, 22},
-				CV{9, 23}, CV{9, 24}, CV{9, 25}, CV{9, 26},
-				CV{9, 27}, CV{10, 1}, CV{10, 2}, CV{10, 3},
-				CV{10, 4}, CV{10, 5}, CV{10, 6}, CV{10, 7},
-				CV{10, 8}, CV{10, 9}, CV{10, 10}, CV{10, 11},
-				CV{10, 12}, CV{10, 13}, CV{10, 14}, CV{10, 15},
-				CV{10, 16}, CV{10, 17}, CV{10, 18}, CV{10, 19},
-				CV{10, 20}, CV{10, 21}, CV{11, 1}, CV{11, 2},
-				CV{11, 3}, CV{11, 4}, CV{11, 5}, CV{11, 6},
-				CV{11, 7}, CV{11, 8}, CV{11, 9}, CV{11, 10},
-				CV{11, 11}, CV{11, 12}, CV{11, 13}, CV{11, 14},
-				CV{11, 15}, CV{11, 16}, CV{11, 17}, CV{11, 18},
-				CV{11, 19}, CV{11, 20}, CV{11, 21}, CV{11, 22},
-				CV{11, 23}, CV{11, 24}, CV{11, 25}, CV{11, 26},
-				CV{11, 27}, CV{11, 28}, CV{11, 29}, CV{11, 30},
-				CV{11, 31}, CV{11, 32}, CV{11, 33}, CV{11, 34},
-				CV{11, 35}, CV{11, 36}, CV{11, 37}, CV{11, 38},
-				CV{11, 39}, CV{11, 40}, CV{11, 41}, CV{11, 42},
-				CV{11, 43}, CV{11, 44}, CV{11, 45}, CV{12, 1},
-				CV{12, 2}, CV{12, 3}, CV{12, 4}, CV{12, 5},
-				CV{12, 6}, CV{12, 7}, CV{12, 8}, CV{12, 9},
-				CV{12, 10}, CV{12, 11}, CV{12, 12}, CV{12, 13},
+			Runs: []VerseRun{
+				{Chapter: 1, First: 1, Last: 21},
+				{Chapter: 2, First: 1, Last: 49},
+				{Chapter: 3, First: 1, Last: 30},
+				{Chapter: 4, First: 1, Last: 37},
+				{Chapter: 5, First: 1, Last: 31},
+				{Chapter: 6, First: 1, Last: 28},
+				{Chapter: 7, First: 1, Last: 28},
+				{Chapter: 8, First: 1, Last: 27},
+				{Chapter: 9, First: 1, Last: 27},
+				{Chapter: 10, First: 1, Last: 21},
+				{Chapter: 11, First: 1, Last: 45},
+				{Chapter: 12, First: 1, Last: 13},
 			},
 		},
 		{
 			Name:      "Hosea",
+			USFM:      "HOS",
+			Testament: OldTestament,
 			JustVerse: false,
-			Verses: []Verse{CV{1, 1},
-				CV{1, 2}, CV{1, 3}, CV{1, 4}, CV{1, 5},
-				CV{1, 6}, CV{1, 7}, CV{1, 8}, CV{1, 9},
-				CV{1, 10}, CV{1, 11}, CV{2, 1}, CV{2, 2},
-				CV{2, 3}, CV{2, 4}, CV{2, 5}, CV{2, 6},
-				CV{2, 7}, CV{2, 8}, CV{2, 9}, CV{2, 10},
-				CV{2, 11}, CV{2, 12}, CV{2, 13}, CV{2, 14},
-				CV{2, 15}, CV{2, 16}, CV{2, 17}, CV{2, 18},
-				CV{2, 19}, CV{2, 20}, CV{2, 21}, CV{2, 22},
-				CV{2, 23}, CV{3, 1}, CV{3, 2}, CV{3, 3},
-				CV{3, 4}, CV{3, 5}, CV{4, 1}, CV{4, 2},
-				CV{4, 3}, CV{4, 4}, CV{4, 5}, CV{4, 6},
-				CV{4, 7}, CV{4, 8}, CV{4, 9}, CV{4, 10},
-				CV{4, 11}, CV{4, 12}, CV{4, 13}, CV{4, 14},
-				CV{4, 15}, CV{4, 16}, CV{4, 17}, CV{4, 18},
-				CV{4, 19}, CV{5, 1}, CV{5, 2}, CV{5, 3},
-				CV{5, 4}, CV{5, 5}, CV{5, 6}, CV{5, 7},
-				CV{5, 8}, CV{5, 9}, CV{5, 10}, CV{5, 11},
-				CV{5, 12}, CV{5, 13}, CV{5, 14}, CV{5, 15},
-				CV{6, 1}, CV{6, 2}, CV{6, 3}, CV{6, 4},
-				CV{6, 5}, CV{6, 6}, CV{6, 7}, CV{6, 8},
-				CV{6, 9}, CV{6, 10}, CV{6, 11}, CV{7, 1},
-				CV{7, 2}, CV{7, 3}, CV{7, 4}, CV{7, 5},
-				CV{7, 6}, CV{7, 7}, CV{7, 8}, CV{7, 9},
-				CV{7, 10}, CV{7, 11}, CV{7, 12}, CV{7, 13},
-				CV{7, 14}, CV{7, 15}, CV{7, 16}, CV{8, 1},
-				CV{8, 2}, CV{8, 3}, CV{8, 4}, CV{8, 5},
-				CV{8, 6}, CV{8, 7}, CV{8, 8}, CV{8, 9},
-				CV{8, 10}, CV{8, 11}, CV{8, 12}, CV{8, 13},
-				CV{8, 14}, CV{9, 1}, CV{9, 2}, CV{9, 3},
-				CV{9, 4}, CV{9, 5}, CV{9, 6}, CV{9, 7},
-				CV{9, 8}, CV{9, 9}, CV{9, 10}, CV{9, 11},
-				CV{9, 12}, CV{9, 13}, CV{9, 14}, CV{9, 15},
-				CV{9, 16}, CV{9, 17}, CV{10, 1}, CV{10, 2},
-				CV{10, 3}, CV{10, 4}, CV{10, 5}, CV{10, 6},
-				CV{10, 7}, CV{10, 8}, CV{10, 9}, CV{10, 10},
-				CV{10, 11}, CV{10, 12}, CV{10, 13}, CV{10, 14},
-				CV{10, 15}, CV{11, 1}, CV{11, 2}, CV{11, 3},
-				CV{11, 4}, CV{11, 5}, CV{11, 6}, CV{11, 7},
-				CV{11, 8}, CV{11, 9}, CV{11, 10}, CV{11, 11},
-				CV{11, 12}, CV{12, 1}, CV{12, 2}, CV{12, 3},
-				CV{12, 4}, CV{12, 5}, CV{12, 6}, CV{12, 7},
-				CV{12, 8}, CV{12, 9}, CV{12, 10}, CV{12, 11},
-				CV{12, 12}, CV{12, 13}, CV{12, 14}, CV{13, 1},
-				CV{13, 2}, CV{13, 3}, CV{13, 4}, CV{13, 5},
-				CV{13, 6}, CV{13, 7}, CV{13, 8}, CV{13, 9},
-				CV{13, 10}, CV{13, 11}, CV{13, 12}, CV{13, 13},
-				CV{13, 14}, CV{13, 15}, CV{13, 16}, CV{14, 1},
-				CV{14, 2}, CV{14, 3}, CV{14, 4}, CV{14, 5},
-				CV{14, 6}, CV{14, 7}, CV{14, 8}, CV{14, 9},
+			Runs: []VerseRun{
+				{Chapter: 1, First: 1, Last: 11},
+				{Chapter: 2, First: 1, Last: 23},
+				{Chapter: 3, First: 1, Last: 5},
+				{Chapter: 4, First: 1, Last: 19},
+				{Chapter: 5, First: 1, Last: 15},
+				{Chapter: 6, First: 1, Last: 11},
+				{Chapter: 7, First: 1, Last: 16},
+				{Chapter: 8, First: 1, Last: 14},
+				{Chapter: 9, First: 1, Last: 17},
+				{Chapter: 10, First: 1, Last: 15},
+				{Chapter: 11, First: 1, Last: 12},
+				{Chapter: 12, First: 1, Last: 14},
+				{Chapter: 13, First: 1, Last: 16},
+				{Chapter: 14, First: 1, Last: 9},
 			},
 		},
 		{
 			Name:      "Joel",
+			USFM:      "JOL",
+			Testament: OldTestament,
 			JustVerse: false,
-			Verses: []Verse{CV{1, 1},
-				CV{1, 2}, CV{1, 3}, CV{1, 4}, CV{1, 5},
-				CV{1, 6}, CV{1, 7}, CV{1, 8}, CV{1, 9},
-				CV{1, 10}, CV{1, 11}, CV{1, 12}, CV{1, 13},
-				CV{1, 14}, CV{1, 15}, CV{1, 16}, CV{1, 17},
-				CV{1, 18}, CV{1, 19}, CV{1, 20}, CV{2, 1},
-				CV{2, 2}, CV{2, 3}, CV{2, 4}, CV{2, 5},
-				CV{2, 6}, CV{2, 7}, CV{2, 8}, CV{2, 9},
-				CV{2, 10}, CV{2, 11}, CV{2, 12}, CV{2, 13},
-				CV{2, 14}, CV{2, 15}, CV{2, 16}, CV{2, 17},
-				CV{2, 18}, CV{2, 19}, CV{2, 20}, CV{2, 21},
-				CV{2, 22}, CV{2, 23}, CV{2, 24}, CV{2, 25},
-				CV{2, 26}, CV{2, 27}, CV{2, 28}, CV{2, 29},
-				CV{2, 30}, CV{2, 31}, CV{2, 32}, CV{3, 1},
-				CV{3, 2}, CV{3, 3}, CV{3, 4}, CV{3, 5},
-				CV{3, 6}, CV{3, 7}, CV{3, 8}, CV{3, 9},
-				CV{3, 10}, CV{3, 11}, CV{3, 12}, CV{3, 13},
-				CV{3, 14}, CV{3, 15}, CV{3, 16}, CV{3, 17},
-				CV{3, 18}, CV{3, 19}, CV{3, 20}, CV{3, 21},
+			Runs: []VerseRun{
+				{Chapter: 1, First: 1, Last: 20},
+				{Chapter: 2, First: 1, Last: 32},
+				{Chapter: 3, First: 1, Last: 21},
 			},
 		},
 		{
 			Name:      "Amos",
+			USFM:      "AMO",
+			Testament: OldTestament,
 			JustVerse: false,
-			Verses: []Verse{CV{1, 1},
-				CV{1, 2}, CV{1, 3}, CV{1, 4}, CV{1, 5},
-				CV{1, 6}, CV{1, 7}, CV{1, 8}, CV{1, 9},
-				CV{1, 10}, CV{1, 11}, CV{1, 12}, CV{1, 13},
-				CV{1, 14}, CV{1, 15}, CV{2, 1}, CV{2, 2},
-				CV{2, 3}, CV{2, 4}, CV{2, 5}, CV{2, 6},
-				CV{2, 7}, CV{2, 8}, CV{2, 9}, CV{2, 10},
-				CV{2, 11}, CV{2, 12}, CV{2, 13}, CV{2, 14},
-				CV{2, 15}, CV{2, 16}, CV{3, 1}, CV{3, 2},
-				CV{3, 3}, CV{3, 4}, CV{3, 5}, CV{3, 6},
-				CV{3, 7}, CV{3, 8}, CV{3, 9}, CV{3, 10},
-				CV{3, 11}, CV{3, 12}, CV{3, 13}, CV{3, 14},
-				CV{3, 15}, CV{4, 1}, CV{4, 2}, CV{4, 3},
-				CV{4, 4}, CV{4, 5}, CV{4, 6}, CV{4, 7},
-				CV{4, 8}, CV{4, 9}, CV{4, 10}, CV{4, 11},
-				CV{4, 12}, CV{4, 13}, CV{5, 1}, CV{5, 2},
-				CV{5, 3}, CV{5, 4}, CV{5, 5}, CV{5, 6},
-				CV{5, 7}, CV{5, 8}, CV{5, 9}, CV{5, 10},
-				CV{5, 11}, CV{5, 12}, CV{5, 13}, CV{5, 14},
-				CV{5, 15}, CV{5, 16}, CV{5, 17}, CV{5, 18},
-				CV{5, 19}, CV{5, 20}, CV{5, 21}, CV{5, 22},
-				CV{5, 23}, CV{5, 24}, CV{5, 25}, CV{5, 26},
-				CV{5, 27}, CV{6, 1}, CV{6, 2}, CV{6, 3},
-				CV{6, 4}, CV{6, 5}, CV{6, 6}, CV{6, 7},
-				CV{6, 8}, CV{6, 9}, CV{6, 10}, CV{6, 11},
-				CV{6, 12}, CV{6, 13}, CV{6, 14}, CV{7, 1},
-				CV{7, 2}, CV{7, 3}, CV{7, 4}, CV{7, 5},
-				CV{7, 6}, CV{7, 7}, CV{7, 8}, CV{7, 9},
-				CV{7, 10}, CV{7, 11}, CV{7, 12}, CV{7, 13},
-				CV{7, 14}, CV{7, 15}, CV{7, 16}, CV{7, 17},
-				CV{8, 1}, CV{8, 2}, CV{8, 3}, CV{8, 4},
-				CV{8, 5}, CV{8, 6}, CV{8, 7}, CV{8, 8},
-				CV{8, 9}, CV{8, 10}, CV{8, 11}, CV{8, 12},
-				CV{8, 13}, CV{8, 14}, CV{9, 1}, CV{9, 2},
-				CV{9, 3}, CV{9, 4}, CV{9, 5}, CV{9, 6},
-				CV{9, 7}, CV{9, 8}, CV{9, 9}, CV{9, 10},
-				CV{9, 11}, CV{9, 12}, CV{9, 13}, CV{9, 14},
-				CV{9, 15},
+			Runs: []VerseRun{
+				{Chapter: 1, First: 1, Last: 15},
+				{Chapter: 2, First: 1, Last: 16},
+				{Chapter: 3, First: 1, Last: 15},
+				{Chapter: 4, First: 1, Last: 13},
+				{Chapter: 5, First: 1, Last: 27},
+				{Chapter: 6, First: 1, Last: 14},
+				{Chapter: 7, First: 1, Last: 17},
+				{Chapter: 8, First: 1, Last: 14},
+				{Chapter: 9, First: 1, Last: 15},
 			},
 		},
 		{
 			Name:      "Obadiah",
+			USFM:      "OBA",
+			Testament: OldTestament,
 			JustVerse: true,
-			Verses: []Verse{N{1},
-				N{2}, N{3}, N{4}, N{5},
-				N{6}, N{7}, N{8}, N{9},
-				N{10}, N{11}, N{12}, N{13},
-				N{14}, N{15}, N{16}, N{17},
-				N{18}, N{19}, N{20}, N{21},
+			Runs: []VerseRun{
+				{Chapter: 1, First: 1, Last: 21},
 			},
 		},
 		{
 			Name:      "Jonah",
+			USFM:      "JON",
+			Testament: OldTestament,
 			JustVerse: false,
-			Verses: []Verse{CV{1, 1},
-				CV{1, 2}, CV{1, 3}, CV{1, 4}, CV{1, 5},
-				CV{1, 6}, CV{1, 7}, CV{1, 8}, CV{1, 9},
-				CV{1, 10}, CV{1, 11}, CV{1, 12}, CV{1, 13},
-				CV{1, 14}, CV{1, 15}, CV{1, 16}, CV{1, 17},
-				CV{2, 1}, CV{2, 2}, CV{2, 3}, CV{2, 4},
-				CV{2, 5}, CV{2, 6}, CV{2, 7}, CV{2, 8},
-				CV{2, 9}, CV{2, 10}, CV{3, 1}, CV{3, 2},
-				CV{3, 3}, CV{3, 4}, CV{3, 5}, CV{3, 6},
-				CV{3, 7}, CV{3, 8}, CV{3, 9}, CV{3, 10},
-				CV{4, 1}, CV{4, 2}, CV{4, 3}, CV{4, 4},
-				CV{4, 5}, CV{4, 6}, CV{4, 7}, CV{4, 8},
-				CV{4, 9}, CV{4, 10}, CV{4, 11},
+			Runs: []VerseRun{
+				{Chapter: 1, First: 1, Last: 17},
+				{Chapter: 2, First: 1, Last: 10},
+				{Chapter: 3, First: 1, Last: 10},
+				{Chapter: 4, First: 1, Last: 11},
 			},
 		},
 		{
 			Name:      "Micah",
+			USFM:      "MIC",
+			Testament: OldTestament,
 			JustVerse: false,
-			Verses: []Verse{CV{1, 1},
-				CV{1, 2}, CV{1, 3}, CV{1, 4}, CV{1, 5},
-				CV{1, 6}, CV{1, 7}, CV{1, 8}, CV{1, 9},
-				CV{1, 10}, CV{1, 11}, CV{1, 12}, CV{1, 13},
-				CV{1, 14}, CV{1, 15}, CV{1, 16}, CV{2, 1},
-				CV{2, 2}, CV{2, 3}, CV{2, 4}, CV{2, 5},
-				CV{2, 6}, CV{2, 7}, CV{2, 8}, CV{2, 9},
-				CV{2, 10}, CV{2, 11}, CV{2, 12}, CV{2, 13},
-				CV{3, 1}, CV{3, 2}, CV{3, 3}, CV{3, 4},
-				CV{3, 5}, CV{3, 6}, CV{3, 7}, CV{3, 8},
-				CV{3, 9}, CV{3, 10}, CV{3, 11}, CV{3, 12},
-				CV{4, 1}, CV{4, 2}, CV{4, 3}, CV{4, 4},
-				CV{4, 5}, CV{4, 6}, CV{4, 7}, CV{4, 8},
-				CV{4, 9}, CV{4, 10}, CV{4, 11}, CV{4, 12},
-				CV{4, 13}, CV{5, 1}, CV{5, 2}, CV{5, 3},
-				CV{5, 4}, CV{5, 5}, CV{5, 6}, CV{5, 7},
-				CV{5, 8}, CV{5, 9}, CV{5, 10}, CV{5, 11},
-				CV{5, 12}, CV{5, 13}, CV{5, 14}, CV{5, 15},
-				CV{6, 1}, CV{6, 2}, CV{6, 3}, CV{6, 4},
-				CV{6, 5}, CV{6, 6}, CV{6, 7}, CV{6, 8},
-				CV{6, 9}, CV{6, 10}, CV{6, 11}, CV{6, 12},
-				CV{6, 13}, CV{6, 14}, CV{6, 15}, CV{6, 16},
-				CV{7, 1}, CV{7, 2}, CV{7, 3}, CV{7, 4},
-				CV{7, 5}, CV{7, 6}, CV{7, 7}, CV{7, 8},
-				CV{7, 9}, CV{7, 10}, CV{7, 11}, CV{7, 12},
-				CV{7, 13}, CV{7, 14}, CV{7, 15}, CV{7, 16},
-				CV{7, 17}, CV{7, 18}, CV{7, 19}, CV{7, 20},
+			Runs: []VerseRun{
+				{Chapter: 1, First: 1, Last: 16},
+				{Chapter: 2, First: 1, Last: 13},
+				{Chapter: 3, First: 1, Last: 12},
+				{Chapter: 4, First: 1, Last: 13},
+				{Chapter: 5, First: 1, Last: 15},
+				{Chapter: 6, First: 1, Last: 16},
+				{Chapter: 7, First: 1, Last: 20},
 			},
 		},
 		{
 			Name:      "Nahum",
+			USFM:      "NAM",
+			Testament: OldTestament,
 			JustVerse: false,
-			Verses: []Verse{CV{1, 1},
-				CV{1, 2}, CV{1, 3}, CV{1, 4}, CV{1, 5},
-				CV{1, 6}, CV{1, 7}, CV{1, 8}, CV{1, 9},
-				CV{1, 10}, CV{1, 11}, CV{1, 12}, CV{1, 13},
-				CV{1, 14}, CV{1, 15}, CV{2, 1}, CV{2, 2},
-				CV{2, 3}, CV{2, 4}, CV{2, 5}, CV{2, 6},
-				CV{2, 7}, CV{2, 8}, CV{2, 9}, CV{2, 10},
-				CV{2, 11}, CV{2, 12}, CV{2, 13}, CV{3, 1},
-				CV{3, 2}, CV{3, 3}, CV{3, 4}, CV{3, 5},
-				CV{3, 6}, CV{3, 7}, CV{3, 8}, CV{3, 9},
-				CV{3, 10}, CV{3, 11}, CV{3, 12}, CV{3, 13},
-				CV{3, 14}, CV{3, 15}, CV{3, 16}, CV{3, 17},
-				CV{3, 18}, CV{3, 19},
+			Runs: []VerseRun{
+				{Chapter: 1, First: 1, Last: 15},
+				{Chapter: 2, First: 1, Last: 13},
+				{Chapter: 3, First: 1, Last: 19},
 			},
 		},
 		{
 			Name:      "Habakkuk",
+			USFM:      "HAB",
+			Testament: OldTestament,
 			JustVerse: false,
-			Verses: []Verse{CV{1, 1},
-				CV{1, 2}, CV{1, 3}, CV{1, 4}, CV{1, 5},
-				CV{1, 6}, CV{1, 7}, CV{1, 8}, CV{1, 9},
-				CV{1, 10}, CV{1, 11}, CV{1, 12}, CV{1, 13},
-				CV{1, 14}, CV{1, 15}, CV{1, 16}, CV{1, 17},
-				CV{2, 1}, CV{2, 2}, CV{2, 3}, CV{2, 4},
-				CV{2, 5}, CV{2, 6}, CV{2, 7}, CV{2, 8},
-				CV{2, 9}, CV{2, 10}, CV{2, 11}, CV{2, 12},
-				CV{2, 13}, CV{2, 14}, CV{2, 15}, CV{2, 16},
-				CV{2, 17}, CV{2, 18}, CV{2, 19}, CV{2, 20},
-				CV{3, 1}, CV{3, 2}, CV{3, 3}, CV{3, 4},
-				CV{3, 5}, CV{3, 6}, CV{3, 7}, CV{3, 8},
-				CV{3, 9}, CV{3, 10}, CV{3, 11}, CV{3, 12},
-				CV{3, 13}, CV{3, 14}, CV{3, 15}, CV{3, 16},
-				CV{3, 17}, CV{3, 18}, CV{3, 19},
+			Runs: []VerseRun{
+				{Chapter: 1, First: 1, Last: 17},
+				{Chapter: 2, First: 1, Last: 20},
+				{Chapter: 3, First: 1, Last: 19},
 			},
 		},
 		{
 			Name:      "Zephaniah",
+			USFM:      "ZEP",
+			Testament: OldTestament,
 			JustVerse: false,
-			Verses: []Verse{CV{1, 1},
-				CV{1, 2}, CV{1, 3}, CV{1, 4}, CV{1, 5},
-				CV{1, 6}, CV{1, 7}, CV{1, 8}, CV{1, 9},
-				CV{1, 10}, CV{1, 11}, CV{1, 12}, CV{1, 13},
-				CV{1, 14}, CV{1, 15}, CV{1, 16}, CV{1, 17},
-				CV{1, 18}, CV{2, 1}, CV{2, 2}, CV{2, 3},
-				CV{2, 4}, CV{2, 5}, CV{2, 6}, CV{2, 7},
-				CV{2, 8}, CV{2, 9}, CV{2, 10}, CV{2, 11},
-				CV{2, 12}, CV{2, 13}, CV{2, 14}, CV{2, 15},
-				CV{3, 1}, CV{3, 2}, CV{3, 3}, CV{3, 4},
-				CV{3, 5}, CV{3, 6}, CV{3, 7}, CV{3, 8},
-				CV{3, 9}, CV{3, 10}, CV{3, 11}, CV{3, 12},
-				CV{3, 13}, CV{3, 14}, CV{3, 15}, CV{3, 16},
-				CV{3, 17}, CV{3, 18}, CV{3, 19}, CV{3, 20},
+			Runs: []VerseRun{
+				{Chapter: 1, First: 1, Last: 18},
+				{Chapter: 2, First: 1, Last: 15},
+				{Chapter: 3, First: 1, Last: 20},
 			},
 		},
 		{
 			Name:      "Haggai",
+			USFM:      "HAG",
+			Testament: OldTestament,
 			JustVerse: false,
-			Verses: []Verse{CV{1, 1},
-				CV{1, 2}, CV{1, 3}, CV{1, 4}, CV{1, 5},
-				CV{1, 6}, CV{1, 7}, CV{1, 8}, CV{1, 9},
-				CV{1, 10}, CV{1, 11}, CV{1, 12}, CV{1, 13},
-				CV{1, 14}, CV{1, 15}, CV{2, 1}, CV{2, 2},
-				CV{2, 3}, CV{2, 4}, CV{2, 5}, CV{2, 6},
-				CV{2, 7}, CV{2, 8}, CV{2, 9}, CV{2, 10},
-				CV{2, 11}, CV{2, 12}, CV{2, 13}, CV{2, 14},
-				CV{2, 15}, CV{2, 16}, CV{2, 17}, CV{2, 18},
-				CV{2, 19}, CV{2, 20}, CV{2, 21}, CV{2, 22},
-				CV{2, 23},
+			Runs: []VerseRun{
+				{Chapter: 1, First: 1, Last: 15},
+				{Chapter: 2, First: 1, Last: 23},
 			},
 		},
 		{
 			Name:      "Zechariah",
+			USFM:      "ZEC",
+			Testament: OldTestament,
 			JustVerse: false,
-			Verses: []Verse{CV{1, 1},
-				CV{1, 2}, CV{1, 3}, CV{1, 4}, CV{1, 5},
-				CV{1, 6}, CV{1, 7}, CV{1, 8}, CV{1, 9},
-				CV{1, 10}, CV{1, 11}, CV{1, 12}, CV{1, 13},
-				CV{1, 14}, CV{1, 15}, CV{1, 16}, CV{1, 17},
-				CV{1, 18}, CV{1, 19}, CV{1, 20}, CV{1, 21},
-				CV{2, 1}, CV{2, 2}, CV{2, 3}, CV{2, 4},
-				CV{2, 5}, CV{2, 6}, CV{2, 7}, CV{2, 8},
-				CV{2, 9}, CV{2, 10}, CV{2, 11}, CV{2, 12},
-				CV{2, 13}, CV{3, 1}, CV{3, 2}, CV{3, 3},
-				CV{3, 4}, CV{3, 5}, CV{3, 6}, CV{3, 7},
-				CV{3, 8}, CV{3, 9}, CV{3, 10}, CV{4, 1},
-				CV{4, 2}, CV{4, 3}, CV{4, 4}, CV{4, 5},
-				CV{4, 6}, CV{4, 7}, CV{4, 8}, CV{4, 9},
-				CV{4, 10}, CV{4, 11}, CV{4, 12}, CV{4, 13},
-				CV{4, 14}, CV{5, 1}, CV{5, 2}, CV{5, 3},
-				CV{5, 4}, CV{5, 5}, CV{5, 6}, CV{5, 7},
-				CV{5, 8}, CV{5, 9}, CV{5, 10}, CV{5, 11},
-				CV{6, 1}, CV{6, 2}, CV{6, 3}, CV{6, 4},
-				CV{6, 5}, CV{6, 6}, CV{6, 7}, CV{6, 8},
-				CV{6, 9}, CV{6, 10}, CV{6, 11}, CV{6, 12},
-				CV{6, 13}, CV{6, 14}, CV{6, 15}, CV{7, 1},
-				CV{7, 2}, CV{7, 3}, CV{7, 4}, CV{7, 5},
-				CV{7, 6}, CV{7, 7}, CV{7, 8}, CV{7, 9},
-				CV{7, 10}, CV{7, 11}, CV{7, 12}, CV{7, 13},
-				CV{7, 14}, CV{8, 1}, CV{8, 2}, CV{8, 3},
-				CV{8, 4}, CV{8, 5}, CV{8, 6}, CV{8, 7},
-				CV{8, 8}, CV{8, 9}, CV{8, 10}, CV{8, 11},
-				CV{8, 12}, CV{8, 13}, CV{8, 14}, CV{8, 15},
-				CV{8, 16}, CV{8, 17}, CV{8, 18}, CV{8, 19},
-				CV{8, 20}, CV{8, 21}, CV{8, 22}, CV{8, 23},
-				CV{9, 1}, CV{9, 2}, CV{9, 3}, CV{9, 4},
-				CV{9, 5}, CV{9, 6}, CV{9, 7}, CV{9, 8},
-				CV{9, 9}, CV{9, 10}, CV{9, 11}, CV{9, 12},
-				CV{9, 13}, CV{9, 14}, CV{9, 15}, CV{9, 16},
-				CV{9, 17}, CV{10, 1}, CV{10, 2}, CV{10, 3},
-				CV{10, 4}, CV{10, 5}, CV{10, 6}, CV{10, 7},
-				CV{10, 8}, CV{10, 9}, CV{10, 10}, CV{10, 11},
-				CV{10, 12}, CV{11, 1}, CV{11, 2}, CV{11, 3},
-				CV{11, 4}, CV{11, 5}, CV{11, 6}, CV{11, 7},
-				CV{11, 8}, CV{11, 9}, CV{11, 10}, CV{11, 11},
-				CV{11, 12}, CV{11, 13}, CV{11, 14}, CV{11, 15},
-				CV{11, 16}, CV{11, 17}, CV{12, 1}, CV{12, 2},
-				CV{12, 3}, CV{12, 4}, CV{12, 5}, CV{12, 6},
-				CV{12, 7}, CV{12, 8}, CV{12, 9}, CV{12, 10},
-				CV{12, 11}, CV{12, 12}, CV{12, 13}, CV{12, 14},
-				CV{13, 1}, CV{13, 2}, CV{13, 3}, CV{13, 4},
-				CV{13, 5}, CV{13, 6}, CV{13, 7}, CV{13, 8},
-				CV{13, 9}, CV{14, 1}, CV{14, 2}, CV{14, 3},
-				CV{14, 4}, CV{14, 5}, CV{14, 6}, CV{14, 7},
-				CV{14, 8}, CV{14, 9}, CV{14, 10}, CV{14, 11},
-				CV{14, 12}, CV{14, 13}, CV{14, 14}, CV{14, 15},
-				CV{14, 16}, CV{14, 17}, CV{14, 18}, CV{14, 19},
-				CV{14, 20}, CV{14, 21},
+			Runs: []VerseRun{
+				{Chapter: 1, First: 1, Last: 21},
+				{Chapter: 2, First: 1, Last: 13},
+				{Chapter: 3, First: 1, Last: 10},
+				{Chapter: 4, First: 1, Last: 14},
+				{Chapter: 5, First: 1, Last: 11},
+				{Chapter: 6, First: 1, Last: 15},
+				{Chapter: 7, First: 1, Last: 14},
+				{Chapter: 8, First: 1, Last: 23},
+				{Chapter: 9, First: 1, Last: 17},
+				{Chapter: 10, First: 1, Last: 12},
+				{Chapter: 11, First: 1, Last: 17},
+				{Chapter: 12, First: 1, Last: 14},
+				{Chapter: 13, First: 1, Last: 9},
+				{Chapter: 14, First: 1, Last: 21},
 			},
 		},
 		{
 			Name:      "Malachi",
+			USFM:      "MAL",
+			Testament: OldTestament,
 			JustVerse: false,
-			Verses: []Verse{CV{1, 1},
-				CV{1, 2}, CV{1, 3}, CV{1, 4}, CV{1, 5},
-				CV{1, 6}, CV{1, 7}, CV{1, 8}, CV{1, 9},
-				CV{1, 10}, CV{1, 11}, CV{1, 12}, CV{1, 13},
-				CV{1, 14}, CV{2, 1}, CV{2, 2}, CV{2, 3},
-				CV{2, 4}, CV{2, 5}, CV{2, 6}, CV{2, 7},
-				CV{2, 8}, CV{2, 9}, CV{2, 10}, CV{2, 11},
-				CV{2, 12}, CV{2, 13}, CV{2, 14}, CV{2, 15},
-				CV{2, 16}, CV{2, 17}, CV{3, 1}, CV{3, 2},
-				CV{3, 3}, CV{3, 4}, CV{3, 5}, CV{3, 6},
-				CV{3, 7}, CV{3, 8}, CV{3, 9}, CV{3, 10},
-				CV{3, 11}, CV{3, 12}, CV{3, 13}, CV{3, 14},
-				CV{3, 15}, CV{3, 16}, CV{3, 17}, CV{3, 18},
-				CV{4, 1}, CV{4, 2}, CV{4, 3}, CV{4, 4},
-				CV{4, 5}, CV{4, 6},
+			Runs: []VerseRun{
+				{Chapter: 1, First: 1, Last: 14},
+				{Chapter: 2, First: 1, Last: 17},
+				{Chapter: 3, First: 1, Last: 18},
+				{Chapter: 4, First: 1, Last: 6},
 			},
 		},
 		{
 			Name:      "Matthew",
+			USFM:      "MAT",
+			Testament: NewTestament,
 			JustVerse: false,
-			Verses: []Verse{CV{1, 1},
-				CV{1, 2}, CV{1, 3}, CV{1, 4}, CV{1, 5},
-				CV{1, 6}, CV{1, 7}, CV{1, 8}, CV{1, 9},
-				CV{1, 10}, CV{1, 11}, CV{1, 12}, CV{1, 13},
-				CV{1, 14}, CV{1, 15}, CV{1, 16}, CV{1, 17},
-				CV{1, 18}, CV{1, 19}, CV{1, 20}, CV{1, 21},
-				CV{1, 22}, CV{1, 23}, CV{1, 24}, CV{1, 25},
-				CV{2, 1}, CV{2, 2}, CV{2, 3}, CV{2, 4},
-				CV{2, 5}, CV{2, 6}, CV{2, 7}, CV{2, 8},
-				CV{2, 9}, CV{2, 10}, CV{2, 11}, CV{2, 12},
-				CV{2, 13}, CV{2, 14}, CV{2, 15}, CV{2, 16},
-				CV{2, 17}, CV{2, 18}, CV{2, 19}, CV{2, 20},
-				CV{2, 21}, CV{2, 22}, CV{2, 23}, CV{3, 1},
-				CV{3, 2}, CV{3, 3}, CV{3, 4}, CV{3, 5},
-				CV{3, 6}, CV{3, 7}, CV{3, 8}, CV{3, 9},
-				CV{3, 10}, CV{3, 11}, CV{3, 12}, CV{3, 13},
-				CV{3, 14}, CV{3, 15}, CV{3, 16}, CV{3, 17},
-				CV{4, 1}, CV{4, 2}, CV{4, 3}, CV{4, 4},
-				CV{4, 5}, CV{4, 6}, CV{4, 7}, CV{4, 8},
-				CV{4, 9}, CV{4, 10}, CV{4, 11}, CV{4, 12},
-				CV{4, 13}, CV{4, 14}, CV{4, 15}, CV{4, 16},
-				CV{4, 17}, CV{4, 18}, CV{4, 19}, CV{4, 20},
-				CV{4, 21}, CV{4, 22}, CV{4, 23}, CV{4, 24},
-				CV{4, 25}, CV{5, 1}, CV{5, 2}, CV{5, 3},
-				CV{5, 4}, CV{5, 5}, CV{5, 6}, CV{5, 7},
-				CV{5, 8}, CV{5, 9}, CV{5, 10}, CV{5, 11},
-				CV{5, 12}, CV{5, 13}, CV{5, 14}, CV{5, 15},
-				CV{5, 16}, CV{5, 17}, CV{5, 18}, CV{5, 19},
-				CV{5, 20}, CV{5, 21}, CV{5, 22}, CV{5, 23},
-				CV{5, 24}, CV{5, 25}, CV{5, 26}, CV{5, 27},
-				CV{5, 28}, CV{5, 29}, CV{5, 30}, CV{5, 31},
-				CV{5, 32}, CV{5, 33}, CV{5, 34}, CV{5, 35},
-				CV{5, 36}, CV{5, 37}, CV{5, 38}, CV{5, 39},
-				CV{5, 40}, CV{5, 41}, CV{5, 42}, CV{5, 43},
-				CV{5, 44}, CV{5, 45}, CV{5, 46}, CV{5, 47},
-				CV{5, 48}, CV{6, 1}, CV{6, 2}, CV{6, 3},
-				CV{6, 4}, CV{6, 5}, CV{6, 6}, CV{6, 7},
-				CV{6, 8}, CV{6, 9}, CV{6, 10}, CV{6, 11},
-				CV{6, 12}, CV{6, 13}, CV{6, 14}, CV{6, 15},
-				CV{6, 16}, CV{6, 17}, CV{6, 18}, CV{6, 19},
-				CV{6, 20}, CV{6, 21}, CV{6, 22}, CV{6, 23},
-				CV{6, 24}, CV{6, 25}, CV{6, 26}, CV{6, 27},
-				CV{6, 28}, CV{6, 29}, CV{6, 30}, CV{6, 31},
-				CV{6, 32}, CV{6, 33}, CV{6, 34}, CV{7, 1},
-				CV{7, 2}, CV{7, 3}, CV{7, 4}, CV{7, 5},
-				CV{7, 6}, CV{7, 7}, CV{7, 8}, CV{7, 9},
-				CV{7, 10}, CV{7, 11}, CV{7, 12}, CV{7, 13},
-				CV{7, 14}, CV{7, 15}, CV{7, 16}, CV{7, 17},
-				CV{7, 18}, CV{7, 19}, CV{7, 20}, CV{7, 21},
-				CV{7, 22}, CV{7, 23}, CV{7, 24}, CV{7, 25},
-				CV{7, 26}, CV{7, 27}, CV{7, 28}, CV{7, 29},
-				CV{8, 1}, CV{8, 2}, CV{8, 3}, CV{8, 4},
-				CV{8, 5}, CV{8, 6}, CV{8, 7}, CV{8, 8},
-				CV{8, 9}, CV{8, 10}, CV{8, 11}, CV{8, 12},
-				CV{8, 13}, CV{8, 14}, CV{8, 15}, CV{8, 16},
-				CV{8, 17}, CV{8, 18}, CV{8, 19}, CV{8, 20},
-				CV{8, 21}, CV{8, 22}, CV{8, 23}, CV{8, 24},
-				CV{8, 25}, CV{8, 26}, CV{8, 27}, CV{8, 28},
-				CV{8, 29}, CV{8, 30}, CV{8, 31}, CV{8, 32},
-				CV{8, 33}, CV{8, 34}, CV{9, 1}, CV{9, 2},
-				CV{9, 3}, CV{9, 4}, CV{9, 5}, CV{9, 6},
-				CV{9, 7}, CV{9, 8}, CV{9, 9}, CV{9, 10},
-				CV{9, 11}, CV{9, 12}, CV{9, 13}, CV{9, 14},
-				CV{9, 15}, CV{9, 16}, CV{9, 17}, CV{9, 18},
-				CV{9, 19}, CV{9, 20}, CV{9, 21}, CV{9, 22},
-				CV{9, 23}, CV{9, 24}, CV{9, 25}, CV{9, 26},
-				CV{9, 27}, CV{9, 28}, CV{9, 29}, CV{9, 30},
-				CV{9, 31}, CV{9, 32}, CV{9, 33}, CV{9, 34},
-				CV{9, 35}, CV{9, 36}, CV{9, 37}, CV{9, 38},
-				CV{10, 1}, CV{10, 2}, CV{10, 3}, CV{10, 4},
-				CV{10, 5}, CV{10, 6}, CV{10, 7}, CV{10, 8},
-				CV{10, 9}, CV{10, 10}, CV{10, 11}, CV{10, 12},
-				CV{10, 13}, CV{10, 14}, CV{10, 15}, CV{10, 16},
-				CV{10, 17}, CV{10, 18}, CV{10, 19}, CV{10, 20},
-				CV{10, 21}, CV{10, 22}, CV{10, 23}, CV{10, 24},
-				CV{10, 25}, CV{10, 26}, CV{10, 27}, CV{10, 28},
-				CV{10, 29}, CV{10, 30}, CV{10, 31}, CV{10, 32},
-				CV{10, 33}, CV{10, 34}, CV{10, 35}, CV{10, 36},
-				CV{10, 37}, CV{10, 38}, CV{10, 39}, CV{10, 40},
-				CV{10, 41}, CV{10, 42}, CV{11, 1}, CV{11, 2},
-				CV{11, 3}, CV{11, 4}, CV{11, 5}, CV{11, 6},
-				CV{11, 7}, CV{11, 8}, CV{11, 9}, CV{11, 10},
-				CV{11, 11}, CV{11, 12}, CV{11, 13}, CV{11, 14},
-				CV{11, 15}, CV{11, 16}, CV{11, 17}, CV{11, 18},
-				CV{11, 19}, CV{11, 20}, CV{11, 21}, CV{11, 22},
-				CV{11, 23}, CV{11, 24}, CV{11, 25}, CV{11, 26},
-				CV{11, 27}, CV{11, 28}, CV{11, 29}, CV{11, 30},
-				CV{12, 1}, CV{12, 2}, CV{12, 3}, CV{12, 4},
-				CV{12, 5}, CV{12, 6}, CV{12, 7}, CV{12, 8},
-				CV{12, 9}, CV{12, 10}, CV{12, 11}, CV{12, 12},
-				CV{12, 13}, CV{12, 14}, CV{12, 15}, CV{12, 16},
-				CV{12, 17}, CV{12, 18}, CV{12, 19}, CV{12, 20},
-				CV{12, 21}, CV{12, 22}, CV{12, 23}, CV{12, 24},
-				CV{12, 25}, CV{12, 26}, CV{12, 27}, CV{12, 28},
-				CV{12, 29}, CV{12, 30}, CV{12, 31}, CV{12, 32},
-				CV{12, 33}, CV{12, 34}, CV{12, 35}, CV{12, 36},
-				CV{12, 37}, CV{12, 38}, CV{12, 39}, CV{12, 40},
-				CV{12, 41}, CV{12, 42}, CV{12, 43}, CV{12, 44},
-				CV{12, 45}, CV{12, 46}, CV{12, 48}, CV{12, 49},
-				CV{12, 50}, CV{13, 1}, CV{13, 2}, CV{13, 3},
-				CV{13, 4}, CV{13, 5}, CV{13, 6}, CV{13, 7},
-				CV{13, 8}, CV{13, 9}, CV{13, 10}, CV{13, 11},
-				CV{13, 12}, CV{13, 13}, CV{13, 14}, CV{13, 15},
-				CV{13, 16}, CV{13, 17}, CV{13, 18}, CV{13, 19},
-				CV{13, 20}, CV{13, 21}, CV{13, 22}, CV{13, 23},
-				CV{13, 24}, CV{13, 25}, CV{13, 26}, CV{13, 27},
-				CV{13, 28}, CV{13, 29}, CV{13, 30}, CV{13, 31},
-				CV{13, 32}, CV{13, 33}, CV{13, 34}, CV{13, 35},
-				CV{13, 36}, CV{13, 37}, CV{13, 38}, CV{13, 39},
-				CV{13, 40}, CV{13, 41}, CV{13, 42}, CV{13, 43},
-				CV{13, 44}, CV{13, 45}, CV{13, 46}, CV{13, 47},
-				CV{13, 48}, CV{13, 49}, CV{13, 50}, CV{13, 51},
-				CV{13, 52}, CV{13, 53}, CV{13, 54}, CV{13, 55},
-				CV{13, 56}, CV{13, 57}, CV{13, 58}, CV{14, 1},
-				CV{14, 2}, CV{14, 3}, CV{14, 4}, CV{14, 5},
-				CV{14, 6}, CV{14, 7}, CV{14, 8}, CV{14, 9},
-				CV{14, 10}, CV{14, 11}, CV{14, 12}, CV{14, 13},
-				CV{14, 14}, CV{14, 15}, CV{14, 16}, CV{14, 17},
-				CV{14, 18}, CV{14, 19}, CV{14, 20}, CV{14, 21},
-				CV{14, 22}, CV{14, 23}, CV{14, 24}, CV{14, 25},
-				CV{14, 26}, CV{14, 27}, CV{14, 28}, CV{14, 29},
-				CV{14, 30}, CV{14, 31}, CV{14, 32}, CV{14, 33},
-				CV{14, 34}, CV{14, 35}, CV{14, 36}, CV{15, 1},
-				CV{15, 2}, CV{15, 3}, CV{15, 4}, CV{15, 5},
-				CV{15, 6}, CV{15, 7}, CV{15, 8}, CV{15, 9},
-				CV{15, 10}, CV{15, 11}, CV{15, 12}, CV{15, 13},
-				CV{15, 14}, CV{15, 15}, CV{15, 16}, CV{15, 17},
-				CV{15, 18}, CV{15, 19}, CV{15, 20}, CV{15, 21},
-				CV{15, 22}, CV{15, 23}, CV{15, 24}, CV{15, 25},
-				CV{15, 26}, CV{15, 27}, CV{15, 28}, CV{15, 29},
-				CV{15, 30}, CV{15, 31}, CV{15, 32}, CV{15, 33},
-				CV{15, 34}, CV{15, 35}, CV{15, 36}, CV{15, 37},
-				CV{15, 38}, CV{15, 39}, CV{16, 1}, CV{16, 2},
-				CV{16, 3}, CV{16, 4}, CV{16, 5}, CV{16, 6},
-				CV{16, 7}, CV{16, 8}, CV{16, 9}, CV{16, 10},
-				CV{16, 11}, CV{16, 12}, CV{16, 13}, CV{16, 14},
-				CV{16, 15}, CV{16, 16}, CV{16, 17}, CV{16, 18},
-				CV{16, 19}, CV{16, 20}, CV{16, 21}, CV{16, 22},
-				CV{16, 23}, CV{16, 24}, CV{16, 25}, CV{16, 26},
-				CV{16, 27}, CV{16, 28}, CV{17, 1}, CV{17, 2},
-				CV{17, 3}, CV{17, 4}, CV{17, 5}, CV{17, 6},
-				CV{17, 7}, CV{17, 8}, CV{17, 9}, CV{17, 10},
-				CV{17, 11}, CV{17, 12}, CV{17, 13}, CV{17, 14},
-				CV{17, 15}, CV{17, 16}, CV{17, 17}, CV{17, 18},
-				CV{17, 19}, CV{17, 20}, CV{17, 21}, CV{17, 22},
-				CV{17, 23}, CV{17, 24}, CV{17, 25}, CV{17, 26},
-				CV{17, 27}, CV{18, 1}, CV{18, 2}, CV{18, 3},
-				CV{18, 4}, CV{18, 5}, CV{18, 6}, CV{18, 7},
-				CV{18, 8}, CV{18, 9}, CV{18, 10}, CV{18, 11},
-				CV{18, 12}, CV{18, 13}, CV{18, 14}, CV{18, 15},
-				CV{18, 16}, CV{18, 17}, CV{18, 18}, CV{18, 19},
-				CV{18, 20}, CV{18, 21}, CV{18, 22}, CV{18, 23},
-				CV{18, 24}, CV{18, 25}, CV{18, 26}, CV{18, 27},
-				CV{18, 28}, CV{18, 29}, CV{18, 30}, CV{18, 31},
-				CV{18, 32}, CV{18, 33}, CV{18, 34}, CV{18, 35},
-				CV{19, 1}, CV{19, 2}, CV{19, 3}, CV{19, 4},
-				CV{19, 5}, CV{19, 6}, CV{19, 7}, CV{19, 8},
-				CV{19, 9}, CV{19, 10}, CV{19, 11}, CV{19, 12},
-				CV{19, 13}, CV{19, 14}, CV{19, 15}, CV{19, 16},
-				CV{19, 17}, CV{19, 18}, CV{19, 19}, CV{19, 20},
-				CV{19, 21}, CV{19, 22}, CV{19, 23}, CV{19, 24},
-				CV{19, 25}, CV{19, 26}, CV{19, 27}, CV{19, 28},
-				CV{19, 29}, CV{19, 30}, CV{20, 1}, CV{20, 2},
-				CV{20, 3}, CV{20, 4}, CV{20, 5}, CV{20, 6},
-				CV{20, 7}, CV{20, 8}, CV{20, 9}, CV{20, 10},
-				CV{20, 11}, CV{20, 12}, CV{20, 13}, CV{20, 14},
-				CV{20, 15}, CV{20, 16}, CV{20, 17}, CV{20, 18},
-				CV{20, 19}, CV{20, 20}, CV{20, 21}, CV{20, 22},
-				CV{20, 23}, CV{20, 24}, CV{20, 25}, CV{20, 26},
-				CV{20, 27}, CV{20, 28}, CV{20, 29}, CV{20, 30},
-				CV{20, 31}, CV{20, 32}, CV{20, 33}, CV{20, 34},
-				CV{21, 1}, CV{21, 2}, CV{21, 3}, CV{21, 4},
-				CV{21, 5}, CV{21, 6}, CV{21, 7}, CV{21, 8},
-				CV{21, 9}, CV{21, 10}, CV{21, 11}, CV{21, 12},
-				CV{21, 13}, CV{21, 14}, CV{21, 15}, CV{21, 16},
-				CV{21, 17}, CV{21, 18}, CV{21, 19}, CV{21, 20},
-				CV{21, 21}, CV{21, 22}, CV{21, 23}, CV{21, 24},
-				CV{21, 25}, CV{21, 26}, CV{21, 27}, CV{21, 28},
-				CV{21, 29}, CV{21, 30}, CV{21, 31}, CV{21, 32},
-				CV{21, 33}, CV{21, 34}, CV{21, 35}, CV{21, 36},
-				CV{21, 37}, CV{21, 38}, CV{21, 39}, CV{21, 40},
-				CV{21, 41}, CV{21, 42}, CV{21, 43}, CV{21, 44},
-				CV{21, 45}, CV{21, 46}, CV{22, 1}, CV{22, 2},
-				CV{22, 3}, CV{22, 4}, CV{22, 5}, CV{22, 6},
-				CV{22, 7}, CV{22, 8}, CV{22, 9}, CV{22, 10},
-				CV{22, 11}, CV{22, 12}, CV{22, 13}, CV{22, 14},
-				CV{22, 15}, CV{22, 16}, CV{22, 17}, CV{22, 18},
-				CV{22, 19}, CV{22, 20}, CV{22, 21}, CV{22, 22},
-				CV{22, 23}, CV{22, 24}, CV{22, 25}, CV{22, 26},
-				CV{22, 27}, CV{22, 28}, CV{22, 29}, CV{22, 30},
-				CV{22, 31}, CV{22, 32}, CV{22, 33}, CV{22, 34},
-				CV{22, 35}, CV{22, 36}, CV{22, 37}, CV{22, 38},
-				CV{22, 39}, CV{22, 40}, CV{22, 41}, CV{22, 42},
-				CV{22, 43}, CV{22, 44}, CV{22, 45}, CV{22, 46},
-				CV{23, 1}, CV{23, 2}, CV{23, 3}, CV{23, 4},
-				CV{23, 5}, CV{23, 6}, CV{23, 7}, CV{23, 8},
-				CV{23, 9}, CV{23, 10}, CV{23, 11}, CV{23, 12},
-				CV{23, 13}, CV{23, 14}, CV{23, 15}, CV{23, 16},
-				CV{23, 17}, CV{23, 18}, CV{23, 19}, CV{23, 20},
-				CV{23, 21}, CV{23, 22}, CV{23, 23}, CV{23, 24},
-				CV{23, 25}, CV{23, 26}, CV{23, 27}, CV{23, 28},
-				CV{23, 29}, CV{23, 30}, CV{23, 31}, CV{23, 32},
-				CV{23, 33}, CV{23, 34}, CV{23, 35}, CV{23, 36},
-				CV{23, 37}, CV{23, 38}, CV{23, 39}, CV{24, 1},
-				CV{24, 2}, CV{24, 3}, CV{24, 4}, CV{24, 5},
-				CV{24, 6}, CV{24, 7}, CV{24, 8}, CV{24, 9},
-				CV{24, 10}, CV{24, 11}, CV{24, 12}, CV{24, 13},
-				CV{24, 14}, CV{24, 15}, CV{24, 16}, CV{24, 17},
-				CV{24, 18}, CV{24, 19}, CV{24, 20}, CV{24, 21},
-				CV{24, 22}, CV{24, 23}, CV{24, 24}, CV{24, 25},
-				CV{24, 26}, CV{24, 27}, CV{24, 28}, CV{24, 29},
-				CV{24, 30}, CV{24, 31}, CV{24, 32}, CV{24, 33},
-				CV{24, 34}, CV{24, 35}, CV{24, 36}, CV{24, 37},
-				CV{24, 38}, CV{24, 39}, CV{24, 40}, CV{24, 41},
-				CV{24, 42}, CV{24, 43}, CV{24, 44}, CV{24, 45},
-				CV{24, 46}, CV{24, 47}, CV{24, 48}, CV{24, 49},
-				CV{24, 50}, CV{24, 51}, CV{25, 1}, CV{25, 2},
-				CV{25, 3}, CV{25, 4}, CV{25, 5}, CV{25, 6},
-				CV{25, 7}, CV{25, 8}, CV{25, 9}, CV{25, 10},
-				CV{25, 11}, CV{25, 12}, CV{25, 13}, CV{25, 14},
-				CV{25, 15}, CV{25, 16}, CV{25, 17}, CV{25, 18},
-				CV{25, 19}, CV{25, 20}, CV{25, 21}, CV{25, 22},
-				CV{25, 23}, CV{25, 24}, CV{25, 25}, CV{25, 26},
-				CV{25, 27}, CV{25, 28}, CV{25, 29}, CV{25, 30},
-				CV{25, 31}, CV{25, 32}, CV{25, 33}, CV{25, 34},
-				CV{25, 35}, CV{25, 36}, CV{25, 37}, CV{25, 38},
-				CV{25, 39}, CV{25, 40}, CV{25, 41}, CV{25, 42},
-				CV{25, 43}, CV{25, 44}, CV{25, 45}, CV{25, 46},
-				CV{26, 1}, CV{26, 2}, CV{26, 3}, CV{26, 4},
-				CV{26, 5}, CV{26, 6}, CV{26, 7}, CV{26, 8},
-				CV{26, 9}, CV{26, 10}, CV{26, 11}, CV{26, 12},
-				CV{26, 13}, CV{26, 14}, CV{26, 15}, CV{26, 16},
-				CV{26, 17}, CV{26, 18}, CV{26, 19}, CV{26, 20},
-				CV{26, 21}, CV{26, 22}, CV{26, 23}, CV{26, 24},
-				CV{26, 25}, CV{26, 26}, CV{26, 27}, CV{26, 28},
-				CV{26, 29}, CV{26, 30}, CV{26, 31}, CV{26, 32},
-				CV{26, 33}, CV{26, 34}, CV{26, 35}, CV{26, 36},
-				CV{26, 37}, CV{26, 38}, CV{26, 39}, CV{26, 40},
-				CV{26, 41}, CV{26, 42}, CV{26, 43}, CV{26, 44},
-				CV{26, 45}, CV{26, 46}, CV{26, 47}, CV{26, 48},
-				CV{26, 49}, CV{26, 50}, CV{26, 51}, CV{26, 52},
-				CV{26, 53}, CV{26, 54}, CV{26, 55}, CV{26, 56},
-				CV{26, 57}, CV{26, 58}, CV{26, 59}, CV{26, 60},
-				CV{26, 61}, CV{26, 62}, CV{26, 63}, CV{26, 64},
-				CV{26, 65}, CV{26, 66}, CV{26, 67}, CV{26, 68},
-				CV{26, 69}, CV{26, 70}, CV{26, 71}, CV{26, 72},
-				CV{26, 73}, CV{26, 74}, CV{26, 75}, CV{27, 1},
-				CV{27, 2}, CV{27, 3}, CV{27, 4}, CV{27, 5},
-				CV{27, 6}, CV{27, 7}, CV{27, 8}, CV{27, 9},
-				CV{27, 10}, CV{27, 11}, CV{27, 12}, CV{27, 13},
-				CV{27, 14}, CV{27, 15}, CV{27, 16}, CV{27, 17},
-				CV{27, 18}, CV{27, 19}, CV{27, 20}, CV{27, 21},
-				CV{27, 22}, CV{27, 23}, CV{27, 24}, CV{27, 25},
-				CV{27, 26}, CV{27, 27}, CV{27, 28}, CV{27, 29},
-				CV{27, 30}, CV{27, 31}, CV{27, 32}, CV{27, 33},
-				CV{27, 34}, CV{27, 35}, CV{27, 36}, CV{27, 37},
-				CV{27, 38}, CV{27, 39}, CV{27, 40}, CV{27, 41},
-				CV{27, 42}, CV{27, 43}, CV{27, 44}, CV{27, 45},
-				CV{27, 46}, CV{27, 47}, CV{27, 48}, CV{27, 49},
-				CV{27, 50}, CV{27, 51}, CV{27, 52}, CV{27, 53},
-				CV{27, 54}, CV{27, 55}, CV{27, 56}, CV{27, 57},
-				CV{27, 58}, CV{27, 59}, CV{27, 60}, CV{27, 61},
-				CV{27, 62}, CV{27, 63}, CV{27, 64}, CV{27, 65},
-				CV{27, 66}, CV{28, 1}, CV{28, 2}, CV{28, 3},
-				CV{28, 4}, CV{28, 5}, CV{28, 6}, CV{28, 7},
-				CV{28, 8}, CV{28, 9}, CV{28, 10}, CV{28, 11},
-				CV{28, 12}, CV{28, 13}, CV{28, 14}, CV{28, 15},
-				CV{28, 16}, CV{28, 17}, CV{28, 18}, CV{28, 19},
-				CV{28, 20},
+			Runs: []VerseRun{
+				{Chapter: 1, First: 1, Last: 25},
+				{Chapter: 2, First: 1, Last: 23},
+				{Chapter: 3, First: 1, Last: 17},
+				{Chapter: 4, First: 1, Last: 25},
+				{Chapter: 5, First: 1, Last: 48},
+				{Chapter: 6, First: 1, Last: 34},
+				{Chapter: 7, First: 1, Last: 29},
+				{Chapter: 8, First: 1, Last: 34},
+				{Chapter: 9, First: 1, Last: 38},
+				{Chapter: 10, First: 1, Last: 42},
+				{Chapter: 11, First: 1, Last: 30},
+				{Chapter: 12, First: 1, Last: 46},
+				{Chapter: 12, First: 48, Last: 50},
+				{Chapter: 13, First: 1, Last: 58},
+				{Chapter: 14, First: 1, Last: 36},
+				{Chapter: 15, First: 1, Last: 39},
+				{Chapter: 16, First: 1, Last: 28},
+				{Chapter: 17, First: 1, Last: 27},
+				{Chapter: 18, First: 1, Last: 35},
+				{Chapter: 19, First: 1, Last: 30},
+				{Chapter: 20, First: 1, Last: 34},
+				{Chapter: 21, First: 1, Last: 46},
+				{Chapter: 22, First: 1, Last: 46},
+				{Chapter: 23, First: 1, Last: 39},
+				{Chapter: 24, First: 1, Last: 51},
+				{Chapter: 25, First: 1, Last: 46},
+				{Chapter: 26, First: 1, Last: 75},
+				{Chapter: 27, First: 1, Last: 66},
+				{Chapter: 28, First: 1, Last: 20},
 			},
 		},
 		{
 			Name:      "Mark",
+			USFM:      "MRK",
+			Testament: NewTestament,
 			JustVerse: false,
-			Verses: []Verse{CV{1, 1},
-				CV{1, 2}, CV{1, 3}, CV{1, 4}, CV{1, 5},
-				CV{1, 6}, CV{1, 7}, CV{1, 8}, CV{1, 9},
-				CV{1, 10}, CV{1, 11}, CV{1, 12}, CV{1, 13},
-				CV{1, 14}, CV{1, 15}, CV{1, 16}, CV{1, 17},
-				CV{1, 18}, CV{1, 19}, CV{1, 20}, CV{1, 21},
-				CV{1, 22}, CV{1, 23}, CV{1, 24}, CV{1, 25},
-				CV{1, 26}, CV{1, 27}, CV{1, 28}, CV{1, 29},
-				CV{1, 30}, CV{1, 31}, CV{1, 32}, CV{1, 33},
-				CV{1, 34}, CV{1, 35}, CV{1, 36}, CV{1, 37},
-				CV{1, 38}, CV{1, 39}, CV{1, 40}, CV{1, 41},
-				CV{1, 42}, CV{1, 43}, CV{1, 44}, CV{1, 45},
-				CV{2, 1}, CV{2, 2}, CV{2, 3}, CV{2, 4},
-				CV{2, 5}, CV{2, 6}, CV{2, 7}, CV{2, 8},
-				CV{2, 9}, CV{2, 10}, CV{2, 11}, CV{2, 12},
-				CV{2, 13}, CV{2, 14}, CV{2, 15}, CV{2, 16},
-				CV{2, 17}, CV{2, 18}, CV{2, 19}, CV{2, 20},
-				CV{2, 21}, CV{2, 22}, CV{2, 23}, CV{2, 24},
-				CV{2, 25}, CV{2, 26}, CV{2, 27}, CV{2, 28},
-				CV{3, 1}, CV{3, 2}, CV{3, 3}, CV{3, 4},
-				CV{3, 5}, CV{3, 6}, CV{3, 7}, CV{3, 8},
-				CV{3, 9}, CV{3, 10}, CV{3, 11}, CV{3, 12},
-				CV{3, 13}, CV{3, 14}, CV{3, 15}, CV{3, 16},
-				CV{3, 17}, CV{3, 18}, CV{3, 19}, CV{3, 20},
-				CV{3, 21}, CV{3, 22}, CV{3, 23}, CV{3, 24},
-				CV{3, 25}, CV{3, 26}, CV{3, 27}, CV{3, 28},
-				CV{3, 29}, CV{3, 30}, CV{3, 31}, CV{3, 32},
-				CV{3, 33}, CV{3, 34}, CV{3, 35}, CV{4, 1},
-				CV{4, 2}, CV{4, 3}, CV{4, 4}, CV{4, 5},
-				CV{4, 6}, CV{4, 7}, CV{4, 8}, CV{4, 9},
-				CV{4, 10}, CV{4, 11}, CV{4, 12}, CV{4, 13},
-				CV{4, 14}, CV{4, 15}, CV{4, 16}, CV{4, 17},
-				CV{4, 18}, CV{4, 19}, CV{4, 20}, CV{4, 21},
-				CV{4, 22}, CV{4, 23}, CV{4, 24}, CV{4, 25},
-				CV{4, 26}, CV{4, 27}, CV{4, 28}, CV{4, 29},
-				CV{4, 30}, CV{4, 31}, CV{4, 32}, CV{4, 33},
-				CV{4, 34}, CV{4, 35}, CV{4, 36}, CV{4, 37},
-				CV{4, 38}, CV{4, 39}, CV{4, 40}, CV{4, 41},
-				CV{5, 1}, CV{5, 2}, CV{5, 3}, CV{5, 4},
-				CV{5, 5}, CV{5, 6}, CV{5, 7}, CV{5, 8},
-				CV{5, 9}, CV{5, 10}, CV{5, 11}, CV{5, 12},
-				CV{5, 13}, CV{5, 14}, CV{5, 15}, CV{5, 16},
-				CV{5, 17}, CV{5, 18}, CV{5, 19}, CV{5, 20},
-				CV{5, 21}, CV{5, 22}, CV{5, 23}, CV{5, 24},
-				CV{5, 25}, CV{5, 26}, CV{5, 27}, CV{5, 28},
-				CV{5, 29}, CV{5, 30}, CV{5, 31}, CV{5, 32},
-				CV{5, 33}, CV{5, 34}, CV{5, 35}, CV{5, 36},
-				CV{5, 37}, CV{5, 38}, CV{5, 39}, CV{5, 40},
-				CV{5, 41}, CV{5, 42}, CV{5, 43}, CV{6, 1},
-				CV{6, 2}, CV{6, 3}, CV{6, 4}, CV{6, 5},
-				CV{6, 6}, CV{6, 7}, CV{6, 8}, CV{6, 9},
-				CV{6, 10}, CV{6, 11}, CV{6, 12}, CV{6, 13},
-				CV{6, 14}, CV{6, 15}, CV{6, 16}, CV{6, 17},
-				CV{6, 18}, CV{6, 19}, CV{6, 20}, CV{6, 21},
-				CV{6, 22}, CV{6, 23}, CV{6, 24}, CV{6, 25},
-				CV{6, 26}, CV{6, 27}, CV{6, 28}, CV{6, 29},
-				CV{6, 30}, CV{6, 31}, CV{6, 32}, CV{6, 33},
-				CV{6, 34}, CV{6, 35}, CV{6, 36}, CV{6, 37},
-				CV{6, 38}, CV{6, 39}, CV{6, 40}, CV{6, 41},
-				CV{6, 42}, CV{6, 43}, CV{6, 44}, CV{6, 45},
-				CV{6, 46}, CV{6, 47}, CV{6, 48}, CV{6, 49},
-				CV{6, 50}, CV{6, 51}, CV{6, 52}, CV{6, 53},
-				CV{6, 54}, CV{6, 55}, CV{6, 56}, CV{7, 1},
-				CV{7, 2}, CV{7, 3}, CV{7, 4}, CV{7, 5},
-				CV{7, 6}, CV{7, 7}, CV{7, 8}, CV{7, 9},
-				CV{7, 10}, CV{7, 11}, CV{7, 12}, CV{7, 13},
-				CV{7, 14}, CV{7, 15}, CV{7, 16}, CV{7, 17},
-				CV{7, 18}, CV{7, 19}, CV{7, 20}, CV{7, 21},
-				CV{7, 22}, CV{7, 23}, CV{7, 24}, CV{7, 25},
-				CV{7, 26}, CV{7, 27}, CV{7, 28}, CV{7, 29},
-				CV{7, 30}, CV{7, 31}, CV{7, 32}, CV{7, 33},
-				CV{7, 34}, CV{7, 35}, CV{7, 36}, CV{7, 37},
-				CV{8, 1}, CV{8, 2}, CV{8, 3}, CV{8, 4},
-				CV{8, 5}, CV{8, 6}, CV{8, 7}, CV{8, 8},
-				CV{8, 9}, CV{8, 10}, CV{8, 11}, CV{8, 12},
-				CV{8, 13}, CV{8, 14}, CV{8, 15}, CV{8, 16},
-				CV{8, 17}, CV{8, 18}, CV{8, 19}, CV{8, 20},
-				CV{8, 21}, CV{8, 22}, CV{8, 23}, CV{8, 24},
-				CV{8, 25}, CV{8, 26}, CV{8, 27}, CV{8, 28},
-				CV{8, 29}, CV{8, 30}, CV{8, 31}, CV{8, 32},
-				CV{8, 33}, CV{8, 34}, CV{8, 35}, CV{8, 36},
-				CV{8, 37}, CV{8, 38}, CV{9, 1}, CV{9, 2},
-				CV{9, 3}, CV{9, 4}, CV{9, 5}, CV{9, 6},
-				CV{9, 7}, CV{9, 8}, CV{9, 9}, CV{9, 10},
-				CV{9, 11}, CV{9, 12}, CV{9, 13}, CV{9, 14},
-				CV{9, 15}, CV{9, 16}, CV{9, 17}, CV{9, 18},
-				CV{9, 19}, CV{9, 20}, CV{9, 21}, CV{9, 22},
-				CV{9, 23}, CV{9, 24}, CV{9, 25}, CV{9, 26},
-				CV{9, 27}, CV{9, 28}, CV{9, 29}, CV{9, 30},
-				CV{9, 31}, CV{9, 32}, CV{9, 33}, CV{9, 34},
-				CV{9, 35}, CV{9, 36}, CV{9, 37}, CV{9, 38},
-				CV{9, 39}, CV{9, 40}, CV{9, 41}, CV{9, 42},
-				CV{9, 43}, CV{9, 44}, CV{9, 45}, CV{9, 46},
-				CV{9, 47}, CV{9, 48}, CV{9, 49}, CV{9, 50},
-				CV{10, 1}, CV{10, 2}, CV{10, 3}, CV{10, 4},
-				CV{10, 5}, CV{10, 6}, CV{10, 7}, CV{10, 8},
-				CV{10, 9}, CV{10, 10}, CV{10, 11}, CV{10, 12},
-				CV{10, 13}, CV{10, 14}, CV{10, 15}, CV{10, 16},
-				CV{10, 17}, CV{10, 18}, CV{10, 19}, CV{10, 20},
-				CV{10, 21}, CV{10, 22}, CV{10, 23}, CV{10, 24},
-				CV{10, 25}, CV{10, 26}, CV{10, 27}, CV{10, 28},
-				CV{10, 29}, CV{10, 30}, CV{10, 31}, CV{10, 32},
-				CV{10, 33}, CV{10, 34}, CV{10, 35}, CV{10, 36},
-				CV{10, 37}, CV{10, 38}, CV{10, 39}, CV{10, 40},
-				CV{10, 41}, CV{10, 42}, CV{10, 43}, CV{10, 44},
-				CV{10, 45}, CV{10, 46}, CV{10, 47}, CV{10, 48},
-				CV{10, 49}, CV{10, 50}, CV{10, 51}, CV{10, 52},
-				CV{11, 1}, CV{11, 2}, CV{11, 3}, CV{11, 4},
-				CV{11, 5}, CV{11, 6}, CV{11, 7}, CV{11, 8},
-				CV{11, 9}, CV{11, 10}, CV{11, 11}, CV{11, 12},
-				CV{11, 13}, CV{11, 14}, CV{11, 15}, CV{11, 16},
-				CV{11, 17}, CV{11, 18}, CV{11, 19}, CV{11, 20},
-				CV{11, 21}, CV{11, 22}, CV{11, 23}, CV{11, 24},
-				CV{11, 25}, CV{11, 26}, CV{11, 27}, CV{11, 28},
-				CV{11, 29}, CV{11, 30}, CV{11, 31}, CV{11, 32},
-				CV{11, 33}, CV{12, 1}, CV{12, 2}, CV{12, 3},
-				CV{12, 4}, CV{12, 5}, CV{12, 6}, CV{12, 7},
-				CV{12, 8}, CV{12, 9}, CV{12, 10}, CV{12, 11},
-				CV{12, 12}, CV{12, 13}, CV{12, 14}, CV{12, 15},
-				CV{12, 16}, CV{12, 17}, CV{12, 18}, CV{12, 19},
-				CV{12, 20}, CV{12, 21}, CV{12, 22}, CV{12, 23},
-				CV{12, 24}, CV{12, 25}, CV{12, 26}, CV{12, 27},
-				CV{12, 28}, CV{12, 29}, CV{12, 30}, CV{12, 31},
-				CV{12, 32}, CV{12, 33}, CV{12, 34}, CV{12, 35},
-				CV{12, 36}, CV{12, 37}, CV{12, 38}, CV{12, 39},
-				CV{12, 40}, CV{12, 41}, CV{12, 42}, CV{12, 43},
-				CV{12, 44}, CV{13, 1}, CV{13, 2}, CV{13, 3},
-				CV{13, 4}, CV{13, 5}, CV{13, 6}, CV{13, 7},
-				CV{13, 8}, CV{13, 9}, CV{13, 10}, CV{13, 11},
-				CV{13, 12}, CV{13, 13}, CV{13, 14}, CV{13, 15},
-				CV{13, 16}, CV{13, 17}, CV{13, 18}, CV{13, 19},
-				CV{13, 20}, CV{13, 21}, CV{13, 22}, CV{13, 23},
-				CV{13, 24}, CV{13, 25}, CV{13, 26}, CV{13, 27},
-				CV{13, 28}, CV{13, 29}, CV{13, 30}, CV{13, 31},
-				CV{13, 32}, CV{13, 33}, CV{13, 34}, CV{13, 35},
-				CV{13, 36}, CV{13, 37}, CV{14, 1}, CV{14, 2},
-				CV{14, 3}, CV{14, 4}, CV{14, 5}, CV{14, 6},
-				CV{14, 7}, CV{14, 8}, CV{14, 9}, CV{14, 10},
-				CV{14, 11}, CV{14, 12}, CV{14, 13}, CV{14, 14},
-				CV{14, 15}, CV{14, 16}, CV{14, 17}, CV{14, 18},
-				CV{14, 19}, CV{14, 20}, CV{14, 21}, CV{14, 22},
-				CV{14, 23}, CV{14, 24}, CV{14, 25}, CV{14, 26},
-				CV{14, 27}, CV{14, 28}, CV{14, 29}, CV{14, 30},
-				CV{14, 31}, CV{14, 32}, CV{14, 33}, CV{14, 34},
-				CV{14, 35}, CV{14, 36}, CV{14, 37}, CV{14, 38},
-				CV{14, 39}, CV{14, 40}, CV{14, 41}, CV{14, 42},
-				CV{14, 43}, CV{14, 44}, CV{14, 45}, CV{14, 46},
-				CV{14, 47}, CV{14, 48}, CV{14, 49}, CV{14, 50},
-				CV{14, 51}, CV{14, 52}, CV{14, 53}, CV{14, 54},
-				CV{14, 55}, CV{14, 56}, CV{14, 57}, CV{14, 58},
-				CV{14, 59}, CV{14, 60}, CV{14, 61}, CV{14, 62},
-				CV{14, 63}, CV{14, 64}, CV{14, 65}, CV{14, 66},
-				CV{14, 67}, CV{14, 68}, CV{14, 69}, CV{14, 70},
-				CV{14, 71}, CV{14, 72}, CV{15, 1}, CV{15, 2},
-				CV{15, 3}, CV{15, 4}, CV{15, 5}, CV{15, 6},
-				CV{15, 7}, CV{15, 8}, CV{15, 9}, CV{15, 10},
-				CV{15, 11}, CV{15, 12}, CV{15, 13}, CV{15, 14},
-				CV{15, 15}, CV{15, 16}, CV{15, 17}, CV{15, 18},
-				CV{15, 19}, CV{15, 20}, CV{15, 21}, CV{15, 22},
-				CV{15, 23}, CV{15, 24}, CV{15, 25}, CV{15, 26},
-				CV{15, 27}, CV{15, 28}, CV{15, 29}, CV{15, 30},
-				CV{15, 31}, CV{15, 32}, CV{15, 33}, CV{15, 34},
-				CV{15, 35}, CV{15, 36}, CV{15, 37}, CV{15, 38},
-				CV{15, 39}, CV{15, 40}, CV{15, 41}, CV{15, 42},
-				CV{15, 43}, CV{15, 44}, CV{15, 45}, CV{15, 46},
-				CV{15, 47}, CV{16, 1}, CV{16, 2}, CV{16, 3},
-				CV{16, 4}, CV{16, 5}, CV{16, 6}, CV{16, 7},
-				CV{16, 8}, CV{16, 9}, CV{16, 10}, CV{16, 11},
-				CV{16, 12}, CV{16, 13}, CV{16, 14}, CV{16, 15},
-				CV{16, 16}, CV{16, 17}, CV{16, 18}, CV{16, 19},
-				CV{16, 20},
+			Runs: []VerseRun{
+				{Chapter: 1, First: 1, Last: 45},
+				{Chapter: 2, First: 1, Last: 28},
+				{Chapter: 3, First: 1, Last: 35},
+				{Chapter: 4, First: 1, Last: 41},
+				{Chapter: 5, First: 1, Last: 43},
+				{Chapter: 6, First: 1, Last: 56},
+				{Chapter: 7, First: 1, Last: 37},
+				{Chapter: 8, First: 1, Last: 38},
+				{Chapter: 9, First: 1, Last: 50},
+				{Chapter: 10, First: 1, Last: 52},
+				{Chapter: 11, First: 1, Last: 33},
+				{Chapter: 12, First: 1, Last: 44},
+				{Chapter: 13, First: 1, Last: 37},
+				{Chapter: 14, First: 1, Last: 72},
+				{Chapter: 15, First: 1, Last: 47},
+				{Chapter: 16, First: 1, Last: 20},
 			},
 		},
 		{
 			Name:      "Luke",
+			USFM:      "LUK",
+			Testament: NewTestament,
 			JustVerse: false,
-			Verses: []Verse{CV{1, 1},
-				CV{1, 2}, CV{1, 3}, CV{1, 4}, CV{1, 5},
-				CV{1, 6}, CV{1, 7}, CV{1, 8}, CV{1, 9},
-				CV{1, 10}, CV{1, 11}, CV{1, 12}, CV{1, 13},
-				CV{1, 14}, CV{1, 15}, CV{1, 16}, CV{1, 17},
-				CV{1, 18}, CV{1, 19}, CV{1, 20}, CV{1, 21},
-				CV{1, 22}, CV{1, 23}, CV{1, 24}, CV{1, 25},
-				CV{1, 26}, CV{1, 27}, CV{1, 28}, CV{1, 29},
-				CV{1, 30}, CV{1, 31}, CV{1, 32}, CV{1, 33},
-				CV{1, 34}, CV{1, 35}, CV{1, 36}, CV{1, 37},
-				CV{1, 38}, CV{1, 39}, CV{1, 40}, CV{1, 41},
-				CV{1, 42}, CV{1, 43}, CV{1, 44}, CV{1, 45},
-				CV{1, 46}, CV{1, 47}, CV{1, 48}, CV{1, 49},
-				CV{1, 50}, CV{1, 51}, CV{1, 52}, CV{1, 53},
-				CV{1, 54}, CV{1, 55}, CV{1, 56}, CV{1, 57},
-				CV{1, 58}, CV{1, 59}, CV{1, 60}, CV{1, 61},
-				CV{1, 62}, CV{1, 63}, CV{1, 64}, CV{1, 65},
-				CV{1, 66}, CV{1, 67}, CV{1, 68}, CV{1, 69},
-				CV{1, 70}, CV{1, 71}, CV{1, 72}, CV{1, 73},
-				CV{1, 74}, CV{1, 75}, CV{1, 76}, CV{1, 77},
-				CV{1, 78}, CV{1, 79}, CV{1, 80}, CV{2, 1},
-				CV{2, 2}, CV{2, 3}, CV{2, 4}, CV{2, 5},
-				CV{2, 6}, CV{2, 7}, CV{2, 8}, CV{2, 9},
-				CV{2, 10}, CV{2, 11}, CV{2, 12}, CV{2, 13},
-				CV{2, 14}, CV{2, 15}, CV{2, 16}, CV{2, 17},
-				CV{2, 18}, CV{2, 19}, CV{2, 20}, CV{2, 21},
-				CV{2, 22}, CV{2, 23}, CV{2, 24}, CV{2, 25},
-				CV{2, 26}, CV{2, 27}, CV{2, 28}, CV{2, 29},
-				CV{2, 30}, CV{2, 31}, CV{2, 32}, CV{2, 33},
-				CV{2, 34}, CV{2, 35}, CV{2, 36}, CV{2, 37},
-				CV{2, 38}, CV{2, 39}, CV{2, 40}, CV{2, 41},
-				CV{2, 42}, CV{2, 43}, CV{2, 44}, CV{2, 45},
-				CV{2, 46}, CV{2, 47}, CV{2, 48}, CV{2, 49},
-				CV{2, 50}, CV{2, 51}, CV{2, 52}, CV{3, 1},
-				CV{3, 2}, CV{3, 3}, CV{3, 4}, CV{3, 5},
-				CV{3, 6}, CV{3, 7}, CV{3, 8}, CV{3, 9},
-				CV{3, 10}, CV{3, 11}, CV{3, 12}, CV{3, 13},
-				CV{3, 14}, CV{3, 15}, CV{3, 16}, CV{3, 17},
-				CV{3, 18}, CV{3, 19}, CV{3, 20}, CV{3, 21},
-				CV{3, 22}, CV{3, 23}, CV{3, 24}, CV{3, 25},
-				CV{3, 26}, CV{3, 27}, CV{3, 28}, CV{3, 29},
-				CV{3, 30}, CV{3, 31}, CV{3, 32}, CV{3, 33},
-				CV{3, 34}, CV{3, 35}, CV{3, 36}, CV{3, 37},
-				CV{3, 38}, CV{4, 1}, CV{4, 2}, CV{4, 3},
-				CV{4, 4}, CV{4, 5}, CV{4, 6}, CV{4, 7},
-				CV{4, 8}, CV{4, 9}, CV{4, 10}, CV{4, 11},
-				CV{4, 12}, CV{4, 13}, CV{4, 14}, CV{4, 15},
-				CV{4, 16}, CV{4, 17}, CV{4, 18}, CV{4, 19},
-				CV{4, 20}, CV{4, 21}, CV{4, 22}, CV{4, 23},
-				CV{4, 24}, CV{4, 25}, CV{4, 26}, CV{4, 27},
-				CV{4, 28}, CV{4, 29}, CV{4, 30}, CV{4, 31},
-				CV{4, 32}, CV{4, 33}, CV{4, 34}, CV{4, 35},
-				CV{4, 36}, CV{4, 37}, CV{4, 38}, CV{4, 39},
-				CV{4, 40}, CV{4, 41}, CV{4, 42}, CV{4, 43},
-				CV{4, 44}, CV{5, 1}, CV{5, 2}, CV{5, 3},
-				CV{5, 4}, CV{5, 5}, CV{5, 6}, CV{5, 7},
-				CV{5, 8}, CV{5, 9}, CV{5, 10}, CV{5, 11},
-				CV{5, 12}, CV{5, 13}, CV{5, 14}, CV{5, 15},
-				CV{5, 16}, CV{5, 17}, CV{5, 18}, CV{5, 19},
-				CV{5, 20}, CV{5, 21}, CV{5, 22}, CV{5, 23},
-				CV{5, 24}, CV{5, 25}, CV{5, 26}, CV{5, 27},
-				CV{5, 28}, CV{5, 29}, CV{5, 30}, CV{5, 31},
-				CV{5, 32}, CV{5, 33}, CV{5, 34}, CV{5, 35},
-				CV{5, 36}, CV{5, 37}, CV{5, 38}, CV{5, 39},
-				CV{6, 1}, CV{6, 2}, CV{6, 3}, CV{6, 4},
-				CV{6, 5}, CV{6, 6}, CV{6, 7}, CV{6, 8},
-				CV{6, 9}, CV{6, 10}, CV{6, 11}, CV{6, 12},
-				CV{6, 13}, CV{6, 14}, CV{6, 15}, CV{6, 16},
-				CV{6, 17}, CV{6, 18}, CV{6, 19}, CV{6, 20},
-				CV{6, 21}, CV{6, 22}, CV{6, 23}, CV{6, 24},
-				CV{6, 25}, CV{6, 26}, CV{6, 27}, CV{6, 28},
-				CV{6, 29}, CV{6, 30}, CV{6, 31}, CV{6, 32},
-				CV{6, 33}, CV{6, 34}, CV{6, 35}, CV{6, 36},
-				CV{6, 37}, CV{6, 38}, CV{6, 39}, CV{6, 40},
-				CV{6, 41}, CV{6, 42}, CV{6, 43}, CV{6, 44},
-				CV{6, 45}, CV{6, 46}, CV{6, 47}, CV{6, 48},
-				CV{6, 49}, CV{7, 1}, CV{7, 2}, CV{7, 3},
-				CV{7, 4}, CV{7, 5}, CV{7, 6}, CV{7, 7},
-				CV{7, 8}, CV{7, 9}, CV{7, 10}, CV{7, 11},
-				CV{7, 12}, CV{7, 13}, CV{7, 14}, CV{7, 15},
-				CV{7, 16}, CV{7, 17}, CV{7, 18}, CV{7, 19},
-				CV{7, 20}, CV{7, 21}, CV{7, 22}, CV{7, 23},
-				CV{7, 24}, CV{7, 25}, CV{7, 26}, CV{7, 27},
-				CV{7, 28}, CV{7, 29}, CV{7, 30}, CV{7, 31},
-				CV{7, 32}, CV{7, 33}, CV{7, 34}, CV{7, 35},
-				CV{7, 36}, CV{7, 37}, CV{7, 38}, CV{7, 39},
-				CV{7, 40}, CV{7, 41}, CV{7, 42}, CV{7, 43},
-				CV{7, 44}, CV{7, 45}, CV{7, 46}, CV{7, 47},
-				CV{7, 48}, CV{7, 49}, CV{7, 50}, CV{8, 1},
-				CV{8, 2}, CV{8, 3}, CV{8, 4}, CV{8, 5},
-				CV{8, 6}, CV{8, 7}, CV{8, 8}, CV{8, 9},
-				CV{8, 10}, CV{8, 11}, CV{8, 12}, CV{8, 13},
-				CV{8, 14}, CV{8, 15}, CV{8, 16}, CV{8, 17},
-				CV{8, 18}, CV{8, 19}, CV{8, 20}, CV{8, 21},
-				CV{8, 22}, CV{8, 23}, CV{8, 24}, CV{8, 25},
-				CV{8, 26}, CV{8, 27}, CV{8, 28}, CV{8, 29},
-				CV{8, 30}, CV{8, 31}, CV{8, 32}, CV{8, 33},
-				CV{8, 34}, CV{8, 35}, CV{8, 36}, CV{8, 37},
-				CV{8, 38}, CV{8, 39}, CV{8, 40}, CV{8, 41},
-				CV{8, 42}, CV{8, 43}, CV{8, 44}, CV{8, 45},
-				CV{8, 46}, CV{8, 47}, CV{8, 48}, CV{8, 49},
-				CV{8, 50}, CV{8, 51}, CV{8, 52}, CV{8, 53},
-				CV{8, 54}, CV{8, 55}, CV{8, 56}, CV{9, 1},
-				CV{9, 2}, CV{9, 3}, CV{9, 4}, CV{9, 5},
-				CV{9, 6}, CV{9, 7}, CV{9, 8}, CV{9, 9},
-				CV{9, 10}, CV{9, 11}, CV{9, 12}, CV{9, 13},
-				CV{9, 14}, CV{9, 15}, CV{9, 16}, CV{9, 17},
-				CV{9, 18}, CV{9, 19}, CV{9, 20}, CV{9, 21},
-				CV{9, 22}, CV{9, 23}, CV{9, 24}, CV{9, 25},
-				CV{9, 26}, CV{9, 27}, CV{9, 28}, CV{9, 29},
-				CV{9, 30}, CV{9, 31}, CV{9, 32}, CV{9, 33},
-				CV{9, 34}, CV{9, 35}, CV{9, 36}, CV{9, 37},
-				CV{9, 38}, CV{9, 39}, CV{9, 40}, CV{9, 41},
-				CV{9, 42}, CV{9, 43}, CV{9, 44}, CV{9, 45},
-				CV{9, 46}, CV{9, 47}, CV{9, 48}, CV{9, 49},
-				CV{9, 50}, CV{9, 51}, CV{9, 52}, CV{9, 53},
-				CV{9, 54}, CV{9, 55}, CV{9, 56}, CV{9, 57},
-				CV{9, 58}, CV{9, 59}, CV{9, 60}, CV{9, 61},
-				CV{9, 62}, CV{10, 1}, CV{10, 2}, CV{10, 3},
-				CV{10, 4}, CV{10, 5}, CV{10, 6}, CV{10, 7},
-				CV{10, 8}, CV{10, 9}, CV{10, 10}, CV{10, 11},
-				CV{10, 12}, CV{10, 13}, CV{10, 14}, CV{10, 15},
-				CV{10, 16}, CV{10, 17}, CV{10, 18}, CV{10, 19},
-				CV{10, 20}, CV{10, 21}, CV{10, 22}, CV{10, 23},
-				CV{10, 24}, CV{10, 25}, CV{10, 26}, CV{10, 27},
-				CV{10, 28}, CV{10, 29}, CV{10, 30}, CV{10, 31},
-				CV{10, 32}, CV{10, 33}, CV{10, 34}, CV{10, 35},
-				CV{10, 36}, CV{10, 37}, CV{10, 38}, CV{10, 39},
-				CV{10, 40}, CV{10, 41}, CV{10, 42}, CV{11, 1},
-				CV{11, 2}, CV{11, 3}, CV{11, 4}, CV{11, 5},
-				CV{11, 6}, CV{11, 7}, CV{11, 8}, CV{11, 9},
-				CV{11, 10}, CV{11, 11}, CV{11, 12}, CV{11, 13},
-				CV{11, 14}, CV{11, 15}, CV{11, 16}, CV{11, 17},
-				CV{11, 18}, CV{11, 19}, CV{11, 20}, CV{11, 21},
-				CV{11, 22}, CV{11, 23}, CV{11, 24}, CV{11, 25},
-				CV{11, 26}, CV{11, 27}, CV{11, 28}, CV{11, 29},
-				CV{11, 30}, CV{11, 31}, CV{11, 32}, CV{11, 33},
-				CV{11, 34}, CV{11, 35}, CV{11, 36}, CV{11, 37},
-				CV{11, 38}, CV{11, 39}, CV{11, 40}, CV{11, 41},
-				CV{11, 42}, CV{11, 43}, CV{11, 44}, CV{11, 45},
-				CV{11, 46}, CV{11, 47}, CV{11, 48}, CV{11, 49},
-				CV{11, 50}, CV{11, 51}, CV{11, 52}, CV{11, 53},
-				CV{11, 54}, CV{12, 1}, CV{12, 2}, CV{12, 3},
-				CV{12, 4}, CV{12, 5}, CV{12, 6}, CV{12, 7},
-				CV{12, 8}, CV{12, 9}, CV{12, 10}, CV{12, 11},
-				CV{12, 12}, CV{12, 13}, CV{12, 14}, CV{12, 15},
-				CV{12, 16}, CV{12, 17}, CV{12, 18}, CV{12, 19},
-				CV{12, 20}, CV{12, 21}, CV{12, 22}, CV{12, 23},
-				CV{12, 24}, CV{12, 25}, CV{12, 26}, CV{12, 27},
-				CV{12, 28}, CV{12, 29}, CV{12, 30}, CV{12, 31},
-				CV{12, 32}, CV{12, 33}, CV{12, 34}, CV{12, 35},
-				CV{12, 36}, CV{12, 37}, CV{12, 38}, CV{12, 39},
-				CV{12, 40}, CV{12, 41}, CV{12, 42}, CV{12, 43},
-				CV{12, 44}, CV{12, 45}, CV{12, 46}, CV{12, 47},
-				CV{12, 48}, CV{12, 49}, CV{12, 50}, CV{12, 51},
-				CV{12, 52}, CV{12, 53}, CV{12, 54}, CV{12, 55},
-				CV{12, 56}, CV{12, 57}, CV{12, 58}, CV{12, 59},
-				CV{13, 1}, CV{13, 2}, CV{13, 3}, CV{13, 4},
-				CV{13, 5}, CV{13, 6}, CV{13, 7}, CV{13, 8},
-				CV{13, 9}, CV{13, 10}, CV{13, 11}, CV{13, 12},
-				CV{13, 13}, CV{13, 14}, CV{13, 15}, CV{13, 16},
-				CV{13, 17}, CV{13, 18}, CV{13, 19}, CV{13, 20},
-				CV{13, 21}, CV{13, 22}, CV{13, 23}, CV{13, 24},
-				CV{13, 25}, CV{13, 26}, CV{13, 27}, CV{13, 28},
-				CV{13, 29}, CV{13, 30}, CV{13, 31}, CV{13, 32},
-				CV{13, 33}, CV{13, 34}, CV{13, 35}, CV{14, 1},
-				CV{14, 2}, CV{14, 3}, CV{14, 4}, CV{14, 5},
-				CV{14, 6}, CV{14, 7}, CV{14, 8}, CV{14, 9},
-				CV{14, 10}, CV{14, 11}, CV{14, 12}, CV{14, 13},
-				CV{14, 14}, CV{14, 15}, CV{14, 16}, CV{14, 17},
-				CV{14, 18}, CV{14, 19}, CV{14, 20}, CV{14, 21},
-				CV{14, 22}, CV{14, 23}, CV{14, 24}, CV{14, 25},
-				CV{14, 26}, CV{14, 27}, CV{14, 28}, CV{14, 29},
-				CV{14, 30}, CV{14, 31}, CV{14, 32}, CV{14, 33},
-				CV{14, 34}, CV{14, 35}, CV{15, 1}, CV{15, 2},
-				CV{15, 3}, CV{15, 4}, CV{15, 5}, CV{15, 6},
-				CV{15, 7}, CV{15, 8}, CV{15, 9}, CV{15, 10},
-				CV{15, 11}, CV{15, 12}, CV{15, 13}, CV{15, 14},
-				CV{15, 15}, CV{15, 16}, CV{15, 17}, CV{15, 18},
-				CV{15, 19}, CV{15, 20}, CV{15, 21}, CV{15, 22},
-				CV{15, 23}, CV{15, 24}, CV{15, 25}, CV{15, 26},
-				CV{15, 27}, CV{15, 28}, CV{15, 29}, CV{15, 30},
-				CV{15, 31}, CV{15, 32}, CV{16, 1}, CV{16, 2},
-				CV{16, 3}, CV{16, 4}, CV{16, 5}, CV{16, 6},
-				CV{16, 7}, CV{16, 8}, CV{16, 9}, CV{16, 10},
-				CV{16, 11}, CV{16, 12}, CV{16, 13}, CV{16, 14},
-				CV{16, 15}, CV{16, 16}, CV{16, 17}, CV{16, 18},
-				CV{16, 19}, CV{16, 20}, CV{16, 21}, CV{16, 22},
-				CV{16, 23}, CV{16, 24}, CV{16, 25}, CV{16, 26},
-				CV{16, 27}, CV{16, 28}, CV{16, 29}, CV{16, 30},
-				CV{16, 31}, CV{17, 1}, CV{17, 2}, CV{17, 3},
-				CV{17, 4}, CV{17, 5}, CV{17, 6}, CV{17, 7},
-				CV{17, 8}, CV{17, 9}, CV{17, 10}, CV{17, 11},
-				CV{17, 12}, CV{17, 13}, CV{17, 14}, CV{17, 15},
-				CV{17, 16}, CV{17, 17}, CV{17, 18}, CV{17, 19},
-				CV{17, 20}, CV{17, 21}, CV{17, 22}, CV{17, 23},
-				CV{17, 24}, CV{17, 25}, CV{17, 26}, CV{17, 27},
-				CV{17, 28}, CV{17, 29}, CV{17, 30}, CV{17, 31},
-				CV{17, 32}, CV{17, 33}, CV{17, 34}, CV{17, 35},
-				CV{17, 36}, CV{17, 37}, CV{18, 1}, CV{18, 2},
-				CV{18, 3}, CV{18, 4}, CV{18, 5}, CV{18, 6},
-				CV{18, 7}, CV{18, 8}, CV{18, 9}, CV{18, 10},
-				CV{18, 11}, CV{18, 12}, CV{18, 13}, CV{18, 14},
-				CV{18, 15}, CV{18, 16}, CV{18, 17}, CV{18, 18},
-				CV{18, 19}, CV{18, 20}, CV{18, 21}, CV{18, 22},
-				CV{18, 23}, CV{18, 24}, CV{18, 25}, CV{18, 26},
-				CV{18, 27}, CV{18, 28}, CV{18, 29}, CV{18, 30},
-				CV{18, 31}, CV{18, 32}, CV{18, 33}, CV{18, 34},
-				CV{18, 35}, CV{18, 36}, CV{18, 37}, CV{18, 38},
-				CV{18, 39}, CV{18, 40}, CV{18, 41}, CV{18, 42},
-				CV{18, 43}, CV{19, 1}, CV{19, 2}, CV{19, 3},
-				CV{19, 4}, CV{19, 5}, CV{19, 6}, CV{19, 7},
-				CV{19, 8}, CV{19, 9}, CV{19, 10}, CV{19, 11},
-				CV{19, 12}, CV{19, 13}, CV{19, 14}, CV{19, 15},
-				CV{19, 16}, CV{19, 17}, CV{19, 18}, CV{19, 19},
-				CV{19, 20}, CV{19, 21}, CV{19, 22}, CV{19, 23},
-				CV{19, 24}, CV{19, 25}, CV{19, 26}, CV{19, 27},
-				CV{19, 28}, CV{19, 29}, CV{19, 30}, CV{19, 31},
-				CV{19, 32}, CV{19, 33}, CV{19, 34}, CV{19, 35},
-				CV{19, 36}, CV{19, 37}, CV{19, 38}, CV{19, 39},
-				CV{19, 40}, CV{19, 41}, CV{19, 42}, CV{19, 43},
-				CV{19, 44}, CV{19, 45}, CV{19, 46}, CV{19, 47},
-				CV{19, 48}, CV{20, 1}, CV{20, 2}, CV{20, 3},
-				CV{20, 4}, CV{20, 5}, CV{20, 6}, CV{20, 7},
-				CV{20, 8}, CV{20, 9}, CV{20, 10}, CV{20, 11},
-				CV{20, 12}, CV{20, 13}, CV{20, 14}, CV{20, 15},
-				CV{20, 16}, CV{20, 17}, CV{20, 18}, CV{20, 19},
-				CV{20, 20}, CV{20, 21}, CV{20, 22}, CV{20, 23},
-				CV{20, 24}, CV{20, 25}, CV{20, 26}, CV{20, 27},
-				CV{20, 28}, CV{20, 29}, CV{20, 30}, CV{20, 31},
-				CV{20, 32}, CV{20, 33}, CV{20, 34}, CV{20, 35},
-				CV{20, 36}, CV{20, 37}, CV{20, 38}, CV{20, 39},
-				CV{20, 40}, CV{20, 41}, CV{20, 42}, CV{20, 43},
-				CV{20, 44}, CV{20, 45}, CV{20, 46}, CV{20, 47},
-				CV{21, 1}, CV{21, 2}, CV{21, 3}, CV{21, 4},
-				CV{21, 5}, CV{21, 6}, CV{21, 7}, CV{21, 8},
-				CV{21, 9}, CV{21, 10}, CV{21, 11}, CV{21, 12},
-				CV{21, 13}, CV{21, 14}, CV{21, 15}, CV{21, 16},
-				CV{21, 17}, CV{21, 18}, CV{21, 19}, CV{21, 20},
-				CV{21, 21}, CV{21, 22}, CV{21, 23}, CV{21, 24},
-				CV{21, 25}, CV{21, 26}, CV{21, 27}, CV{21, 28},
-				CV{21, 29}, CV{21, 30}, CV{21, 31}, CV{21, 32},
-				CV{21, 33}, CV{21, 34}, CV{21, 35}, CV{21, 36},
-				CV{21, 37}, CV{21, 38}, CV{22, 1}, CV{22, 2},
-				CV{22, 3}, CV{22, 4}, CV{22, 5}, CV{22, 6},
-				CV{22, 7}, CV{22, 8}, CV{22, 9}, CV{22, 10},
-				CV{22, 11}, CV{22, 12}, CV{22, 13}, CV{22, 14},
-				CV{22, 15}, CV{22, 16}, CV{22, 17}, CV{22, 18},
-				CV{22, 19}, CV{22, 20}, CV{22, 21}, CV{22, 22},
-				CV{22, 23}, CV{22, 24}, CV{22, 25}, CV{22, 26},
-				CV{22, 27}, CV{22, 28}, CV{22, 29}, CV{22, 30},
-				CV{22, 31}, CV{22, 32}, CV{22, 33}, CV{22, 34},
-				CV{22, 35}, CV{22, 36}, CV{22, 37}, CV{22, 38},
-				CV{22, 39}, CV{22, 40}, CV{22, 41}, CV{22, 42},
-				CV{22, 43}, CV{22, 44}, CV{22, 45}, CV{22, 46},
-				CV{22, 47}, CV{22, 48}, CV{22, 49}, CV{22, 50},
-				CV{22, 51}, CV{22, 52}, CV{22, 53}, CV{22, 54},
-				CV{22, 55}, CV{22, 56}, CV{22, 57}, CV{22, 58},
-				CV{22, 59}, CV{22, 60}, CV{22, 61}, CV{22, 62},
-				CV{22, 63}, CV{22, 64}, CV{22, 65}, CV{22, 66},
-				CV{22, 67}, CV{22, 68}, CV{22, 69}, CV{22, 70},
-				CV{22, 71}, CV{23, 1}, CV{23, 2}, CV{23, 3},
-				CV{23, 4}, CV{23, 5}, CV{23, 6}, CV{23, 7},
-				CV{23, 8}, CV{23, 9}, CV{23, 10}, CV{23, 11},
-				CV{23, 12}, CV{23, 13}, CV{23, 14}, CV{23, 15},
-				CV{23, 16}, CV{23, 17}, CV{23, 18}, CV{23, 19},
-				CV{23, 20}, CV{23, 21}, CV{23, 22}, CV{23, 23},
-				CV{23, 24}, CV{23, 25}, CV{23, 26}, CV{23, 27},
-				CV{23, 28}, CV{23, 29}, CV{23, 30}, CV{23, 31},
-				CV{23, 32}, CV{23, 33}, CV{23, 34}, CV{23, 35},
-				CV{23, 36}, CV{23, 37}, CV{23, 38}, CV{23, 39},
-				CV{23, 40}, CV{23, 41}, CV{23, 42}, CV{23, 43},
-				CV{23, 44}, CV{23, 45}, CV{23, 46}, CV{23, 47},
-				CV{23, 48}, CV{23, 49}, CV{23, 50}, CV{23, 51},
-				CV{23, 52}, CV{23, 53}, CV{23, 54}, CV{23, 55},
-				CV{23, 56}, CV{24, 1}, CV{24, 2}, CV{24, 3},
-				CV{24, 4}, CV{24, 5}, CV{24, 6}, CV{24, 7},
-				CV{24, 8}, CV{24, 9}, CV{24, 10}, CV{24, 11},
-				CV{24, 12}, CV{24, 13}, CV{24, 14}, CV{24, 15},
-				CV{24, 16}, CV{24, 17}, CV{24, 18}, CV{24, 19},
-				CV{24, 20}, CV{24, 21}, CV{24, 22}, CV{24, 23},
-				CV{24, 24}, CV{24, 25}, CV{24, 26}, CV{24, 27},
-				CV{24, 28}, CV{24, 29}, CV{24, 30}, CV{24, 31},
-				CV{24, 32}, CV{24, 33}, CV{24, 34}, CV{24, 35},
-				CV{24, 36}, CV{24, 37}, CV{24, 38}, CV{24, 39},
-				CV{24, 40}, CV{24, 41}, CV{24, 42}, CV{24, 43},
-				CV{24, 44}, CV{24, 45}, CV{24, 46}, CV{24, 47},
-				CV{24, 48}, CV{24, 49}, CV{24, 50}, CV{24, 51},
-				CV{24, 52}, CV{24, 53},
+			Runs: []VerseRun{
+				{Chapter: 1, First: 1, Last: 80},
+				{Chapter: 2, First: 1, Last: 52},
+				{Chapter: 3, First: 1, Last: 38},
+				{Chapter: 4, First: 1, Last: 44},
+				{Chapter: 5, First: 1, Last: 39},
+				{Chapter: 6, First: 1, Last: 49},
+				{Chapter: 7, First: 1, Last: 50},
+				{Chapter: 8, First: 1, Last: 56},
+				{Chapter: 9, First: 1, Last: 62},
+				{Chapter: 10, First: 1, Last: 42},
+				{Chapter: 11, First: 1, Last: 54},
+				{Chapter: 12, First: 1, Last: 59},
+				{Chapter: 13, First: 1, Last: 35},
+				{Chapter: 14, First: 1, Last: 35},
+				{Chapter: 15, First: 1, Last: 32},
+				{Chapter: 16, First: 1, Last: 31},
+				{Chapter: 17, First: 1, Last: 37},
+				{Chapter: 18, First: 1, Last: 43},
+				{Chapter: 19, First: 1, Last: 48},
+				{Chapter: 20, First: 1, Last: 47},
+				{Chapter: 21, First: 1, Last: 38},
+				{Chapter: 22, First: 1, Last: 71},
+				{Chapter: 23, First: 1, Last: 56},
+				{Chapter: 24, First: 1, Last: 53},
 			},
 		},
 		{
 			Name:      "John",
+			USFM:      "JHN",
+			Testament: NewTestament,
 			JustVerse: false,
-			Verses: []Verse{CV{1, 1},
-				CV{1, 2}, CV{1, 3}, CV{1, 4}, CV{1, 5},
-				CV{1, 6}, CV{1, 7}, CV{1, 8}, CV{1, 9},
-				CV{1, 10}, CV{1, 11}, CV{1, 12}, CV{1, 13},
-				CV{1, 14}, CV{1, 15}, CV{1, 16}, CV{1, 17},
-				CV{1, 18}, CV{1, 19}, CV{1, 20}, CV{1, 21},
-				CV{1, 22}, CV{1, 23}, CV{1, 24}, CV{1, 25},
-				CV{1, 26}, CV{1, 27}, CV{1, 28}, CV{1, 29},
-				CV{1, 30}, CV{1, 31}, CV{1, 32}, CV{1, 33},
-				CV{1, 34}, CV{1, 35}, CV{1, 36}, CV{1, 37},
-				CV{1, 38}, CV{1, 39}, CV{1, 40}, CV{1, 41},
-				CV{1, 42}, CV{1, 43}, CV{1, 44}, CV{1, 45},
-				CV{1, 46}, CV{1, 47}, CV{1, 48}, CV{1, 49},
-				CV{1, 50}, CV{1, 51}, CV{2, 1}, CV{2, 2},
-				CV{2, 3}, CV{2, 4}, CV{2, 5}, CV{2, 6},
-				CV{2, 7}, CV{2, 8}, CV{2, 9}, CV{2, 10},
-				CV{2, 11}, CV{2, 12}, CV{2, 13}, CV{2, 14},
-				CV{2, 15}, CV{2, 16}, CV{2, 17}, CV{2, 18},
-				CV{2, 19}, CV{2, 20}, CV{2, 21}, CV{2, 22},
-				CV{2, 23}, CV{2, 24}, CV{2, 25}, CV{3, 1},
-				CV{3, 2}, CV{3, 3}, CV{3, 4}, CV{3, 5},
-				CV{3, 6}, CV{3, 7}, CV{3, 8}, CV{3, 9},
-				CV{3, 10}, CV{3, 11}, CV{3, 12}, CV{3, 13},
-				CV{3, 14}, CV{3, 15}, CV{3, 16}, CV{3, 17},
-				CV{3, 18}, CV{3, 19}, CV{3, 20}, CV{3, 21},
-				CV{3, 22}, CV{3, 23}, CV{3, 24}, CV{3, 25},
-				CV{3, 26}, CV{3, 27}, CV{3, 28}, CV{3, 29},
-				CV{3, 30}, CV{3, 31}, CV{3, 32}, CV{3, 33},
-				CV{3, 34}, CV{3, 35}, CV{3, 36}, CV{4, 1},
-				CV{4, 2}, CV{4, 3}, CV{4, 4}, CV{4, 5},
-				CV{4, 6}, CV{4, 7}, CV{4, 8}, CV{4, 9},
-				CV{4, 10}, CV{4, 11}, CV{4, 12}, CV{4, 13},
-				CV{4, 14}, CV{4, 15}, CV{4, 16}, CV{4, 17},
-				CV{4, 18}, CV{4, 19}, CV{4, 20}, CV{4, 21},
-				CV{4, 22}, CV{4, 23}, CV{4, 24}, CV{4, 25},
-				CV{4, 26}, CV{4, 27}, CV{4, 28}, CV{4, 29},
-				CV{4, 30}, CV{4, 31}, CV{4, 32}, CV{4, 33},
-				CV{4, 34}, CV{4, 35}, CV{4, 36}, CV{4, 37},
-				CV{4, 38}, CV{4, 39}, CV{4, 40}, CV{4, 41},
-				CV{4, 42}, CV{4, 43}, CV{4, 44}, CV{4, 45},
-				CV{4, 46}, CV{4, 47}, CV{4, 48}, CV{4, 49},
-				CV{4, 50}, CV{4, 51}, CV{4, 52}, CV{4, 53},
-				CV{4, 54}, CV{5, 1}, CV{5, 2}, CV{5, 3},
-				CV{5, 4}, CV{5, 5}, CV{5, 6}, CV{5, 7},
-				CV{5, 8}, CV{5, 9}, CV{5, 10}, CV{5, 11},
-				CV{5, 12}, CV{5, 13}, CV{5, 14}, CV{5, 15},
-				CV{5, 16}, CV{5, 17}, CV{5, 18}, CV{5, 19},
-				CV{5, 20}, CV{5, 21}, CV{5, 22}, CV{5, 23},
-				CV{5, 24}, CV{5, 25}, CV{5, 26}, CV{5, 27},
-				CV{5, 28}, CV{5, 29}, CV{5, 30}, CV{5, 31},
-				CV{5, 32}, CV{5, 33}, CV{5, 34}, CV{5, 35},
-				CV{5, 36}, CV{5, 37}, CV{5, 38}, CV{5, 39},
-				CV{5, 40}, CV{5, 41}, CV{5, 42}, CV{5, 43},
-				CV{5, 44}, CV{5, 45}, CV{5, 46}, CV{5, 47},
-				CV{6, 1}, CV{6, 2}, CV{6, 3}, CV{6, 4},
-				CV{6, 5}, CV{6, 6}, CV{6, 7}, CV{6, 8},
-				CV{6, 9}, CV{6, 10}, CV{6, 11}, CV{6, 12},
-				CV{6, 13}, CV{6, 14}, CV{6, 15}, CV{6, 16},
-				CV{6, 17}, CV{6, 18}, CV{6, 19}, CV{6, 20},
-				CV{6, 21}, CV{6, 22}, CV{6, 23}, CV{6, 24},
-				CV{6, 25}, CV{6, 26}, CV{6, 27}, CV{6, 28},
-				CV{6, 29}, CV{6, 30}, CV{6, 31}, CV{6, 32},
-				CV{6, 33}, CV{6, 34}, CV{6, 35}, CV{6, 36},
-				CV{6, 37}, CV{6, 38}, CV{6, 39}, CV{6, 40},
-				CV{6, 41}, CV{6, 42}, CV{6, 43}, CV{6, 44},
-				CV{6, 45}, CV{6, 46}, CV{6, 47}, CV{6, 48},
-				CV{6, 49}, CV{6, 50}, CV{6, 51}, CV{6, 52},
-				CV{6, 53}, CV{6, 54}, CV{6, 55}, CV{6, 56},
-				CV{6, 57}, CV{6, 58}, CV{6, 59}, CV{6, 60},
-				CV{6, 61}, CV{6, 62}, CV{6, 63}, CV{6, 64},
-				CV{6, 65}, CV{6, 66}, CV{6, 67}, CV{6, 68},
-				CV{6, 69}, CV{6, 70}, CV{6, 71}, CV{7, 1},
-				CV{7, 2}, CV{7, 3}, CV{7, 4}, CV{7, 5},
-				CV{7, 6}, CV{7, 7}, CV{7, 8}, CV{7, 9},
-				CV{7, 10}, CV{7, 11}, CV{7, 12}, CV{7, 13},
-				CV{7, 14}, CV{7, 15}, CV{7, 16}, CV{7, 17},
-				CV{7, 18}, CV{7, 19}, CV{7, 20}, CV{7, 21},
-				CV{7, 22}, CV{7, 23}, CV{7, 24}, CV{7, 25},
-				CV{7, 26}, CV{7, 27}, CV{7, 28}, CV{7, 29},
-				CV{7, 30}, CV{7, 31}, CV{7, 32}, CV{7, 33},
-				CV{7, 34}, CV{7, 35}, CV{7, 36}, CV{7, 37},
-				CV{7, 38}, CV{7, 39}, CV{7, 40}, CV{7, 41},
-				CV{7, 42}, CV{7, 43}, CV{7, 44}, CV{7, 45},
-				CV{7, 46}, CV{7, 47}, CV{7, 48}, CV{7, 49},
-				CV{7, 50}, CV{7, 51}, CV{7, 52}, CV{7, 53},
-				CV{8, 1}, CV{8, 2}, CV{8, 3}, CV{8, 4},
-				CV{8, 5}, CV{8, 6}, CV{8, 7}, CV{8, 8},
-				CV{8, 9}, CV{8, 10}, CV{8, 11}, CV{8, 12},
-				CV{8, 13}, CV{8, 14}, CV{8, 15}, CV{8, 16},
-				CV{8, 17}, CV{8, 18}, CV{8, 19}, CV{8, 20},
-				CV{8, 21}, CV{8, 22}, CV{8, 23}, CV{8, 24},
-				CV{8, 25}, CV{8, 26}, CV{8, 27}, CV{8, 28},
-				CV{8, 29}, CV{8, 30}, CV{8, 31}, CV{8, 32},
-				CV{8, 33}, CV{8, 34}, CV{8, 35}, CV{8, 36},
-				CV{8, 37}, CV{8, 38}, CV{8, 39}, CV{8, 40},
-				CV{8, 41}, CV{8, 42}, CV{8, 43}, CV{8, 44},
-				CV{8, 45}, CV{8, 46}, CV{8, 47}, CV{8, 48},
-				CV{8, 49}, CV{8, 50}, CV{8, 51}, CV{8, 52},
-				CV{8, 53}, CV{8, 54}, CV{8, 55}, CV{8, 56},
-				CV{8, 57}, CV{8, 58}, CV{8, 59}, CV{9, 1},
-				CV{9, 2}, CV{9, 3}, CV{9, 4}, CV{9, 5},
-				CV{9, 6}, CV{9, 7}, CV{9, 8}, CV{9, 9},
-				CV{9, 10}, CV{9, 11}, CV{9, 12}, CV{9, 13},
-				CV{9, 14}, CV{9, 15}, CV{9, 16}, CV{9, 17},
-				CV{9, 18}, CV{9, 19}, CV{9, 20}, CV{9, 21},
-				CV{9, 22}, CV{9, 23}, CV{9, 24}, CV{9, 25},
-				CV{9, 26}, CV{9, 27}, CV{9, 28}, CV{9, 29},
-				CV{9, 30}, CV{9, 31}, CV{9, 32}, CV{9, 33},
-				CV{9, 34}, CV{9, 35}, CV{9, 36}, CV{9, 37},
-				CV{9, 38}, CV{9, 39}, CV{9, 40}, CV{9, 41},
-				CV{10, 1}, CV{10, 2}, CV{10, 3}, CV{10, 4},
-				CV{10, 5}, CV{10, 6}, CV{10, 7}, CV{10, 8},
-				CV{10, 9}, CV{10, 10}, CV{10, 11}, CV{10, 12},
-				CV{10, 13}, CV{10, 14}, CV{10, 15}, CV{10, 16},
-				CV{10, 17}, CV{10, 18}, CV{10, 19}, CV{10, 20},
-				CV{10, 21}, CV{10, 22}, CV{10, 23}, CV{10, 24},
-				CV{10, 25}, CV{10, 26}, CV{10, 27}, CV{10, 28},
-				CV{10, 29}, CV{10, 30}, CV{10, 31}, CV{10, 32},
-				CV{10, 33}, CV{10, 34}, CV{10, 35}, CV{10, 36},
-				CV{10, 37}, CV{10, 38}, CV{10, 39}, CV{10, 40},
-				CV{10, 41}, CV{10, 42}, CV{11, 1}, CV{11, 2},
-				CV{11, 3}, CV{11, 4}, CV{11, 5}, CV{11, 6},
-				CV{11, 7}, CV{11, 8}, CV{11, 9}, CV{11, 10},
-				CV{11, 11}, CV{11, 12}, CV{11, 13}, CV{11, 14},
-				CV{11, 15}, CV{11, 16}, CV{11, 17}, CV{11, 18},
-				CV{11, 19}, CV{11, 20}, CV{11, 21}, CV{11, 22},
-				CV{11, 23}, CV{11, 24}, CV{11, 25}, CV{11, 26},
-				CV{11, 27}, CV{11, 28}, CV{11, 29}, CV{11, 30},
-				CV{11, 31}, CV{11, 32}, CV{11, 33}, CV{11, 34},
-				CV{11, 35}, CV{11, 36}, CV{11, 37}, CV{11, 38},
-				CV{11, 39}, CV{11, 40}, CV{11, 41}, CV{11, 42},
-				CV{11, 43}, CV{11, 44}, CV{11, 45}, CV{11, 46},
-				CV{11, 47}, CV{11, 48}, CV{11, 49}, CV{11, 50},
-				CV{11, 51}, CV{11, 52}, CV{11, 53}, CV{11, 54},
-				CV{11, 55}, CV{11, 56}, CV{11, 57}, CV{12, 1},
-				CV{12, 2}, CV{12, 3}, CV{12, 4}, CV{12, 5},
-				CV{12, 6}, CV{12, 7}, CV{12, 8}, CV{12, 9},
-				CV{12, 10}, CV{12, 11}, CV{12, 12}, CV{12, 13},
-				CV{12, 14}, CV{12, 15}, CV{12, 16}, CV{12, 17},
-				CV{12, 18}, CV{12, 19}, CV{12, 20}, CV{12, 21},
-				CV{12, 22}, CV{12, 23}, CV{12, 24}, CV{12, 25},
-				CV{12, 26}, CV{12, 27}, CV{12, 28}, CV{12, 29},
-				CV{12, 30}, CV{12, 31}, CV{12, 32}, CV{12, 33},
-				CV{12, 34}, CV{12, 35}, CV{12, 36}, CV{12, 37},
-				CV{12, 38}, CV{12, 39}, CV{12, 40}, CV{12, 41},
-				CV{12, 42}, CV{12, 43}, CV{12, 44}, CV{12, 45},
-				CV{12, 46}, CV{12, 47}, CV{12, 48}, CV{12, 49},
-				CV{12, 50}, CV{13, 1}, CV{13, 2}, CV{13, 3},
-				CV{13, 4}, CV{13, 5}, CV{13, 6}, CV{13, 7},
-				CV{13, 8}, CV{13, 9}, CV{13, 10}, CV{13, 11},
-				CV{13, 12}, CV{13, 13}, CV{13, 14}, CV{13, 15},
-				CV{13, 16}, CV{13, 17}, CV{13, 18}, CV{13, 19},
-				CV{13, 20}, CV{13, 21}, CV{13, 22}, CV{13, 23},
-				CV{13, 24}, CV{13, 25}, CV{13, 26}, CV{13, 27},
-				CV{13, 28}, CV{13, 29}, CV{13, 30}, CV{13, 31},
-				CV{13, 32}, CV{13, 33}, CV{13, 34}, CV{13, 35},
-				CV{13, 36}, CV{13, 37}, CV{13, 38}, CV{14, 1},
-				CV{14, 2}, CV{14, 3}, CV{14, 4}, CV{14, 5},
-				CV{14, 6}, CV{14, 7}, CV{14, 8}, CV{14, 9},
-				CV{14, 10}, CV{14, 11}, CV{14, 12}, CV{14, 13},
-				CV{14, 14}, CV{14, 15}, CV{14, 16}, CV{14, 17},
-				CV{14, 18}, CV{14, 19}, CV{14, 20}, CV{14, 21},
-				CV{14, 22}, CV{14, 23}, CV{14, 24}, CV{14, 25},
-				CV{14, 26}, CV{14, 27}, CV{14, 28}, CV{14, 29},
-				CV{14, 30}, CV{14, 31}, CV{15, 1}, CV{15, 2},
-				CV{15, 3}, CV{15, 4}, CV{15, 5}, CV{15, 6},
-				CV{15, 7}, CV{15, 8}, CV{15, 9}, CV{15, 10},
-				CV{15, 11}, CV{15, 12}, CV{15, 13}, CV{15, 14},
-				CV{15, 15}, CV{15, 16}, CV{15, 17}, CV{15, 18},
-				CV{15, 19}, CV{15, 20}, CV{15, 21}, CV{15, 22},
-				CV{15, 23}, CV{15, 24}, CV{15, 25}, CV{15, 26},
-				CV{15, 27}, CV{16, 1}, CV{16, 2}, CV{16, 3},
-				CV{16, 4}, CV{16, 5}, CV{16, 6}, CV{16, 7},
-				CV{16, 8}, CV{16, 9}, CV{16, 10}, CV{16, 11},
-				CV{16, 12}, CV{16, 13}, CV{16, 14}, CV{16, 15},
-				CV{16, 16}, CV{16, 17}, CV{16, 18}, CV{16, 19},
-				CV{16, 20}, CV{16, 21}, CV{16, 22}, CV{16, 23},
-				CV{16, 24}, CV{16, 25}, CV{16, 26}, CV{16, 27},
-				CV{16, 28}, CV{16, 29}, CV{16, 30}, CV{16, 31},
-				CV{16, 32}, CV{16, 33}, CV{17, 1}, CV{17, 2},
-				CV{17, 3}, CV{17, 4}, CV{17, 5}, CV{17, 6},
-				CV{17, 7}, CV{17, 8}, CV{17, 9}, CV{17, 10},
-				CV{17, 11}, CV{17, 12}, CV{17, 13}, CV{17, 14},
-				CV{17, 15}, CV{17, 16}, CV{17, 17}, CV{17, 18},
-				CV{17, 19}, CV{17, 20}, CV{17, 21}, CV{17, 22},
-				CV{17, 23}, CV{17, 24}, CV{17, 25}, CV{17, 26},
-				CV{18, 1}, CV{18, 2}, CV{18, 3}, CV{18, 4},
-				CV{18, 5}, CV{18, 6}, CV{18, 7}, CV{18, 8},
-				CV{18, 9}, CV{18, 10}, CV{18, 11}, CV{18, 12},
-				CV{18, 13}, CV{18, 14}, CV{18, 15}, CV{18, 16},
-				CV{18, 17}, CV{18, 18}, CV{18, 19}, CV{18, 20},
-				CV{18, 21}, CV{18, 22}, CV{18, 23}, CV{18, 24},
-				CV{18, 25}, CV{18, 26}, CV{18, 27}, CV{18, 28},
-				CV{18, 29}, CV{18, 30}, CV{18, 31}, CV{18, 32},
-				CV{18, 33}, CV{18, 34}, CV{18, 35}, CV{18, 36},
-				CV{18, 37}, CV{18, 38}, CV{18, 39}, CV{18, 40},
-				CV{19, 1}, CV{19, 2}, CV{19, 3}, CV{19, 4},
-				CV{19, 5}, CV{19, 6}, CV{19, 7}, CV{19, 8},
-				CV{19, 9}, CV{19, 10}, CV{19, 11}, CV{19, 12},
-				CV{19, 13}, CV{19, 14}, CV{19, 15}, CV{19, 16},
-				CV{19, 17}, CV{19, 18}, CV{19, 19}, CV{19, 20},
-				CV{19, 21}, CV{19, 22}, CV{19, 23}, CV{19, 24},
-				CV{19, 25}, CV{19, 26}, CV{19, 27}, CV{19, 28},
-				CV{19, 29}, CV{19, 30}, CV{19, 31}, CV{19, 32},
-				CV{19, 33}, CV{19, 34}, CV{19, 35}, CV{19, 36},
-				CV{19, 37}, CV{19, 38}, CV{19, 39}, CV{19, 40},
-				CV{19, 41}, CV{19, 42}, CV{20, 1}, CV{20, 2},
-				CV{20, 3}, CV{20, 4}, CV{20, 5}, CV{20, 6},
-				CV{20, 7}, CV{20, 8}, CV{20, 9}, CV{20, 10},
-				CV{20, 11}, CV{20, 12}, CV{20, 13}, CV{20, 14},
-				CV{20, 15}, CV{20, 16}, CV{20, 17}, CV{20, 18},
-				CV{20, 19}, CV{20, 20}, CV{20, 21}, CV{20, 22},
-				CV{20, 23}, CV{20, 24}, CV{20, 25}, CV{20, 26},
-				CV{20, 27}, CV{20, 28}, CV{20, 29}, CV{20, 30},
-				CV{20, 31}, CV{21, 1}, CV{21, 2}, CV{21, 3},
-				CV{21, 4}, CV{21, 5}, CV{21, 6}, CV{21, 7},
-				CV{21, 8}, CV{21, 9}, CV{21, 10}, CV{21, 11},
-				CV{21, 12}, CV{21, 13}, CV{21, 14}, CV{21, 15},
-				CV{21, 16}, CV{21, 17}, CV{21, 18}, CV{21, 19},
-				CV{21, 20}, CV{21, 21}, CV{21, 22}, CV{21, 23},
-				CV{21, 24}, CV{21, 25},
+			Runs: []VerseRun{
+				{Chapter: 1, First: 1, Last: 51},
+				{Chapter: 2, First: 1, Last: 25},
+				{Chapter: 3, First: 1, Last: 36},
+				{Chapter: 4, First: 1, Last: 54},
+				{Chapter: 5, First: 1, Last: 47},
+				{Chapter: 6, First: 1, Last: 71},
+				{Chapter: 7, First: 1, Last: 53},
+				{Chapter: 8, First: 1, Last: 59},
+				{Chapter: 9, First: 1, Last: 41},
+				{Chapter: 10, First: 1, Last: 42},
+				{Chapter: 11, First: 1, Last: 57},
+				{Chapter: 12, First: 1, Last: 50},
+				{Chapter: 13, First: 1, Last: 38},
+				{Chapter: 14, First: 1, Last: 31},
+				{Chapter: 15, First: 1, Last: 27},
+				{Chapter: 16, First: 1, Last: 33},
+				{Chapter: 17, First: 1, Last: 26},
+				{Chapter: 18, First: 1, Last: 40},
+				{Chapter: 19, First: 1, Last: 42},
+				{Chapter: 20, First: 1, Last: 31},
+				{Chapter: 21, First: 1, Last: 25},
 			},
 		},
 		{
 			Name:      "Acts",
+			USFM:      "ACT",
+			Testament: NewTestament,
 			JustVerse: false,
-			Verses: []Verse{CV{1, 1},
-				CV{1, 2}, CV{1, 3}, CV{1, 4}, CV{1, 5},
-				CV{1, 6}, CV{1, 7}, CV{1, 8}, CV{1, 9},
-				CV{1, 10}, CV{1, 11}, CV{1, 12}, CV{1, 13},
-				CV{1, 14}, CV{1, 15}, CV{1, 16}, CV{1, 17},
-				CV{1, 18}, CV{1, 19}, CV{1, 20}, CV{1, 21},
-				CV{1, 22}, CV{1, 23}, CV{1, 24}, CV{1, 25},
-				CV{1, 26}, CV{2, 1}, CV{2, 2}, CV{2, 3},
-				CV{2, 4}, CV{2, 5}, CV{2, 6}, CV{2, 7},
-				CV{2, 8}, CV{2, 9}, CV{2, 10}, CV{2, 11},
-				CV{2, 12}, CV{2, 13}, CV{2, 14}, CV{2, 15},
-				CV{2, 16}, CV{2, 17}, CV{2, 18}, CV{2, 19},
-				CV{2, 20}, CV{2, 21}, CV{2, 22}, CV{2, 23},
-				CV{2, 24}, CV{2, 25}, CV{2, 26}, CV{2, 27},
-				CV{2, 28}, CV{2, 29}, CV{2, 30}, CV{2, 31},
-				CV{2, 32}, CV{2, 33}, CV{2, 34}, CV{2, 35},
-				CV{2, 36}, CV{2, 37}, CV{2, 38}, CV{2, 39},
-				CV{2, 40}, CV{2, 41}, CV{2, 42}, CV{2, 43},
-				CV{2, 44}, CV{2, 45}, CV{2, 46}, CV{2, 47},
-				CV{3, 1}, CV{3, 2}, CV{3, 3}, CV{3, 4},
-				CV{3, 5}, CV{3, 6}, CV{3, 7}, CV{3, 8},
-				CV{3, 9}, CV{3, 10}, CV{3, 11}, CV{3, 12},
-				CV{3, 13}, CV{3, 14}, CV{3, 15}, CV{3, 16},
-				CV{3, 17}, CV{3, 18}, CV{3, 19}, CV{3, 20},
-				CV{3, 21}, CV{3, 22}, CV{3, 23}, CV{3, 24},
-				CV{3, 25}, CV{3, 26}, CV{4, 1}, CV{4, 2},
-				CV{4, 3}, CV{4, 4}, CV{4, 5}, CV{4, 6},
-				CV{4, 7}, CV{4, 8}, CV{4, 9}, CV{4, 10},
-				CV{4, 11}, CV{4, 12}, CV{4, 13}, CV{4, 14},
-				CV{4, 15}, CV{4, 16}, CV{4, 17}, CV{4, 18},
-				CV{4, 19}, CV{4, 20}, CV{4, 21}, CV{4, 22},
-				CV{4, 23}, CV{4, 24}, CV{4, 25}, CV{4, 26},
-				CV{4, 27}, CV{4, 28}, CV{4, 29}, CV{4, 30},
-				CV{4, 31}, CV{4, 32}, CV{4, 33}, CV{4, 34},
-				CV{4, 35}, CV{4, 36}, CV{4, 37}, CV{5, 1},
-				CV{5, 2}, CV{5, 3}, CV{5, 4}, CV{5, 5},
-				CV{5, 6}, CV{5, 7}, CV{5, 8}, CV{5, 9},
-				CV{5, 10}, CV{5, 11}, CV{5, 12}, CV{5, 13},
-				CV{5, 14}, CV{5, 15}, CV{5, 16}, CV{5, 17},
-				CV{5, 18}, CV{5, 19}, CV{5, 20}, CV{5, 21},
-				CV{5, 22}, CV{5, 23}, CV{5, 24}, CV{5, 25},
-				CV{5, 26}, CV{5, 27}, CV{5, 28}, CV{5, 29},
-				CV{5, 30}, CV{5, 31}, CV{5, 32}, CV{5, 33},
-				CV{5, 34}, CV{5, 35}, CV{5, 36}, CV{5, 37},
-				CV{5, 38}, CV{5, 39}, CV{5, 40}, CV{5, 41},
-				CV{5, 42}, CV{6, 1}, CV{6, 2}, CV{6, 3},
-				CV{6, 4}, CV{6, 5}, CV{6, 6}, CV{6, 7},
-				CV{6, 8}, CV{6, 9}, CV{6, 10}, CV{6, 11},
-				CV{6, 12}, CV{6, 13}, CV{6, 14}, CV{6, 15},
-				CV{7, 1}, CV{7, 2}, CV{7, 3}, CV{7, 4},
-				CV{7, 5}, CV{7, 6}, CV{7, 7}, CV{7, 8},
-				CV{7, 9}, CV{7, 10}, CV{7, 11}, CV{7, 12},
-				CV{7, 13}, CV{7, 14}, CV{7, 15}, CV{7, 16},
-				CV{7, 17}, CV{7, 18}, CV{7, 19}, CV{7, 20},
-				CV{7, 21}, CV{7, 22}, CV{7, 23}, CV{7, 24},
-				CV{7, 25}, CV{7, 26}, CV{7, 27}, CV{7, 28},
-				CV{7, 29}, CV{7, 30}, CV{7, 31}, CV{7, 32},
-				CV{7, 33}, CV{7, 34}, CV{7, 35}, CV{7, 36},
-				CV{7, 37}, CV{7, 38}, CV{7, 39}, CV{7, 40},
-				CV{7, 41}, CV{7, 42}, CV{7, 43}, CV{7, 44},
-				CV{7, 45}, CV{7, 46}, CV{7, 47}, CV{7, 48},
-				CV{7, 49}, CV{7, 50}, CV{7, 51}, CV{7, 52},
-				CV{7, 53}, CV{7, 54}, CV{7, 55}, CV{7, 56},
-				CV{7, 57}, CV{7, 58}, CV{7, 59}, CV{7, 60},
-				CV{8, 1}, CV{8, 2}, CV{8, 3}, CV{8, 4},
-				CV{8, 5}, CV{8, 6}, CV{8, 7}, CV{8, 8},
-				CV{8, 9}, CV{8, 10}, CV{8, 11}, CV{8, 12},
-				CV{8, 13}, CV{8, 14}, CV{8, 15}, CV{8, 16},
-				CV{8, 17}, CV{8, 18}, CV{8, 19}, CV{8, 20},
-				CV{8, 21}, CV{8, 22}, CV{8, 23}, CV{8, 24},
-				CV{8, 25}, CV{8, 26}, CV{8, 27}, CV{8, 28},
-				CV{8, 29}, CV{8, 30}, CV{8, 31}, CV{8, 32},
-				CV{8, 33}, CV{8, 34}, CV{8, 35}, CV{8, 36},
-				CV{8, 37}, CV{8, 38}, CV{8, 39}, CV{8, 40},
-				CV{9, 1}, CV{9, 2}, CV{9, 3}, CV{9, 4},
-				CV{9, 5}, CV{9, 6}, CV{9, 7}, CV{9, 8},
-				CV{9, 9}, CV{9, 10}, CV{9, 11}, CV{9, 12},
-				CV{9, 13}, CV{9, 14}, CV{9, 15}, CV{9, 16},
-				CV{9, 17}, CV{9, 18}, CV{9, 19}, CV{9, 20},
-				CV{9, 21}, CV{9, 22}, CV{9, 23}, CV{9, 24},
-				CV{9, 25}, CV{9, 26}, CV{9, 27}, CV{9, 28},
-				CV{9, 29}, CV{9, 30}, CV{9, 31}, CV{9, 32},
-				CV{9, 33}, CV{9, 34}, CV{9, 35}, CV{9, 36},
-				CV{9, 37}, CV{9, 38}, CV{9, 39}, CV{9, 40},
-				CV{9, 41}, CV{9, 42}, CV{9, 43}, CV{10, 1},
-				CV{10, 2}, CV{10, 3}, CV{10, 4}, CV{10, 5},
-				CV{10, 6}, CV{10, 7}, CV{10, 8}, CV{10, 9},
-				CV{10, 10}, CV{10, 11}, CV{10, 12}, CV{10, 13},
-				CV{10, 14}, CV{10, 15}, CV{10, 16}, CV{10, 17},
-				CV{10, 18}, CV{10, 19}, CV{10, 20}, CV{10, 21},
-				CV{10, 22}, CV{10, 23}, CV{10, 24}, CV{10, 25},
-				CV{10, 26}, CV{10, 27}, CV{10, 28}, CV{10, 29},
-				CV{10, 30}, CV{10, 31}, CV{10, 32}, CV{10, 33},
-				CV{10, 34}, CV{10, 35}, CV{10, 36}, CV{10, 37},
-				CV{10, 38}, CV{10, 39}, CV{10, 40}, CV{10, 41},
-				CV{10, 42}, CV{10, 43}, CV{10, 44}, CV{10, 45},
-				CV{10, 46}, CV{10, 47}, CV{10, 48}, CV{11, 1},
-				CV{11, 2}, CV{11, 3}, CV{11, 4}, CV{11, 5},
-				CV{11, 6}, CV{11, 7}, CV{11, 8}, CV{11, 9},
-				CV{11, 10}, CV{11, 11}, CV{11, 12}, CV{11, 13},
-				CV{11, 14}, CV{11, 15}, CV{11, 16}, CV{11, 17},
-				CV{11, 18}, CV{11, 19}, CV{11, 20}, CV{11, 21},
-				CV{11, 22}, CV{11, 23}, CV{11, 24}, CV{11, 25},
-				CV{11, 26}, CV{11, 27}, CV{11, 28}, CV{11, 29},
-				CV{11, 30}, CV{12, 1}, CV{12, 2}, CV{12, 3},
-				CV{12, 4}, CV{12, 5}, CV{12, 6}, CV{12, 7},
-				CV{12, 8}, CV{12, 9}, CV{12, 10}, CV{12, 11},
-				CV{12, 12}, CV{12, 13}, CV{12, 14}, CV{12, 15},
-				CV{12, 16}, CV{12, 17}, CV{12, 18}, CV{12, 19},
-				CV{12, 20}, CV{12, 21}, CV{12, 22}, CV{12, 23},
-				CV{12, 24}, CV{12, 25}, CV{13, 1}, CV{13, 2},
-				CV{13, 3}, CV{13, 4}, CV{13, 5}, CV{13, 6},
-				CV{13, 7}, CV{13, 8}, CV{13, 9}, CV{13, 10},
-				CV{13, 11}, CV{13, 12}, CV{13, 13}, CV{13, 14},
-				CV{13, 15}, CV{13, 16}, CV{13, 17}, CV{13, 18},
-				CV{13, 19}, CV{13, 20}, CV{13, 21}, CV{13, 22},
-				CV{13, 23}, CV{13, 24}, CV{13, 25}, CV{13, 26},
-				CV{13, 27}, CV{13, 28}, CV{13, 29}, CV{13, 30},
-				CV{13, 31}, CV{13, 32}, CV{13, 33}, CV{13, 34},
-				CV{13, 35}, CV{13, 36}, CV{13, 37}, CV{13, 38},
-				CV{13, 39}, CV{13, 40}, CV{13, 41}, CV{13, 42},
-				CV{13, 43}, CV{13, 44}, CV{13, 45}, CV{13, 46},
-				CV{13, 47}, CV{13, 48}, CV{13, 49}, CV{13, 50},
-				CV{13, 51}, CV{13, 52}, CV{14, 1}, CV{14, 2},
-				CV{14, 3}, CV{14, 4}, CV{14, 5}, CV{14, 6},
-				CV{14, 7}, CV{14, 8}, CV{14, 9}, CV{14, 10},
-				CV{14, 11}, CV{14, 12}, CV{14, 13}, CV{14, 14},
-				CV{14, 15}, CV{14, 16}, CV{14, 17}, CV{14, 18},
-				CV{14, 19}, CV{14, 20}, CV{14, 21}, CV{14, 22},
-				CV{14, 23}, CV{14, 24}, CV{14, 25}, CV{14, 26},
-				CV{14, 27}, CV{14, 28}, CV{15, 1}, CV{15, 2},
-				CV{15, 3}, CV{15, 4}, CV{15, 5}, CV{15, 6},
-				CV{15, 7}, CV{15, 8}, CV{15, 9}, CV{15, 10},
-				CV{15, 11}, CV{15, 12}, CV{15, 13}, CV{15, 14},
-				CV{15, 15}, CV{15, 16}, CV{15, 17}, CV{15, 18},
-				CV{15, 19}, CV{15, 20}, CV{15, 21}, CV{15, 22},
-				CV{15, 23}, CV{15, 24}, CV{15, 25}, CV{15, 26},
-				CV{15, 27}, CV{15, 28}, CV{15, 29}, CV{15, 30},
-				CV{15, 31}, CV{15, 32}, CV{15, 33}, CV{15, 34},
-				CV{15, 35}, CV{15, 36}, CV{15, 37}, CV{15, 38},
-				CV{15, 39}, CV{15, 40}, CV{15, 41}, CV{16, 1},
-				CV{16, 2}, CV{16, 3}, CV{16, 4}, CV{16, 5},
-				CV{16, 6}, CV{16, 7}, CV{16, 8}, CV{16, 9},
-				CV{16, 10}, CV{16, 11}, CV{16, 12}, CV{16, 13},
-				CV{16, 14}, CV{16, 15}, CV{16, 16}, CV{16, 17},
-				CV{16, 18}, CV{16, 19}, CV{16, 20}, CV{16, 21},
-				CV{16, 22}, CV{16, 23}, CV{16, 24}, CV{16, 25},
-				CV{16, 26}, CV{16, 27}, CV{16, 28}, CV{16, 29},
-				CV{16, 30}, CV{16, 31}, CV{16, 32}, CV{16, 33},
-				CV{16, 34}, CV{16, 35}, CV{16, 36}, CV{16, 37},
-				CV{16, 38}, CV{16, 39}, CV{16, 40}, CV{17, 1},
-				CV{17, 2}, CV{17, 3}, CV{17, 4}, CV{17, 5},
-				CV{17, 6}, CV{17, 7}, CV{17, 8}, CV{17, 9},
-				CV{17, 10}, CV{17, 11}, CV{17, 12}, CV{17, 13},
-				CV{17, 14}, CV{17, 15}, CV{17, 16}, CV{17, 17},
-				CV{17, 18}, CV{17, 19}, CV{17, 20}, CV{17, 21},
-				CV{17, 22}, CV{17, 23}, CV{17, 24}, CV{17, 25},
-				CV{17, 26}, CV{17, 27}, CV{17, 28}, CV{17, 29},
-				CV{17, 30}, CV{17, 31}, CV{17, 32}, CV{17, 33},
-				CV{17, 34}, CV{18, 1}, CV{18, 2}, CV{18, 3},
-				CV{18, 4}, CV{18, 5}, CV{18, 6}, CV{18, 7},
-				CV{18, 8}, CV{18, 9}, CV{18, 10}, CV{18, 11},
-				CV{18, 12}, CV{18, 13}, CV{18, 14}, CV{18, 15},
-				CV{18, 16}, CV{18, 17}, CV{18, 18}, CV{18, 19},
-				CV{18, 20}, CV{18, 21}, CV{18, 22}, CV{18, 23},
-				CV{18, 24}, CV{18, 25}, CV{18, 26}, CV{18, 27},
-				CV{18, 28}, CV{19, 1}, CV{19, 2}, CV{19, 3},
-				CV{19, 4}, CV{19, 5}, CV{19, 6}, CV{19, 7},
-				CV{19, 8}, CV{19, 9}, CV{19, 10}, CV{19, 11},
-				CV{19, 12}, CV{19, 13}, CV{19, 14}, CV{19, 15},
-				CV{19, 16}, CV{19, 17}, CV{19, 18}, CV{19, 19},
-				CV{19, 20}, CV{19, 21}, CV{19, 22}, CV{19, 23},
-				CV{19, 24}, CV{19, 25}, CV{19, 26}, CV{19, 27},
-				CV{19, 28}, CV{19, 29}, CV{19, 30}, CV{19, 31},
-				CV{19, 32}, CV{19, 33}, CV{19, 34}, CV{19, 35},
-				CV{19, 36}, CV{19, 37}, CV{19, 38}, CV{19, 39},
-				CV{19, 40}, CV{19, 41}, CV{20, 1}, CV{20, 2},
-				CV{20, 3}, CV{20, 4}, CV{20, 5}, CV{20, 6},
-				CV{20, 7}, CV{20, 8}, CV{20, 9}, CV{20, 10},
-				CV{20, 11}, CV{20, 12}, CV{20, 13}, CV{20, 14},
-				CV{20, 15}, CV{20, 16}, CV{20, 17}, CV{20, 18},
-				CV{20, 19}, CV{20, 20}, CV{20, 21}, CV{20, 22},
-				CV{20, 23}, CV{20, 24}, CV{20, 25}, CV{20, 26},
-				CV{20, 27}, CV{20, 28}, CV{20, 29}, CV{20, 30},
-				CV{20, 31}, CV{20, 32}, CV{20, 33}, CV{20, 34},
-				CV{20, 35}, CV{20, 36}, CV{20, 37}, CV{20, 38},
-				CV{21, 1}, CV{21, 2}, CV{21, 3}, CV{21, 4},
-				CV{21, 5}, CV{21, 6}, CV{21, 7}, CV{21, 8},
-				CV{21, 9}, CV{21, 10}, CV{21, 11}, CV{21, 12},
-				CV{21, 13}, CV{21, 14}, CV{21, 15}, CV{21, 16},
-				CV{21, 17}, CV{21, 18}, CV{21, 19}, CV{21, 20},
-				CV{21, 21}, CV{21, 22}, CV{21, 23}, CV{21, 24},
-				CV{21, 25}, CV{21, 26}, CV{21, 27}, CV{21, 28},
-				CV{21, 29}, CV{21, 30}, CV{21, 31}, CV{21, 32},
-				CV{21, 33}, CV{21, 34}, CV{21, 35}, CV{21, 36},
-				CV{21, 37}, CV{21, 38}, CV{21, 39}, CV{21, 40},
-				CV{22, 1}, CV{22, 2}, CV{22, 3}, CV{22, 4},
-				CV{22, 5}, CV{22, 6}, CV{22, 7}, CV{22, 8},
-				CV{22, 9}, CV{22, 10}, CV{22, 11}, CV{22, 12},
-				CV{22, 13}, CV{22, 14}, CV{22, 15}, CV{22, 16},
-				CV{22, 17}, CV{22, 18}, CV{22, 19}, CV{22, 20},
-				CV{22, 21}, CV{22, 22}, CV{22, 23}, CV{22, 24},
-				CV{22, 25}, CV{22, 26}, CV{22, 27}, CV{22, 28},
-				CV{22, 29}, CV{22, 30}, CV{23, 1}, CV{23, 2},
-				CV{23, 3}, CV{23, 4}, CV{23, 5}, CV{23, 6},
-				CV{23, 7}, CV{23, 8}, CV{23, 9}, CV{23, 10},
-				CV{23, 11}, CV{23, 12}, CV{23, 13}, CV{23, 14},
-				CV{23, 15}, CV{23, 16}, CV{23, 17}, CV{23, 18},
-				CV{23, 19}, CV{23, 20}, CV{23, 21}, CV{23, 22},
-				CV{23, 23}, CV{23, 24}, CV{23, 25}, CV{23, 26},
-				CV{23, 27}, CV{23, 28}, CV{23, 29}, CV{23, 30},
-				CV{23, 31}, CV{23, 32}, CV{23, 33}, CV{23, 34},
-				CV{23, 35}, CV{24, 1}, CV{24, 2}, CV{24, 3},
-				CV{24, 4}, CV{24, 5}, CV{24, 6}, CV{24, 7},
-				CV{24, 8}, CV{24, 9}, CV{24, 10}, CV{24, 11},
-				CV{24, 12}, CV{24, 13}, CV{24, 14}, CV{24, 15},
-				CV{24, 16}, CV{24, 17}, CV{24, 18}, CV{24, 19},
-				CV{24, 20}, CV{24, 21}, CV{24, 22}, CV{24, 23},
-				CV{24, 24}, CV{24, 25}, CV{24, 26}, CV{24, 27},
-				CV{25, 1}, CV{25, 2}, CV{25, 3}, CV{25, 4},
-				CV{25, 5}, CV{25, 6}, CV{25, 7}, CV{25, 8},
-				CV{25, 9}, CV{25, 10}, CV{25, 11}, CV{25, 12},
-				CV{25, 13}, CV{25, 14}, CV{25, 15}, CV{25, 16},
-				CV{25, 17}, CV{25, 18}, CV{25, 19}, CV{25, 20},
-				CV{25, 21}, CV{25, 22}, CV{25, 23}, CV{25, 24},
-				CV{25, 25}, CV{25, 26}, CV{25, 27}, CV{26, 1},
-				CV{26, 2}, CV{26, 3}, CV{26, 4}, CV{26, 5},
-				CV{26, 6}, CV{26, 7}, CV{26, 8}, CV{26, 9},
-				CV{26, 10}, CV{26, 11}, CV{26, 12}, CV{26, 13},
-				CV{26, 14}, CV{26, 15}, CV{26, 16}, CV{26, 17},
-				CV{26, 18}, CV{26, 19}, CV{26, 20}, CV{26, 21},
-				CV{26, 22}, CV{26, 23}, CV{26, 24}, CV{26, 25},
-				CV{26, 26}, CV{26, 27}, CV{26, 28}, CV{26, 29},
-				CV{26, 30}, CV{26, 31}, CV{26, 32}, CV{27, 1},
-				CV{27, 2}, CV{27, 3}, CV{27, 4}, CV{27, 5},
-				CV{27, 6}, CV{27, 7}, CV{27, 8}, CV{27, 9},
-				CV{27, 10}, CV{27, 11}, CV{27, 12}, CV{27, 13},
-				CV{27, 14}, CV{27, 15}, CV{27, 16}, CV{27, 17},
-				CV{27, 18}, CV{27, 19}, CV{27, 20}, CV{27, 21},
-				CV{27, 22}, CV{27, 23}, CV{27, 24}, CV{27, 25},
-				CV{27, 26}, CV{27, 27}, CV{27, 28}, CV{27, 29},
-				CV{27, 30}, CV{27, 31}, CV{27, 32}, CV{27, 33},
-				CV{27, 34}, CV{27, 35}, CV{27, 36}, CV{27, 37},
-				CV{27, 38}, CV{27, 39}, CV{27, 40}, CV{27, 41},
-				CV{27, 42}, CV{27, 43}, CV{27, 44}, CV{28, 1},
-				CV{28, 2}, CV{28, 3}, CV{28, 4}, CV{28, 5},
-				CV{28, 6}, CV{28, 7}, CV{28, 8}, CV{28, 9},
-				CV{28, 10}, CV{28, 11}, CV{28, 12}, CV{28, 13},
-				CV{28, 14}, CV{28, 15}, CV{28, 16}, CV{28, 17},
-				CV{28, 18}, CV{28, 19}, CV{28, 20}, CV{28, 21},
-				CV{28, 22}, CV{28, 23}, CV{28, 24}, CV{28, 25},
-				CV{28, 26}, CV{28, 27}, CV{28, 28}, CV{28, 29},
-				CV{28, 30}, CV{28, 31},
+			Runs: []VerseRun{
+				{Chapter: 1, First: 1, Last: 26},
+				{Chapter: 2, First: 1, Last: 47},
+				{Chapter: 3, First: 1, Last: 26},
+				{Chapter: 4, First: 1, Last: 37},
+				{Chapter: 5, First: 1, Last: 42},
+				{Chapter: 6, First: 1, Last: 15},
+				{Chapter: 7, First: 1, Last: 60},
+				{Chapter: 8, First: 1, Last: 40},
+				{Chapter: 9, First: 1, Last: 43},
+				{Chapter: 10, First: 1, Last: 48},
+				{Chapter: 11, First: 1, Last: 30},
+				{Chapter: 12, First: 1, Last: 25},
+				{Chapter: 13, First: 1, Last: 52},
+				{Chapter: 14, First: 1, Last: 28},
+				{Chapter: 15, First: 1, Last: 41},
+				{Chapter: 16, First: 1, Last: 40},
+				{Chapter: 17, First: 1, Last: 34},
+				{Chapter: 18, First: 1, Last: 28},
+				{Chapter: 19, First: 1, Last: 41},
+				{Chapter: 20, First: 1, Last: 38},
+				{Chapter: 21, First: 1, Last: 40},
+				{Chapter: 22, First: 1, Last: 30},
+				{Chapter: 23, First: 1, Last: 35},
+				{Chapter: 24, First: 1, Last: 27},
+				{Chapter: 25, First: 1, Last: 27},
+				{Chapter: 26, First: 1, Last: 32},
+				{Chapter: 27, First: 1, Last: 44},
+				{Chapter: 28, First: 1, Last: 31},
 			},
 		},
 		{
 			Name:      "Romans",
+			USFM:      "ROM",
+			Testament: NewTestament,
 			JustVerse: false,
-			Verses: []Verse{CV{1, 1},
-				CV{1, 2}, CV{1, 3}, CV{1, 4}, CV{1, 5},
-				CV{1, 6}, CV{1, 7}, CV{1, 8}, CV{1, 9},
-				CV{1, 10}, CV{1, 11}, CV{1, 12}, CV{1, 13},
-				CV{1, 14}, CV{1, 15}, CV{1, 16}, CV{1, 17},
-				CV{1, 18}, CV{1, 19}, CV{1, 20}, CV{1, 21},
-				CV{1, 22}, CV{1, 23}, CV{1, 24}, CV{1, 25},
-				CV{1, 26}, CV{1, 27}, CV{1, 28}, CV{1, 29},
-				CV{1, 30}, CV{1, 31}, CV{1, 32}, CV{2, 1},
-				CV{2, 2}, CV{2, 3}, CV{2, 4}, CV{2, 5},
-				CV{2, 6}, CV{2, 7}, CV{2, 8}, CV{2, 9},
-				CV{2, 10}, CV{2, 11}, CV{2, 12}, CV{2, 13},
-				CV{2, 14}, CV{2, 15}, CV{2, 16}, CV{2, 17},
-				CV{2, 18}, CV{2, 19}, CV{2, 20}, CV{2, 21},
-				CV{2, 22}, CV{2, 23}, CV{2, 24}, CV{2, 25},
-				CV{2, 26}, CV{2, 27}, CV{2, 28}, CV{2, 29},
-				CV{3, 1}, CV{3, 2}, CV{3, 3}, CV{3, 4},
-				CV{3, 5}, CV{3, 6}, CV{3, 7}, CV{3, 8},
-				CV{3, 9}, CV{3, 10}, CV{3, 11}, CV{3, 12},
-				CV{3, 13}, CV{3, 14}, CV{3, 15}, CV{3, 16},
-				CV{3, 17}, CV{3, 18}, CV{3, 19}, CV{3, 20},
-				CV{3, 21}, CV{3, 22}, CV{3, 23}, CV{3, 24},
-				CV{3, 25}, CV{3, 26}, CV{3, 27}, CV{3, 28},
-				CV{3, 29}, CV{3, 30}, CV{3, 31}, CV{4, 1},
-				CV{4, 2}, CV{4, 3}, CV{4, 4}, CV{4, 5},
-				CV{4, 6}, CV{4, 7}, CV{4, 8}, CV{4, 9},
-				CV{4, 10}, CV{4, 11}, CV{4, 12}, CV{4, 13},
-				CV{4, 14}, CV{4, 15}, CV{4, 16}, CV{4, 17},
-				CV{4, 18}, CV{4, 19}, CV{4, 20}, CV{4, 21},
-				CV{4, 22}, CV{4, 23}, CV{4, 24}, CV{4, 25},
-				CV{5, 1}, CV{5, 2}, CV{5, 3}, CV{5, 4},
-				CV{5, 5}, CV{5, 6}, CV{5, 7}, CV{5, 8},
-				CV{5, 9}, CV{5, 10}, CV{5, 11}, CV{5, 12},
-				CV{5, 13}, CV{5, 14}, CV{5, 15}, CV{5, 16},
-				CV{5, 17}, CV{5, 18}, CV{5, 19}, CV{5, 20},
-				CV{5, 21}, CV{6, 1}, CV{6, 2}, CV{6, 3},
-				CV{6, 4}, CV{6, 5}, CV{6, 6}, CV{6, 7},
-				CV{6, 8}, CV{6, 9}, CV{6, 10}, CV{6, 11},
-				CV{6, 12}, CV{6, 13}, CV{6, 14}, CV{6, 15},
-				CV{6, 16}, CV{6, 17}, CV{6, 18}, CV{6, 19},
-				CV{6, 20}, CV{6, 21}, CV{6, 22}, CV{6, 23},
-				CV{7, 1}, CV{7, 2}, CV{7, 3}, CV{7, 4},
-				CV{7, 5}, CV{7, 6}, CV{7, 7}, CV{7, 8},
-				CV{7, 9}, CV{7, 10}, CV{7, 11}, CV{7, 12},
-				CV{7, 13}, CV{7, 14}, CV{7, 15}, CV{7, 16},
-				CV{7, 17}, CV{7, 18}, CV{7, 19}, CV{7, 20},
-				CV{7, 21}, CV{7, 22}, CV{7, 23}, CV{7, 24},
-				CV{7, 25}, CV{8, 1}, CV{8, 2}, CV{8, 3},
-				CV{8, 4}, CV{8, 5}, CV{8, 6}, CV{8, 7},
-				CV{8, 8}, CV{8, 9}, CV{8, 10}, CV{8, 11},
-				CV{8, 12}, CV{8, 13}, CV{8, 14}, CV{8, 15},
-				CV{8, 16}, CV{8, 17}, CV{8, 18}, CV{8, 19},
-				CV{8, 20}, CV{8, 21}, CV{8, 22}, CV{8, 23},
-				CV{8, 24}, CV{8, 25}, CV{8, 26}, CV{8, 27},
-				CV{8, 28}, CV{8, 29}, CV{8, 30}, CV{8, 31},
-				CV{8, 32}, CV{8, 33}, CV{8, 34}, CV{8, 35},
-				CV{8, 36}, CV{8, 37}, CV{8, 38}, CV{8, 39},
-				CV{9, 1}, CV{9, 2}, CV{9, 3}, CV{9, 4},
-				CV{9, 5}, CV{9, 6}, CV{9, 7}, CV{9, 8},
-				CV{9, 9}, CV{9, 10}, CV{9, 11}, CV{9, 12},
-				CV{9, 13}, CV{9, 14}, CV{9, 15}, CV{9, 16},
-				CV{9, 17}, CV{9, 18}, CV{9, 19}, CV{9, 20},
-				CV{9, 21}, CV{9, 22}, CV{9, 23}, CV{9, 24},
-				CV{9, 25}, CV{9, 26}, CV{9, 27}, CV{9, 28},
-				CV{9, 29}, CV{9, 30}, CV{9, 31}, CV{9, 32},
-				CV{9, 33}, CV{10, 1}, CV{10, 2}, CV{10, 3},
-				CV{10, 4}, CV{10, 5}, CV{10, 6}, CV{10, 7},
-				CV{10, 8}, CV{10, 9}, CV{10, 10}, CV{10, 11},
-				CV{10, 12}, CV{10, 13}, CV{10, 14}, CV{10, 15},
-				CV{10, 16}, CV{10, 17}, CV{10, 18}, CV{10, 19},
-				CV{10, 20}, CV{10, 21}, CV{11, 1}, CV{11, 2},
-				CV{11, 3}, CV{11, 4}, CV{11, 5}, CV{11, 6},
-				CV{11, 7}, CV{11, 8}, CV{11, 9}, CV{11, 10},
-				CV{11, 11}, CV{11, 12}, CV{11, 13}, CV{11, 14},
-				CV{11, 15}, CV{11, 16}, CV{11, 17}, CV{11, 18},
-				CV{11, 19}, CV{11, 20}, CV{11, 21}, CV{11, 22},
-				CV{11, 23}, CV{11, 24}, CV{11, 25}, CV{11, 26},
-				CV{11, 27}, CV{11, 28}, CV{11, 29}, CV{11, 30},
-				CV{11, 31}, CV{11, 32}, CV{11, 33}, CV{11, 34},
-				CV{11, 35}, CV{11, 36}, CV{12, 1}, CV{12, 2},
-				CV{12, 3}, CV{12, 4}, CV{12, 5}, CV{12, 6},
-				CV{12, 7}, CV{12, 8}, CV{12, 9}, CV{12, 10},
-				CV{12, 11}, CV{12, 12}, CV{12, 13}, CV{12, 14},
-				CV{12, 15}, CV{12, 16}, CV{12, 17}, CV{12, 18},
-				CV{12, 19}, CV{12, 20}, CV{12, 21}, CV{13, 1},
-				CV{13, 2}, CV{13, 3}, CV{13, 4}, CV{13, 5},
-				CV{13, 6}, CV{13, 7}, CV{13, 8}, CV{13, 9},
-				CV{13, 10}, CV{13, 11}, CV{13, 12}, CV{13, 13},
-				CV{13, 14}, CV{14, 1}, CV{14, 2}, CV{14, 3},
-				CV{14, 4}, CV{14, 5}, CV{14, 6}, CV{14, 7},
-				CV{14, 8}, CV{14, 9}, CV{14, 10}, CV{14, 11},
-				CV{14, 12}, CV{14, 13}, CV{14, 14}, CV{14, 15},
-				CV{14, 16}, CV{14, 17}, CV{14, 18}, CV{14, 19},
-				CV{14, 20}, CV{14, 21}, CV{14, 22}, CV{14, 23},
-				CV{15, 1}, CV{15, 2}, CV{15, 3}, CV{15, 4},
-				CV{15, 5}, CV{15, 6}, CV{15, 7}, CV{15, 8},
-				CV{15, 9}, CV{15, 10}, CV{15, 11}, CV{15, 12},
-				CV{15, 13}, CV{15, 14}, CV{15, 15}, CV{15, 16},
-				CV{15, 17}, CV{15, 18}, CV{15, 19}, CV{15, 20},
-				CV{15, 21}, CV{15, 22}, CV{15, 23}, CV{15, 24},
-				CV{15, 25}, CV{15, 26}, CV{15, 27}, CV{15, 28},
-				CV{15, 29}, CV{15, 30}, CV{15, 31}, CV{15, 32},
-				CV{15, 33}, CV{16, 1}, CV{16, 2}, CV{16, 3},
-				CV{16, 4}, CV{16, 5}, CV{16, 6}, CV{16, 7},
-				CV{16, 8}, CV{16, 9}, CV{16, 10}, CV{16, 11},
-				CV{16, 12}, CV{16, 13}, CV{16, 14}, CV{16, 15},
-				CV{16, 16}, CV{16, 17}, CV{16, 18}, CV{16, 19},
-				CV{16, 20}, CV{16, 21}, CV{16, 22}, CV{16, 23},
-				CV{16, 24}, CV{16, 25}, CV{16, 26}, CV{16, 27},
+			Runs: []VerseRun{
+				{Chapter: 1, First: 1, Last: 32},
+				{Chapter: 2, First: 1, Last: 29},
+				{Chapter: 3, First: 1, Last: 31},
+				{Chapter: 4, First: 1, Last: 25},
+				{Chapter: 5, First: 1, Last: 21},
+				{Chapter: 6, First: 1, Last: 23},
+				{Chapter: 7, First: 1, Last: 25},
+				{Chapter: 8, First: 1, Last: 39},
+				{Chapter: 9, First: 1, Last: 33},
+				{Chapter: 10, First: 1, Last: 21},
+				{Chapter: 11, First: 1, Last: 36},
+				{Chapter: 12, First: 1, Last: 21},
+				{Chapter: 13, First: 1, Last: 14},
+				{Chapter: 14, First: 1, Last: 23},
+				{Chapter: 15, First: 1, Last: 33},
+				{Chapter: 16, First: 1, Last: 27},
 			},
 		},
 		{
 			Name:      "1 Corinthians",
+			USFM:      "1CO",
+			Testament: NewTestament,
 			JustVerse: false,
-			Verses: []Verse{CV{1, 1},
-				CV{1, 2}, CV{1, 3}, CV{1, 4}, CV{1, 5},
-				CV{1, 6}, CV{1, 7}, CV{1, 8}, CV{1, 9},
-				CV{1, 10}, CV{1, 11}, CV{1, 12}, CV{1, 13},
-				CV{1, 14}, CV{1, 15}, CV{1, 16}, CV{1, 17},
-				CV{1, 18}, CV{1, 19}, CV{1, 20}, CV{1, 21},
-				CV{1, 22}, CV{1, 23}, CV{1, 24}, CV{1, 25},
-				CV{1, 26}, CV{1, 27}, CV{1, 28}, CV{1, 29},
-				CV{1, 30}, CV{1, 31}, CV{2, 1}, CV{2, 2},
-				CV{2, 3}, CV{2, 4}, CV{2, 5}, CV{2, 6},
-				CV{2, 7}, CV{2, 8}, CV{2, 9}, CV{2, 10},
-				CV{2, 11}, CV{2, 12}, CV{2, 13}, CV{2, 14},
-				CV{2, 15}, CV{2, 16}, CV{3, 1}, CV{3, 2},
-				CV{3, 3}, CV{3, 4}, CV{3, 5}, CV{3, 6},
-				CV{3, 7}, CV{3, 8}, CV{3, 9}, CV{3, 10},
-				CV{3, 11}, CV{3, 12}, CV{3, 13}, CV{3, 14},
-				CV{3, 15}, CV{3, 16}, CV{3, 17}, CV{3, 18},
-				CV{3, 19}, CV{3, 20}, CV{3, 21}, CV{3, 22},
-				CV{3, 23}, CV{4, 1}, CV{4, 2}, CV{4, 3},
-				CV{4, 4}, CV{4, 5}, CV{4, 6}, CV{4, 7},
-				CV{4, 8}, CV{4, 9}, CV{4, 10}, CV{4, 11},
-				CV{4, 12}, CV{4, 13}, CV{4, 14}, CV{4, 15},
-				CV{4, 16}, CV{4, 17}, CV{4, 18}, CV{4, 19},
-				CV{4, 20}, CV{4, 21}, CV{5, 1}, CV{5, 2},
-				CV{5, 3}, CV{5, 4}, CV{5, 5}, CV{5, 6},
-				CV{5, 7}, CV{5, 8}, CV{5, 9}, CV{5, 10},
-				CV{5, 11}, CV{5, 12}, CV{5, 13}, CV{6, 1},
-				CV{6, 2}, CV{6, 3}, CV{6, 4}, CV{6, 5},
-				CV{6, 6}, CV{6, 7}, CV{6, 8}, CV{6, 9},
-				CV{6, 10}, CV{6, 11}, CV{6, 12}, CV{6, 13},
-				CV{6, 14}, CV{6, 15}, CV{6, 16}, CV{6, 17},
-				CV{6, 18}, CV{6, 19}, CV{6, 20}, CV{7, 1},
-				CV{7, 2}, CV{7, 3}, CV{7, 4}, CV{7, 5},
-				CV{7, 6}, CV{7, 7}, CV{7, 8}, CV{7, 9},
-				CV{7, 10}, CV{7, 11}, CV{7, 12}, CV{7, 13},
-				CV{7, 14}, CV{7, 15}, CV{7, 16}, CV{7, 17},
-				CV{7, 18}, CV{7, 19}, CV{7, 20}, CV{7, 21},
-				CV{7, 22}, CV{7, 23}, CV{7, 24}, CV{7, 25},
-				CV{7, 26}, CV{7, 27}, CV{7, 28}, CV{7, 29},
-				CV{7, 30}, CV{7, 31}, CV{7, 32}, CV{7, 33},
-				CV{7, 34}, CV{7, 35}, CV{7, 36}, CV{7, 37},
-				CV{7, 38}, CV{7, 39}, CV{7, 40}, CV{8, 1},
-				CV{8, 2}, CV{8, 3}, CV{8, 4}, CV{8, 5},
-				CV{8, 6}, CV{8, 7}, CV{8, 8}, CV{8, 9},
-				CV{8, 10}, CV{8, 11}, CV{8, 12}, CV{8, 13},
-				CV{9, 1}, CV{9, 2}, CV{9, 3}, CV{9, 4},
-				CV{9, 5}, CV{9, 6}, CV{9, 7}, CV{9, 8},
-				CV{9, 9}, CV{9, 10}, CV{9, 11}, CV{9, 12},
-				CV{9, 13}, CV{9, 14}, CV{9, 15}, CV{9, 16},
-				CV{9, 17}, CV{9, 18}, CV{9, 19}, CV{9, 20},
-				CV{9, 21}, CV{9, 22}, CV{9, 23}, CV{9, 24},
-				CV{9, 25}, CV{9, 26}, CV{9, 27}, CV{10, 1},
-				CV{10, 2}, CV{10, 3}, CV{10, 4}, CV{10, 5},
-				CV{10, 6}, CV{10, 7}, CV{10, 8}, CV{10, 9},
-				CV{10, 10}, CV{10, 11}, CV{10, 12}, CV{10, 13},
-				CV{10, 14}, CV{10, 15}, CV{10, 16}, CV{10, 17},
-				CV{10, 18}, CV{10, 19}, CV{10, 20}, CV{10, 21},
-				CV{10, 22}, CV{10, 23}, CV{10, 24}, CV{10, 25},
-				CV{10, 26}, CV{10, 27}, CV{10, 28}, CV{10, 29},
-				CV{10, 30}, CV{10, 31}, CV{10, 32}, CV{10, 33},
-				CV{11, 1}, CV{11, 2}, CV{11, 3}, CV{11, 4},
-				CV{11, 5}, CV{11, 6}, CV{11, 7}, CV{11, 8},
-				CV{11, 9}, CV{11, 10}, CV{11, 11}, CV{11, 12},
-				CV{11, 13}, CV{11, 14}, CV{11, 15}, CV{11, 16},
-				CV{11, 17}, CV{11, 18}, CV{11, 19}, CV{11, 20},
-				CV{11, 21}, CV{11, 22}, CV{11, 23}, CV{11, 24},
-				CV{11, 25}, CV{11, 26}, CV{11, 27}, CV{11, 28},
-				CV{11, 29}, CV{11, 30}, CV{11, 31}, CV{11, 32},
-				CV{11, 33}, CV{11, 34}, CV{12, 1}, CV{12, 2},
-				CV{12, 3}, CV{12, 4}, CV{12, 5}, CV{12, 6},
-				CV{12, 7}, CV{12, 8}, CV{12, 9}, CV{12, 10},
-				CV{12, 11}, CV{12, 12}, CV{12, 13}, CV{12, 14},
-				CV{12, 15}, CV{12, 16}, CV{12, 17}, CV{12, 18},
-				CV{12, 19}, CV{12, 20}, CV{12, 21}, CV{12, 22},
-				CV{12, 23}, CV{12, 24}, CV{12, 25}, CV{12, 26},
-				CV{12, 27}, CV{12, 28}, CV{12, 29}, CV{12, 30},
-				CV{12, 31}, CV{13, 1}, CV{13, 2}, CV{13, 3},
-				CV{13, 4}, CV{13, 5}, CV{13, 6}, CV{13, 7},
-				CV{13, 8}, CV{13, 9}, CV{13, 10}, CV{13, 11},
-				CV{13, 12}, CV{13, 13}, CV{14, 1}, CV{14, 2},
-				CV{14, 3}, CV{14, 4}, CV{14, 5}, CV{14, 6},
-				CV{14, 7}, CV{14, 8}, CV{14, 9}, CV{14, 10},
-				CV{14, 11}, CV{14, 12}, CV{14, 13}, CV{14, 14},
-				CV{14, 15}, CV{14, 16}, CV{14, 17}, CV{14, 18},
-				CV{14, 19}, CV{14, 20}, CV{14, 21}, CV{14, 22},
-				CV{14, 23}, CV{14, 24}, CV{14, 25}, CV{14, 26},
-				CV{14, 27}, CV{14, 28}, CV{14, 29}, CV{14, 30},
-				CV{14, 31}, CV{14, 32}, CV{14, 33}, CV{14, 34},
-				CV{14, 35}, CV{14, 36}, CV{14, 37}, CV{14, 38},
-				CV{14, 39}, CV{14, 40}, CV{15, 1}, CV{15, 2},
-				CV{15, 3}, CV{15, 4}, CV{15, 5}, CV{15, 6},
-				CV{15, 7}, CV{15, 8}, CV{15, 9}, CV{15, 10},
-				CV{15, 11}, CV{15, 12}, CV{15, 13}, CV{15, 14},
-				CV{15, 15}, CV{15, 16}, CV{15, 17}, CV{15, 18},
-				CV{15, 19}, CV{15, 20}, CV{15, 21}, CV{15, 22},
-				CV{15, 23}, CV{15, 24}, CV{15, 25}, CV{15, 26},
-				CV{15, 27}, CV{15, 28}, CV{15, 29}, CV{15, 30},
-				CV{15, 31}, CV{15, 32}, CV{15, 33}, CV{15, 34},
-				CV{15, 35}, CV{15, 36}, CV{15, 37}, CV{15, 38},
-				CV{15, 39}, CV{15, 40}, CV{15, 41}, CV{15, 42},
-				CV{15, 43}, CV{15, 44}, CV{15, 45}, CV{15, 46},
-				CV{15, 47}, CV{15, 48}, CV{15, 49}, CV{15, 50},
-				CV{15, 51}, CV{15, 52}, CV{15, 53}, CV{15, 54},
-				CV{15, 55}, CV{15, 56}, CV{15, 57}, CV{15, 58},
-				CV{16, 1}, CV{16, 2}, CV{16, 3}, CV{16, 4},
-				CV{16, 5}, CV{16, 6}, CV{16, 7}, CV{16, 8},
-				CV{16, 9}, CV{16, 10}, CV{16, 11}, CV{16, 12},
-				CV{16, 13}, CV{16, 14}, CV{16, 15}, CV{16, 16},
-				CV{16, 17}, CV{16, 18}, CV{16, 19}, CV{16, 20},
-				CV{16, 21}, CV{16, 22}, CV{16, 23}, CV{16, 24},
+			Runs: []VerseRun{
+				{Chapter: 1, First: 1, Last: 31},
+				{Chapter: 2, First: 1, Last: 16},
+				{Chapter: 3, First: 1, Last: 23},
+				{Chapter: 4, First: 1, Last: 21},
+				{Chapter: 5, First: 1, Last: 13},
+				{Chapter: 6, First: 1, Last: 20},
+				{Chapter: 7, First: 1, Last: 40},
+				{Chapter: 8, First: 1, Last: 13},
+				{Chapter: 9, First: 1, Last: 27},
+				{Chapter: 10, First: 1, Last: 33},
+				{Chapter: 11, First: 1, Last: 34},
+				{Chapter: 12, First: 1, Last: 31},
+				{Chapter: 13, First: 1, Last: 13},
+				{Chapter: 14, First: 1, Last: 40},
+				{Chapter: 15, First: 1, Last: 58},
+				{Chapter: 16, First: 1, Last: 24},
 			},
 		},
 		{
 			Name:      "2 Corinthians",
+			USFM:      "2CO",
+			Testament: NewTestament,
 			JustVerse: false,
-			Verses: []Verse{CV{1, 1},
-				CV{1, 2}, CV{1, 3}, CV{1, 4}, CV{1, 5},
-				CV{1, 6}, CV{1, 7}, CV{1, 8}, CV{1, 9},
-				CV{1, 10}, CV{1, 11}, CV{1, 12}, CV{1, 13},
-				CV{1, 14}, CV{1, 15}, CV{1, 16}, CV{1, 17},
-				CV{1, 18}, CV{1, 19}, CV{1, 20}, CV{1, 21},
-				CV{1, 22}, CV{1, 23}, CV{1, 24}, CV{2, 1},
-				CV{2, 2}, CV{2, 3}, CV{2, 4}, CV{2, 5},
-				CV{2, 6}, CV{2, 7}, CV{2, 8}, CV{2, 9},
-				CV{2, 10}, CV{2, 11}, CV{2, 12}, CV{2, 13},
-				CV{2, 14}, CV{2, 15}, CV{2, 16}, CV{2, 17},
-				CV{3, 1}, CV{3, 2}, CV{3, 3}, CV{3, 4},
-				CV{3, 5}, CV{3, 6}, CV{3, 7}, CV{3, 8},
-				CV{3, 9}, CV{3, 10}, CV{3, 11}, CV{3, 12},
-				CV{3, 13}, CV{3, 14}, CV{3, 15}, CV{3, 16},
-				CV{3, 17}, CV{3, 18}, CV{4, 1}, CV{4, 2},
-				CV{4, 3}, CV{4, 4}, CV{4, 5}, CV{4, 6},
-				CV{4, 7}, CV{4, 8}, CV{4, 9}, CV{4, 10},
-				CV{4, 11}, CV{4, 12}, CV{4, 13}, CV{4, 14},
-				CV{4, 15}, CV{4, 16}, CV{4, 17}, CV{4, 18},
-				CV{5, 1}, CV{5, 2}, CV{5, 3}, CV{5, 4},
-				CV{5, 5}, CV{5, 6}, CV{5, 7}, CV{5, 8},
-				CV{5, 9}, CV{5, 10}, CV{5, 11}, CV{5, 12},
-				CV{5, 13}, CV{5, 14}, CV{5, 15}, CV{5, 16},
-				CV{5, 17}, CV{5, 18}, CV{5, 19}, CV{5, 20},
-				CV{5, 21}, CV{6, 1}, CV{6, 2}, CV{6, 3},
-				CV{6, 4}, CV{6, 5}, CV{6, 6}, CV{6, 7},
-				CV{6, 8}, CV{6, 9}, CV{6, 10}, CV{6, 11},
-				CV{6, 12}, CV{6, 13}, CV{6, 14}, CV{6, 15},
-				CV{6, 16}, CV{6, 17}, CV{6, 18}, CV{7, 1},
-				CV{7, 2}, CV{7, 3}, CV{7, 4}, CV{7, 5},
-				CV{7, 6}, CV{7, 7}, CV{7, 8}, CV{7, 9},
-				CV{7, 10}, CV{7, 11}, CV{7, 12}, CV{7, 13},
-				CV{7, 14}, CV{7, 15}, CV{7, 16}, CV{8, 1},
-				CV{8, 2}, CV{8, 3}, CV{8, 4}, CV{8, 5},
-				CV{8, 6}, CV{8, 7}, CV{8, 8}, CV{8, 9},
-				CV{8, 10}, CV{8, 11}, CV{8, 12}, CV{8, 13},
-				CV{8, 14}, CV{8, 15}, CV{8, 16}, CV{8, 17},
-				CV{8, 18}, CV{8, 19}, CV{8, 20}, CV{8, 21},
-				CV{8, 22}, CV{8, 23}, CV{8, 24}, CV{9, 1},
-				CV{9, 2}, CV{9, 3}, CV{9, 4}, CV{9, 5},
-				CV{9, 6}, CV{9, 7}, CV{9, 8}, CV{9, 9},
-				CV{9, 10}, CV{9, 11}, CV{9, 12}, CV{9, 13},
-				CV{9, 14}, CV{9, 15}, CV{10, 1}, CV{10, 2},
-				CV{10, 3}, CV{10, 4}, CV{10, 5}, CV{10, 6},
-				CV{10, 7}, CV{10, 8}, CV{10, 9}, CV{10, 10},
-				CV{10, 11}, CV{10, 12}, CV{10, 13}, CV{10, 14},
-				CV{10, 15}, CV{10, 16}, CV{10, 17}, CV{10, 18},
-				CV{11, 1}, CV{11, 2}, CV{11, 3}, CV{11, 4},
-				CV{11, 5}, CV{11, 6}, CV{11, 7}, CV{11, 8},
-				CV{11, 9}, CV{11, 10}, CV{11, 11}, CV{11, 12},
-				CV{11, 13}, CV{11, 14}, CV{11, 15}, CV{11, 16},
-				CV{11, 17}, CV{11, 18}, CV{11, 19}, CV{11, 20},
-				CV{11, 21}, CV{11, 22}, CV{11, 23}, CV{11, 24},
-				CV{11, 25}, CV{11, 26}, CV{11, 27}, CV{11, 28},
-				CV{11, 29}, CV{11, 30}, CV{11, 31}, CV{11, 32},
-				CV{11, 33}, CV{12, 1}, CV{12, 2}, CV{12, 3},
-				CV{12, 4}, CV{12, 5}, CV{12, 6}, CV{12, 7},
-				CV{12, 8}, CV{12, 9}, CV{12, 10}, CV{12, 11},
-				CV{12, 12}, CV{12, 13}, CV{12, 14}, CV{12, 15},
-				CV{12, 16}, CV{12, 17}, CV{12, 18}, CV{12, 19},
-				CV{12, 20}, CV{12, 21}, CV{13, 1}, CV{13, 2},
-				CV{13, 3}, CV{13, 4}, CV{13, 5}, CV{13, 6},
-				CV{13, 7}, CV{13, 8}, CV{13, 9}, CV{13, 10},
-				CV{13, 11}, CV{13, 12}, CV{13, 13}, CV{13, 14},
+			Runs: []VerseRun{
+				{Chapter: 1, First: 1, Last: 24},
+				{Chapter: 2, First: 1, Last: 17},
+				{Chapter: 3, First: 1, Last: 18},
+				{Chapter: 4, First: 1, Last: 18},
+				{Chapter: 5, First: 1, Last: 21},
+				{Chapter: 6, First: 1, Last: 18},
+				{Chapter: 7, First: 1, Last: 16},
+				{Chapter: 8, First: 1, Last: 24},
+				{Chapter: 9, First: 1, Last: 15},
+				{Chapter: 10, First: 1, Last: 18},
+				{Chapter: 11, First: 1, Last: 33},
+				{Chapter: 12, First: 1, Last: 21},
+				{Chapter: 13, First: 1, Last: 14},
 			},
 		},
 		{
 			Name:      "Galatians",
+			USFM:      "GAL",
+			Testament: NewTestament,
 			JustVerse: false,
-			Verses: []Verse{CV{1, 1},
-				CV{1, 2}, CV{1, 3}, CV{1, 4}, CV{1, 5},
-				CV{1, 6}, CV{1, 7}, CV{1, 8}, CV{1, 9},
-				CV{1, 10}, CV{1, 11}, CV{1, 12}, CV{1, 13},
-				CV{1, 14}, CV{1, 15}, CV{1, 16}, CV{1, 17},
-				CV{1, 18}, CV{1, 19}, CV{1, 20}, CV{1, 21},
-				CV{1, 22}, CV{1, 23}, CV{1, 24}, CV{2, 1},
-				CV{2, 2}, CV{2, 3}, CV{2, 4}, CV{2, 5},
-				CV{2, 6}, CV{2, 7}, CV{2, 8}, CV{2, 9},
-				CV{2, 10}, CV{2, 11}, CV{2, 12}, CV{2, 13},
-				CV{2, 14}, CV{2, 15}, CV{2, 16}, CV{2, 17},
-				CV{2, 18}, CV{2, 19}, CV{2, 20}, CV{2, 21},
-				CV{3, 1}, CV{3, 2}, CV{3, 3}, CV{3, 4},
-				CV{3, 5}, CV{3, 6}, CV{3, 7}, CV{3, 8},
-				CV{3, 9}, CV{3, 10}, CV{3, 11}, CV{3, 12},
-				CV{3, 13}, CV{3, 14}, CV{3, 15}, CV{3, 16},
-				CV{3, 17}, CV{3, 18}, CV{3, 19}, CV{3, 20},
-				CV{3, 21}, CV{3, 22}, CV{3, 23}, CV{3, 24},
-				CV{3, 25}, CV{3, 26}, CV{3, 27}, CV{3, 28},
-				CV{3, 29}, CV{4, 1}, CV{4, 2}, CV{4, 3},
-				CV{4, 4}, CV{4, 5}, CV{4, 6}, CV{4, 7},
-				CV{4, 8}, CV{4, 9}, CV{4, 10}, CV{4, 11},
-				CV{4, 12}, CV{4, 13}, CV{4, 14}, CV{4, 15},
-				CV{4, 16}, CV{4, 17}, CV{4, 18}, CV{4, 19},
-				CV{4, 20}, CV{4, 21}, CV{4, 22}, CV{4, 23},
-				CV{4, 24}, CV{4, 25}, CV{4, 26}, CV{4, 27},
-				CV{4, 28}, CV{4, 29}, CV{4, 30}, CV{4, 31},
-				CV{5, 1}, CV{5, 2}, CV{5, 3}, CV{5, 4},
-				CV{5, 5}, CV{5, 6}, CV{5, 7}, CV{5, 8},
-				CV{5, 9}, CV{5, 10}, CV{5, 11}, CV{5, 12},
-				CV{5, 13}, CV{5, 14}, CV{5, 15}, CV{5, 16},
-				CV{5, 17}, CV{5, 18}, CV{5, 19}, CV{5, 20},
-				CV{5, 21}, CV{5, 22}, CV{5, 23}, CV{5, 24},
-				CV{5, 25}, CV{5, 26}, CV{6, 1}, CV{6, 2},
-				CV{6, 3}, CV{6, 4}, CV{6, 5}, CV{6, 6},
-				CV{6, 7}, CV{6, 8}, CV{6, 9}, CV{6, 10},
-				CV{6, 11}, CV{6, 12}, CV{6, 13}, CV{6, 14},
-				CV{6, 15}, CV{6, 16}, CV{6, 17}, CV{6, 18},
+			Runs: []VerseRun{
+				{Chapter: 1, First: 1, Last: 24},
+				{Chapter: 2, First: 1, Last: 21},
+				{Chapter: 3, First: 1, Last: 29},
+				{Chapter: 4, First: 1, Last: 31},
+				{Chapter: 5, First: 1, Last: 26},
+				{Chapter: 6, First: 1, Last: 18},
 			},
 		},
 		{
 			Name:      "Ephesians",
+			USFM:      "EPH",
+			Testament: NewTestament,
 			JustVerse: false,
-			Verses: []Verse{CV{1, 1},
-				CV{1, 2}, CV{1, 3}, CV{1, 4}, CV{1, 5},
-				CV{1, 6}, CV{1, 7}, CV{1, 8}, CV{1, 9},
-				CV{1, 10}, CV{1, 11}, CV{1, 12}, CV{1, 13},
-				CV{1, 14}, CV{1, 15}, CV{1, 16}, CV{1, 17},
-				CV{1, 18}, CV{1, 19}, CV{1, 20}, CV{1, 21},
-				CV{1, 22}, CV{1, 23}, CV{2, 1}, CV{2, 2},
-				CV{2, 3}, CV{2, 4}, CV{2, 5}, CV{2, 6},
-				CV{2, 7}, CV{2, 8}, CV{2, 9}, CV{2, 10},
-				CV{2, 11}, CV{2, 12}, CV{2, 13}, CV{2, 14},
-				CV{2, 15}, CV{2, 16}, CV{2, 17}, CV{2, 18},
-				CV{2, 19}, CV{2, 20}, CV{2, 21}, CV{2, 22},
-				CV{3, 1}, CV{3, 2}, CV{3, 3}, CV{3, 4},
-				CV{3, 5}, CV{3, 6}, CV{3, 7}, CV{3, 8},
-				CV{3, 9}, CV{3, 10}, CV{3, 11}, CV{3, 12},
-				CV{3, 13}, CV{3, 14}, CV{3, 15}, CV{3, 16},
-				CV{3, 17}, CV{3, 18}, CV{3, 19}, CV{3, 20},
-				CV{3, 21}, CV{4, 1}, CV{4, 2}, CV{4, 3},
-				CV{4, 4}, CV{4, 5}, CV{4, 6}, CV{4, 7},
-				CV{4, 8}, CV{4, 9}, CV{4, 10}, CV{4, 11},
-				CV{4, 12}, CV{4, 13}, CV{4, 14}, CV{4, 15},
-				CV{4, 16}, CV{4, 17}, CV{4, 18}, CV{4, 19},
-				CV{4, 20}, CV{4, 21}, CV{4, 22}, CV{4, 23},
-				CV{4, 24}, CV{4, 25}, CV{4, 26}, CV{4, 27},
-				CV{4, 28}, CV{4, 29}, CV{4, 30}, CV{4, 31},
-				CV{4, 32}, CV{5, 1}, CV{5, 2}, CV{5, 3},
-				CV{5, 4}, CV{5, 5}, CV{5, 6}, CV{5, 7},
-				CV{5, 8}, CV{5, 9}, CV{5, 10}, CV{5, 11},
-				CV{5, 12}, CV{5, 13}, CV{5, 14}, CV{5, 15},
-				CV{5, 16}, CV{5, 17}, CV{5, 18}, CV{5, 19},
-				CV{5, 20}, CV{5, 21}, CV{5, 22}, CV{5, 23},
-				CV{5, 24}, CV{5, 25}, CV{5, 26}, CV{5, 27},
-				CV{5, 28}, CV{5, 29}, CV{5, 30}, CV{5, 31},
-				CV{5, 32}, CV{5, 33}, CV{6, 1}, CV{6, 2},
-				CV{6, 3}, CV{6, 4}, CV{6, 5}, CV{6, 6},
-				CV{6, 7}, CV{6, 8}, CV{6, 9}, CV{6, 10},
-				CV{6, 11}, CV{6, 12}, CV{6, 13}, CV{6, 14},
-				CV{6, 15}, CV{6, 16}, CV{6, 17}, CV{6, 18},
-				CV{6, 19}, CV{6, 20}, CV{6, 21}, CV{6, 22},
-				CV{6, 23}, CV{6, 24},
+			Runs: []VerseRun{
+				{Chapter: 1, First: 1, Last: 23},
+				{Chapter: 2, First: 1, Last: 22},
+				{Chapter: 3, First: 1, Last: 21},
+				{Chapter: 4, First: 1, Last: 32},
+				{Chapter: 5, First: 1, Last: 33},
+				{Chapter: 6, First: 1, Last: 24},
 			},
 		},
 		{
 			Name:      "Philippians",
+			USFM:      "PHP",
+			Testament: NewTestament,
 			JustVerse: false,
-			Verses: []Verse{CV{1, 1},
-				CV{1, 2}, CV{1, 3}, CV{1, 4}, CV{1, 5},
-				CV{1, 6}, CV{1, 7}, CV{1, 8}, CV{1, 9},
-				CV{1, 10}, CV{1, 11}, CV{1, 12}, CV{1, 13},
-				CV{1, 14}, CV{1, 15}, CV{1, 16}, CV{1, 17},
-				CV{1, 18}, CV{1, 19}, CV{1, 20}, CV{1, 21},
-				CV{1, 22}, CV{1, 23}, CV{1, 24}, CV{1, 25},
-				CV{1, 26}, CV{1, 27}, CV{1, 28}, CV{1, 29},
-				CV{1, 30}, CV{2, 1}, CV{2, 2}, CV{2, 3},
-				CV{2, 4}, CV{2, 5}, CV{2, 6}, CV{2, 7},
-				CV{2, 8}, CV{2, 9}, CV{2, 10}, CV{2, 11},
-				CV{2, 12}, CV{2, 13}, CV{2, 14}, CV{2, 15},
-				CV{2, 16}, CV{2, 17}, CV{2, 18}, CV{2, 19},
-				CV{2, 20}, CV{2, 21}, CV{2, 22}, CV{2, 23},
-				CV{2, 24}, CV{2, 25}, CV{2, 26}, CV{2, 27},
-				CV{2, 28}, CV{2, 29}, CV{2, 30}, CV{3, 1},
-				CV{3, 2}, CV{3, 3}, CV{3, 4}, CV{3, 5},
-				CV{3, 6}, CV{3, 7}, CV{3, 8}, CV{3, 9},
-				CV{3, 10}, CV{3, 11}, CV{3, 12}, CV{3, 13},
-				CV{3, 14}, CV{3, 15}, CV{3, 16}, CV{3, 17},
-				CV{3, 18}, CV{3, 19}, CV{3, 20}, CV{3, 21},
-				CV{4, 1}, CV{4, 2}, CV{4, 3}, CV{4, 4},
-				CV{4, 5}, CV{4, 6}, CV{4, 7}, CV{4, 8},
-				CV{4, 9}, CV{4, 10}, CV{4, 11}, CV{4, 12},
-				CV{4, 13}, CV{4, 14}, CV{4, 15}, CV{4, 16},
-				CV{4, 17}, CV{4, 18}, CV{4, 19}, CV{4, 20},
-				CV{4, 21}, CV{4, 22}, CV{4, 23},
+			Runs: []VerseRun{
+				{Chapter: 1, First: 1, Last: 30},
+				{Chapter: 2, First: 1, Last: 30},
+				{Chapter: 3, First: 1, Last: 21},
+				{Chapter: 4, First: 1, Last: 23},
 			},
 		},
 		{
 			Name:      "Colossians",
+			USFM:      "COL",
+			Testament: NewTestament,
 			JustVerse: false,
-			Verses: []Verse{CV{1, 1},
-				CV{1, 2}, CV{1, 3}, CV{1, 4}, CV{1, 5},
-				CV{1, 6}, CV{1, 7}, CV{1, 8}, CV{1, 9},
-				CV{1, 10}, CV{1, 11}, CV{1, 12}, CV{1, 13},
-				CV{1, 14}, CV{1, 15}, CV{1, 16}, CV{1, 17},
-				CV{1, 18}, CV{1, 19}, CV{1, 20}, CV{1, 21},
-				CV{1, 22}, CV{1, 23}, CV{1, 24}, CV{1, 25},
-				CV{1, 26}, CV{1, 27}, CV{1, 28}, CV{1, 29},
-				CV{2, 1}, CV{2, 2}, CV{2, 3}, CV{2, 4},
-				CV{2, 5}, CV{2, 6}, CV{2, 7}, CV{2, 8},
-				CV{2, 9}, CV{2, 10}, CV{2, 11}, CV{2, 12},
-				CV{2, 13}, CV{2, 14}, CV{2, 15}, CV{2, 16},
-				CV{2, 17}, CV{2, 18}, CV{2, 19}, CV{2, 20},
-				CV{2, 21}, CV{2, 22}, CV{2, 23}, CV{3, 1},
-				CV{3, 2}, CV{3, 3}, CV{3, 4}, CV{3, 5},
-				CV{3, 6}, CV{3, 7}, CV{3, 8}, CV{3, 9},
-				CV{3, 10}, CV{3, 11}, CV{3, 12}, CV{3, 13},
-				CV{3, 14}, CV{3, 15}, CV{3, 16}, CV{3, 17},
-				CV{3, 18}, CV{3, 19}, CV{3, 20}, CV{3, 21},
-				CV{3, 22}, CV{3, 23}, CV{3, 24}, CV{3, 25},
-				CV{4, 1}, CV{4, 2}, CV{4, 3}, CV{4, 4},
-				CV{4, 5}, CV{4, 6}, CV{4, 7}, CV{4, 8},
-				CV{4, 9}, CV{4, 10}, CV{4, 11}, CV{4, 12},
-				CV{4, 13}, CV{4, 14}, CV{4, 15}, CV{4, 16},
-				CV{4, 17}, CV{4, 18},
+			Runs: []VerseRun{
+				{Chapter: 1, First: 1, Last: 29},
+				{Chapter: 2, First: 1, Last: 23},
+				{Chapter: 3, First: 1, Last: 25},
+				{Chapter: 4, First: 1, Last: 18},
 			},
 		},
 		{
 			Name:      "1 Thessalonians",
+			USFM:      "1TH",
+			Testament: NewTestament,
 			JustVerse: false,
-			Verses: []Verse{CV{1, 1},
-				CV{1, 2}, CV{1, 3}, CV{1, 4}, CV{1, 5},
-				CV{1, 6}, CV{1, 7}, CV{1, 8}, CV{1, 9},
-				CV{1, 10}, CV{2, 1}, CV{2, 2}, CV{2, 3},
-				CV{2, 4}, CV{2, 5}, CV{2, 6}, CV{2, 7},
-				CV{2, 8}, CV{2, 9}, CV{2, 10}, CV{2, 11},
-				CV{2, 12}, CV{2, 13}, CV{2, 14}, CV{2, 15},
-				CV{2, 16}, CV{2, 17}, CV{2, 18}, CV{2, 19},
-				CV{2, 20}, CV{3, 1}, CV{3, 2}, CV{3, 3},
-				CV{3, 4}, CV{3, 5}, CV{3, 6}, CV{3, 7},
-				CV{3, 8}, CV{3, 9}, CV{3, 10}, CV{3, 11},
-				CV{3, 12}, CV{3, 13}, CV{4, 1}, CV{4, 2},
-				CV{4, 3}, CV{4, 4}, CV{4, 5}, CV{4, 6},
-				CV{4, 7}, CV{4, 8}, CV{4, 9}, CV{4, 10},
-				CV{4, 11}, CV{4, 12}, CV{4, 13}, CV{4, 14},
-				CV{4, 15}, CV{4, 16}, CV{4, 17}, CV{4, 18},
-				CV{5, 1}, CV{5, 2}, CV{5, 3}, CV{5, 4},
-				CV{5, 5}, CV{5, 6}, CV{5, 7}, CV{5, 8},
-				CV{5, 9}, CV{5, 10}, CV{5, 11}, CV{5, 12},
-				CV{5, 13}, CV{5, 14}, CV{5, 15}, CV{5, 16},
-				CV{5, 17}, CV{5, 18}, CV{5, 19}, CV{5, 20},
-				CV{5, 21}, CV{5, 22}, CV{5, 23}, CV{5, 24},
-				CV{5, 25}, CV{5, 26}, CV{5, 27}, CV{5, 28},
+			Runs: []VerseRun{
+				{Chapter: 1, First: 1, Last: 10},
+				{Chapter: 2, First: 1, Last: 20},
+				{Chapter: 3, First: 1, Last: 13},
+				{Chapter: 4, First: 1, Last: 18},
+				{Chapter: 5, First: 1, Last: 28},
 			},
 		},
 		{
 			Name:      "2 Thessalonians",
+			USFM:      "2TH",
+			Testament: NewTestament,
 			JustVerse: false,
-			Verses: []Verse{CV{1, 1},
-				CV{1, 2}, CV{1, 3}, CV{1, 4}, CV{1, 5},
-				CV{1, 6}, CV{1, 7}, CV{1, 8}, CV{1, 9},
-				CV{1, 10}, CV{1, 11}, CV{1, 12}, CV{2, 1},
-				CV{2, 2}, CV{2, 3}, CV{2, 4}, CV{2, 5},
-				CV{2, 6}, CV{2, 7}, CV{2, 8}, CV{2, 9},
-				CV{2, 10}, CV{2, 11}, CV{2, 12}, CV{2, 13},
-				CV{2, 14}, CV{2, 15}, CV{2, 16}, CV{2, 17},
-				CV{3, 1}, CV{3, 2}, CV{3, 3}, CV{3, 4},
-				CV{3, 5}, CV{3, 6}, CV{3, 7}, CV{3, 8},
-				CV{3, 9}, CV{3, 10}, CV{3, 11}, CV{3, 12},
-				CV{3, 13}, CV{3, 14}, CV{3, 15}, CV{3, 16},
-				CV{3, 17}, CV{3, 18},
+			Runs: []VerseRun{
+				{Chapter: 1, First: 1, Last: 12},
+				{Chapter: 2, First: 1, Last: 17},
+				{Chapter: 3, First: 1, Last: 18},
 			},
 		},
 		{
 			Name:      "1 Timothy",
+			USFM:      "1TI",
+			Testament: NewTestament,
 			JustVerse: false,
-			Verses: []Verse{CV{1, 1},
-				CV{1, 2}, CV{1, 3}, CV{1, 4}, CV{1, 5},
-				CV{1, 6}, CV{1, 7}, CV{1, 8}, CV{1, 9},
-				CV{1, 10}, CV{1, 11}, CV{1, 12}, CV{1, 13},
-				CV{1, 14}, CV{1, 15}, CV{1, 16}, CV{1, 17},
-				CV{1, 18}, CV{1, 19}, CV{1, 20}, CV{2, 1},
-				CV{2, 2}, CV{2, 3}, CV{2, 4}, CV{2, 5},
-				CV{2, 6}, CV{2, 7}, CV{2, 8}, CV{2, 9},
-				CV{2, 10}, CV{2, 11}, CV{2, 12}, CV{2, 13},
-				CV{2, 14}, CV{2, 15}, CV{3, 1}, CV{3, 2},
-				CV{3, 3}, CV{3, 4}, CV{3, 5}, CV{3, 6},
-				CV{3, 7}, CV{3, 8}, CV{3, 9}, CV{3, 10},
-				CV{3, 11}, CV{3, 12}, CV{3, 13}, CV{3, 14},
-				CV{3, 15}, CV{3, 16}, CV{4, 1}, CV{4, 2},
-				CV{4, 3}, CV{4, 4}, CV{4, 5}, CV{4, 6},
-				CV{4, 7}, CV{4, 8}, CV{4, 9}, CV{4, 10},
-				CV{4, 11}, CV{4, 12}, CV{4, 13}, CV{4, 14},
-				CV{4, 15}, CV{4, 16}, CV{5, 1}, CV{5, 2},
-				CV{5, 3}, CV{5, 4}, CV{5, 5}, CV{5, 6},
-				CV{5, 7}, CV{5, 8}, CV{5, 9}, CV{5, 10},
-				CV{5, 11}, CV{5, 12}, CV{5, 13}, CV{5, 14},
-				CV{5, 15}, CV{5, 16}, CV{5, 17}, CV{5, 18},
-				CV{5, 19}, CV{5, 20}, CV{5, 21}, CV{5, 22},
-				CV{5, 23}, CV{5, 24}, CV{5, 25}, CV{6, 1},
-				CV{6, 2}, CV{6, 3}, CV{6, 4}, CV{6, 5},
-				CV{6, 6}, CV{6, 7}, CV{6, 8}, CV{6, 9},
-				CV{6, 10}, CV{6, 11}, CV{6, 12}, CV{6, 13},
-				CV{6, 14}, CV{6, 15}, CV{6, 16}, CV{6, 17},
-				CV{6, 18}, CV{6, 19}, CV{6, 20}, CV{6, 21},
+			Runs: []VerseRun{
+				{Chapter: 1, First: 1, Last: 20},
+				{Chapter: 2, First: 1, Last: 15},
+				{Chapter: 3, First: 1, Last: 16},
+				{Chapter: 4, First: 1, Last: 16},
+				{Chapter: 5, First: 1, Last: 25},
+				{Chapter: 6, First: 1, Last: 21},
 			},
 		},
 		{
 			Name:      "2 Timothy",
+			USFM:      "2TI",
+			Testament: NewTestament,
 			JustVerse: false,
-			Verses: []Verse{CV{1, 1},
-				CV{1, 2}, CV{1, 3}, CV{1, 4}, CV{1, 5},
-				CV{1, 6}, CV{1, 7}, CV{1, 8}, CV{1, 9},
-				CV{1, 10}, CV{1, 11}, CV{1, 12}, CV{1, 13},
-				CV{1, 14}, CV{1, 15}, CV{1, 16}, CV{1, 17},
-				CV{1, 18}, CV{2, 1}, CV{2, 2}, CV{2, 3},
-				CV{2, 4}, CV{2, 5}, CV{2, 6}, CV{2, 7},
-				CV{2, 8}, CV{2, 9}, CV{2, 10}, CV{2, 11},
-				CV{2, 12}, CV{2, 13}, CV{2, 14}, CV{2, 15},
-				CV{2, 16}, CV{2, 17}, CV{2, 18}, CV{2, 19},
-				CV{2, 20}, CV{2, 21}, CV{2, 22}, CV{2, 23},
-				CV{2, 24}, CV{2, 25}, CV{2, 26}, CV{3, 1},
-				CV{3, 2}, CV{3, 3}, CV{3, 4}, CV{3, 5},
-				CV{3, 6}, CV{3, 7}, CV{3, 8}, CV{3, 9},
-				CV{3, 10}, CV{3, 11}, CV{3, 12}, CV{3, 13},
-				CV{3, 14}, CV{3, 15}, CV{3, 16}, CV{3, 17},
-				CV{4, 1}, CV{4, 2}, CV{4, 3}, CV{4, 4},
-				CV{4, 5}, CV{4, 6}, CV{4, 7}, CV{4, 8},
-				CV{4, 9}, CV{4, 10}, CV{4, 11}, CV{4, 12},
-				CV{4, 13}, CV{4, 14}, CV{4, 15}, CV{4, 16},
-				CV{4, 17}, CV{4, 18}, CV{4, 19}, CV{4, 20},
-				CV{4, 21}, CV{4, 22},
+			Runs: []VerseRun{
+				{Chapter: 1, First: 1, Last: 18},
+				{Chapter: 2, First: 1, Last: 26},
+				{Chapter: 3, First: 1, Last: 17},
+				{Chapter: 4, First: 1, Last: 22},
 			},
 		},
 		{
 			Name:      "Titus",
+			USFM:      "TIT",
+			Testament: NewTestament,
 			JustVerse: false,
-			Verses: []Verse{CV{1, 1},
-				CV{1, 2}, CV{1, 3}, CV{1, 4}, CV{1, 5},
-				CV{1, 6}, CV{1, 7}, CV{1, 8}, CV{1, 9},
-				CV{1, 10}, CV{1, 11}, CV{1, 12}, CV{1, 13},
-				CV{1, 14}, CV{1, 15}, CV{1, 16}, CV{2, 1},
-				CV{2, 2}, CV{2, 3}, CV{2, 4}, CV{2, 5},
-				CV{2, 6}, CV{2, 7}, CV{2, 8}, CV{2, 9},
-				CV{2, 10}, CV{2, 11}, CV{2, 12}, CV{2, 13},
-				CV{2, 14}, CV{2, 15}, CV{3, 1}, CV{3, 2},
-				CV{3, 3}, CV{3, 4}, CV{3, 5}, CV{3, 6},
-				CV{3, 7}, CV{3, 8}, CV{3, 9}, CV{3, 10},
-				CV{3, 11}, CV{3, 12}, CV{3, 13}, CV{3, 14},
-				CV{3, 15},
+			Runs: []VerseRun{
+				{Chapter: 1, First: 1, Last: 16},
+				{Chapter: 2, First: 1, Last: 15},
+				{Chapter: 3, First: 1, Last: 15},
 			},
 		},
 		{
 			Name:      "Philemon",
+			USFM:      "PHM",
+			Testament: NewTestament,
 			JustVerse: true,
-			Verses: []Verse{N{1},
-				N{2}, N{3}, N{4}, N{5},
-				N{6}, N{7}, N{8}, N{9},
-				N{10}, N{11}, N{12}, N{13},
-				N{14}, N{15}, N{16}, N{17},
-				N{18}, N{19}, N{20}, N{21},
-				N{22}, N{23}, N{24}, N{25},
+			Runs: []VerseRun{
+				{Chapter: 1, First: 1, Last: 25},
 			},
 		},
 		{
 			Name:      "Hebrews",
+			USFM:      "HEB",
+			Testament: NewTestament,
 			JustVerse: false,
-			Verses: []Verse{CV{1, 1},
-				CV{1, 2}, CV{1, 3}, CV{1, 4}, CV{1, 5},
-				CV{1, 6}, CV{1, 7}, CV{1, 8}, CV{1, 9},
-				CV{1, 10}, CV{1, 11}, CV{1, 12}, CV{1, 13},
-				CV{1, 14}, CV{2, 1}, CV{2, 2}, CV{2, 3},
-				CV{2, 4}, CV{2, 5}, CV{2, 6}, CV{2, 7},
-				CV{2, 8}, CV{2, 9}, CV{2, 10}, CV{2, 11},
-				CV{2, 12}, CV{2, 13}, CV{2, 14}, CV{2, 15},
-				CV{2, 16}, CV{2, 17}, CV{2, 18}, CV{3, 1},
-				CV{3, 2}, CV{3, 3}, CV{3, 4}, CV{3, 5},
-				CV{3, 6}, CV{3, 7}, CV{3, 8}, CV{3, 9},
-				CV{3, 10}, CV{3, 11}, CV{3, 12}, CV{3, 13},
-				CV{3, 14}, CV{3, 15}, CV{3, 16}, CV{3, 17},
-				CV{3, 18}, CV{3, 19}, CV{4, 1}, CV{4, 2},
-				CV{4, 3}, CV{4, 4}, CV{4, 5}, CV{4, 6},
-				CV{4, 7}, CV{4, 8}, CV{4, 9}, CV{4, 10},
-				CV{4, 11}, CV{4, 12}, CV{4, 13}, CV{4, 14},
-				CV{4, 15}, CV{4, 16}, CV{5, 1}, CV{5, 2},
-				CV{5, 3}, CV{5, 4}, CV{5, 5}, CV{5, 6},
-				CV{5, 7}, CV{5, 8}, CV{5, 9}, CV{5, 10},
-				CV{5, 11}, CV{5, 12}, CV{5, 13}, CV{5, 14},
-				CV{6, 1}, CV{6, 2}, CV{6, 3}, CV{6, 4},
-				CV{6, 5}, CV{6, 6}, CV{6, 7}, CV{6, 8},
-				CV{6, 9}, CV{6, 10}, CV{6, 11}, CV{6, 12},
-				CV{6, 13}, CV{6, 14}, CV{6, 15}, CV{6, 16},
-				CV{6, 17}, CV{6, 18}, CV{6, 19}, CV{6, 20},
-				CV{7, 1}, CV{7, 2}, CV{7, 3}, CV{7, 4},
-				CV{7, 5}, CV{7, 6}, CV{7, 7}, CV{7, 8},
-				CV{7, 9}, CV{7, 10}, CV{7, 11}, CV{7, 12},
-				CV{7, 13}, CV{7, 14}, CV{7, 15}, CV{7, 16},
-				CV{7, 17}, CV{7, 18}, CV{7, 19}, CV{7, 20},
-				CV{7, 21}, CV{7, 22}, CV{7, 23}, CV{7, 24},
-				CV{7, 25}, CV{7, 26}, CV{7, 27}, CV{7, 28},
-				CV{8, 1}, CV{8, 2}, CV{8, 3}, CV{8, 4},
-				CV{8, 5}, CV{8, 6}, CV{8, 7}, CV{8, 8},
-				CV{8, 9}, CV{8, 10}, CV{8, 11}, CV{8, 12},
-				CV{8, 13}, CV{9, 1}, CV{9, 2}, CV{9, 3},
-				CV{9, 4}, CV{9, 5}, CV{9, 6}, CV{9, 7},
-				CV{9, 8}, CV{9, 9}, CV{9, 10}, CV{9, 11},
-				CV{9, 12}, CV{9, 13}, CV{9, 14}, CV{9, 15},
-				CV{9, 16}, CV{9, 17}, CV{9, 18}, CV{9, 19},
-				CV{9, 20}, CV{9, 21}, CV{9, 22}, CV{9, 23},
-				CV{9, 24}, CV{9, 25}, CV{9, 26}, CV{9, 27},
-				CV{9, 28}, CV{10, 1}, CV{10, 2}, CV{10, 3},
-				CV{10, 4}, CV{10, 5}, CV{10, 6}, CV{10, 7},
-				CV{10, 8}, CV{10, 9}, CV{10, 10}, CV{10, 11},
-				CV{10, 12}, CV{10, 13}, CV{10, 14}, CV{10, 15},
-				CV{10, 16}, CV{10, 17}, CV{10, 18}, CV{10, 19},
-				CV{10, 20}, CV{10, 21}, CV{10, 22}, CV{10, 23},
-				CV{10, 24}, CV{10, 25}, CV{10, 26}, CV{10, 27},
-				CV{10, 28}, CV{10, 29}, CV{10, 30}, CV{10, 31},
-				CV{10, 32}, CV{10, 33}, CV{10, 34}, CV{10, 35},
-				CV{10, 36}, CV{10, 37}, CV{10, 38}, CV{10, 39},
-				CV{11, 1}, CV{11, 2}, CV{11, 3}, CV{11, 4},
-				CV{11, 5}, CV{11, 6}, CV{11, 7}, CV{11, 8},
-				CV{11, 9}, CV{11, 10}, CV{11, 11}, CV{11, 12},
-				CV{11, 13}, CV{11, 14}, CV{11, 15}, CV{11, 16},
-				CV{11, 17}, CV{11, 18}, CV{11, 19}, CV{11, 20},
-				CV{11, 21}, CV{11, 22}, CV{11, 23}, CV{11, 24},
-				CV{11, 25}, CV{11, 26}, CV{11, 27}, CV{11, 28},
-				CV{11, 29}, CV{11, 30}, CV{11, 31}, CV{11, 32},
-				CV{11, 33}, CV{11, 34}, CV{11, 35}, CV{11, 36},
-				CV{11, 37}, CV{11, 38}, CV{11, 39}, CV{11, 40},
-				CV{12, 1}, CV{12, 2}, CV{12, 3}, CV{12, 4},
-				CV{12, 5}, CV{12, 6}, CV{12, 7}, CV{12, 8},
-				CV{12, 9}, CV{12, 10}, CV{12, 11}, CV{12, 12},
-				CV{12, 13}, CV{12, 14}, CV{12, 15}, CV{12, 16},
-				CV{12, 17}, CV{12, 18}, CV{12, 19}, CV{12, 20},
-				CV{12, 21}, CV{12, 22}, CV{12, 23}, CV{12, 24},
-				CV{12, 25}, CV{12, 26}, CV{12, 27}, CV{12, 28},
-				CV{12, 29}, CV{13, 1}, CV{13, 2}, CV{13, 3},
-				CV{13, 4}, CV{13, 5}, CV{13, 6}, CV{13, 7},
-				CV{13, 8}, CV{13, 9}, CV{13, 10}, CV{13, 11},
-				CV{13, 12}, CV{13, 13}, CV{13, 14}, CV{13, 15},
-				CV{13, 16}, CV{13, 17}, CV{13, 18}, CV{13, 19},
-				CV{13, 20}, CV{13, 21}, CV{13, 22}, CV{13, 23},
-				CV{13, 24}, CV{13, 25},
+			Runs: []VerseRun{
+				{Chapter: 1, First: 1, Last: 14},
+				{Chapter: 2, First: 1, Last: 18},
+				{Chapter: 3, First: 1, Last: 19},
+				{Chapter: 4, First: 1, Last: 16},
+				{Chapter: 5, First: 1, Last: 14},
+				{Chapter: 6, First: 1, Last: 20},
+				{Chapter: 7, First: 1, Last: 28},
+				{Chapter: 8, First: 1, Last: 13},
+				{Chapter: 9, First: 1, Last: 28},
+				{Chapter: 10, First: 1, Last: 39},
+				{Chapter: 11, First: 1, Last: 40},
+				{Chapter: 12, First: 1, Last: 29},
+				{Chapter: 13, First: 1, Last: 25},
 			},
 		},
 		{
 			Name:      "James",
+			USFM:      "JAS",
+			Testament: NewTestament,
 			JustVerse: false,
-			Verses: []Verse{CV{1, 1},
-				CV{1, 2}, CV{1, 3}, CV{1, 4}, CV{1, 5},
-				CV{1, 6}, CV{1, 7}, CV{1, 8}, CV{1, 9},
-				CV{1, 10}, CV{1, 11}, CV{1, 12}, CV{1, 13},
-				CV{1, 14}, CV{1, 15}, CV{1, 16}, CV{1, 17},
-				CV{1, 18}, CV{1, 19}, CV{1, 20}, CV{1, 21},
-				CV{1, 22}, CV{1, 23}, CV{1, 24}, CV{1, 25},
-				CV{1, 26}, CV{1, 27}, CV{2, 1}, CV{2, 2},
-				CV{2, 3}, CV{2, 4}, CV{2, 5}, CV{2, 6},
-				CV{2, 7}, CV{2, 8}, CV{2, 9}, CV{2, 10},
-				CV{2, 11}, CV{2, 12}, CV{2, 13}, CV{2, 14},
-				CV{2, 15}, CV{2, 16}, CV{2, 17}, CV{2, 18},
-				CV{2, 19}, CV{2, 20}, CV{2, 21}, CV{2, 22},
-				CV{2, 23}, CV{2, 24}, CV{2, 25}, CV{2, 26},
-				CV{3, 1}, CV{3, 2}, CV{3, 3}, CV{3, 4},
-				CV{3, 5}, CV{3, 6}, CV{3, 7}, CV{3, 8},
-				CV{3, 9}, CV{3, 10}, CV{3, 11}, CV{3, 12},
-				CV{3, 13}, CV{3, 14}, CV{3, 15}, CV{3, 16},
-				CV{3, 17}, CV{3, 18}, CV{4, 1}, CV{4, 2},
-				CV{4, 3}, CV{4, 4}, CV{4, 5}, CV{4, 6},
-				CV{4, 7}, CV{4, 8}, CV{4, 9}, CV{4, 10},
-				CV{4, 11}, CV{4, 12}, CV{4, 13}, CV{4, 14},
-				CV{4, 15}, CV{4, 16}, CV{4, 17}, CV{5, 1},
-				CV{5, 2}, CV{5, 3}, CV{5, 4}, CV{5, 5},
-				CV{5, 6}, CV{5, 7}, CV{5, 8}, CV{5, 9},
-				CV{5, 10}, CV{5, 11}, CV{5, 12}, CV{5, 13},
-				CV{5, 14}, CV{5, 15}, CV{5, 16}, CV{5, 17},
-				CV{5, 18}, CV{5, 19}, CV{5, 20},
+			Runs: []VerseRun{
+				{Chapter: 1, First: 1, Last: 27},
+				{Chapter: 2, First: 1, Last: 26},
+				{Chapter: 3, First: 1, Last: 18},
+				{Chapter: 4, First: 1, Last: 17},
+				{Chapter: 5, First: 1, Last: 20},
 			},
 		},
 		{
 			Name:      "1 Peter",
+			USFM:      "1PE",
+			Testament: NewTestament,
 			JustVerse: false,
-			Verses: []Verse{CV{1, 1},
-				CV{1, 2}, CV{1, 3}, CV{1, 4}, CV{1, 5},
-				CV{1, 6}, CV{1, 7}, CV{1, 8}, CV{1, 9},
-				CV{1, 10}, CV{1, 11}, CV{1, 12}, CV{1, 13},
-				CV{1, 14}, CV{1, 15}, CV{1, 16}, CV{1, 17},
-				CV{1, 18}, CV{1, 19}, CV{1, 20}, CV{1, 21},
-				CV{1, 22}, CV{1, 23}, CV{1, 24}, CV{1, 25},
-				CV{2, 1}, CV{2, 2}, CV{2, 3}, CV{2, 4},
-				CV{2, 5}, CV{2, 6}, CV{2, 7}, CV{2, 8},
-				CV{2, 9}, CV{2, 10}, CV{2, 11}, CV{2, 12},
-				CV{2, 13}, CV{2, 14}, CV{2, 15}, CV{2, 16},
-				CV{2, 17}, CV{2, 18}, CV{2, 19}, CV{2, 20},
-				CV{2, 21}, CV{2, 22}, CV{2, 23}, CV{2, 24},
-				CV{2, 25}, CV{3, 1}, CV{3, 2}, CV{3, 3},
-				CV{3, 4}, CV{3, 5}, CV{3, 6}, CV{3, 7},
-				CV{3, 8}, CV{3, 9}, CV{3, 10}, CV{3, 11},
-				CV{3, 12}, CV{3, 13}, CV{3, 14}, CV{3, 15},
-				CV{3, 16}, CV{3, 17}, CV{3, 18}, CV{3, 19},
-				CV{3, 20}, CV{3, 21}, CV{3, 22}, CV{4, 1},
-				CV{4, 2}, CV{4, 3}, CV{4, 4}, CV{4, 5},
-				CV{4, 6}, CV{4, 7}, CV{4, 8}, CV{4, 9},
-				CV{4, 10}, CV{4, 11}, CV{4, 12}, CV{4, 13},
-				CV{4, 14}, CV{4, 15}, CV{4, 16}, CV{4, 17},
-				CV{4, 18}, CV{4, 19}, CV{5, 1}, CV{5, 2},
-				CV{5, 3}, CV{5, 4}, CV{5, 5}, CV{5, 6},
-				CV{5, 7}, CV{5, 8}, CV{5, 9}, CV{5, 10},
-				CV{5, 11}, CV{5, 12}, CV{5, 13}, CV{5, 14},
+			Runs: []VerseRun{
+				{Chapter: 1, First: 1, Last: 25},
+				{Chapter: 2, First: 1, Last: 25},
+				{Chapter: 3, First: 1, Last: 22},
+				{Chapter: 4, First: 1, Last: 19},
+				{Chapter: 5, First: 1, Last: 14},
 			},
 		},
 		{
 			Name:      "2 Peter",
+			USFM:      "2PE",
+			Testament: NewTestament,
 			JustVerse: false,
-			Verses: []Verse{CV{1, 1},
-				CV{1, 2}, CV{1, 3}, CV{1, 4}, CV{1, 5},
-				CV{1, 6}, CV{1, 7}, CV{1, 8}, CV{1, 9},
-				CV{1, 10}, CV{1, 11}, CV{1, 12}, CV{1, 13},
-				CV{1, 14}, CV{1, 15}, CV{1, 16}, CV{1, 17},
-				CV{1, 18}, CV{1, 19}, CV{1, 20}, CV{1, 21},
-				CV{2, 1}, CV{2, 2}, CV{2, 3}, CV{2, 4},
-				CV{2, 5}, CV{2, 6}, CV{2, 7}, CV{2, 8},
-				CV{2, 9}, CV{2, 10}, CV{2, 11}, CV{2, 12},
-				CV{2, 13}, CV{2, 14}, CV{2, 15}, CV{2, 16},
-				CV{2, 17}, CV{2, 18}, CV{2, 19}, CV{2, 20},
-				CV{2, 21}, CV{2, 22}, CV{3, 1}, CV{3, 2},
-				CV{3, 3}, CV{3, 4}, CV{3, 5}, CV{3, 6},
-				CV{3, 7}, CV{3, 8}, CV{3, 9}, CV{3, 10},
-				CV{3, 11}, CV{3, 12}, CV{3, 13}, CV{3, 14},
-				CV{3, 15}, CV{3, 16}, CV{3, 17}, CV{3, 18},
+			Runs: []VerseRun{
+				{Chapter: 1, First: 1, Last: 21},
+				{Chapter: 2, First: 1, Last: 22},
+				{Chapter: 3, First: 1, Last: 18},
 			},
 		},
 		{
 			Name:      "1 John",
+			USFM:      "1JN",
+			Testament: NewTestament,
 			JustVerse: false,
-			Verses: []Verse{CV{1, 1},
-				CV{1, 2}, CV{1, 3}, CV{1, 4}, CV{1, 5},
-				CV{1, 6}, CV{1, 7}, CV{1, 8}, CV{1, 9},
-				CV{1, 10}, CV{2, 1}, CV{2, 2}, CV{2, 3},
-				CV{2, 4}, CV{2, 5}, CV{2, 6}, CV{2, 7},
-				CV{2, 8}, CV{2, 9}, CV{2, 10}, CV{2, 11},
-				CV{2, 12}, CV{2, 13}, CV{2, 14}, CV{2, 15},
-				CV{2, 16}, CV{2, 17}, CV{2, 18}, CV{2, 19},
-				CV{2, 20}, CV{2, 21}, CV{2, 22}, CV{2, 23},
-				CV{2, 24}, CV{2, 25}, CV{2, 26}, CV{2, 27},
-				CV{2, 28}, CV{2, 29}, CV{3, 1}, CV{3, 2},
-				CV{3, 3}, CV{3, 4}, CV{3, 5}, CV{3, 6},
-				CV{3, 7}, CV{3, 8}, CV{3, 9}, CV{3, 10},
-				CV{3, 11}, CV{3, 12}, CV{3, 13}, CV{3, 14},
-				CV{3, 15}, CV{3, 16}, CV{3, 17}, CV{3, 18},
-				CV{3, 19}, CV{3, 20}, CV{3, 21}, CV{3, 22},
-				CV{3, 23}, CV{3, 24}, CV{4, 1}, CV{4, 2},
-				CV{4, 3}, CV{4, 4}, CV{4, 5}, CV{4, 6},
-				CV{4, 7}, CV{4, 8}, CV{4, 9}, CV{4, 10},
-				CV{4, 11}, CV{4, 12}, CV{4, 13}, CV{4, 14},
-				CV{4, 15}, CV{4, 16}, CV{4, 17}, CV{4, 18},
-				CV{4, 19}, CV{4, 20}, CV{4, 21}, CV{5, 1},
-				CV{5, 2}, CV{5, 3}, CV{5, 4}, CV{5, 5},
-				CV{5, 6}, CV{5, 7}, CV{5, 8}, CV{5, 9},
-				CV{5, 10}, CV{5, 11}, CV{5, 12}, CV{5, 13},
-				CV{5, 14}, CV{5, 15}, CV{5, 16}, CV{5, 17},
-				CV{5, 18}, CV{5, 19}, CV{5, 20}, CV{5, 21},
+			Runs: []VerseRun{
+				{Chapter: 1, First: 1, Last: 10},
+				{Chapter: 2, First: 1, Last: 29},
+				{Chapter: 3, First: 1, Last: 24},
+				{Chapter: 4, First: 1, Last: 21},
+				{Chapter: 5, First: 1, Last: 21},
 			},
 		},
 		{
 			Name:      "2 John",
+			USFM:      "2JN",
+			Testament: NewTestament,
 			JustVerse: true,
-			Verses: []Verse{N{1},
-				N{2}, N{3}, N{4}, N{5},
-				N{6}, N{7}, N{8}, N{9},
-				N{10}, N{11}, N{12}, N{13},
+			Runs: []VerseRun{
+				{Chapter: 1, First: 1, Last: 13},
 			},
 		},
 		{
 			Name:      "3 John",
+			USFM:      "3JN",
+			Testament: NewTestament,
 			JustVerse: true,
-			Verses: []Verse{N{1},
-				N{2}, N{3}, N{4}, N{5},
-				N{6}, N{7}, N{8}, N{9},
-				N{10}, N{11}, N{12}, N{13},
-				N{14},
+			Runs: []VerseRun{
+				{Chapter: 1, First: 1, Last: 14},
 			},
 		},
 		{
 			Name:      "Jude",
+			USFM:      "JUD",
+			Testament: NewTestament,
 			JustVerse: true,
-			Verses: []Verse{N{1},
-				N{2}, N{3}, N{4}, N{5},
-				N{6}, N{7}, N{8}, N{9},
-				N{10}, N{11}, N{12}, N{13},
-				N{14}, N{15}, N{16}, N{17},
-				N{18}, N{19}, N{20}, N{21},
-				N{22}, N{23}, N{24}, N{25},
+			Runs: []VerseRun{
+				{Chapter: 1, First: 1, Last: 25},
 			},
 		},
 		{
 			Name:      "Revelation",
+			USFM:      "REV",
+			Testament: NewTestament,
+			AlternateNames: []string{
+				"The Apocalypse",
+				"Apocalypse",
+			},
 			JustVerse: false,
-			Verses: []Verse{CV{1, 1},
-				CV{1, 2}, CV{1, 3}, CV{1, 4}, CV{1, 5},
-				CV{1, 6}, CV{1, 7}, CV{1, 8}, CV{1, 9},
-				CV{1, 10}, CV{1, 11}, CV{1, 12}, CV{1, 13},
-				CV{1, 14}, CV{1, 15}, CV{1, 16}, CV{1, 17},
-				CV{1, 18}, CV{1, 19}, CV{1, 20}, CV{2, 1},
-				CV{2, 2}, CV{2, 3}, CV{2, 4}, CV{2, 5},
-				CV{2, 6}, CV{2, 7}, CV{2, 8}, CV{2, 9},
-				CV{2, 10}, CV{2, 11}, CV{2, 12}, CV{2, 13},
-				CV{2, 14}, CV{2, 15}, CV{2, 16}, CV{2, 17},
-				CV{2, 18}, CV{2, 19}, CV{2, 20}, CV{2, 21},
-				CV{2, 22}, CV{2, 23}, CV{2, 24}, CV{2, 25},
-				CV{2, 26}, CV{2, 27}, CV{2, 28}, CV{2, 29},
-				CV{3, 1}, CV{3, 2}, CV{3, 3}, CV{3, 4},
-				CV{3, 5}, CV{3, 6}, CV{3, 7}, CV{3, 8},
-				CV{3, 9}, CV{3, 10}, CV{3, 11}, CV{3, 12},
-				CV{3, 13}, CV{3, 14}, CV{3, 15}, CV{3, 16},
-				CV{3, 17}, CV{3, 18}, CV{3, 19}, CV{3, 20},
-				CV{3, 21}, CV{3, 22}, CV{4, 1}, CV{4, 2},
-				CV{4, 3}, CV{4, 4}, CV{4, 5}, CV{4, 6},
-				CV{4, 7}, CV{4, 8}, CV{4, 9}, CV{4, 10},
-				CV{4, 11}, CV{5, 1}, CV{5, 2}, CV{5, 3},
-				CV{5, 4}, CV{5, 5}, CV{5, 6}, CV{5, 7},
-				CV{5, 8}, CV{5, 9}, CV{5, 10}, CV{5, 11},
-				CV{5, 12}, CV{5, 13}, CV{5, 14}, CV{6, 1},
-				CV{6, 2}, CV{6, 3}, CV{6, 4}, CV{6, 5},
-				CV{6, 6}, CV{6, 7}, CV{6, 8}, CV{6, 9},
-				CV{6, 10}, CV{6, 11}, CV{6, 12}, CV{6, 13},
-				CV{6, 14}, CV{6, 15}, CV{6, 16}, CV{6, 17},
-				CV{7, 1}, CV{7, 2}, CV{7, 3}, CV{7, 4},
-				CV{7, 5}, CV{7, 6}, CV{7, 7}, CV{7, 8},
-				CV{7, 9}, CV{7, 10}, CV{7, 11}, CV{7, 12},
-				CV{7, 13}, CV{7, 14}, CV{7, 15}, CV{7, 16},
-				CV{7, 17}, CV{8, 1}, CV{8, 2}, CV{8, 3},
-				CV{8, 4}, CV{8, 5}, CV{8, 6}, CV{8, 7},
-				CV{8, 8}, CV{8, 9}, CV{8, 10}, CV{8, 11},
-				CV{8, 12}, CV{8, 13}, CV{9, 1}, CV{9, 2},
-				CV{9, 3}, CV{9, 4}, CV{9, 5}, CV{9, 6},
-				CV{9, 7}, CV{9, 8}, CV{9, 9}, CV{9, 10},
-				CV{9, 11}, CV{9, 12}, CV{9, 13}, CV{9, 14},
-				CV{9, 15}, CV{9, 16}, CV{9, 17}, CV{9, 18},
-				CV{9, 19}, CV{9, 20}, CV{9, 21}, CV{10, 1},
-				CV{10, 2}, CV{10, 3}, CV{10, 4}, CV{10, 5},
-				CV{10, 6}, CV{10, 7}, CV{10, 8}, CV{10, 9},
-				CV{10, 10}, CV{10, 11}, CV{11, 1}, CV{11, 2},
-				CV{11, 3}, CV{11, 4}, CV{11, 5}, CV{11, 6},
-				CV{11, 7}, CV{11, 8}, CV{11, 9}, CV{11, 10},
-				CV{11, 11}, CV{11, 12}, CV{11, 13}, CV{11, 14},
-				CV{11, 15}, CV{11, 16}, CV{11, 17}, CV{11, 18},
-				CV{11, 19}, CV{12, 1}, CV{12, 2}, CV{12, 3},
-				CV{12, 4}, CV{12, 5}, CV{12, 6}, CV{12, 7},
-				CV{12, 8}, CV{12, 9}, CV{12, 10}, CV{12, 11},
-				CV{12, 12}, CV{12, 13}, CV{12, 14}, CV{12, 15},
-				CV{12, 16}, CV{12, 17}, CV{13, 1}, CV{13, 2},
-				CV{13, 3}, CV{13, 4}, CV{13, 5}, CV{13, 6},
-				CV{13, 7}, CV{13, 8}, CV{13, 9}, CV{13, 10},
-				CV{13, 11}, CV{13, 12}, CV{13, 13}, CV{13, 14},
-				CV{13, 15}, CV{13, 16}, CV{13, 17}, CV{13, 18},
-				CV{14, 1}, CV{14, 2}, CV{14, 3}, CV{14, 4},
-				CV{14, 5}, CV{14, 6}, CV{14, 7}, CV{14, 8},
-				CV{14, 9}, CV{14, 10}, CV{14, 11}, CV{14, 12},
-				CV{14, 13}, CV{14, 14}, CV{14, 15}, CV{14, 16},
-				CV{14, 17}, CV{14, 18}, CV{14, 19}, CV{14, 20},
-				CV{15, 1}, CV{15, 2}, CV{15, 3}, CV{15, 4},
-				CV{15, 5}, CV{15, 6}, CV{15, 7}, CV{15, 8},
-				CV{16, 1}, CV{16, 2}, CV{16, 3}, CV{16, 4},
-				CV{16, 5}, CV{16, 6}, CV{16, 7}, CV{16, 8},
-				CV{16, 9}, CV{16, 10}, CV{16, 11}, CV{16, 12},
-				CV{16, 13}, CV{16, 14}, CV{16, 15}, CV{16, 16},
-				CV{16, 17}, CV{16, 18}, CV{16, 19}, CV{16, 20},
-				CV{16, 21}, CV{17, 1}, CV{17, 2}, CV{17, 3},
-				CV{17, 4}, CV{17, 5}, CV{17, 6}, CV{17, 7},
-				CV{17, 8}, CV{17, 9}, CV{17, 10}, CV{17, 11},
-				CV{17, 12}, CV{17, 13}, CV{17, 14}, CV{17, 15},
-				CV{17, 16}, CV{17, 17}, CV{17, 18}, CV{18, 1},
-				CV{18, 2}, CV{18, 3}, CV{18, 4}, CV{18, 5},
-				CV{18, 6}, CV{18, 7}, CV{18, 8}, CV{18, 9},
-				CV{18, 10}, CV{18, 11}, CV{18, 12}, CV{18, 13},
-				CV{18, 14}, CV{18, 15}, CV{18, 16}, CV{18, 17},
-				CV{18, 18}, CV{18, 19}, CV{18, 20}, CV{18, 21},
-				CV{18, 22}, CV{18, 23}, CV{18, 24}, CV{19, 1},
-				CV{19, 2}, CV{19, 3}, CV{19, 4}, CV{19, 5},
-				CV{19, 6}, CV{19, 7}, CV{19, 8}, CV{19, 9},
-				CV{19, 10}, CV{19, 11}, CV{19, 12}, CV{19, 13},
-				CV{19, 14}, CV{19, 15}, CV{19, 16}, CV{19, 17},
-				CV{19, 18}, CV{19, 19}, CV{19, 20}, CV{19, 21},
-				CV{20, 1}, CV{20, 2}, CV{20, 3}, CV{20, 4},
-				CV{20, 5}, CV{20, 6}, CV{20, 7}, CV{20, 8},
-				CV{20, 9}, CV{20, 10}, CV{20, 11}, CV{20, 12},
-				CV{20, 13}, CV{20, 14}, CV{20, 15}, CV{21, 1},
-				CV{21, 2}, CV{21, 3}, CV{21, 4}, CV{21, 5},
-				CV{21, 6}, CV{21, 7}, CV{21, 8}, CV{21, 9},
-				CV{21, 10}, CV{21, 11}, CV{21, 12}, CV{21, 13},
-				CV{21, 14}, CV{21, 15}, CV{21, 16}, CV{21, 17},
-				CV{21, 18}, CV{21, 19}, CV{21, 20}, CV{21, 21},
-				CV{21, 22}, CV{21, 23}, CV{21, 24}, CV{21, 25},
-				CV{21, 26}, CV{21, 27}, CV{22, 1}, CV{22, 2},
-				CV{22, 3}, CV{22, 4}, CV{22, 5}, CV{22, 6},
-				CV{22, 7}, CV{22, 8}, CV{22, 9}, CV{22, 10},
-				CV{22, 11}, CV{22, 12}, CV{22, 13}, CV{22, 14},
-				CV{22, 15}, CV{22, 16}, CV{22, 17}, CV{22, 18},
-				CV{22, 19}, CV{22, 20}, CV{22, 21},
+			Runs: []VerseRun{
+				{Chapter: 1, First: 1, Last: 20},
+				{Chapter: 2, First: 1, Last: 29},
+				{Chapter: 3, First: 1, Last: 22},
+				{Chapter: 4, First: 1, Last: 11},
+				{Chapter: 5, First: 1, Last: 14},
+				{Chapter: 6, First: 1, Last: 17},
+				{Chapter: 7, First: 1, Last: 17},
+				{Chapter: 8, First: 1, Last: 13},
+				{Chapter: 9, First: 1, Last: 21},
+				{Chapter: 10, First: 1, Last: 11},
+				{Chapter: 11, First: 1, Last: 19},
+				{Chapter: 12, First: 1, Last: 17},
+				{Chapter: 13, First: 1, Last: 18},
+				{Chapter: 14, First: 1, Last: 20},
+				{Chapter: 15, First: 1, Last: 8},
+				{Chapter: 16, First: 1, Last: 21},
+				{Chapter: 17, First: 1, Last: 18},
+				{Chapter: 18, First: 1, Last: 24},
+				{Chapter: 19, First: 1, Last: 21},
+				{Chapter: 20, First: 1, Last: 15},
+				{Chapter: 21, First: 1, Last: 27},
+				{Chapter: 22, First: 1, Last: 21},
 			},
 		},
 	},
@@ -8275,4 +1829,130 @@ var Canonical = &Canon{
 			"Song of Solomon",
 		},
 	},
+	Pericopes: map[string]string{
+		"A Time for Everything":                     "Ecclesiastes 3:1-15",
+		"Build Your House on the Rock":              "Matthew 7:24-27",
+		"By Faith":                                  "Hebrews 11",
+		"Cain and Abel":                             "Genesis 4:1-16",
+		"Christ's Example of Humility":              "Philippians 2:1-11",
+		"Create in Me a Clean Heart":                "Psalms 51",
+		"Crossing the Red Sea":                      "Exodus 14",
+		"Daniel and the Lions' Den":                 "Daniel 6",
+		"David and Bathsheba":                       "2 Samuel 11",
+		"David and Goliath":                         "1 Samuel 17",
+		"Elijah Taken to Heaven":                    "2 Kings 2:1-12",
+		"Elijah and the Prophets of Baal":           "1 Kings 18:20-40",
+		"For God So Loved the World":                "John 3:16-21",
+		"I Am the Bread of Life":                    "John 6:22-59",
+		"I Am the Good Shepherd":                    "John 10:1-21",
+		"I Am the True Vine":                        "John 15:1-17",
+		"I Am the Way, and the Truth, and the Life": "John 14:1-14",
+		"Institution of the Lord's Supper":          "Matthew 26:26-29",
+		"Isaiah's Vision of the Lord":               "Isaiah 6",
+		"Jacob Wrestles with God":                   "Genesis 32:22-32",
+		"Jesus Feeds the Five Thousand":             "Matthew 14:13-21",
+		"Jesus Prays in Gethsemane":                 "Matthew 26:36-46",
+		"Jesus Raises Lazarus":                      "John 11:38-44",
+		"Jesus Walks on the Water":                  "Matthew 14:22-33",
+		"Jesus Washes the Disciples' Feet":          "John 13:1-20",
+		"Jesus and Nicodemus":                       "John 3:1-21",
+		"Jesus and Peter":                           "John 21:15-19",
+		"Jesus and Zacchaeus":                       "Luke 19:1-10",
+		"Jesus and the Woman of Samaria":            "John 4:1-45",
+		"Jonah and the Great Fish":                  "Jonah 1:17-2:10",
+		"Joseph's Dreams":                           "Genesis 37:1-11",
+		"Martha and Mary":                           "Luke 10:38-42",
+		"Naaman Healed":                             "2 Kings 5:1-14",
+		"Nathan Rebukes David":                      "2 Samuel 12:1-15",
+		"Noah and the Flood":                        "Genesis 6:9-9:17",
+		"On the Road to Emmaus":                     "Luke 24:13-35",
+		"Paul in Athens":                            "Acts 17:16-34",
+		"Peter Confesses Jesus as the Christ":       "Matthew 16:13-20",
+		"Ruth and Naomi":                            "Ruth 1",
+		"Salt and Light":                            "Matthew 5:13-16",
+		"Samson and Delilah":                        "Judges 16:1-22",
+		"Solomon's Prayer for Wisdom":               "1 Kings 3:1-15",
+		"Taming the Tongue":                         "James 3:1-12",
+		"The Aaronic Blessing":                      "Numbers 6:22-27",
+		"The Baptism of Jesus":                      "Matthew 3:13-17",
+		"The Beatitudes":                            "Matthew 5:3-12",
+		"The Birth of Jesus Christ":                 "Matthew 1:18-25",
+		"The Burning Bush":                          "Exodus 3",
+		"The Call of Abram":                         "Genesis 12:1-9",
+		"The Coming of the Holy Spirit":             "Acts 2:1-13",
+		"The Conversion of Saul":                    "Acts 9:1-19",
+		"The Creation of the World":                 "Genesis 1:1-2:3",
+		"The Crucifixion":                           "Matthew 27:32-44",
+		"The Fall":                                  "Genesis 3",
+		"The Fall of Jericho":                       "Joshua 6",
+		"The Fiery Furnace":                         "Daniel 3:8-30",
+		"The Final Judgment":                        "Matthew 25:31-46",
+		"The Fruit of the Spirit":                   "Galatians 5:22-23",
+		"The Golden Calf":                           "Exodus 32",
+		"The Golden Rule":                           "Matthew 7:12",
+		"The Great Commandment":                     "Matthew 22:34-40",
+		"The Great Commission":                      "Matthew 28:16-20",
+		"The High Priestly Prayer":                  "John 17",
+		"The Lord Calls Samuel":                     "1 Samuel 3",
+		"The Lord Is My Shepherd":                   "Psalms 23",
+		"The Lord Restores Job":                     "Job 42:10-17",
+		"The Lord Speaks to Elijah":                 "1 Kings 19:9-18",
+		"The Lord's Prayer":                         "Matthew 6:9-13",
+		"The Magnificat":                            "Luke 1:46-55",
+		"The New Covenant":                          "Jeremiah 31:31-34",
+		"The New Heaven and the New Earth":          "Revelation 21:1-8",
+		"The Parable of the Good Samaritan":         "Luke 10:25-37",
+		"The Parable of the Lost Coin":              "Luke 15:8-10",
+		"The Parable of the Lost Sheep":             "Luke 15:1-7",
+		"The Parable of the Mustard Seed":           "Matthew 13:31-32",
+		"The Parable of the Prodigal Son":           "Luke 15:11-32",
+		"The Parable of the Rich Fool":              "Luke 12:13-21",
+		"The Parable of the Sower":                  "Matthew 13:1-9",
+		"The Parable of the Talents":                "Matthew 25:14-30",
+		"The Parable of the Ten Virgins":            "Matthew 25:1-13",
+		"The Passover":                              "Exodus 12:1-28",
+		"The Resurrection":                          "Matthew 28:1-10",
+		"The Rich Man and Lazarus":                  "Luke 16:19-31",
+		"The Sacrifice of Isaac":                    "Genesis 22:1-19",
+		"The Sermon on the Mount":                   "Matthew 5-7",
+		"The Shema":                                 "Deuteronomy 6:4-9",
+		"The Shepherds and the Angels":              "Luke 2:8-21",
+		"The Sign of the Fleece":                    "Judges 6:36-40",
+		"The Songs of Ascents":                      "Psalms 120-134",
+		"The Suffering Servant":                     "Isaiah 52:13-53:12",
+		"The Temptation of Jesus":                   "Matthew 4:1-11",
+		"The Ten Commandments":                      "Exodus 20:1-17",
+		"The Tower of Babel":                        "Genesis 11:1-9",
+		"The Transfiguration":                       "Matthew 17:1-13",
+		"The Triumphal Entry":                       "Matthew 21:1-11",
+		"The Valley of Dry Bones":                   "Ezekiel 37:1-14",
+		"The Visit of the Wise Men":                 "Matthew 2:1-12",
+		"The Way of Love":                           "1 Corinthians 13",
+		"The Wedding at Cana":                       "John 2:1-12",
+		"The Whole Armor of God":                    "Ephesians 6:10-20",
+		"The Woman Caught in Adultery":              "John 7:53-8:11",
+		"The Woman Who Fears the Lord":              "Proverbs 31:10-31",
+		"The Word Became Flesh":                     "John 1:1-18",
+		"You Must Be Born Again":                    "John 3:1-15",
+	},
+	OmittedVerses: map[string][]string{
+		"ESV": {
+			"Matthew 17:21",
+			"Matthew 18:11",
+			"Matthew 23:14",
+			"Mark 7:16",
+			"Mark 9:44",
+			"Mark 9:46",
+			"Mark 11:26",
+			"Mark 15:28",
+			"Luke 17:36",
+			"Luke 23:17",
+			"John 5:4",
+			"Acts 8:37",
+			"Acts 15:34",
+			"Acts 24:7",
+			"Acts 28:29",
+			"Romans 16:24",
+		},
+	},
 }
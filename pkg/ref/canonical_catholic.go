@@ -0,0 +1,2230 @@
+// Code generated by ./tools/gen/verses/main.go; DO NOT EDIT.
+
+package ref
+
+var CatholicCanon = &Canon{
+	Name:          "Catholic Canon",
+	Versification: "kjv",
+	Books: []Book{
+		{
+			Name:      "Genesis",
+			USFM:      "GEN",
+			Testament: OldTestament,
+			JustVerse: false,
+			Runs: []VerseRun{
+				{Chapter: 1, First: 1, Last: 31},
+				{Chapter: 2, First: 1, Last: 25},
+				{Chapter: 3, First: 1, Last: 24},
+				{Chapter: 4, First: 1, Last: 26},
+				{Chapter: 5, First: 1, Last: 32},
+				{Chapter: 6, First: 1, Last: 22},
+				{Chapter: 7, First: 1, Last: 24},
+				{Chapter: 8, First: 1, Last: 22},
+				{Chapter: 9, First: 1, Last: 29},
+				{Chapter: 10, First: 1, Last: 32},
+				{Chapter: 11, First: 1, Last: 32},
+				{Chapter: 12, First: 1, Last: 20},
+				{Chapter: 13, First: 1, Last: 18},
+				{Chapter: 14, First: 1, Last: 24},
+				{Chapter: 15, First: 1, Last: 21},
+				{Chapter: 16, First: 1, Last: 16},
+				{Chapter: 17, First: 1, Last: 27},
+				{Chapter: 18, First: 1, Last: 33},
+				{Chapter: 19, First: 1, Last: 38},
+				{Chapter: 20, First: 1, Last: 18},
+				{Chapter: 21, First: 1, Last: 34},
+				{Chapter: 22, First: 1, Last: 24},
+				{Chapter: 23, First: 1, Last: 20},
+				{Chapter: 24, First: 1, Last: 67},
+				{Chapter: 25, First: 1, Last: 34},
+				{Chapter: 26, First: 1, Last: 35},
+				{Chapter: 27, First: 1, Last: 46},
+				{Chapter: 28, First: 1, Last: 22},
+				{Chapter: 29, First: 1, Last: 35},
+				{Chapter: 30, First: 1, Last: 43},
+				{Chapter: 31, First: 1, Last: 55},
+				{Chapter: 32, First: 1, Last: 32},
+				{Chapter: 33, First: 1, Last: 20},
+				{Chapter: 34, First: 1, Last: 31},
+				{Chapter: 35, First: 1, Last: 29},
+				{Chapter: 36, First: 1, Last: 43},
+				{Chapter: 37, First: 1, Last: 36},
+				{Chapter: 38, First: 1, Last: 30},
+				{Chapter: 39, First: 1, Last: 23},
+				{Chapter: 40, First: 1, Last: 23},
+				{Chapter: 41, First: 1, Last: 57},
+				{Chapter: 42, First: 1, Last: 38},
+				{Chapter: 43, First: 1, Last: 34},
+				{Chapter: 44, First: 1, Last: 34},
+				{Chapter: 45, First: 1, Last: 28},
+				{Chapter: 46, First: 1, Last: 34},
+				{Chapter: 47, First: 1, Last: 31},
+				{Chapter: 48, First: 1, Last: 22},
+				{Chapter: 49, First: 1, Last: 33},
+				{Chapter: 50, First: 1, Last: 26},
+			},
+		},
+		{
+			Name:      "Exodus",
+			USFM:      "EXO",
+			Testament: OldTestament,
+			JustVerse: false,
+			Runs: []VerseRun{
+				{Chapter: 1, First: 1, Last: 22},
+				{Chapter: 2, First: 1, Last: 25},
+				{Chapter: 3, First: 1, Last: 22},
+				{Chapter: 4, First: 1, Last: 31},
+				{Chapter: 5, First: 1, Last: 23},
+				{Chapter: 6, First: 1, Last: 30},
+				{Chapter: 7, First: 1, Last: 25},
+				{Chapter: 8, First: 1, Last: 32},
+				{Chapter: 9, First: 1, Last: 35},
+				{Chapter: 10, First: 1, Last: 29},
+				{Chapter: 11, First: 1, Last: 10},
+				{Chapter: 12, First: 1, Last: 51},
+				{Chapter: 13, First: 1, Last: 22},
+				{Chapter: 14, First: 1, Last: 31},
+				{Chapter: 15, First: 1, Last: 27},
+				{Chapter: 16, First: 1, Last: 36},
+				{Chapter: 17, First: 1, Last: 16},
+				{Chapter: 18, First: 1, Last: 27},
+				{Chapter: 19, First: 1, Last: 25},
+				{Chapter: 20, First: 1, Last: 26},
+				{Chapter: 21, First: 1, Last: 36},
+				{Chapter: 22, First: 1, Last: 31},
+				{Chapter: 23, First: 1, Last: 33},
+				{Chapter: 24, First: 1, Last: 18},
+				{Chapter: 25, First: 1, Last: 40},
+				{Chapter: 26, First: 1, Last: 37},
+				{Chapter: 27, First: 1, Last: 21},
+				{Chapter: 28, First: 1, Last: 43},
+				{Chapter: 29, First: 1, Last: 46},
+				{Chapter: 30, First: 1, Last: 38},
+				{Chapter: 31, First: 1, Last: 18},
+				{Chapter: 32, First: 1, Last: 35},
+				{Chapter: 33, First: 1, Last: 23},
+				{Chapter: 34, First: 1, Last: 35},
+				{Chapter: 35, First: 1, Last: 35},
+				{Chapter: 36, First: 1, Last: 38},
+				{Chapter: 37, First: 1, Last: 29},
+				{Chapter: 38, First: 1, Last: 31},
+				{Chapter: 39, First: 1, Last: 43},
+				{Chapter: 40, First: 1, Last: 38},
+			},
+		},
+		{
+			Name:      "Leviticus",
+			USFM:      "LEV",
+			Testament: OldTestament,
+			JustVerse: false,
+			Runs: []VerseRun{
+				{Chapter: 1, First: 1, Last: 17},
+				{Chapter: 2, First: 1, Last: 16},
+				{Chapter: 3, First: 1, Last: 17},
+				{Chapter: 4, First: 1, Last: 35},
+				{Chapter: 5, First: 1, Last: 19},
+				{Chapter: 6, First: 1, Last: 30},
+				{Chapter: 7, First: 1, Last: 38},
+				{Chapter: 8, First: 1, Last: 36},
+				{Chapter: 9, First: 1, Last: 24},
+				{Chapter: 10, First: 1, Last: 20},
+				{Chapter: 11, First: 1, Last: 47},
+				{Chapter: 12, First: 1, Last: 8},
+				{Chapter: 13, First: 1, Last: 59},
+				{Chapter: 14, First: 1, Last: 57},
+				{Chapter: 15, First: 1, Last: 33},
+				{Chapter: 16, First: 1, Last: 34},
+				{Chapter: 17, First: 1, Last: 16},
+				{Chapter: 18, First: 1, Last: 30},
+				{Chapter: 19, First: 1, Last: 37},
+				{Chapter: 20, First: 1, Last: 27},
+				{Chapter: 21, First: 1, Last: 24},
+				{Chapter: 22, First: 1, Last: 33},
+				{Chapter: 23, First: 1, Last: 44},
+				{Chapter: 24, First: 1, Last: 23},
+				{Chapter: 25, First: 1, Last: 55},
+				{Chapter: 26, First: 1, Last: 46},
+				{Chapter: 27, First: 1, Last: 34},
+			},
+		},
+		{
+			Name:      "Numbers",
+			USFM:      "NUM",
+			Testament: OldTestament,
+			JustVerse: false,
+			Runs: []VerseRun{
+				{Chapter: 1, First: 1, Last: 54},
+				{Chapter: 2, First: 1, Last: 34},
+				{Chapter: 3, First: 1, Last: 51},
+				{Chapter: 4, First: 1, Last: 49},
+				{Chapter: 5, First: 1, Last: 31},
+				{Chapter: 6, First: 1, Last: 27},
+				{Chapter: 7, First: 1, Last: 89},
+				{Chapter: 8, First: 1, Last: 26},
+				{Chapter: 9, First: 1, Last: 23},
+				{Chapter: 10, First: 1, Last: 36},
+				{Chapter: 11, First: 1, Last: 35},
+				{Chapter: 12, First: 1, Last: 16},
+				{Chapter: 13, First: 1, Last: 33},
+				{Chapter: 14, First: 1, Last: 45},
+				{Chapter: 15, First: 1, Last: 41},
+				{Chapter: 16, First: 1, Last: 50},
+				{Chapter: 17, First: 1, Last: 13},
+				{Chapter: 18, First: 1, Last: 32},
+				{Chapter: 19, First: 1, Last: 22},
+				{Chapter: 20, First: 1, Last: 29},
+				{Chapter: 21, First: 1, Last: 35},
+				{Chapter: 22, First: 1, Last: 41},
+				{Chapter: 23, First: 1, Last: 30},
+				{Chapter: 24, First: 1, Last: 25},
+				{Chapter: 25, First: 1, Last: 18},
+				{Chapter: 26, First: 1, Last: 65},
+				{Chapter: 27, First: 1, Last: 23},
+				{Chapter: 28, First: 1, Last: 31},
+				{Chapter: 29, First: 1, Last: 40},
+				{Chapter: 30, First: 1, Last: 16},
+				{Chapter: 31, First: 1, Last: 54},
+				{Chapter: 32, First: 1, Last: 42},
+				{Chapter: 33, First: 1, Last: 56},
+				{Chapter: 34, First: 1, Last: 29},
+				{Chapter: 35, First: 1, Last: 34},
+				{Chapter: 36, First: 1, Last: 13},
+			},
+		},
+		{
+			Name:      "Deuteronomy",
+			USFM:      "DEU",
+			Testament: OldTestament,
+			JustVerse: false,
+			Runs: []VerseRun{
+				{Chapter: 1, First: 1, Last: 46},
+				{Chapter: 2, First: 1, Last: 37},
+				{Chapter: 3, First: 1, Last: 29},
+				{Chapter: 4, First: 1, Last: 49},
+				{Chapter: 5, First: 1, Last: 33},
+				{Chapter: 6, First: 1, Last: 25},
+				{Chapter: 7, First: 1, Last: 26},
+				{Chapter: 8, First: 1, Last: 20},
+				{Chapter: 9, First: 1, Last: 29},
+				{Chapter: 10, First: 1, Last: 22},
+				{Chapter: 11, First: 1, Last: 32},
+				{Chapter: 12, First: 1, Last: 32},
+				{Chapter: 13, First: 1, Last: 18},
+				{Chapter: 14, First: 1, Last: 29},
+				{Chapter: 15, First: 1, Last: 23},
+				{Chapter: 16, First: 1, Last: 22},
+				{Chapter: 17, First: 1, Last: 20},
+				{Chapter: 18, First: 1, Last: 22},
+				{Chapter: 19, First: 1, Last: 21},
+				{Chapter: 20, First: 1, Last: 20},
+				{Chapter: 21, First: 1, Last: 23},
+				{Chapter: 22, First: 1, Last: 30},
+				{Chapter: 23, First: 1, Last: 25},
+				{Chapter: 24, First: 1, Last: 22},
+				{Chapter: 25, First: 1, Last: 19},
+				{Chapter: 26, First: 1, Last: 19},
+				{Chapter: 27, First: 1, Last: 26},
+				{Chapter: 28, First: 1, Last: 68},
+				{Chapter: 29, First: 1, Last: 29},
+				{Chapter: 30, First: 1, Last: 20},
+				{Chapter: 31, First: 1, Last: 30},
+				{Chapter: 32, First: 1, Last: 52},
+				{Chapter: 33, First: 1, Last: 29},
+				{Chapter: 34, First: 1, Last: 12},
+			},
+		},
+		{
+			Name:      "Joshua",
+			USFM:      "JOS",
+			Testament: OldTestament,
+			JustVerse: false,
+			Runs: []VerseRun{
+				{Chapter: 1, First: 1, Last: 18},
+				{Chapter: 2, First: 1, Last: 24},
+				{Chapter: 3, First: 1, Last: 17},
+				{Chapter: 4, First: 1, Last: 24},
+				{Chapter: 5, First: 1, Last: 15},
+				{Chapter: 6, First: 1, Last: 27},
+				{Chapter: 7, First: 1, Last: 26},
+				{Chapter: 8, First: 1, Last: 35},
+				{Chapter: 9, First: 1, Last: 27},
+				{Chapter: 10, First: 1, Last: 43},
+				{Chapter: 11, First: 1, Last: 23},
+				{Chapter: 12, First: 1, Last: 24},
+				{Chapter: 13, First: 1, Last: 33},
+				{Chapter: 14, First: 1, Last: 15},
+				{Chapter: 15, First: 1, Last: 63},
+				{Chapter: 16, First: 1, Last: 10},
+				{Chapter: 17, First: 1, Last: 18},
+				{Chapter: 18, First: 1, Last: 28},
+				{Chapter: 19, First: 1, Last: 51},
+				{Chapter: 20, First: 1, Last: 9},
+				{Chapter: 21, First: 1, Last: 45},
+				{Chapter: 22, First: 1, Last: 34},
+				{Chapter: 23, First: 1, Last: 16},
+				{Chapter: 24, First: 1, Last: 33},
+			},
+		},
+		{
+			Name:      "Judges",
+			USFM:      "JDG",
+			Testament: OldTestament,
+			JustVerse: false,
+			Runs: []VerseRun{
+				{Chapter: 1, First: 1, Last: 36},
+				{Chapter: 2, First: 1, Last: 23},
+				{Chapter: 3, First: 1, Last: 31},
+				{Chapter: 4, First: 1, Last: 24},
+				{Chapter: 5, First: 1, Last: 31},
+				{Chapter: 6, First: 1, Last: 40},
+				{Chapter: 7, First: 1, Last: 25},
+				{Chapter: 8, First: 1, Last: 35},
+				{Chapter: 9, First: 1, Last: 57},
+				{Chapter: 10, First: 1, Last: 18},
+				{Chapter: 11, First: 1, Last: 40},
+				{Chapter: 12, First: 1, Last: 15},
+				{Chapter: 13, First: 1, Last: 25},
+				{Chapter: 14, First: 1, Last: 20},
+				{Chapter: 15, First: 1, Last: 20},
+				{Chapter: 16, First: 1, Last: 31},
+				{Chapter: 17, First: 1, Last: 13},
+				{Chapter: 18, First: 1, Last: 31},
+				{Chapter: 19, First: 1, Last: 30},
+				{Chapter: 20, First: 1, Last: 48},
+				{Chapter: 21, First: 1, Last: 25},
+			},
+		},
+		{
+			Name:      "Ruth",
+			USFM:      "RUT",
+			Testament: OldTestament,
+			JustVerse: false,
+			Runs: []VerseRun{
+				{Chapter: 1, First: 1, Last: 22},
+				{Chapter: 2, First: 1, Last: 23},
+				{Chapter: 3, First: 1, Last: 18},
+				{Chapter: 4, First: 1, Last: 22},
+			},
+		},
+		{
+			Name:      "1 Samuel",
+			USFM:      "1SA",
+			Testament: OldTestament,
+			JustVerse: false,
+			Runs: []VerseRun{
+				{Chapter: 1, First: 1, Last: 28},
+				{Chapter: 2, First: 1, Last: 36},
+				{Chapter: 3, First: 1, Last: 21},
+				{Chapter: 4, First: 1, Last: 22},
+				{Chapter: 5, First: 1, Last: 12},
+				{Chapter: 6, First: 1, Last: 21},
+				{Chapter: 7, First: 1, Last: 17},
+				{Chapter: 8, First: 1, Last: 22},
+				{Chapter: 9, First: 1, Last: 27},
+				{Chapter: 10, First: 1, Last: 27},
+				{Chapter: 11, First: 1, Last: 15},
+				{Chapter: 12, First: 1, Last: 25},
+				{Chapter: 13, First: 1, Last: 23},
+				{Chapter: 14, First: 1, Last: 52},
+				{Chapter: 15, First: 1, Last: 35},
+				{Chapter: 16, First: 1, Last: 23},
+				{Chapter: 17, First: 1, Last: 58},
+				{Chapter: 18, First: 1, Last: 30},
+				{Chapter: 19, First: 1, Last: 24},
+				{Chapter: 20, First: 1, Last: 42},
+				{Chapter: 21, First: 1, Last: 15},
+				{Chapter: 22, First: 1, Last: 23},
+				{Chapter: 23, First: 1, Last: 29},
+				{Chapter: 24, First: 1, Last: 22},
+				{Chapter: 25, First: 1, Last: 44},
+				{Chapter: 26, First: 1, Last: 25},
+				{Chapter: 27, First: 1, Last: 12},
+				{Chapter: 28, First: 1, Last: 25},
+				{Chapter: 29, First: 1, Last: 11},
+				{Chapter: 30, First: 1, Last: 31},
+				{Chapter: 31, First: 1, Last: 13},
+			},
+		},
+		{
+			Name:      "2 Samuel",
+			USFM:      "2SA",
+			Testament: OldTestament,
+			JustVerse: false,
+			Runs: []VerseRun{
+				{Chapter: 1, First: 1, Last: 27},
+				{Chapter: 2, First: 1, Last: 32},
+				{Chapter: 3, First: 1, Last: 39},
+				{Chapter: 4, First: 1, Last: 12},
+				{Chapter: 5, First: 1, Last: 25},
+				{Chapter: 6, First: 1, Last: 23},
+				{Chapter: 7, First: 1, Last: 29},
+				{Chapter: 8, First: 1, Last: 18},
+				{Chapter: 9, First: 1, Last: 13},
+				{Chapter: 10, First: 1, Last: 19},
+				{Chapter: 11, First: 1, Last: 27},
+				{Chapter: 12, First: 1, Last: 31},
+				{Chapter: 13, First: 1, Last: 39},
+				{Chapter: 14, First: 1, Last: 33},
+				{Chapter: 15, First: 1, Last: 37},
+				{Chapter: 16, First: 1, Last: 23},
+				{Chapter: 17, First: 1, Last: 29},
+				{Chapter: 18, First: 1, Last: 33},
+				{Chapter: 19, First: 1, Last: 43},
+				{Chapter: 20, First: 1, Last: 26},
+				{Chapter: 21, First: 1, Last: 22},
+				{Chapter: 22, First: 1, Last: 51},
+				{Chapter: 23, First: 1, Last: 39},
+				{Chapter: 24, First: 1, Last: 25},
+			},
+		},
+		{
+			Name:      "1 Kings",
+			USFM:      "1KI",
+			Testament: OldTestament,
+			JustVerse: false,
+			Runs: []VerseRun{
+				{Chapter: 1, First: 1, Last: 53},
+				{Chapter: 2, First: 1, Last: 46},
+				{Chapter: 3, First: 1, Last: 28},
+				{Chapter: 4, First: 1, Last: 34},
+				{Chapter: 5, First: 1, Last: 18},
+				{Chapter: 6, First: 1, Last: 38},
+				{Chapter: 7, First: 1, Last: 51},
+				{Chapter: 8, First: 1, Last: 66},
+				{Chapter: 9, First: 1, Last: 28},
+				{Chapter: 10, First: 1, Last: 29},
+				{Chapter: 11, First: 1, Last: 43},
+				{Chapter: 12, First: 1, Last: 33},
+				{Chapter: 13, First: 1, Last: 34},
+				{Chapter: 14, First: 1, Last: 31},
+				{Chapter: 15, First: 1, Last: 34},
+				{Chapter: 16, First: 1, Last: 34},
+				{Chapter: 17, First: 1, Last: 24},
+				{Chapter: 18, First: 1, Last: 46},
+				{Chapter: 19, First: 1, Last: 21},
+				{Chapter: 20, First: 1, Last: 43},
+				{Chapter: 21, First: 1, Last: 29},
+				{Chapter: 22, First: 1, Last: 53},
+			},
+		},
+		{
+			Name:      "2 Kings",
+			USFM:      "2KI",
+			Testament: OldTestament,
+			JustVerse: false,
+			Runs: []VerseRun{
+				{Chapter: 1, First: 1, Last: 18},
+				{Chapter: 2, First: 1, Last: 25},
+				{Chapter: 3, First: 1, Last: 27},
+				{Chapter: 4, First: 1, Last: 44},
+				{Chapter: 5, First: 1, Last: 27},
+				{Chapter: 6, First: 1, Last: 33},
+				{Chapter: 7, First: 1, Last: 20},
+				{Chapter: 8, First: 1, Last: 29},
+				{Chapter: 9, First: 1, Last: 37},
+				{Chapter: 10, First: 1, Last: 36},
+				{Chapter: 11, First: 1, Last: 21},
+				{Chapter: 12, First: 1, Last: 21},
+				{Chapter: 13, First: 1, Last: 25},
+				{Chapter: 14, First: 1, Last: 29},
+				{Chapter: 15, First: 1, Last: 38},
+				{Chapter: 16, First: 1, Last: 20},
+				{Chapter: 17, First: 1, Last: 41},
+				{Chapter: 18, First: 1, Last: 37},
+				{Chapter: 19, First: 1, Last: 37},
+				{Chapter: 20, First: 1, Last: 21},
+				{Chapter: 21, First: 1, Last: 26},
+				{Chapter: 22, First: 1, Last: 20},
+				{Chapter: 23, First: 1, Last: 37},
+				{Chapter: 24, First: 1, Last: 20},
+				{Chapter: 25, First: 1, Last: 30},
+			},
+		},
+		{
+			Name:      "1 Chronicles",
+			USFM:      "1CH",
+			Testament: OldTestament,
+			JustVerse: false,
+			Runs: []VerseRun{
+				{Chapter: 1, First: 1, Last: 54},
+				{Chapter: 2, First: 1, Last: 55},
+				{Chapter: 3, First: 1, Last: 24},
+				{Chapter: 4, First: 1, Last: 43},
+				{Chapter: 5, First: 1, Last: 26},
+				{Chapter: 6, First: 1, Last: 81},
+				{Chapter: 7, First: 1, Last: 40},
+				{Chapter: 8, First: 1, Last: 40},
+				{Chapter: 9, First: 1, Last: 44},
+				{Chapter: 10, First: 1, Last: 14},
+				{Chapter: 11, First: 1, Last: 47},
+				{Chapter: 12, First: 1, Last: 40},
+				{Chapter: 13, First: 1, Last: 14},
+				{Chapter: 14, First: 1, Last: 17},
+				{Chapter: 15, First: 1, Last: 29},
+				{Chapter: 16, First: 1, Last: 43},
+				{Chapter: 17, First: 1, Last: 27},
+				{Chapter: 18, First: 1, Last: 17},
+				{Chapter: 19, First: 1, Last: 19},
+				{Chapter: 20, First: 1, Last: 8},
+				{Chapter: 21, First: 1, Last: 30},
+				{Chapter: 22, First: 1, Last: 19},
+				{Chapter: 23, First: 1, Last: 32},
+				{Chapter: 24, First: 1, Last: 31},
+				{Chapter: 25, First: 1, Last: 31},
+				{Chapter: 26, First: 1, Last: 32},
+				{Chapter: 27, First: 1, Last: 34},
+				{Chapter: 28, First: 1, Last: 21},
+				{Chapter: 29, First: 1, Last: 30},
+			},
+		},
+		{
+			Name:      "2 Chronicles",
+			USFM:      "2CH",
+			Testament: OldTestament,
+			JustVerse: false,
+			Runs: []VerseRun{
+				{Chapter: 1, First: 1, Last: 17},
+				{Chapter: 2, First: 1, Last: 18},
+				{Chapter: 3, First: 1, Last: 17},
+				{Chapter: 4, First: 1, Last: 22},
+				{Chapter: 5, First: 1, Last: 14},
+				{Chapter: 6, First: 1, Last: 42},
+				{Chapter: 7, First: 1, Last: 22},
+				{Chapter: 8, First: 1, Last: 18},
+				{Chapter: 9, First: 1, Last: 31},
+				{Chapter: 10, First: 1, Last: 19},
+				{Chapter: 11, First: 1, Last: 23},
+				{Chapter: 12, First: 1, Last: 16},
+				{Chapter: 13, First: 1, Last: 22},
+				{Chapter: 14, First: 1, Last: 15},
+				{Chapter: 15, First: 1, Last: 19},
+				{Chapter: 16, First: 1, Last: 14},
+				{Chapter: 17, First: 1, Last: 19},
+				{Chapter: 18, First: 1, Last: 34},
+				{Chapter: 19, First: 1, Last: 11},
+				{Chapter: 20, First: 1, Last: 37},
+				{Chapter: 21, First: 1, Last: 20},
+				{Chapter: 22, First: 1, Last: 12},
+				{Chapter: 23, First: 1, Last: 21},
+				{Chapter: 24, First: 1, Last: 27},
+				{Chapter: 25, First: 1, Last: 28},
+				{Chapter: 26, First: 1, Last: 23},
+				{Chapter: 27, First: 1, Last: 9},
+				{Chapter: 28, First: 1, Last: 27},
+				{Chapter: 29, First: 1, Last: 36},
+				{Chapter: 30, First: 1, Last: 27},
+				{Chapter: 31, First: 1, Last: 21},
+				{Chapter: 32, First: 1, Last: 33},
+				{Chapter: 33, First: 1, Last: 25},
+				{Chapter: 34, First: 1, Last: 33},
+				{Chapter: 35, First: 1, Last: 27},
+				{Chapter: 36, First: 1, Last: 23},
+			},
+		},
+		{
+			Name:      "Ezra",
+			USFM:      "EZR",
+			Testament: OldTestament,
+			JustVerse: false,
+			Runs: []VerseRun{
+				{Chapter: 1, First: 1, Last: 11},
+				{Chapter: 2, First: 1, Last: 70},
+				{Chapter: 3, First: 1, Last: 13},
+				{Chapter: 4, First: 1, Last: 24},
+				{Chapter: 5, First: 1, Last: 17},
+				{Chapter: 6, First: 1, Last: 22},
+				{Chapter: 7, First: 1, Last: 28},
+				{Chapter: 8, First: 1, Last: 36},
+				{Chapter: 9, First: 1, Last: 15},
+				{Chapter: 10, First: 1, Last: 44},
+			},
+		},
+		{
+			Name:      "Nehemiah",
+			USFM:      "NEH",
+			Testament: OldTestament,
+			JustVerse: false,
+			Runs: []VerseRun{
+				{Chapter: 1, First: 1, Last: 11},
+				{Chapter: 2, First: 1, Last: 20},
+				{Chapter: 3, First: 1, Last: 32},
+				{Chapter: 4, First: 1, Last: 23},
+				{Chapter: 5, First: 1, Last: 19},
+				{Chapter: 6, First: 1, Last: 19},
+				{Chapter: 7, First: 1, Last: 73},
+				{Chapter: 8, First: 1, Last: 18},
+				{Chapter: 9, First: 1, Last: 38},
+				{Chapter: 10, First: 1, Last: 39},
+				{Chapter: 11, First: 1, Last: 36},
+				{Chapter: 12, First: 1, Last: 47},
+				{Chapter: 13, First: 1, Last: 31},
+			},
+		},
+		{
+			Name:      "Tobit",
+			USFM:      "TOB",
+			Testament: OldTestament,
+			JustVerse: false,
+			Runs: []VerseRun{
+				{Chapter: 1, First: 1, Last: 22},
+				{Chapter: 2, First: 1, Last: 14},
+				{Chapter: 3, First: 1, Last: 17},
+				{Chapter: 4, First: 1, Last: 21},
+				{Chapter: 5, First: 1, Last: 22},
+				{Chapter: 6, First: 1, Last: 17},
+				{Chapter: 7, First: 1, Last: 18},
+				{Chapter: 8, First: 1, Last: 21},
+				{Chapter: 9, First: 1, Last: 6},
+				{Chapter: 10, First: 1, Last: 12},
+				{Chapter: 11, First: 1, Last: 19},
+				{Chapter: 12, First: 1, Last: 22},
+				{Chapter: 13, First: 1, Last: 18},
+				{Chapter: 14, First: 1, Last: 15},
+			},
+		},
+		{
+			Name:      "Judith",
+			USFM:      "JDT",
+			Testament: OldTestament,
+			JustVerse: false,
+			Runs: []VerseRun{
+				{Chapter: 1, First: 1, Last: 16},
+				{Chapter: 2, First: 1, Last: 28},
+				{Chapter: 3, First: 1, Last: 10},
+				{Chapter: 4, First: 1, Last: 15},
+				{Chapter: 5, First: 1, Last: 24},
+				{Chapter: 6, First: 1, Last: 21},
+				{Chapter: 7, First: 1, Last: 32},
+				{Chapter: 8, First: 1, Last: 36},
+				{Chapter: 9, First: 1, Last: 14},
+				{Chapter: 10, First: 1, Last: 23},
+				{Chapter: 11, First: 1, Last: 23},
+				{Chapter: 12, First: 1, Last: 20},
+				{Chapter: 13, First: 1, Last: 20},
+				{Chapter: 14, First: 1, Last: 19},
+				{Chapter: 15, First: 1, Last: 13},
+				{Chapter: 16, First: 1, Last: 25},
+			},
+		},
+		{
+			Name:      "Esther",
+			USFM:      "EST",
+			Testament: OldTestament,
+			JustVerse: false,
+			Runs: []VerseRun{
+				{Chapter: 1, First: 1, Last: 22},
+				{Chapter: 2, First: 1, Last: 23},
+				{Chapter: 3, First: 1, Last: 15},
+				{Chapter: 4, First: 1, Last: 17},
+				{Chapter: 5, First: 1, Last: 14},
+				{Chapter: 6, First: 1, Last: 14},
+				{Chapter: 7, First: 1, Last: 10},
+				{Chapter: 8, First: 1, Last: 17},
+				{Chapter: 9, First: 1, Last: 32},
+				{Chapter: 10, First: 1, Last: 3},
+			},
+		},
+		{
+			Name:      "Additions to Esther",
+			USFM:      "ESG",
+			Testament: OldTestament,
+			AlternateNames: []string{
+				"Rest of Esther",
+				"Greek Esther",
+			},
+			JustVerse: false,
+			Runs: []VerseRun{
+				{Chapter: 10, First: 4, Last: 13},
+				{Chapter: 11, First: 1, Last: 12},
+				{Chapter: 12, First: 1, Last: 6},
+				{Chapter: 13, First: 1, Last: 18},
+				{Chapter: 14, First: 1, Last: 19},
+				{Chapter: 15, First: 1, Last: 16},
+				{Chapter: 16, First: 1, Last: 24},
+			},
+		},
+		{
+			Name:      "1 Maccabees",
+			USFM:      "1MA",
+			Testament: OldTestament,
+			JustVerse: false,
+			Runs: []VerseRun{
+				{Chapter: 1, First: 1, Last: 64},
+				{Chapter: 2, First: 1, Last: 70},
+				{Chapter: 3, First: 1, Last: 60},
+				{Chapter: 4, First: 1, Last: 61},
+				{Chapter: 5, First: 1, Last: 68},
+				{Chapter: 6, First: 1, Last: 63},
+				{Chapter: 7, First: 1, Last: 50},
+				{Chapter: 8, First: 1, Last: 32},
+				{Chapter: 9, First: 1, Last: 73},
+				{Chapter: 10, First: 1, Last: 89},
+				{Chapter: 11, First: 1, Last: 74},
+				{Chapter: 12, First: 1, Last: 53},
+				{Chapter: 13, First: 1, Last: 53},
+				{Chapter: 14, First: 1, Last: 49},
+				{Chapter: 15, First: 1, Last: 41},
+				{Chapter: 16, First: 1, Last: 24},
+			},
+		},
+		{
+			Name:      "2 Maccabees",
+			USFM:      "2MA",
+			Testament: OldTestament,
+			JustVerse: false,
+			Runs: []VerseRun{
+				{Chapter: 1, First: 1, Last: 36},
+				{Chapter: 2, First: 1, Last: 32},
+				{Chapter: 3, First: 1, Last: 40},
+				{Chapter: 4, First: 1, Last: 50},
+				{Chapter: 5, First: 1, Last: 27},
+				{Chapter: 6, First: 1, Last: 31},
+				{Chapter: 7, First: 1, Last: 42},
+				{Chapter: 8, First: 1, Last: 36},
+				{Chapter: 9, First: 1, Last: 29},
+				{Chapter: 10, First: 1, Last: 38},
+				{Chapter: 11, First: 1, Last: 38},
+				{Chapter: 12, First: 1, Last: 45},
+				{Chapter: 13, First: 1, Last: 26},
+				{Chapter: 14, First: 1, Last: 46},
+				{Chapter: 15, First: 1, Last: 39},
+			},
+		},
+		{
+			Name:      "Job",
+			USFM:      "JOB",
+			Testament: OldTestament,
+			JustVerse: false,
+			Runs: []VerseRun{
+				{Chapter: 1, First: 1, Last: 22},
+				{Chapter: 2, First: 1, Last: 13},
+				{Chapter: 3, First: 1, Last: 26},
+				{Chapter: 4, First: 1, Last: 21},
+				{Chapter: 5, First: 1, Last: 27},
+				{Chapter: 6, First: 1, Last: 30},
+				{Chapter: 7, First: 1, Last: 21},
+				{Chapter: 8, First: 1, Last: 22},
+				{Chapter: 9, First: 1, Last: 35},
+				{Chapter: 10, First: 1, Last: 22},
+				{Chapter: 11, First: 1, Last: 20},
+				{Chapter: 12, First: 1, Last: 25},
+				{Chapter: 13, First: 1, Last: 28},
+				{Chapter: 14, First: 1, Last: 22},
+				{Chapter: 15, First: 1, Last: 35},
+				{Chapter: 16, First: 1, Last: 22},
+				{Chapter: 17, First: 1, Last: 16},
+				{Chapter: 18, First: 1, Last: 21},
+				{Chapter: 19, First: 1, Last: 29},
+				{Chapter: 20, First: 1, Last: 29},
+				{Chapter: 21, First: 1, Last: 34},
+				{Chapter: 22, First: 1, Last: 30},
+				{Chapter: 23, First: 1, Last: 17},
+				{Chapter: 24, First: 1, Last: 25},
+				{Chapter: 25, First: 1, Last: 6},
+				{Chapter: 26, First: 1, Last: 14},
+				{Chapter: 27, First: 1, Last: 23},
+				{Chapter: 28, First: 1, Last: 28},
+				{Chapter: 29, First: 1, Last: 25},
+				{Chapter: 30, First: 1, Last: 31},
+				{Chapter: 31, First: 1, Last: 40},
+				{Chapter: 32, First: 1, Last: 22},
+				{Chapter: 33, First: 1, Last: 33},
+				{Chapter: 34, First: 1, Last: 37},
+				{Chapter: 35, First: 1, Last: 16},
+				{Chapter: 36, First: 1, Last: 33},
+				{Chapter: 37, First: 1, Last: 24},
+				{Chapter: 38, First: 1, Last: 41},
+				{Chapter: 39, First: 1, Last: 30},
+				{Chapter: 40, First: 1, Last: 24},
+				{Chapter: 41, First: 1, Last: 34},
+				{Chapter: 42, First: 1, Last: 17},
+			},
+		},
+		{
+			Name:      "Psalms",
+			USFM:      "PSA",
+			Testament: OldTestament,
+			JustVerse: false,
+			Runs: []VerseRun{
+				{Chapter: 1, First: 1, Last: 6},
+				{Chapter: 2, First: 1, Last: 12},
+				{Chapter: 3, First: 1, Last: 8},
+				{Chapter: 4, First: 1, Last: 8},
+				{Chapter: 5, First: 1, Last: 12},
+				{Chapter: 6, First: 1, Last: 10},
+				{Chapter: 7, First: 1, Last: 17},
+				{Chapter: 8, First: 1, Last: 9},
+				{Chapter: 9, First: 1, Last: 20},
+				{Chapter: 10, First: 1, Last: 18},
+				{Chapter: 11, First: 1, Last: 7},
+				{Chapter: 12, First: 1, Last: 8},
+				{Chapter: 13, First: 1, Last: 6},
+				{Chapter: 14, First: 1, Last: 7},
+				{Chapter: 15, First: 1, Last: 5},
+				{Chapter: 16, First: 1, Last: 11},
+				{Chapter: 17, First: 1, Last: 15},
+				{Chapter: 18, First: 1, Last: 50},
+				{Chapter: 19, First: 1, Last: 14},
+				{Chapter: 20, First: 1, Last: 9},
+				{Chapter: 21, First: 1, Last: 13},
+				{Chapter: 22, First: 1, Last: 31},
+				{Chapter: 23, First: 1, Last: 6},
+				{Chapter: 24, First: 1, Last: 10},
+				{Chapter: 25, First: 1, Last: 22},
+				{Chapter: 26, First: 1, Last: 12},
+				{Chapter: 27, First: 1, Last: 14},
+				{Chapter: 28, First: 1, Last: 9},
+				{Chapter: 29, First: 1, Last: 11},
+				{Chapter: 30, First: 1, Last: 12},
+				{Chapter: 31, First: 1, Last: 24},
+				{Chapter: 32, First: 1, Last: 11},
+				{Chapter: 33, First: 1, Last: 22},
+				{Chapter: 34, First: 1, Last: 22},
+				{Chapter: 35, First: 1, Last: 28},
+				{Chapter: 36, First: 1, Last: 12},
+				{Chapter: 37, First: 1, Last: 40},
+				{Chapter: 38, First: 1, Last: 22},
+				{Chapter: 39, First: 1, Last: 13},
+				{Chapter: 40, First: 1, Last: 17},
+				{Chapter: 41, First: 1, Last: 13},
+				{Chapter: 42, First: 1, Last: 11},
+				{Chapter: 43, First: 1, Last: 5},
+				{Chapter: 44, First: 1, Last: 26},
+				{Chapter: 45, First: 1, Last: 17},
+				{Chapter: 46, First: 1, Last: 11},
+				{Chapter: 47, First: 1, Last: 9},
+				{Chapter: 48, First: 1, Last: 14},
+				{Chapter: 49, First: 1, Last: 20},
+				{Chapter: 50, First: 1, Last: 23},
+				{Chapter: 51, First: 1, Last: 19},
+				{Chapter: 52, First: 1, Last: 9},
+				{Chapter: 53, First: 1, Last: 6},
+				{Chapter: 54, First: 1, Last: 7},
+				{Chapter: 55, First: 1, Last: 23},
+				{Chapter: 56, First: 1, Last: 13},
+				{Chapter: 57, First: 1, Last: 11},
+				{Chapter: 58, First: 1, Last: 11},
+				{Chapter: 59, First: 1, Last: 17},
+				{Chapter: 60, First: 1, Last: 12},
+				{Chapter: 61, First: 1, Last: 8},
+				{Chapter: 62, First: 1, Last: 12},
+				{Chapter: 63, First: 1, Last: 11},
+				{Chapter: 64, First: 1, Last: 10},
+				{Chapter: 65, First: 1, Last: 13},
+				{Chapter: 66, First: 1, Last: 20},
+				{Chapter: 67, First: 1, Last: 7},
+				{Chapter: 68, First: 1, Last: 35},
+				{Chapter: 69, First: 1, Last: 36},
+				{Chapter: 70, First: 1, Last: 5},
+				{Chapter: 71, First: 1, Last: 24},
+				{Chapter: 72, First: 1, Last: 20},
+				{Chapter: 73, First: 1, Last: 28},
+				{Chapter: 74, First: 1, Last: 23},
+				{Chapter: 75, First: 1, Last: 10},
+				{Chapter: 76, First: 1, Last: 12},
+				{Chapter: 77, First: 1, Last: 20},
+				{Chapter: 78, First: 1, Last: 72},
+				{Chapter: 79, First: 1, Last: 13},
+				{Chapter: 80, First: 1, Last: 19},
+				{Chapter: 81, First: 1, Last: 16},
+				{Chapter: 82, First: 1, Last: 8},
+				{Chapter: 83, First: 1, Last: 18},
+				{Chapter: 84, First: 1, Last: 12},
+				{Chapter: 85, First: 1, Last: 13},
+				{Chapter: 86, First: 1, Last: 17},
+				{Chapter: 87, First: 1, Last: 7},
+				{Chapter: 88, First: 1, Last: 18},
+				{Chapter: 89, First: 1, Last: 52},
+				{Chapter: 90, First: 1, Last: 17},
+				{Chapter: 91, First: 1, Last: 16},
+				{Chapter: 92, First: 1, Last: 15},
+				{Chapter: 93, First: 1, Last: 5},
+				{Chapter: 94, First: 1, Last: 23},
+				{Chapter: 95, First: 1, Last: 11},
+				{Chapter: 96, First: 1, Last: 13},
+				{Chapter: 97, First: 1, Last: 12},
+				{Chapter: 98, First: 1, Last: 9},
+				{Chapter: 99, First: 1, Last: 9},
+				{Chapter: 100, First: 1, Last: 5},
+				{Chapter: 101, First: 1, Last: 8},
+				{Chapter: 102, First: 1, Last: 28},
+				{Chapter: 103, First: 1, Last: 22},
+				{Chapter: 104, First: 1, Last: 35},
+				{Chapter: 105, First: 1, Last: 45},
+				{Chapter: 106, First: 1, Last: 48},
+				{Chapter: 107, First: 1, Last: 43},
+				{Chapter: 108, First: 1, Last: 13},
+				{Chapter: 109, First: 1, Last: 31},
+				{Chapter: 110, First: 1, Last: 7},
+				{Chapter: 111, First: 1, Last: 10},
+				{Chapter: 112, First: 1, Last: 10},
+				{Chapter: 113, First: 1, Last: 9},
+				{Chapter: 114, First: 1, Last: 8},
+				{Chapter: 115, First: 1, Last: 18},
+				{Chapter: 116, First: 1, Last: 19},
+				{Chapter: 117, First: 1, Last: 2},
+				{Chapter: 118, First: 1, Last: 29},
+				{Chapter: 119, First: 1, Last: 176},
+				{Chapter: 120, First: 1, Last: 7},
+				{Chapter: 121, First: 1, Last: 8},
+				{Chapter: 122, First: 1, Last: 9},
+				{Chapter: 123, First: 1, Last: 4},
+				{Chapter: 124, First: 1, Last: 8},
+				{Chapter: 125, First: 1, Last: 5},
+				{Chapter: 126, First: 1, Last: 6},
+				{Chapter: 127, First: 1, Last: 5},
+				{Chapter: 128, First: 1, Last: 6},
+				{Chapter: 129, First: 1, Last: 8},
+				{Chapter: 130, First: 1, Last: 8},
+				{Chapter: 131, First: 1, Last: 3},
+				{Chapter: 132, First: 1, Last: 18},
+				{Chapter: 133, First: 1, Last: 3},
+				{Chapter: 134, First: 1, Last: 3},
+				{Chapter: 135, First: 1, Last: 21},
+				{Chapter: 136, First: 1, Last: 26},
+				{Chapter: 137, First: 1, Last: 9},
+				{Chapter: 138, First: 1, Last: 8},
+				{Chapter: 139, First: 1, Last: 24},
+				{Chapter: 140, First: 1, Last: 13},
+				{Chapter: 141, First: 1, Last: 10},
+				{Chapter: 142, First: 1, Last: 7},
+				{Chapter: 143, First: 1, Last: 12},
+				{Chapter: 144, First: 1, Last: 15},
+				{Chapter: 145, First: 1, Last: 21},
+				{Chapter: 146, First: 1, Last: 10},
+				{Chapter: 147, First: 1, Last: 20},
+				{Chapter: 148, First: 1, Last: 14},
+				{Chapter: 149, First: 1, Last: 9},
+				{Chapter: 150, First: 1, Last: 6},
+			},
+		},
+		{
+			Name:      "Proverbs",
+			USFM:      "PRO",
+			Testament: OldTestament,
+			JustVerse: false,
+			Runs: []VerseRun{
+				{Chapter: 1, First: 1, Last: 33},
+				{Chapter: 2, First: 1, Last: 22},
+				{Chapter: 3, First: 1, Last: 35},
+				{Chapter: 4, First: 1, Last: 27},
+				{Chapter: 5, First: 1, Last: 23},
+				{Chapter: 6, First: 1, Last: 35},
+				{Chapter: 7, First: 1, Last: 27},
+				{Chapter: 8, First: 1, Last: 36},
+				{Chapter: 9, First: 1, Last: 18},
+				{Chapter: 10, First: 1, Last: 32},
+				{Chapter: 11, First: 1, Last: 31},
+				{Chapter: 12, First: 1, Last: 28},
+				{Chapter: 13, First: 1, Last: 25},
+				{Chapter: 14, First: 1, Last: 35},
+				{Chapter: 15, First: 1, Last: 33},
+				{Chapter: 16, First: 1, Last: 33},
+				{Chapter: 17, First: 1, Last: 28},
+				{Chapter: 18, First: 1, Last: 24},
+				{Chapter: 19, First: 1, Last: 29},
+				{Chapter: 20, First: 1, Last: 30},
+				{Chapter: 21, First: 1, Last: 31},
+				{Chapter: 22, First: 1, Last: 29},
+				{Chapter: 23, First: 1, Last: 35},
+				{Chapter: 24, First: 1, Last: 34},
+				{Chapter: 25, First: 1, Last: 28},
+				{Chapter: 26, First: 1, Last: 28},
+				{Chapter: 27, First: 1, Last: 27},
+				{Chapter: 28, First: 1, Last: 28},
+				{Chapter: 29, First: 1, Last: 27},
+				{Chapter: 30, First: 1, Last: 33},
+				{Chapter: 31, First: 1, Last: 31},
+			},
+		},
+		{
+			Name:      "Ecclesiastes",
+			USFM:      "ECC",
+			Testament: OldTestament,
+			AlternateNames: []string{
+				"Qoheleth",
+			},
+			JustVerse: false,
+			Runs: []VerseRun{
+				{Chapter: 1, First: 1, Last: 18},
+				{Chapter: 2, First: 1, Last: 26},
+				{Chapter: 3, First: 1, Last: 22},
+				{Chapter: 4, First: 1, Last: 16},
+				{Chapter: 5, First: 1, Last: 20},
+				{Chapter: 6, First: 1, Last: 12},
+				{Chapter: 7, First: 1, Last: 29},
+				{Chapter: 8, First: 1, Last: 17},
+				{Chapter: 9, First: 1, Last: 18},
+				{Chapter: 10, First: 1, Last: 20},
+				{Chapter: 11, First: 1, Last: 10},
+				{Chapter: 12, First: 1, Last: 14},
+			},
+		},
+		{
+			Name:      "Song of Solomon",
+			USFM:      "SNG",
+			Testament: OldTestament,
+			AlternateNames: []string{
+				"Song of Songs",
+				"Canticle of Canticles",
+				"Canticles",
+			},
+			JustVerse: false,
+			Runs: []VerseRun{
+				{Chapter: 1, First: 1, Last: 17},
+				{Chapter: 2, First: 1, Last: 17},
+				{Chapter: 3, First: 1, Last: 11},
+				{Chapter: 4, First: 1, Last: 16},
+				{Chapter: 5, First: 1, Last: 16},
+				{Chapter: 6, First: 1, Last: 13},
+				{Chapter: 7, First: 1, Last: 13},
+				{Chapter: 8, First: 1, Last: 14},
+			},
+		},
+		{
+			Name:      "Wisdom of Solomon",
+			USFM:      "WIS",
+			Testament: OldTestament,
+			AlternateNames: []string{
+				"Wisdom",
+			},
+			JustVerse: false,
+			Runs: []VerseRun{
+				{Chapter: 1, First: 1, Last: 16},
+				{Chapter: 2, First: 1, Last: 24},
+				{Chapter: 3, First: 1, Last: 19},
+				{Chapter: 4, First: 1, Last: 20},
+				{Chapter: 5, First: 1, Last: 23},
+				{Chapter: 6, First: 1, Last: 25},
+				{Chapter: 7, First: 1, Last: 30},
+				{Chapter: 8, First: 1, Last: 21},
+				{Chapter: 9, First: 1, Last: 18},
+				{Chapter: 10, First: 1, Last: 21},
+				{Chapter: 11, First: 1, Last: 26},
+				{Chapter: 12, First: 1, Last: 27},
+				{Chapter: 13, First: 1, Last: 19},
+				{Chapter: 14, First: 1, Last: 31},
+				{Chapter: 15, First: 1, Last: 19},
+				{Chapter: 16, First: 1, Last: 29},
+				{Chapter: 17, First: 1, Last: 21},
+				{Chapter: 18, First: 1, Last: 25},
+				{Chapter: 19, First: 1, Last: 22},
+			},
+		},
+		{
+			Name:      "Sirach",
+			USFM:      "SIR",
+			Testament: OldTestament,
+			AlternateNames: []string{
+				"Ben Sira",
+			},
+			JustVerse: false,
+			Runs: []VerseRun{
+				{Chapter: 1, First: 1, Last: 30},
+				{Chapter: 2, First: 1, Last: 18},
+				{Chapter: 3, First: 1, Last: 31},
+				{Chapter: 4, First: 1, Last: 31},
+				{Chapter: 5, First: 1, Last: 15},
+				{Chapter: 6, First: 1, Last: 37},
+				{Chapter: 7, First: 1, Last: 36},
+				{Chapter: 8, First: 1, Last: 19},
+				{Chapter: 9, First: 1, Last: 18},
+				{Chapter: 10, First: 1, Last: 31},
+				{Chapter: 11, First: 1, Last: 34},
+				{Chapter: 12, First: 1, Last: 18},
+				{Chapter: 13, First: 1, Last: 26},
+				{Chapter: 14, First: 1, Last: 27},
+				{Chapter: 15, First: 1, Last: 20},
+				{Chapter: 16, First: 1, Last: 30},
+				{Chapter: 17, First: 1, Last: 32},
+				{Chapter: 18, First: 1, Last: 33},
+				{Chapter: 19, First: 1, Last: 30},
+				{Chapter: 20, First: 1, Last: 32},
+				{Chapter: 21, First: 1, Last: 28},
+				{Chapter: 22, First: 1, Last: 27},
+				{Chapter: 23, First: 1, Last: 28},
+				{Chapter: 24, First: 1, Last: 34},
+				{Chapter: 25, First: 1, Last: 26},
+				{Chapter: 26, First: 1, Last: 29},
+				{Chapter: 27, First: 1, Last: 30},
+				{Chapter: 28, First: 1, Last: 26},
+				{Chapter: 29, First: 1, Last: 28},
+				{Chapter: 30, First: 1, Last: 25},
+				{Chapter: 31, First: 1, Last: 31},
+				{Chapter: 32, First: 1, Last: 24},
+				{Chapter: 33, First: 1, Last: 31},
+				{Chapter: 34, First: 1, Last: 26},
+				{Chapter: 35, First: 1, Last: 20},
+				{Chapter: 36, First: 1, Last: 26},
+				{Chapter: 37, First: 1, Last: 31},
+				{Chapter: 38, First: 1, Last: 34},
+				{Chapter: 39, First: 1, Last: 35},
+				{Chapter: 40, First: 1, Last: 30},
+				{Chapter: 41, First: 1, Last: 24},
+				{Chapter: 42, First: 1, Last: 25},
+				{Chapter: 43, First: 1, Last: 33},
+				{Chapter: 44, First: 1, Last: 23},
+				{Chapter: 45, First: 1, Last: 26},
+				{Chapter: 46, First: 1, Last: 20},
+				{Chapter: 47, First: 1, Last: 25},
+				{Chapter: 48, First: 1, Last: 25},
+				{Chapter: 49, First: 1, Last: 16},
+				{Chapter: 50, First: 1, Last: 29},
+				{Chapter: 51, First: 1, Last: 30},
+			},
+		},
+		{
+			Name:      "Isaiah",
+			USFM:      "ISA",
+			Testament: OldTestament,
+			JustVerse: false,
+			Runs: []VerseRun{
+				{Chapter: 1, First: 1, Last: 31},
+				{Chapter: 2, First: 1, Last: 22},
+				{Chapter: 3, First: 1, Last: 26},
+				{Chapter: 4, First: 1, Last: 6},
+				{Chapter: 5, First: 1, Last: 30},
+				{Chapter: 6, First: 1, Last: 13},
+				{Chapter: 7, First: 1, Last: 25},
+				{Chapter: 8, First: 1, Last: 22},
+				{Chapter: 9, First: 1, Last: 21},
+				{Chapter: 10, First: 1, Last: 34},
+				{Chapter: 11, First: 1, Last: 16},
+				{Chapter: 12, First: 1, Last: 6},
+				{Chapter: 13, First: 1, Last: 22},
+				{Chapter: 14, First: 1, Last: 32},
+				{Chapter: 15, First: 1, Last: 9},
+				{Chapter: 16, First: 1, Last: 14},
+				{Chapter: 17, First: 1, Last: 14},
+				{Chapter: 18, First: 1, Last: 7},
+				{Chapter: 19, First: 1, Last: 25},
+				{Chapter: 20, First: 1, Last: 6},
+				{Chapter: 21, First: 1, Last: 17},
+				{Chapter: 22, First: 1, Last: 25},
+				{Chapter: 23, First: 1, Last: 18},
+				{Chapter: 24, First: 1, Last: 23},
+				{Chapter: 25, First: 1, Last: 12},
+				{Chapter: 26, First: 1, Last: 21},
+				{Chapter: 27, First: 1, Last: 13},
+				{Chapter: 28, First: 1, Last: 29},
+				{Chapter: 29, First: 1, Last: 24},
+				{Chapter: 30, First: 1, Last: 33},
+				{Chapter: 31, First: 1, Last: 9},
+				{Chapter: 32, First: 1, Last: 20},
+				{Chapter: 33, First: 1, Last: 24},
+				{Chapter: 34, First: 1, Last: 17},
+				{Chapter: 35, First: 1, Last: 10},
+				{Chapter: 36, First: 1, Last: 22},
+				{Chapter: 37, First: 1, Last: 38},
+				{Chapter: 38, First: 1, Last: 22},
+				{Chapter: 39, First: 1, Last: 8},
+				{Chapter: 40, First: 1, Last: 31},
+				{Chapter: 41, First: 1, Last: 29},
+				{Chapter: 42, First: 1, Last: 25},
+				{Chapter: 43, First: 1, Last: 28},
+				{Chapter: 44, First: 1, Last: 28},
+				{Chapter: 45, First: 1, Last: 25},
+				{Chapter: 46, First: 1, Last: 13},
+				{Chapter: 47, First: 1, Last: 15},
+				{Chapter: 48, First: 1, Last: 22},
+				{Chapter: 49, First: 1, Last: 26},
+				{Chapter: 50, First: 1, Last: 11},
+				{Chapter: 51, First: 1, Last: 23},
+				{Chapter: 52, First: 1, Last: 15},
+				{Chapter: 53, First: 1, Last: 12},
+				{Chapter: 54, First: 1, Last: 17},
+				{Chapter: 55, First: 1, Last: 13},
+				{Chapter: 56, First: 1, Last: 12},
+				{Chapter: 57, First: 1, Last: 21},
+				{Chapter: 58, First: 1, Last: 14},
+				{Chapter: 59, First: 1, Last: 21},
+				{Chapter: 60, First: 1, Last: 22},
+				{Chapter: 61, First: 1, Last: 11},
+				{Chapter: 62, First: 1, Last: 12},
+				{Chapter: 63, First: 1, Last: 19},
+				{Chapter: 64, First: 1, Last: 12},
+				{Chapter: 65, First: 1, Last: 25},
+				{Chapter: 66, First: 1, Last: 24},
+			},
+		},
+		{
+			Name:      "Jeremiah",
+			USFM:      "JER",
+			Testament: OldTestament,
+			JustVerse: false,
+			Runs: []VerseRun{
+				{Chapter: 1, First: 1, Last: 19},
+				{Chapter: 2, First: 1, Last: 37},
+				{Chapter: 3, First: 1, Last: 25},
+				{Chapter: 4, First: 1, Last: 31},
+				{Chapter: 5, First: 1, Last: 31},
+				{Chapter: 6, First: 1, Last: 30},
+				{Chapter: 7, First: 1, Last: 34},
+				{Chapter: 8, First: 1, Last: 22},
+				{Chapter: 9, First: 1, Last: 26},
+				{Chapter: 10, First: 1, Last: 25},
+				{Chapter: 11, First: 1, Last: 23},
+				{Chapter: 12, First: 1, Last: 17},
+				{Chapter: 13, First: 1, Last: 27},
+				{Chapter: 14, First: 1, Last: 22},
+				{Chapter: 15, First: 1, Last: 21},
+				{Chapter: 16, First: 1, Last: 21},
+				{Chapter: 17, First: 1, Last: 27},
+				{Chapter: 18, First: 1, Last: 23},
+				{Chapter: 19, First: 1, Last: 15},
+				{Chapter: 20, First: 1, Last: 18},
+				{Chapter: 21, First: 1, Last: 14},
+				{Chapter: 22, First: 1, Last: 30},
+				{Chapter: 23, First: 1, Last: 40},
+				{Chapter: 24, First: 1, Last: 10},
+				{Chapter: 25, First: 1, Last: 38},
+				{Chapter: 26, First: 1, Last: 24},
+				{Chapter: 27, First: 1, Last: 22},
+				{Chapter: 28, First: 1, Last: 17},
+				{Chapter: 29, First: 1, Last: 32},
+				{Chapter: 30, First: 1, Last: 24},
+				{Chapter: 31, First: 1, Last: 40},
+				{Chapter: 32, First: 1, Last: 44},
+				{Chapter: 33, First: 1, Last: 26},
+				{Chapter: 34, First: 1, Last: 22},
+				{Chapter: 35, First: 1, Last: 19},
+				{Chapter: 36, First: 1, Last: 32},
+				{Chapter: 37, First: 1, Last: 21},
+				{Chapter: 38, First: 1, Last: 28},
+				{Chapter: 39, First: 1, Last: 18},
+				{Chapter: 40, First: 1, Last: 16},
+				{Chapter: 41, First: 1, Last: 18},
+				{Chapter: 42, First: 1, Last: 22},
+				{Chapter: 43, First: 1, Last: 13},
+				{Chapter: 44, First: 1, Last: 30},
+				{Chapter: 45, First: 1, Last: 5},
+				{Chapter: 46, First: 1, Last: 28},
+				{Chapter: 47, First: 1, Last: 7},
+				{Chapter: 48, First: 1, Last: 47},
+				{Chapter: 49, First: 1, Last: 39},
+				{Chapter: 50, First: 1, Last: 46},
+				{Chapter: 51, First: 1, Last: 64},
+				{Chapter: 52, First: 1, Last: 34},
+			},
+		},
+		{
+			Name:      "Lamentations",
+			USFM:      "LAM",
+			Testament: OldTestament,
+			JustVerse: false,
+			Runs: []VerseRun{
+				{Chapter: 1, First: 1, Last: 22},
+				{Chapter: 2, First: 1, Last: 22},
+				{Chapter: 3, First: 1, Last: 66},
+				{Chapter: 4, First: 1, Last: 22},
+				{Chapter: 5, First: 1, Last: 22},
+			},
+		},
+		{
+			Name:      "Baruch",
+			USFM:      "BAR",
+			Testament: OldTestament,
+			JustVerse: false,
+			Runs: []VerseRun{
+				{Chapter: 1, First: 1, Last: 22},
+				{Chapter: 2, First: 1, Last: 35},
+				{Chapter: 3, First: 1, Last: 37},
+				{Chapter: 4, First: 1, Last: 37},
+				{Chapter: 5, First: 1, Last: 9},
+				{Chapter: 6, First: 1, Last: 73},
+			},
+		},
+		{
+			Name:      "Ezekiel",
+			USFM:      "EZK",
+			Testament: OldTestament,
+			JustVerse: false,
+			Runs: []VerseRun{
+				{Chapter: 1, First: 1, Last: 28},
+				{Chapter: 2, First: 1, Last: 10},
+				{Chapter: 3, First: 1, Last: 27},
+				{Chapter: 4, First: 1, Last: 17},
+				{Chapter: 5, First: 1, Last: 17},
+				{Chapter: 6, First: 1, Last: 14},
+				{Chapter: 7, First: 1, Last: 27},
+				{Chapter: 8, First: 1, Last: 18},
+				{Chapter: 9, First: 1, Last: 11},
+				{Chapter: 10, First: 1, Last: 22},
+				{Chapter: 11, First: 1, Last: 25},
+				{Chapter: 12, First: 1, Last: 28},
+				{Chapter: 13, First: 1, Last: 23},
+				{Chapter: 14, First: 1, Last: 23},
+				{Chapter: 15, First: 1, Last: 8},
+				{Chapter: 16, First: 1, Last: 63},
+				{Chapter: 17, First: 1, Last: 24},
+				{Chapter: 18, First: 1, Last: 32},
+				{Chapter: 19, First: 1, Last: 14},
+				{Chapter: 20, First: 1, Last: 49},
+				{Chapter: 21, First: 1, Last: 32},
+				{Chapter: 22, First: 1, Last: 31},
+				{Chapter: 23, First: 1, Last: 49},
+				{Chapter: 24, First: 1, Last: 27},
+				{Chapter: 25, First: 1, Last: 17},
+				{Chapter: 26, First: 1, Last: 21},
+				{Chapter: 27, First: 1, Last: 36},
+				{Chapter: 28, First: 1, Last: 26},
+				{Chapter: 29, First: 1, Last: 21},
+				{Chapter: 30, First: 1, Last: 26},
+				{Chapter: 31, First: 1, Last: 18},
+				{Chapter: 32, First: 1, Last: 32},
+				{Chapter: 33, First: 1, Last: 33},
+				{Chapter: 34, First: 1, Last: 31},
+				{Chapter: 35, First: 1, Last: 15},
+				{Chapter: 36, First: 1, Last: 38},
+				{Chapter: 37, First: 1, Last: 28},
+				{Chapter: 38, First: 1, Last: 23},
+				{Chapter: 39, First: 1, Last: 29},
+				{Chapter: 40, First: 1, Last: 49},
+				{Chapter: 41, First: 1, Last: 26},
+				{Chapter: 42, First: 1, Last: 20},
+				{Chapter: 43, First: 1, Last: 27},
+				{Chapter: 44, First: 1, Last: 31},
+				{Chapter: 45, First: 1, Last: 25},
+				{Chapter: 46, First: 1, Last: 24},
+				{Chapter: 47, First: 1, Last: 23},
+				{Chapter: 48, First: 1, Last: 35},
+			},
+		},
+		{
+			Name:      "Daniel",
+			USFM:      "DAN",
+			Testament: OldTestament,
+			JustVerse: false,
+			Runs: []VerseRun{
+				{Chapter: 1, First: 1, Last: 21},
+				{Chapter: 2, First: 1, Last: 49},
+				{Chapter: 3, First: 1, Last: 30},
+				{Chapter: 4, First: 1, Last: 37},
+				{Chapter: 5, First: 1, Last: 31},
+				{Chapter: 6, First: 1, Last: 28},
+				{Chapter: 7, First: 1, Last: 28},
+				{Chapter: 8, First: 1, Last: 27},
+				{Chapter: 9, First: 1, Last: 27},
+				{Chapter: 10, First: 1, Last: 21},
+				{Chapter: 11, First: 1, Last: 45},
+				{Chapter: 12, First: 1, Last: 13},
+			},
+		},
+		{
+			Name:      "Prayer of Azariah",
+			USFM:      "S3Y",
+			Testament: OldTestament,
+			JustVerse: true,
+			Runs: []VerseRun{
+				{Chapter: 1, First: 1, Last: 68},
+			},
+		},
+		{
+			Name:      "Susanna",
+			USFM:      "SUS",
+			Testament: OldTestament,
+			JustVerse: true,
+			Runs: []VerseRun{
+				{Chapter: 1, First: 1, Last: 64},
+			},
+		},
+		{
+			Name:      "Bel and the Dragon",
+			USFM:      "BEL",
+			Testament: OldTestament,
+			JustVerse: true,
+			Runs: []VerseRun{
+				{Chapter: 1, First: 1, Last: 42},
+			},
+		},
+		{
+			Name:      "Hosea",
+			USFM:      "HOS",
+			Testament: OldTestament,
+			JustVerse: false,
+			Runs: []VerseRun{
+				{Chapter: 1, First: 1, Last: 11},
+				{Chapter: 2, First: 1, Last: 23},
+				{Chapter: 3, First: 1, Last: 5},
+				{Chapter: 4, First: 1, Last: 19},
+				{Chapter: 5, First: 1, Last: 15},
+				{Chapter: 6, First: 1, Last: 11},
+				{Chapter: 7, First: 1, Last: 16},
+				{Chapter: 8, First: 1, Last: 14},
+				{Chapter: 9, First: 1, Last: 17},
+				{Chapter: 10, First: 1, Last: 15},
+				{Chapter: 11, First: 1, Last: 12},
+				{Chapter: 12, First: 1, Last: 14},
+				{Chapter: 13, First: 1, Last: 16},
+				{Chapter: 14, First: 1, Last: 9},
+			},
+		},
+		{
+			Name:      "Joel",
+			USFM:      "JOL",
+			Testament: OldTestament,
+			JustVerse: false,
+			Runs: []VerseRun{
+				{Chapter: 1, First: 1, Last: 20},
+				{Chapter: 2, First: 1, Last: 32},
+				{Chapter: 3, First: 1, Last: 21},
+			},
+		},
+		{
+			Name:      "Amos",
+			USFM:      "AMO",
+			Testament: OldTestament,
+			JustVerse: false,
+			Runs: []VerseRun{
+				{Chapter: 1, First: 1, Last: 15},
+				{Chapter: 2, First: 1, Last: 16},
+				{Chapter: 3, First: 1, Last: 15},
+				{Chapter: 4, First: 1, Last: 13},
+				{Chapter: 5, First: 1, Last: 27},
+				{Chapter: 6, First: 1, Last: 14},
+				{Chapter: 7, First: 1, Last: 17},
+				{Chapter: 8, First: 1, Last: 14},
+				{Chapter: 9, First: 1, Last: 15},
+			},
+		},
+		{
+			Name:      "Obadiah",
+			USFM:      "OBA",
+			Testament: OldTestament,
+			JustVerse: true,
+			Runs: []VerseRun{
+				{Chapter: 1, First: 1, Last: 21},
+			},
+		},
+		{
+			Name:      "Jonah",
+			USFM:      "JON",
+			Testament: OldTestament,
+			JustVerse: false,
+			Runs: []VerseRun{
+				{Chapter: 1, First: 1, Last: 17},
+				{Chapter: 2, First: 1, Last: 10},
+				{Chapter: 3, First: 1, Last: 10},
+				{Chapter: 4, First: 1, Last: 11},
+			},
+		},
+		{
+			Name:      "Micah",
+			USFM:      "MIC",
+			Testament: OldTestament,
+			JustVerse: false,
+			Runs: []VerseRun{
+				{Chapter: 1, First: 1, Last: 16},
+				{Chapter: 2, First: 1, Last: 13},
+				{Chapter: 3, First: 1, Last: 12},
+				{Chapter: 4, First: 1, Last: 13},
+				{Chapter: 5, First: 1, Last: 15},
+				{Chapter: 6, First: 1, Last: 16},
+				{Chapter: 7, First: 1, Last: 20},
+			},
+		},
+		{
+			Name:      "Nahum",
+			USFM:      "NAM",
+			Testament: OldTestament,
+			JustVerse: false,
+			Runs: []VerseRun{
+				{Chapter: 1, First: 1, Last: 15},
+				{Chapter: 2, First: 1, Last: 13},
+				{Chapter: 3, First: 1, Last: 19},
+			},
+		},
+		{
+			Name:      "Habakkuk",
+			USFM:      "HAB",
+			Testament: OldTestament,
+			JustVerse: false,
+			Runs: []VerseRun{
+				{Chapter: 1, First: 1, Last: 17},
+				{Chapter: 2, First: 1, Last: 20},
+				{Chapter: 3, First: 1, Last: 19},
+			},
+		},
+		{
+			Name:      "Zephaniah",
+			USFM:      "ZEP",
+			Testament: OldTestament,
+			JustVerse: false,
+			Runs: []VerseRun{
+				{Chapter: 1, First: 1, Last: 18},
+				{Chapter: 2, First: 1, Last: 15},
+				{Chapter: 3, First: 1, Last: 20},
+			},
+		},
+		{
+			Name:      "Haggai",
+			USFM:      "HAG",
+			Testament: OldTestament,
+			JustVerse: false,
+			Runs: []VerseRun{
+				{Chapter: 1, First: 1, Last: 15},
+				{Chapter: 2, First: 1, Last: 23},
+			},
+		},
+		{
+			Name:      "Zechariah",
+			USFM:      "ZEC",
+			Testament: OldTestament,
+			JustVerse: false,
+			Runs: []VerseRun{
+				{Chapter: 1, First: 1, Last: 21},
+				{Chapter: 2, First: 1, Last: 13},
+				{Chapter: 3, First: 1, Last: 10},
+				{Chapter: 4, First: 1, Last: 14},
+				{Chapter: 5, First: 1, Last: 11},
+				{Chapter: 6, First: 1, Last: 15},
+				{Chapter: 7, First: 1, Last: 14},
+				{Chapter: 8, First: 1, Last: 23},
+				{Chapter: 9, First: 1, Last: 17},
+				{Chapter: 10, First: 1, Last: 12},
+				{Chapter: 11, First: 1, Last: 17},
+				{Chapter: 12, First: 1, Last: 14},
+				{Chapter: 13, First: 1, Last: 9},
+				{Chapter: 14, First: 1, Last: 21},
+			},
+		},
+		{
+			Name:      "Malachi",
+			USFM:      "MAL",
+			Testament: OldTestament,
+			JustVerse: false,
+			Runs: []VerseRun{
+				{Chapter: 1, First: 1, Last: 14},
+				{Chapter: 2, First: 1, Last: 17},
+				{Chapter: 3, First: 1, Last: 18},
+				{Chapter: 4, First: 1, Last: 6},
+			},
+		},
+		{
+			Name:      "Matthew",
+			USFM:      "MAT",
+			Testament: NewTestament,
+			JustVerse: false,
+			Runs: []VerseRun{
+				{Chapter: 1, First: 1, Last: 25},
+				{Chapter: 2, First: 1, Last: 23},
+				{Chapter: 3, First: 1, Last: 17},
+				{Chapter: 4, First: 1, Last: 25},
+				{Chapter: 5, First: 1, Last: 48},
+				{Chapter: 6, First: 1, Last: 34},
+				{Chapter: 7, First: 1, Last: 29},
+				{Chapter: 8, First: 1, Last: 34},
+				{Chapter: 9, First: 1, Last: 38},
+				{Chapter: 10, First: 1, Last: 42},
+				{Chapter: 11, First: 1, Last: 30},
+				{Chapter: 12, First: 1, Last: 46},
+				{Chapter: 12, First: 48, Last: 50},
+				{Chapter: 13, First: 1, Last: 58},
+				{Chapter: 14, First: 1, Last: 36},
+				{Chapter: 15, First: 1, Last: 39},
+				{Chapter: 16, First: 1, Last: 28},
+				{Chapter: 17, First: 1, Last: 27},
+				{Chapter: 18, First: 1, Last: 35},
+				{Chapter: 19, First: 1, Last: 30},
+				{Chapter: 20, First: 1, Last: 34},
+				{Chapter: 21, First: 1, Last: 46},
+				{Chapter: 22, First: 1, Last: 46},
+				{Chapter: 23, First: 1, Last: 39},
+				{Chapter: 24, First: 1, Last: 51},
+				{Chapter: 25, First: 1, Last: 46},
+				{Chapter: 26, First: 1, Last: 75},
+				{Chapter: 27, First: 1, Last: 66},
+				{Chapter: 28, First: 1, Last: 20},
+			},
+		},
+		{
+			Name:      "Mark",
+			USFM:      "MRK",
+			Testament: NewTestament,
+			JustVerse: false,
+			Runs: []VerseRun{
+				{Chapter: 1, First: 1, Last: 45},
+				{Chapter: 2, First: 1, Last: 28},
+				{Chapter: 3, First: 1, Last: 35},
+				{Chapter: 4, First: 1, Last: 41},
+				{Chapter: 5, First: 1, Last: 43},
+				{Chapter: 6, First: 1, Last: 56},
+				{Chapter: 7, First: 1, Last: 37},
+				{Chapter: 8, First: 1, Last: 38},
+				{Chapter: 9, First: 1, Last: 50},
+				{Chapter: 10, First: 1, Last: 52},
+				{Chapter: 11, First: 1, Last: 33},
+				{Chapter: 12, First: 1, Last: 44},
+				{Chapter: 13, First: 1, Last: 37},
+				{Chapter: 14, First: 1, Last: 72},
+				{Chapter: 15, First: 1, Last: 47},
+				{Chapter: 16, First: 1, Last: 20},
+			},
+		},
+		{
+			Name:      "Luke",
+			USFM:      "LUK",
+			Testament: NewTestament,
+			JustVerse: false,
+			Runs: []VerseRun{
+				{Chapter: 1, First: 1, Last: 80},
+				{Chapter: 2, First: 1, Last: 52},
+				{Chapter: 3, First: 1, Last: 38},
+				{Chapter: 4, First: 1, Last: 44},
+				{Chapter: 5, First: 1, Last: 39},
+				{Chapter: 6, First: 1, Last: 49},
+				{Chapter: 7, First: 1, Last: 50},
+				{Chapter: 8, First: 1, Last: 56},
+				{Chapter: 9, First: 1, Last: 62},
+				{Chapter: 10, First: 1, Last: 42},
+				{Chapter: 11, First: 1, Last: 54},
+				{Chapter: 12, First: 1, Last: 59},
+				{Chapter: 13, First: 1, Last: 35},
+				{Chapter: 14, First: 1, Last: 35},
+				{Chapter: 15, First: 1, Last: 32},
+				{Chapter: 16, First: 1, Last: 31},
+				{Chapter: 17, First: 1, Last: 37},
+				{Chapter: 18, First: 1, Last: 43},
+				{Chapter: 19, First: 1, Last: 48},
+				{Chapter: 20, First: 1, Last: 47},
+				{Chapter: 21, First: 1, Last: 38},
+				{Chapter: 22, First: 1, Last: 71},
+				{Chapter: 23, First: 1, Last: 56},
+				{Chapter: 24, First: 1, Last: 53},
+			},
+		},
+		{
+			Name:      "John",
+			USFM:      "JHN",
+			Testament: NewTestament,
+			JustVerse: false,
+			Runs: []VerseRun{
+				{Chapter: 1, First: 1, Last: 51},
+				{Chapter: 2, First: 1, Last: 25},
+				{Chapter: 3, First: 1, Last: 36},
+				{Chapter: 4, First: 1, Last: 54},
+				{Chapter: 5, First: 1, Last: 47},
+				{Chapter: 6, First: 1, Last: 71},
+				{Chapter: 7, First: 1, Last: 53},
+				{Chapter: 8, First: 1, Last: 59},
+				{Chapter: 9, First: 1, Last: 41},
+				{Chapter: 10, First: 1, Last: 42},
+				{Chapter: 11, First: 1, Last: 57},
+				{Chapter: 12, First: 1, Last: 50},
+				{Chapter: 13, First: 1, Last: 38},
+				{Chapter: 14, First: 1, Last: 31},
+				{Chapter: 15, First: 1, Last: 27},
+				{Chapter: 16, First: 1, Last: 33},
+				{Chapter: 17, First: 1, Last: 26},
+				{Chapter: 18, First: 1, Last: 40},
+				{Chapter: 19, First: 1, Last: 42},
+				{Chapter: 20, First: 1, Last: 31},
+				{Chapter: 21, First: 1, Last: 25},
+			},
+		},
+		{
+			Name:      "Acts",
+			USFM:      "ACT",
+			Testament: NewTestament,
+			JustVerse: false,
+			Runs: []VerseRun{
+				{Chapter: 1, First: 1, Last: 26},
+				{Chapter: 2, First: 1, Last: 47},
+				{Chapter: 3, First: 1, Last: 26},
+				{Chapter: 4, First: 1, Last: 37},
+				{Chapter: 5, First: 1, Last: 42},
+				{Chapter: 6, First: 1, Last: 15},
+				{Chapter: 7, First: 1, Last: 60},
+				{Chapter: 8, First: 1, Last: 40},
+				{Chapter: 9, First: 1, Last: 43},
+				{Chapter: 10, First: 1, Last: 48},
+				{Chapter: 11, First: 1, Last: 30},
+				{Chapter: 12, First: 1, Last: 25},
+				{Chapter: 13, First: 1, Last: 52},
+				{Chapter: 14, First: 1, Last: 28},
+				{Chapter: 15, First: 1, Last: 41},
+				{Chapter: 16, First: 1, Last: 40},
+				{Chapter: 17, First: 1, Last: 34},
+				{Chapter: 18, First: 1, Last: 28},
+				{Chapter: 19, First: 1, Last: 41},
+				{Chapter: 20, First: 1, Last: 38},
+				{Chapter: 21, First: 1, Last: 40},
+				{Chapter: 22, First: 1, Last: 30},
+				{Chapter: 23, First: 1, Last: 35},
+				{Chapter: 24, First: 1, Last: 27},
+				{Chapter: 25, First: 1, Last: 27},
+				{Chapter: 26, First: 1, Last: 32},
+				{Chapter: 27, First: 1, Last: 44},
+				{Chapter: 28, First: 1, Last: 31},
+			},
+		},
+		{
+			Name:      "Romans",
+			USFM:      "ROM",
+			Testament: NewTestament,
+			JustVerse: false,
+			Runs: []VerseRun{
+				{Chapter: 1, First: 1, Last: 32},
+				{Chapter: 2, First: 1, Last: 29},
+				{Chapter: 3, First: 1, Last: 31},
+				{Chapter: 4, First: 1, Last: 25},
+				{Chapter: 5, First: 1, Last: 21},
+				{Chapter: 6, First: 1, Last: 23},
+				{Chapter: 7, First: 1, Last: 25},
+				{Chapter: 8, First: 1, Last: 39},
+				{Chapter: 9, First: 1, Last: 33},
+				{Chapter: 10, First: 1, Last: 21},
+				{Chapter: 11, First: 1, Last: 36},
+				{Chapter: 12, First: 1, Last: 21},
+				{Chapter: 13, First: 1, Last: 14},
+				{Chapter: 14, First: 1, Last: 23},
+				{Chapter: 15, First: 1, Last: 33},
+				{Chapter: 16, First: 1, Last: 27},
+			},
+		},
+		{
+			Name:      "1 Corinthians",
+			USFM:      "1CO",
+			Testament: NewTestament,
+			JustVerse: false,
+			Runs: []VerseRun{
+				{Chapter: 1, First: 1, Last: 31},
+				{Chapter: 2, First: 1, Last: 16},
+				{Chapter: 3, First: 1, Last: 23},
+				{Chapter: 4, First: 1, Last: 21},
+				{Chapter: 5, First: 1, Last: 13},
+				{Chapter: 6, First: 1, Last: 20},
+				{Chapter: 7, First: 1, Last: 40},
+				{Chapter: 8, First: 1, Last: 13},
+				{Chapter: 9, First: 1, Last: 27},
+				{Chapter: 10, First: 1, Last: 33},
+				{Chapter: 11, First: 1, Last: 34},
+				{Chapter: 12, First: 1, Last: 31},
+				{Chapter: 13, First: 1, Last: 13},
+				{Chapter: 14, First: 1, Last: 40},
+				{Chapter: 15, First: 1, Last: 58},
+				{Chapter: 16, First: 1, Last: 24},
+			},
+		},
+		{
+			Name:      "2 Corinthians",
+			USFM:      "2CO",
+			Testament: NewTestament,
+			JustVerse: false,
+			Runs: []VerseRun{
+				{Chapter: 1, First: 1, Last: 24},
+				{Chapter: 2, First: 1, Last: 17},
+				{Chapter: 3, First: 1, Last: 18},
+				{Chapter: 4, First: 1, Last: 18},
+				{Chapter: 5, First: 1, Last: 21},
+				{Chapter: 6, First: 1, Last: 18},
+				{Chapter: 7, First: 1, Last: 16},
+				{Chapter: 8, First: 1, Last: 24},
+				{Chapter: 9, First: 1, Last: 15},
+				{Chapter: 10, First: 1, Last: 18},
+				{Chapter: 11, First: 1, Last: 33},
+				{Chapter: 12, First: 1, Last: 21},
+				{Chapter: 13, First: 1, Last: 14},
+			},
+		},
+		{
+			Name:      "Galatians",
+			USFM:      "GAL",
+			Testament: NewTestament,
+			JustVerse: false,
+			Runs: []VerseRun{
+				{Chapter: 1, First: 1, Last: 24},
+				{Chapter: 2, First: 1, Last: 21},
+				{Chapter: 3, First: 1, Last: 29},
+				{Chapter: 4, First: 1, Last: 31},
+				{Chapter: 5, First: 1, Last: 26},
+				{Chapter: 6, First: 1, Last: 18},
+			},
+		},
+		{
+			Name:      "Ephesians",
+			USFM:      "EPH",
+			Testament: NewTestament,
+			JustVerse: false,
+			Runs: []VerseRun{
+				{Chapter: 1, First: 1, Last: 23},
+				{Chapter: 2, First: 1, Last: 22},
+				{Chapter: 3, First: 1, Last: 21},
+				{Chapter: 4, First: 1, Last: 32},
+				{Chapter: 5, First: 1, Last: 33},
+				{Chapter: 6, First: 1, Last: 24},
+			},
+		},
+		{
+			Name:      "Philippians",
+			USFM:      "PHP",
+			Testament: NewTestament,
+			JustVerse: false,
+			Runs: []VerseRun{
+				{Chapter: 1, First: 1, Last: 30},
+				{Chapter: 2, First: 1, Last: 30},
+				{Chapter: 3, First: 1, Last: 21},
+				{Chapter: 4, First: 1, Last: 23},
+			},
+		},
+		{
+			Name:      "Colossians",
+			USFM:      "COL",
+			Testament: NewTestament,
+			JustVerse: false,
+			Runs: []VerseRun{
+				{Chapter: 1, First: 1, Last: 29},
+				{Chapter: 2, First: 1, Last: 23},
+				{Chapter: 3, First: 1, Last: 25},
+				{Chapter: 4, First: 1, Last: 18},
+			},
+		},
+		{
+			Name:      "1 Thessalonians",
+			USFM:      "1TH",
+			Testament: NewTestament,
+			JustVerse: false,
+			Runs: []VerseRun{
+				{Chapter: 1, First: 1, Last: 10},
+				{Chapter: 2, First: 1, Last: 20},
+				{Chapter: 3, First: 1, Last: 13},
+				{Chapter: 4, First: 1, Last: 18},
+				{Chapter: 5, First: 1, Last: 28},
+			},
+		},
+		{
+			Name:      "2 Thessalonians",
+			USFM:      "2TH",
+			Testament: NewTestament,
+			JustVerse: false,
+			Runs: []VerseRun{
+				{Chapter: 1, First: 1, Last: 12},
+				{Chapter: 2, First: 1, Last: 17},
+				{Chapter: 3, First: 1, Last: 18},
+			},
+		},
+		{
+			Name:      "1 Timothy",
+			USFM:      "1TI",
+			Testament: NewTestament,
+			JustVerse: false,
+			Runs: []VerseRun{
+				{Chapter: 1, First: 1, Last: 20},
+				{Chapter: 2, First: 1, Last: 15},
+				{Chapter: 3, First: 1, Last: 16},
+				{Chapter: 4, First: 1, Last: 16},
+				{Chapter: 5, First: 1, Last: 25},
+				{Chapter: 6, First: 1, Last: 21},
+			},
+		},
+		{
+			Name:      "2 Timothy",
+			USFM:      "2TI",
+			Testament: NewTestament,
+			JustVerse: false,
+			Runs: []VerseRun{
+				{Chapter: 1, First: 1, Last: 18},
+				{Chapter: 2, First: 1, Last: 26},
+				{Chapter: 3, First: 1, Last: 17},
+				{Chapter: 4, First: 1, Last: 22},
+			},
+		},
+		{
+			Name:      "Titus",
+			USFM:      "TIT",
+			Testament: NewTestament,
+			JustVerse: false,
+			Runs: []VerseRun{
+				{Chapter: 1, First: 1, Last: 16},
+				{Chapter: 2, First: 1, Last: 15},
+				{Chapter: 3, First: 1, Last: 15},
+			},
+		},
+		{
+			Name:      "Philemon",
+			USFM:      "PHM",
+			Testament: NewTestament,
+			JustVerse: true,
+			Runs: []VerseRun{
+				{Chapter: 1, First: 1, Last: 25},
+			},
+		},
+		{
+			Name:      "Hebrews",
+			USFM:      "HEB",
+			Testament: NewTestament,
+			JustVerse: false,
+			Runs: []VerseRun{
+				{Chapter: 1, First: 1, Last: 14},
+				{Chapter: 2, First: 1, Last: 18},
+				{Chapter: 3, First: 1, Last: 19},
+				{Chapter: 4, First: 1, Last: 16},
+				{Chapter: 5, First: 1, Last: 14},
+				{Chapter: 6, First: 1, Last: 20},
+				{Chapter: 7, First: 1, Last: 28},
+				{Chapter: 8, First: 1, Last: 13},
+				{Chapter: 9, First: 1, Last: 28},
+				{Chapter: 10, First: 1, Last: 39},
+				{Chapter: 11, First: 1, Last: 40},
+				{Chapter: 12, First: 1, Last: 29},
+				{Chapter: 13, First: 1, Last: 25},
+			},
+		},
+		{
+			Name:      "James",
+			USFM:      "JAS",
+			Testament: NewTestament,
+			JustVerse: false,
+			Runs: []VerseRun{
+				{Chapter: 1, First: 1, Last: 27},
+				{Chapter: 2, First: 1, Last: 26},
+				{Chapter: 3, First: 1, Last: 18},
+				{Chapter: 4, First: 1, Last: 17},
+				{Chapter: 5, First: 1, Last: 20},
+			},
+		},
+		{
+			Name:      "1 Peter",
+			USFM:      "1PE",
+			Testament: NewTestament,
+			JustVerse: false,
+			Runs: []VerseRun{
+				{Chapter: 1, First: 1, Last: 25},
+				{Chapter: 2, First: 1, Last: 25},
+				{Chapter: 3, First: 1, Last: 22},
+				{Chapter: 4, First: 1, Last: 19},
+				{Chapter: 5, First: 1, Last: 14},
+			},
+		},
+		{
+			Name:      "2 Peter",
+			USFM:      "2PE",
+			Testament: NewTestament,
+			JustVerse: false,
+			Runs: []VerseRun{
+				{Chapter: 1, First: 1, Last: 21},
+				{Chapter: 2, First: 1, Last: 22},
+				{Chapter: 3, First: 1, Last: 18},
+			},
+		},
+		{
+			Name:      "1 John",
+			USFM:      "1JN",
+			Testament: NewTestament,
+			JustVerse: false,
+			Runs: []VerseRun{
+				{Chapter: 1, First: 1, Last: 10},
+				{Chapter: 2, First: 1, Last: 29},
+				{Chapter: 3, First: 1, Last: 24},
+				{Chapter: 4, First: 1, Last: 21},
+				{Chapter: 5, First: 1, Last: 21},
+			},
+		},
+		{
+			Name:      "2 John",
+			USFM:      "2JN",
+			Testament: NewTestament,
+			JustVerse: true,
+			Runs: []VerseRun{
+				{Chapter: 1, First: 1, Last: 13},
+			},
+		},
+		{
+			Name:      "3 John",
+			USFM:      "3JN",
+			Testament: NewTestament,
+			JustVerse: true,
+			Runs: []VerseRun{
+				{Chapter: 1, First: 1, Last: 14},
+			},
+		},
+		{
+			Name:      "Jude",
+			USFM:      "JUD",
+			Testament: NewTestament,
+			JustVerse: true,
+			Runs: []VerseRun{
+				{Chapter: 1, First: 1, Last: 25},
+			},
+		},
+		{
+			Name:      "Revelation",
+			USFM:      "REV",
+			Testament: NewTestament,
+			AlternateNames: []string{
+				"The Apocalypse",
+				"Apocalypse",
+			},
+			JustVerse: false,
+			Runs: []VerseRun{
+				{Chapter: 1, First: 1, Last: 20},
+				{Chapter: 2, First: 1, Last: 29},
+				{Chapter: 3, First: 1, Last: 22},
+				{Chapter: 4, First: 1, Last: 11},
+				{Chapter: 5, First: 1, Last: 14},
+				{Chapter: 6, First: 1, Last: 17},
+				{Chapter: 7, First: 1, Last: 17},
+				{Chapter: 8, First: 1, Last: 13},
+				{Chapter: 9, First: 1, Last: 21},
+				{Chapter: 10, First: 1, Last: 11},
+				{Chapter: 11, First: 1, Last: 19},
+				{Chapter: 12, First: 1, Last: 17},
+				{Chapter: 13, First: 1, Last: 18},
+				{Chapter: 14, First: 1, Last: 20},
+				{Chapter: 15, First: 1, Last: 8},
+				{Chapter: 16, First: 1, Last: 21},
+				{Chapter: 17, First: 1, Last: 18},
+				{Chapter: 18, First: 1, Last: 24},
+				{Chapter: 19, First: 1, Last: 21},
+				{Chapter: 20, First: 1, Last: 15},
+				{Chapter: 21, First: 1, Last: 27},
+				{Chapter: 22, First: 1, Last: 21},
+			},
+		},
+	},
+	Categories: map[string][]string{
+		"Apocalyptic": {
+			"Daniel 7:1ffb",
+			"Revelation",
+			"Amos 7:1-9",
+			"Amos 8:1-13",
+			"Isaiah 24-27",
+			"Isaiah 33",
+			"Isaiah 55-56",
+			"Jeremiah 1:11-16",
+			"Ezekiel 38-39",
+			"Zechariah 9:1ffb",
+			"Joel",
+		},
+		"Deuterocanon": {
+			"Tobit",
+			"Judith",
+			"Additions to Esther",
+			"1 Maccabees",
+			"2 Maccabees",
+			"Wisdom of Solomon",
+			"Sirach",
+			"Baruch",
+			"Prayer of Azariah",
+			"Susanna",
+			"Bel and the Dragon",
+		},
+		"Epistles": {
+			"Romans",
+			"1 Corinthians",
+			"2 Corinthians",
+			"Galatians",
+			"Ephesians",
+			"Philippians",
+			"Colossians",
+			"1 Thessalonians",
+			"2 Thessalonians",
+			"1 Timothy",
+			"2 Timothy",
+			"Titus",
+			"Philemon",
+			"Hebrews",
+			"James",
+			"1 Peter",
+			"2 Peter",
+			"1 John",
+			"2 John",
+			"3 John",
+			"Jude",
+		},
+		"Gospels": {
+			"Matthew",
+			"Mark",
+			"Luke",
+			"John",
+			"Acts",
+		},
+		"History": {
+			"Joshua",
+			"Judges",
+			"Ruth",
+			"1 Samuel",
+			"2 Samuel",
+			"1 Kings",
+			"2 Kings",
+			"1 Chronicles",
+			"2 Chronicles",
+			"Ezra",
+			"Nehemiah",
+			"Esther",
+			"Tobit",
+			"Judith",
+			"Additions to Esther",
+			"1 Maccabees",
+			"2 Maccabees",
+		},
+		"Law": {
+			"Genesis",
+			"Exodus",
+			"Leviticus",
+			"Numbers",
+			"Deuteronomy",
+		},
+		"Prophets": {
+			"Isaiah",
+			"Jeremiah",
+			"Lamentations",
+			"Ezekiel",
+			"Daniel",
+			"Hosea",
+			"Joel",
+			"Amos",
+			"Obadiah",
+			"Jonah",
+			"Micah",
+			"Nahum",
+			"Habakkuk",
+			"Zephaniah",
+			"Haggai",
+			"Zechariah",
+			"Malachi",
+			"Baruch",
+			"Prayer of Azariah",
+			"Susanna",
+			"Bel and the Dragon",
+		},
+		"Wisdom": {
+			"Job",
+			"Psalms",
+			"Proverbs",
+			"Ecclesiastes",
+			"Song of Solomon",
+			"Wisdom of Solomon",
+			"Sirach",
+		},
+	},
+	Pericopes: map[string]string{
+		"A Time for Everything":                     "Ecclesiastes 3:1-15",
+		"Build Your House on the Rock":              "Matthew 7:24-27",
+		"By Faith":                                  "Hebrews 11",
+		"Cain and Abel":                             "Genesis 4:1-16",
+		"Christ's Example of Humility":              "Philippians 2:1-11",
+		"Create in Me a Clean Heart":                "Psalms 51",
+		"Crossing the Red Sea":                      "Exodus 14",
+		"Daniel and the Lions' Den":                 "Daniel 6",
+		"Daniel and the Priests of Bel":             "Bel and the Dragon 1-22",
+		"David and Bathsheba":                       "2 Samuel 11",
+		"David and Goliath":                         "1 Samuel 17",
+		"Elijah Taken to Heaven":                    "2 Kings 2:1-12",
+		"Elijah and the Prophets of Baal":           "1 Kings 18:20-40",
+		"For God So Loved the World":                "John 3:16-21",
+		"I Am the Bread of Life":                    "John 6:22-59",
+		"I Am the Good Shepherd":                    "John 10:1-21",
+		"I Am the True Vine":                        "John 15:1-17",
+		"I Am the Way, and the Truth, and the Life": "John 14:1-14",
+		"Institution of the Lord's Supper":          "Matthew 26:26-29",
+		"Isaiah's Vision of the Lord":               "Isaiah 6",
+		"Jacob Wrestles with God":                   "Genesis 32:22-32",
+		"Jesus Feeds the Five Thousand":             "Matthew 14:13-21",
+		"Jesus Prays in Gethsemane":                 "Matthew 26:36-46",
+		"Jesus Raises Lazarus":                      "John 11:38-44",
+		"Jesus Walks on the Water":                  "Matthew 14:22-33",
+		"Jesus Washes the Disciples' Feet":          "John 13:1-20",
+		"Jesus and Nicodemus":                       "John 3:1-21",
+		"Jesus and Peter":                           "John 21:15-19",
+		"Jesus and Zacchaeus":                       "Luke 19:1-10",
+		"Jesus and the Woman of Samaria":            "John 4:1-45",
+		"Jonah and the Great Fish":                  "Jonah 1:17-2:10",
+		"Joseph's Dreams":                           "Genesis 37:1-11",
+		"Martha and Mary":                           "Luke 10:38-42",
+		"Naaman Healed":                             "2 Kings 5:1-14",
+		"Nathan Rebukes David":                      "2 Samuel 12:1-15",
+		"Noah and the Flood":                        "Genesis 6:9-9:17",
+		"On the Road to Emmaus":                     "Luke 24:13-35",
+		"Paul in Athens":                            "Acts 17:16-34",
+		"Peter Confesses Jesus as the Christ":       "Matthew 16:13-20",
+		"Ruth and Naomi":                            "Ruth 1",
+		"Salt and Light":                            "Matthew 5:13-16",
+		"Samson and Delilah":                        "Judges 16:1-22",
+		"Solomon's Prayer for Wisdom":               "1 Kings 3:1-15",
+		"Susanna and the Elders":                    "Susanna 1-64",
+		"Taming the Tongue":                         "James 3:1-12",
+		"The Aaronic Blessing":                      "Numbers 6:22-27",
+		"The Baptism of Jesus":                      "Matthew 3:13-17",
+		"The Beatitudes":                            "Matthew 5:3-12",
+		"The Birth of Jesus Christ":                 "Matthew 1:18-25",
+		"The Burning Bush":                          "Exodus 3",
+		"The Call of Abram":                         "Genesis 12:1-9",
+		"The Coming of the Holy Spirit":             "Acts 2:1-13",
+		"The Conversion of Saul":                    "Acts 9:1-19",
+		"The Creation of the World":                 "Genesis 1:1-2:3",
+		"The Crucifixion":                           "Matthew 27:32-44",
+		"The Fall":                                  "Genesis 3",
+		"The Fall of Jericho":                       "Joshua 6",
+		"The Fiery Furnace":                         "Daniel 3:8-30",
+		"The Final Judgment":                        "Matthew 25:31-46",
+		"The Fruit of the Spirit":                   "Galatians 5:22-23",
+		"The Golden Calf":                           "Exodus 32",
+		"The Golden Rule":                           "Matthew 7:12",
+		"The Great Commandment":                     "Matthew 22:34-40",
+		"The Great Commission":                      "Matthew 28:16-20",
+		"The High Priestly Prayer":                  "John 17",
+		"The Lord Calls Samuel":                     "1 Samuel 3",
+		"The Lord Is My Shepherd":                   "Psalms 23",
+		"The Lord Restores Job":                     "Job 42:10-17",
+		"The Lord Speaks to Elijah":                 "1 Kings 19:9-18",
+		"The Lord's Prayer":                         "Matthew 6:9-13",
+		"The Magnificat":                            "Luke 1:46-55",
+		"The New Covenant":                          "Jeremiah 31:31-34",
+		"The New Heaven and the New Earth":          "Revelation 21:1-8",
+		"The Parable of the Good Samaritan":         "Luke 10:25-37",
+		"The Parable of the Lost Coin":              "Luke 15:8-10",
+		"The Parable of the Lost Sheep":             "Luke 15:1-7",
+		"The Parable of the Mustard Seed":           "Matthew 13:31-32",
+		"The Parable of the Prodigal Son":           "Luke 15:11-32",
+		"The Parable of the Rich Fool":              "Luke 12:13-21",
+		"The Parable of the Sower":                  "Matthew 13:1-9",
+		"The Parable of the Talents":                "Matthew 25:14-30",
+		"The Parable of the Ten Virgins":            "Matthew 25:1-13",
+		"The Passover":                              "Exodus 12:1-28",
+		"The Resurrection":                          "Matthew 28:1-10",
+		"The Rich Man and Lazarus":                  "Luke 16:19-31",
+		"The Sacrifice of Isaac":                    "Genesis 22:1-19",
+		"The Sermon on the Mount":                   "Matthew 5-7",
+		"The Shema":                                 "Deuteronomy 6:4-9",
+		"The Shepherds and the Angels":              "Luke 2:8-21",
+		"The Sign of the Fleece":                    "Judges 6:36-40",
+		"The Song of the Three Young Men":           "Prayer of Azariah 28-68",
+		"The Songs of Ascents":                      "Psalms 120-134",
+		"The Suffering Servant":                     "Isaiah 52:13-53:12",
+		"The Temptation of Jesus":                   "Matthew 4:1-11",
+		"The Ten Commandments":                      "Exodus 20:1-17",
+		"The Tower of Babel":                        "Genesis 11:1-9",
+		"The Transfiguration":                       "Matthew 17:1-13",
+		"The Triumphal Entry":                       "Matthew 21:1-11",
+		"The Valley of Dry Bones":                   "Ezekiel 37:1-14",
+		"The Visit of the Wise Men":                 "Matthew 2:1-12",
+		"The Way of Love":                           "1 Corinthians 13",
+		"The Wedding at Cana":                       "John 2:1-12",
+		"The Whole Armor of God":                    "Ephesians 6:10-20",
+		"The Woman Caught in Adultery":              "John 7:53-8:11",
+		"The Woman Who Fears the Lord":              "Proverbs 31:10-31",
+		"The Word Became Flesh":                     "John 1:1-18",
+		"You Must Be Born Again":                    "John 3:1-15",
+	},
+	OmittedVerses: map[string][]string{
+		"ESV": {
+			"Matthew 17:21",
+			"Matthew 18:11",
+			"Matthew 23:14",
+			"Mark 7:16",
+			"Mark 9:44",
+			"Mark 9:46",
+			"Mark 11:26",
+			"Mark 15:28",
+			"Luke 17:36",
+			"Luke 23:17",
+			"John 5:4",
+			"Acts 8:37",
+			"Acts 15:34",
+			"Acts 24:7",
+			"Acts 28:29",
+			"Romans 16:24",
+		},
+	},
+}
@@ -14,6 +14,21 @@ func TestCanonical(t *testing.T) {
 	assert.Len(t, ref.Canonical.Books, 66)
 }
 
+func TestCanonical_USFM(t *testing.T) {
+	t.Parallel()
+
+	seen := map[string]bool{}
+	for _, b := range ref.Canonical.Books {
+		assert.Len(t, b.USFM, 3, b.Name)
+		assert.False(t, seen[b.USFM], "duplicate USFM code %s", b.USFM)
+		seen[b.USFM] = true
+	}
+
+	g, err := ref.Canonical.Book("1 Corinthians")
+	assert.NoError(t, err)
+	assert.Equal(t, "1CO", g.USFM)
+}
+
 func TestCanonicalBook(t *testing.T) {
 	t.Parallel()
 
@@ -22,9 +37,9 @@ func TestCanonicalBook(t *testing.T) {
 	assert.Equal(t, "Genesis", g.Name)
 	assert.False(t, g.JustVerse)
 
-	first := g.Verses[0]
+	first := g.FirstVerse()
 	assert.Equal(t, (ref.CV{Chapter: 1, Verse: 1}).Ref(), first.Ref())
 
-	last := g.Verses[len(g.Verses)-1]
+	last := g.LastVerse()
 	assert.Equal(t, (ref.CV{Chapter: 50, Verse: 26}).Ref(), last.Ref())
 }
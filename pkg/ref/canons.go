@@ -0,0 +1,289 @@
+package ref
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Canons lists the built-in canons by name.
+var Canons = map[string]*Canon{
+	"protestant": Canonical,
+	"catholic":   CatholicCanon,
+	"orthodox":   OrthodoxCanon,
+}
+
+// GetCanon returns the named canon from Canons. It returns ErrNotFound if there
+// is no such canon.
+func GetCanon(name string) (*Canon, error) {
+	if c, ok := Canons[name]; ok {
+		return c, nil
+	}
+	return nil, fmt.Errorf("%w: canon %q", ErrNotFound, name)
+}
+
+// CanonNames returns the names of the built-in canons, sorted.
+func CanonNames() []string {
+	names := make([]string, 0, len(Canons))
+	for name := range Canons {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// ErrBadCanon is returned when a canon loaded by LoadCanon is invalid.
+var ErrBadCanon = errors.New("bad canon")
+
+type bookFileConfig struct {
+	Name           string      `json:"name" yaml:"name"`
+	USFM           string      `json:"usfm,omitempty" yaml:"usfm,omitempty"`
+	Testament      Testament   `json:"testament,omitempty" yaml:"testament,omitempty"`
+	AlternateNames []string    `json:"alternate_names,omitempty" yaml:"alternate_names,omitempty"`
+	Verses         []versePair `json:"verses" yaml:"verses"`
+}
+
+// versePair is the chapter and verse numbers of a verse in a canon file. It is
+// written to YAML in the flow style (e.g., "[1, 1]").
+type versePair []int
+
+// MarshalYAML writes the pair in the flow style.
+func (p versePair) MarshalYAML() (any, error) {
+	n := &yaml.Node{}
+	if err := n.Encode([]int(p)); err != nil {
+		return nil, err
+	}
+	n.Style = yaml.FlowStyle
+	return n, nil
+}
+
+type canonFileConfig struct {
+	Name          string              `json:"name,omitempty" yaml:"name,omitempty"`
+	Versification string              `json:"versification,omitempty" yaml:"versification,omitempty"`
+	Books         []bookFileConfig    `json:"books" yaml:"books"`
+	Categories    map[string][]string `json:"categories,omitempty" yaml:"categories,omitempty"`
+	Subcategories map[string][]string `json:"subcategories,omitempty" yaml:"subcategories,omitempty"`
+	Pericopes     map[string]string   `json:"pericopes,omitempty" yaml:"pericopes,omitempty"`
+	OmittedVerses map[string][]string `json:"omitted_verses,omitempty" yaml:"omitted_verses,omitempty"`
+}
+
+// LoadCanon reads a canon from JSON or YAML. This uses the same format as the
+// esv.json database and the categories.yaml file used to generate Canonical,
+// combined into one document with a few optional additions:
+//
+//	name: My Canon
+//	versification: kjv
+//	books:
+//	  - name: Genesis
+//	    usfm: GEN
+//	    testament: Old Testament
+//	    alternate_names: [Bereshit]
+//	    verses: [[1, 1], [1, 2], [1, 3]]
+//	  - name: Jude
+//	    verses: [[0, 1], [0, 2], [0, 3]]
+//	categories:
+//	  Law:
+//	    - Genesis
+//	subcategories:
+//	  Old Testament:
+//	    - Law
+//	pericopes:
+//	  In the Beginning: Genesis 1:1-3
+//	omitted_verses:
+//	  ESV: [Matthew 17:21]
+//
+// Each verse is a pair of chapter and verse numbers. Books without chapters
+// use chapter 0 for every verse. The verses of each book must be in ascending
+// order, the book names must be unique, every category reference and omitted
+// verse must resolve against the canon, every subcategory must name a
+// category, and every pericope must be a single range of the canon. A book may
+// set a usfm code, but the USFM code of the book in Abbreviations is used if it
+// does not. The testament of a book, if given, must be "Old Testament" or "New
+// Testament". The versification defaults to "kjv".
+//
+// It returns an error matching ErrBadCanon if the canon is invalid.
+func LoadCanon(r io.Reader) (*Canon, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+
+	var cfg canonFileConfig
+	if json.Valid(data) {
+		err = json.Unmarshal(data, &cfg)
+	} else {
+		err = yaml.Unmarshal(data, &cfg)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := GetVersification(cfg.Versification); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrBadCanon, err)
+	}
+
+	c := &Canon{
+		Name:          cfg.Name,
+		Versification: cfg.Versification,
+		Books:         make([]Book, 0, len(cfg.Books)),
+		Categories:    cfg.Categories,
+		Subcategories: cfg.Subcategories,
+		Pericopes:     cfg.Pericopes,
+		OmittedVerses: cfg.OmittedVerses,
+	}
+
+	if c.Versification == "" {
+		c.Versification = EnglishVersification.Name
+	}
+
+	if c.Categories == nil {
+		c.Categories = map[string][]string{}
+	}
+
+	seen := make(map[string]bool, len(cfg.Books))
+	for i := range cfg.Books {
+		b, err := cfg.Books[i].book()
+		if err != nil {
+			return nil, err
+		}
+
+		if seen[b.Name] {
+			return nil, fmt.Errorf("%w: book %q is listed more than once", ErrBadCanon, b.Name)
+		}
+		seen[b.Name] = true
+
+		c.Books = append(c.Books, b)
+	}
+
+	if err := c.checkCategories(); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrBadCanon, err)
+	}
+
+	for title, sr := range c.Pericopes {
+		if _, err := Lookup(c, sr, title); err != nil {
+			return nil, fmt.Errorf("%w: pericope %q: %w", ErrBadCanon, title, err)
+		}
+	}
+
+	if _, err := c.Omissions(""); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrBadCanon, err)
+	}
+
+	return c, nil
+}
+
+// book returns the book described by this configuration, recording the verses
+// as runs.
+func (bc *bookFileConfig) book() (Book, error) {
+	if bc.Name == "" {
+		return Book{}, fmt.Errorf("%w: book is missing a name", ErrBadCanon)
+	}
+
+	if len(bc.Verses) == 0 {
+		return Book{}, fmt.Errorf("%w: book %q has no verses", ErrBadCanon, bc.Name)
+	}
+
+	switch bc.Testament {
+	case "", OldTestament, NewTestament:
+	default:
+		return Book{}, fmt.Errorf("%w: book %q has unknown testament %q", ErrBadCanon, bc.Name, bc.Testament)
+	}
+
+	b := Book{
+		Name:           bc.Name,
+		USFM:           bc.USFM,
+		Testament:      bc.Testament,
+		AlternateNames: bc.AlternateNames,
+		JustVerse:      len(bc.Verses[0]) == 2 && bc.Verses[0][0] == 0,
+		Runs:           make([]VerseRun, 0, len(bc.Verses)),
+	}
+
+	if b.USFM == "" {
+		b.USFM = defaultUSFM(b.Name)
+	}
+
+	prevChapter, prevVerse := -1, 0
+	for _, cv := range bc.Verses {
+		if len(cv) != 2 {
+			return Book{}, fmt.Errorf("%w: book %q has verse %v, which is not a chapter and verse pair", ErrBadCanon, bc.Name, cv)
+		}
+
+		chapter, verse := cv[0], cv[1]
+		switch {
+		case verse < 1 || chapter < 0:
+			return Book{}, fmt.Errorf("%w: book %q has bad verse %d:%d", ErrBadCanon, bc.Name, chapter, verse)
+		case b.JustVerse != (chapter == 0):
+			return Book{}, fmt.Errorf("%w: book %q mixes verses with and without chapters", ErrBadCanon, bc.Name)
+		case chapter < prevChapter || (chapter == prevChapter && verse <= prevVerse):
+			return Book{}, fmt.Errorf("%w: book %q has verse %d:%d out of order", ErrBadCanon, bc.Name, chapter, verse)
+		}
+		prevChapter, prevVerse = chapter, verse
+
+		if b.JustVerse {
+			chapter = 1
+		}
+
+		b.Runs = appendRun(b.Runs, chapter, verse)
+	}
+
+	return b, nil
+}
+
+// Export writes the canon as JSON in the format read by LoadCanon.
+func (c *Canon) Export(w io.Writer) error {
+	return json.NewEncoder(w).Encode(c.fileConfig())
+}
+
+// ExportYAML writes the canon as YAML in the format read by LoadCanon. The
+// chapter and verse pair of each verse is written on one line (e.g., "[1, 1]").
+func (c *Canon) ExportYAML(w io.Writer) error {
+	enc := yaml.NewEncoder(w)
+	enc.SetIndent(2)
+	if err := enc.Encode(c.fileConfig()); err != nil {
+		return err
+	}
+	return enc.Close()
+}
+
+// fileConfig returns the canon in the format read by LoadCanon.
+func (c *Canon) fileConfig() *canonFileConfig {
+	cfg := &canonFileConfig{
+		Name:          c.Name,
+		Versification: c.Versification,
+		Books:         make([]bookFileConfig, 0, len(c.Books)),
+		Categories:    c.Categories,
+		Subcategories: c.Subcategories,
+		Pericopes:     c.Pericopes,
+		OmittedVerses: c.OmittedVerses,
+	}
+
+	for i := range c.Books {
+		b := &c.Books[i]
+		bc := bookFileConfig{
+			Name:           b.Name,
+			USFM:           b.USFM,
+			Testament:      b.Testament,
+			AlternateNames: b.AlternateNames,
+			Verses:         make([]versePair, 0, b.VerseCount()),
+		}
+
+		for _, r := range b.verseRuns() {
+			chapter := r.Chapter
+			if b.JustVerse {
+				chapter = 0
+			}
+
+			for verse := r.First; verse <= r.Last; verse++ {
+				bc.Verses = append(bc.Verses, versePair{chapter, verse})
+			}
+		}
+
+		cfg.Books = append(cfg.Books, bc)
+	}
+
+	return cfg
+}
@@ -0,0 +1,363 @@
+package ref_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zostay/today/pkg/ref"
+)
+
+func TestGetCanon(t *testing.T) {
+	t.Parallel()
+
+	c, err := ref.GetCanon("catholic")
+	assert.NoError(t, err)
+	assert.Same(t, ref.CatholicCanon, c)
+
+	c, err = ref.GetCanon("protestant")
+	assert.NoError(t, err)
+	assert.Same(t, ref.Canonical, c)
+
+	c, err = ref.GetCanon("mormon")
+	assert.ErrorIs(t, err, ref.ErrNotFound)
+	assert.Nil(t, c)
+
+	assert.Equal(t, []string{"catholic", "orthodox", "protestant"}, ref.CanonNames())
+}
+
+func TestCanons(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		books int
+		first string
+		last  string
+	}{
+		{"protestant", 66, "Genesis", "Revelation"},
+		{"catholic", 77, "Genesis", "Revelation"},
+		{"orthodox", 81, "Genesis", "Revelation"},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			c, err := ref.GetCanon(tt.name)
+			require.NoError(t, err)
+
+			assert.Len(t, c.Books, tt.books)
+			assert.Equal(t, tt.first, c.Books[0].Name)
+			assert.Equal(t, tt.last, c.Books[len(c.Books)-1].Name)
+
+			seen := map[string]bool{}
+			for _, b := range c.Books {
+				assert.NotEmpty(t, b.Runs, b.Name)
+				assert.Len(t, b.USFM, 3, b.Name)
+				assert.NotEmpty(t, b.Testament, b.Name)
+				assert.False(t, seen[b.Name], "duplicate book %s", b.Name)
+				seen[b.Name] = true
+			}
+
+			// every category resolves in its canon
+			for name := range c.Categories {
+				ps, err := c.Category(name)
+				assert.NoError(t, err, name)
+				assert.NotEmpty(t, ps, name)
+			}
+
+			// every pericope resolves in its canon
+			assert.NotEmpty(t, c.Pericopes)
+			for title, sr := range c.Pericopes {
+				_, err := ref.Lookup(c, sr, title)
+				assert.NoError(t, err, title)
+			}
+		})
+	}
+}
+
+func TestCatholicCanon(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		in       string
+		expected string
+	}{
+		{"Tobit 1:1", "Tobit 1:1"},
+		{"Sir 51", "Sirach 51"},
+		{"Wis 3:1-9", "Wisdom of Solomon 3:1-9"},
+		{"Baruch 6", "Baruch 6"},
+		{"1 Macc 1:1-2:70", "1 Maccabees 1-2"},
+		{"Sus 1-5", "Susanna 1-5"},
+		{"Add Esth 10:4-13", "Additions to Esther 10:4-13"},
+		{"Additions to Esther", "Additions to Esther"},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.in, func(t *testing.T) {
+			t.Parallel()
+
+			p, err := ref.ParseProper(tt.in)
+			require.NoError(t, err)
+
+			rs, err := ref.CatholicCanon.Resolve(p, ref.WithAbbreviations(ref.Abbreviations))
+			require.NoError(t, err)
+			require.Len(t, rs, 1)
+
+			got, err := rs[0].CompactRef()
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, got)
+		})
+	}
+
+	b, err := ref.CatholicCanon.Book("Baruch")
+	require.NoError(t, err)
+	assert.Equal(t, ref.CV{Chapter: 6, Verse: 73}, b.LastVerse())
+
+	_, err = ref.CatholicCanon.Book("Prayer of Manasseh")
+	assert.ErrorIs(t, err, ref.ErrNotFound)
+
+	p, err := ref.ParseProper("Tobit 1:1")
+	require.NoError(t, err)
+
+	_, err = ref.Canonical.Resolve(p)
+	assert.ErrorIs(t, err, ref.ErrNotFound)
+}
+
+func TestOrthodoxCanon_Psalm151Categories(t *testing.T) {
+	t.Parallel()
+
+	ps, err := ref.OrthodoxCanon.Book("Psalms")
+	require.NoError(t, err)
+
+	for _, tt := range []struct {
+		category string
+		psalm23  bool
+	}{
+		{"Deuterocanon", false},
+		{"Wisdom", true},
+	} {
+		pericopes, err := ref.OrthodoxCanon.Category(tt.category)
+		require.NoError(t, err)
+
+		rs := make([]ref.Resolved, 0, len(pericopes))
+		for _, p := range pericopes {
+			rs = append(rs, *p.Ref)
+		}
+
+		set, err := ref.OrthodoxCanon.Set(rs...)
+		require.NoError(t, err)
+
+		assert.True(t, set.Contains(ps, ref.CV{Chapter: 151, Verse: 1}), tt.category)
+		assert.True(t, set.Contains(ps, ref.CV{Chapter: 151, Verse: 7}), tt.category)
+		assert.Equal(t, tt.psalm23, set.Contains(ps, ref.CV{Chapter: 23, Verse: 1}), tt.category)
+	}
+}
+
+func TestOrthodoxCanon(t *testing.T) {
+	t.Parallel()
+
+	ps, err := ref.OrthodoxCanon.Book("Psalms")
+	require.NoError(t, err)
+	assert.Equal(t, ref.CV{Chapter: 151, Verse: 7}, ps.LastVerse())
+
+	b, err := ref.OrthodoxCanon.Book("Baruch")
+	require.NoError(t, err)
+	assert.Equal(t, ref.CV{Chapter: 5, Verse: 9}, b.LastVerse())
+
+	pm, err := ref.OrthodoxCanon.Book("Prayer of Manasseh")
+	require.NoError(t, err)
+	assert.True(t, pm.JustVerse)
+	assert.Equal(t, ref.N{Number: 15}, pm.LastVerse())
+
+	// Psalm 151 is only in the Orthodox canon
+	cps, err := ref.Canonical.Book("Psalms")
+	require.NoError(t, err)
+	assert.Equal(t, ref.CV{Chapter: 150, Verse: 6}, cps.LastVerse())
+}
+
+func TestCanon_Export(t *testing.T) {
+	t.Parallel()
+
+	for _, name := range ref.CanonNames() {
+		name := name
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			c, err := ref.GetCanon(name)
+			require.NoError(t, err)
+
+			var buf bytes.Buffer
+			require.NoError(t, c.Export(&buf))
+
+			loaded, err := ref.LoadCanon(&buf)
+			require.NoError(t, err)
+			assert.Equal(t, c, loaded)
+
+			buf.Reset()
+			require.NoError(t, c.ExportYAML(&buf))
+			assert.Contains(t, buf.String(), "\n      - [1, 1]\n")
+
+			loaded, err = ref.LoadCanon(&buf)
+			require.NoError(t, err)
+			assert.Equal(t, c, loaded)
+		})
+	}
+}
+
+func TestLoadCanon(t *testing.T) {
+	t.Parallel()
+
+	c, err := ref.LoadCanon(strings.NewReader(`
+name: Tiny Canon
+books:
+  - name: Ruth
+    verses: [[1, 1], [1, 2], [1, 3], [2, 1], [2, 2], [2, 4]]
+  - name: Jude
+    usfm: JDE
+    verses: [[0, 1], [0, 2], [0, 3]]
+categories:
+  Short:
+    - Ruth 1
+    - Jude
+subcategories:
+  Everything:
+    - Short
+`))
+	require.NoError(t, err)
+
+	assert.Equal(t, "Tiny Canon", c.Name)
+	assert.Equal(t, "kjv", c.Versification)
+	assert.Equal(t, []ref.Book{
+		{
+			Name: "Ruth",
+			USFM: "RUT",
+			Runs: []ref.VerseRun{
+				{Chapter: 1, First: 1, Last: 3},
+				{Chapter: 2, First: 1, Last: 2},
+				{Chapter: 2, First: 4, Last: 4},
+			},
+		},
+		{
+			Name:      "Jude",
+			USFM:      "JDE",
+			JustVerse: true,
+			Runs:      []ref.VerseRun{{Chapter: 1, First: 1, Last: 3}},
+		},
+	}, c.Books)
+
+	ps, err := c.Category("Short")
+	require.NoError(t, err)
+	require.Len(t, ps, 2)
+	cs, err := ps[0].Ref.CompactRef()
+	assert.NoError(t, err)
+	assert.Equal(t, "Ruth 1", cs)
+
+	ps, err = c.Category("Everything")
+	require.NoError(t, err)
+	assert.Len(t, ps, 2)
+
+	// JSON, as in esv.json
+	c, err = ref.LoadCanon(strings.NewReader(`{"books":[{"name":"Obadiah","verses":[[1,1],[1,2]]}]}`))
+	require.NoError(t, err)
+	assert.Len(t, c.Books, 1)
+	assert.Equal(t, "OBA", c.Books[0].USFM)
+}
+
+func TestLoadCanon_OwnBooks(t *testing.T) {
+	t.Parallel()
+
+	c, err := ref.LoadCanon(strings.NewReader(`
+name: With Enoch
+books:
+  - name: Genesis
+    verses: [[1, 1], [1, 2]]
+  - name: Enoch
+    alternate_names: [1 Enoch]
+    verses: [[1, 1], [1, 2], [1, 3], [2, 1]]
+categories:
+  Apocalyptic: [Enoch 1]
+`))
+	require.NoError(t, err)
+
+	ps, err := c.Category("Apocalyptic")
+	require.NoError(t, err)
+	require.Len(t, ps, 1)
+	assert.Equal(t, "Enoch", ps[0].Ref.Book.Name)
+	assert.Equal(t, ref.CV{Chapter: 1, Verse: 1}, ps[0].Ref.First)
+	assert.Equal(t, ref.CV{Chapter: 1, Verse: 3}, ps[0].Ref.Last)
+
+	b, err := c.Book("enoch", ref.WithAbbreviations(ref.Abbreviations))
+	require.NoError(t, err)
+	assert.Equal(t, "Enoch", b.Name)
+
+	abbrs := ref.Abbreviations.WithCanon(c)
+
+	tests := []struct {
+		in     string
+		expect string
+	}{
+		{"Enoch 1:2", "Enoch 1:2"},
+		{"1 Enoch 2", "Enoch 2:1"},
+		{"Gen 1:2; Enoch 1:3", "Genesis 1:2; Enoch 1:3"},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.in, func(t *testing.T) {
+			t.Parallel()
+
+			m, err := ref.ParseMultiple(tt.in, ref.ParseWithAbbreviations(abbrs))
+			require.NoError(t, err)
+
+			rs, err := c.Resolve(m, ref.WithAbbreviations(abbrs))
+			require.NoError(t, err)
+			var out []string
+			for i := range rs {
+				out = append(out, rs[i].Ref())
+			}
+			assert.Equal(t, tt.expect, strings.Join(out, "; "))
+		})
+	}
+}
+
+func TestLoadCanon_Errors(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		in   string
+	}{
+		{"no name", `books: [{verses: [[1, 1]]}]`},
+		{"no verses", `books: [{name: Ruth}]`},
+		{"not a pair", `books: [{name: Ruth, verses: [[1, 1, 1]]}]`},
+		{"verse zero", `books: [{name: Ruth, verses: [[1, 0]]}]`},
+		{"descending", `books: [{name: Ruth, verses: [[1, 2], [1, 1]]}]`},
+		{"repeated", `books: [{name: Ruth, verses: [[1, 1], [1, 1]]}]`},
+		{"mixed chapters", `books: [{name: Jude, verses: [[0, 1], [1, 2]]}]`},
+		{"duplicate book", `books: [{name: Ruth, verses: [[1, 1]]}, {name: Ruth, verses: [[1, 1]]}]`},
+		{"bad category", `{books: [{name: Ruth, verses: [[1, 1]]}], categories: {Law: [Genesis]}}`},
+		{"bad versification", `{versification: nrsv, books: [{name: Ruth, verses: [[1, 1]]}]}`},
+		{"undefined subcategory", `{books: [{name: Ruth, verses: [[1, 1]]}], subcategories: {Old Testament: [Law]}}`},
+		{"bad testament", `books: [{name: Ruth, testament: Apocrypha, verses: [[1, 1]]}]`},
+		{"bad pericope", `{books: [{name: Ruth, verses: [[1, 1]]}], pericopes: {Boaz: Ruth 2}}`},
+		{"bad omitted verse", `{books: [{name: Ruth, verses: [[1, 1]]}], omitted_verses: {ESV: [Ruth 1:2]}}`},
+		{"subcategory cycle", `{books: [{name: Ruth, verses: [[1, 1]]}], subcategories: {A: [B], B: [A]}}`},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := ref.LoadCanon(strings.NewReader(tt.in))
+			assert.ErrorIs(t, err, ref.ErrBadCanon)
+		})
+	}
+}
@@ -0,0 +1,274 @@
+package ref
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"sort"
+
+	"gopkg.in/yaml.v3"
+)
+
+// ErrBadCategories is returned when the categories loaded by LoadCategories
+// are invalid or cannot be merged into a canon.
+var ErrBadCategories = errors.New("bad categories")
+
+// Categories is a set of user-defined categories, which may be merged into a
+// canon with Canon.MergeCategories.
+type Categories struct {
+	// Refs maps each category name to the references it lists.
+	Refs map[string][]string
+
+	// Subcategories maps each category name to the names of the categories
+	// nested within it, in the order they were listed.
+	Subcategories map[string][]string
+}
+
+// LoadCategories reads categories from JSON or YAML. This uses the same format
+// as the categories.yaml file used to generate Canonical, except that a
+// category may nest other categories in place of or in addition to its
+// references:
+//
+//	categories:
+//	  Advent Readings:
+//	    - Isaiah 9:2-7
+//	    - Luke 1:26-38
+//	  Psalms of Ascent:
+//	    - Psalms 120-134
+//	  Old Testament:
+//	    Law:
+//	    History:
+//	    Prophets:
+//	      - Major Prophets:
+//	          - Isaiah
+//	          - Jeremiah
+//	      - Minor Prophets:
+//	          - Hosea
+//	          - Joel
+//
+// A category given as a list holds the references listed as strings and the
+// categories listed as mappings. A category given as a mapping only nests
+// other categories. A category with no value, like Law and History above,
+// nests a category that is already defined, usually by the canon. A category
+// that lists no references of its own, like Prophets above, keeps whatever
+// references the canon already gives it.
+//
+// It returns an error matching ErrBadCategories if the file is malformed. The
+// references are not checked until the categories are merged into a canon.
+func LoadCategories(r io.Reader) (*Categories, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+
+	// YAML is a superset of JSON, so this reads either
+	var cfg struct {
+		Categories yaml.Node `yaml:"categories"`
+	}
+	if err := yaml.Unmarshal(data, &cfg); err != nil {
+		return nil, err
+	}
+
+	cats := &Categories{
+		Refs:          map[string][]string{},
+		Subcategories: map[string][]string{},
+	}
+
+	top := &cfg.Categories
+	if top.Kind == 0 {
+		return cats, nil
+	}
+
+	if top.Kind != yaml.MappingNode {
+		return nil, fmt.Errorf("%w: line %d: categories must be a mapping of names to categories", ErrBadCategories, top.Line)
+	}
+
+	if err := cats.addChildren("", top); err != nil {
+		return nil, err
+	}
+
+	return cats, nil
+}
+
+// addChildren adds each category of the mapping node as a subcategory of the
+// named parent. An empty parent adds top-level categories.
+func (cats *Categories) addChildren(parent string, node *yaml.Node) error {
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		key, value := node.Content[i], node.Content[i+1]
+		if key.Kind != yaml.ScalarNode || key.Value == "" {
+			return fmt.Errorf("%w: line %d: category name must be a string", ErrBadCategories, key.Line)
+		}
+
+		if parent != "" {
+			cats.Subcategories[parent] = append(cats.Subcategories[parent], key.Value)
+		}
+
+		if err := cats.add(key.Value, value); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// add records the category with the given name as described by the node.
+func (cats *Categories) add(name string, node *yaml.Node) error {
+	switch {
+	case node.Kind == yaml.ScalarNode && node.Tag == "!!null":
+		return nil
+
+	case node.Kind == yaml.MappingNode:
+		return cats.addChildren(name, node)
+
+	case node.Kind != yaml.SequenceNode:
+		return fmt.Errorf("%w: line %d: category %q must be a list or a mapping", ErrBadCategories, node.Line, name)
+	}
+
+	var refs []string
+	for _, item := range node.Content {
+		switch item.Kind {
+		case yaml.ScalarNode:
+			refs = append(refs, item.Value)
+		case yaml.MappingNode:
+			if err := cats.addChildren(name, item); err != nil {
+				return err
+			}
+		default:
+			return fmt.Errorf("%w: line %d: category %q must list references or categories", ErrBadCategories, item.Line, name)
+		}
+	}
+
+	if len(refs) == 0 {
+		return nil
+	}
+
+	if _, defined := cats.Refs[name]; defined {
+		return fmt.Errorf("%w: line %d: category %q is defined more than once", ErrBadCategories, node.Line, name)
+	}
+	cats.Refs[name] = refs
+
+	return nil
+}
+
+// MergeCategories returns a new canon with the given categories added. The
+// references of a category replace those of the canon category with the same
+// name and the subcategories are added to those it already has.
+//
+// It returns an error matching ErrBadCategories if a reference does not
+// resolve against the canon, a subcategory is not defined, or a category
+// contains itself.
+func (c *Canon) MergeCategories(cats *Categories) (*Canon, error) {
+	newC := c.Clone()
+
+	for name, refs := range cats.Refs {
+		newC.Categories[name] = make([]string, len(refs))
+		copy(newC.Categories[name], refs)
+	}
+
+	for parent, subs := range cats.Subcategories {
+		for _, sub := range subs {
+			if !slices.Contains(newC.Subcategories[parent], sub) {
+				newC.Subcategories[parent] = append(newC.Subcategories[parent], sub)
+			}
+		}
+	}
+
+	if err := newC.checkCategories(); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrBadCategories, err)
+	}
+
+	return newC, nil
+}
+
+// HasCategory returns true if the canon defines the named category, either
+// with references or with subcategories.
+func (c *Canon) HasCategory(name string) bool {
+	_, hasRefs := c.Categories[name]
+	_, hasSubs := c.Subcategories[name]
+	return hasRefs || hasSubs
+}
+
+// CategoryNames returns the names of all the categories of the canon, including
+// subcategories, sorted.
+func (c *Canon) CategoryNames() []string {
+	names := make([]string, 0, len(c.Categories)+len(c.Subcategories))
+	for name := range c.Categories {
+		names = append(names, name)
+	}
+	for name := range c.Subcategories {
+		if _, hasRefs := c.Categories[name]; !hasRefs {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+// TopCategories returns the names of the categories of the canon that are not
+// a subcategory of any other category, sorted.
+func (c *Canon) TopCategories() []string {
+	nested := map[string]bool{}
+	for _, subs := range c.Subcategories {
+		for _, sub := range subs {
+			nested[sub] = true
+		}
+	}
+
+	var names []string
+	for _, name := range c.CategoryNames() {
+		if !nested[name] {
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
+// checkCategories returns an error if a subcategory is not defined, a category
+// contains itself, or a category reference does not resolve.
+func (c *Canon) checkCategories() error {
+	for parent, subs := range c.Subcategories {
+		for _, sub := range subs {
+			if !c.HasCategory(sub) {
+				return fmt.Errorf("category %q has undefined subcategory %q", parent, sub)
+			}
+		}
+	}
+
+	// depth-first search for a subcategory that leads back to an ancestor
+	const (
+		visiting = 1
+		done     = 2
+	)
+	state := map[string]int{}
+	var visit func(name string) error
+	visit = func(name string) error {
+		switch state[name] {
+		case visiting:
+			return fmt.Errorf("category %q contains itself", name)
+		case done:
+			return nil
+		}
+
+		state[name] = visiting
+		for _, sub := range c.Subcategories[name] {
+			if err := visit(sub); err != nil {
+				return err
+			}
+		}
+		state[name] = done
+		return nil
+	}
+
+	for _, name := range c.CategoryNames() {
+		if err := visit(name); err != nil {
+			return err
+		}
+
+		if _, err := c.Category(name); err != nil {
+			return fmt.Errorf("category %q: %w", name, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,164 @@
+package ref_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zostay/today/pkg/ref"
+)
+
+const testCategories = `
+categories:
+  Advent Readings:
+    - Isaiah 9:2-7
+    - Luke 1:26-38
+  Psalms of Ascent:
+    - Psalms 120-134
+  Old Testament:
+    Law:
+    History:
+    Prophets:
+      - Minor Prophets:
+          - Hosea
+          - Joel
+`
+
+func TestLoadCategories(t *testing.T) {
+	t.Parallel()
+
+	cats, err := ref.LoadCategories(strings.NewReader(testCategories))
+	require.NoError(t, err)
+
+	assert.Equal(t, map[string][]string{
+		"Advent Readings":  {"Isaiah 9:2-7", "Luke 1:26-38"},
+		"Psalms of Ascent": {"Psalms 120-134"},
+		"Minor Prophets":   {"Hosea", "Joel"},
+	}, cats.Refs)
+	assert.Equal(t, map[string][]string{
+		"Old Testament": {"Law", "History", "Prophets"},
+		"Prophets":      {"Minor Prophets"},
+	}, cats.Subcategories)
+
+	// JSON
+	cats, err = ref.LoadCategories(strings.NewReader(`{"categories":{"Short":["Jude"],"All":{"Short":null}}}`))
+	require.NoError(t, err)
+	assert.Equal(t, map[string][]string{"Short": {"Jude"}}, cats.Refs)
+	assert.Equal(t, map[string][]string{"All": {"Short"}}, cats.Subcategories)
+}
+
+func TestLoadCategories_Errors(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		in   string
+	}{
+		{"not a mapping", `categories: [Genesis]`},
+		{"scalar category", `categories: {Law: Genesis}`},
+		{"nested list", `categories: {Law: [[Genesis]]}`},
+		{"defined twice", `categories: {Law: [Genesis], Old Testament: [{Law: [Exodus]}]}`},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := ref.LoadCategories(strings.NewReader(tt.in))
+			assert.ErrorIs(t, err, ref.ErrBadCategories)
+		})
+	}
+}
+
+func TestCanon_MergeCategories(t *testing.T) {
+	t.Parallel()
+
+	cats, err := ref.LoadCategories(strings.NewReader(testCategories))
+	require.NoError(t, err)
+
+	c, err := ref.Canonical.MergeCategories(cats)
+	require.NoError(t, err)
+
+	// the original canon is untouched
+	assert.False(t, ref.Canonical.HasCategory("Old Testament"))
+	assert.Empty(t, ref.Canonical.Subcategories)
+
+	assert.True(t, c.HasCategory("Old Testament"))
+	assert.True(t, c.HasCategory("Minor Prophets"))
+	assert.False(t, c.HasCategory("Major Prophets"))
+
+	assert.Equal(t, []string{
+		"Advent Readings",
+		"Apocalyptic",
+		"Epistles",
+		"Gospels",
+		"Old Testament",
+		"Psalms of Ascent",
+		"Wisdom",
+	}, c.TopCategories())
+
+	ps, err := c.Category("Psalms of Ascent")
+	require.NoError(t, err)
+	require.Len(t, ps, 1)
+	assert.Equal(t, "Psalms 120:1-134:3", ps[0].Ref.Ref())
+
+	// Prophets keeps the books of the canon and adds Minor Prophets
+	prophets, err := ref.Canonical.Category("Prophets")
+	require.NoError(t, err)
+	ps, err = c.Category("Prophets")
+	require.NoError(t, err)
+	require.Len(t, ps, len(prophets)+2)
+	assert.Equal(t, "Hosea", ps[len(prophets)].Ref.Book.Name)
+	assert.Equal(t, "Joel", ps[len(prophets)+1].Ref.Book.Name)
+
+	// Old Testament includes every level below it
+	law, err := ref.Canonical.Category("Law")
+	require.NoError(t, err)
+	history, err := ref.Canonical.Category("History")
+	require.NoError(t, err)
+	ps, err = c.Category("Old Testament")
+	require.NoError(t, err)
+	assert.Len(t, ps, len(law)+len(history)+len(prophets)+2)
+	assert.Equal(t, "Genesis", ps[0].Ref.Book.Name)
+
+	// random passages may be picked from nested categories
+	books := make([]string, 0, len(ps))
+	for _, p := range ps {
+		books = append(books, p.Ref.Book.Name)
+	}
+	r, err := ref.Random(ref.FromCanon(c), ref.FromCategory("Minor Prophets"))
+	require.NoError(t, err)
+	assert.Contains(t, []string{"Hosea", "Joel"}, r.Book.Name)
+	r, err = ref.Random(ref.FromCanon(c), ref.FromCategory("Old Testament"))
+	require.NoError(t, err)
+	assert.Contains(t, books, r.Book.Name)
+}
+
+func TestCanon_MergeCategories_Errors(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		in   string
+	}{
+		{"bad reference", `categories: {Short: [Tobit]}`},
+		{"undefined subcategory", `categories: {Old Testament: {Law: null, Poetry: null}}`},
+		{"cycle", `categories: {Prophets: {Minor Prophets: {Prophets: null}}}`},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			cats, err := ref.LoadCategories(strings.NewReader(tt.in))
+			require.NoError(t, err)
+
+			_, err = ref.Canonical.MergeCategories(cats)
+			assert.ErrorIs(t, err, ref.ErrBadCategories)
+		})
+	}
+}
@@ -0,0 +1,127 @@
+package ref
+
+import (
+	"unicode"
+)
+
+// Extraction is a reference found embedded in a larger text by Extract.
+type Extraction struct {
+	// Start is the byte offset in the text where the reference starts.
+	Start int
+
+	// End is the byte offset in the text just past the end of the reference.
+	End int
+
+	// Text is the reference as it was written in the original text.
+	Text string
+
+	// Ref is the parsed reference. This is a *Proper or *Span if the reference
+	// is a single reference and a *Multiple otherwise.
+	Ref Absolute
+
+	// Resolved holds the result of resolving Ref. This is only set when the
+	// extraction is performed with Canon.Extract.
+	Resolved []Resolved
+}
+
+// Extract scans free-form text (such as sermon notes, blog posts, or email)
+// and returns every scripture reference it finds, in the order they appear.
+//
+// A candidate reference must start at the beginning of a word with either a
+// capital letter or a digit, must name a book that is known to the
+// abbreviations in use, and must include at least a chapter or verse. The
+// WithAbbreviations option may be used to select the abbreviations used to
+// recognize book names (ref.Abbreviations is used by default). If
+// WithoutAbbreviations is given, any capitalized words followed by a chapter or
+// verse will be accepted as a book name (e.g., "See Sterling 2:2" is taken to
+// be a reference to the book named "See Sterling"). The WithNotation option
+// may be used to find references written in another notation, such as
+// EuropeanNotation.
+//
+// For example, "see Jn 3:16 and Rom. 8:28-30" yields two extractions: "Jn
+// 3:16" and "Rom. 8:28-30".
+func Extract(text string, opt ...ResolveOption) []Extraction {
+	o := makeResolveOpts(opt)
+
+	input := []rune(text)
+
+	// map rune offsets to byte offsets
+	offsets := make([]int, len(input)+1)
+	off := 0
+	for i, r := range input {
+		offsets[i] = off
+		off += len(string(r))
+	}
+	offsets[len(input)] = off
+
+	var exs []Extraction
+	for i := 0; i < len(input); i++ {
+		if !isExtractCandidate(input, i) {
+			continue
+		}
+
+		ps := parseState{
+			input:         input,
+			pos:           i,
+			scanning:      true,
+			abbreviations: o.Abbreviations,
+			notation:      o.Notation,
+		}
+
+		m, ps, err := expectMultiple(ps)
+		if err != nil {
+			continue
+		}
+
+		var abs Absolute = m
+		if len(m.Refs) == 1 {
+			abs = m.Refs[0].(Absolute)
+		}
+
+		exs = append(exs, Extraction{
+			Start: offsets[i],
+			End:   offsets[ps.pos],
+			Text:  text[offsets[i]:offsets[ps.pos]],
+			Ref:   abs,
+		})
+
+		i = ps.pos - 1
+	}
+
+	return exs
+}
+
+// Extract works just like ref.Extract, but also resolves each reference found
+// against this canon. Any reference that cannot be resolved (for example,
+// "Psalm 200") is left out of the returned list.
+func (c *Canon) Extract(text string, opt ...ResolveOption) []Extraction {
+	exs := Extract(text, opt...)
+
+	resolved := make([]Extraction, 0, len(exs))
+	for _, ex := range exs {
+		rs, err := c.Resolve(ex.Ref, opt...)
+		if err != nil {
+			continue
+		}
+
+		ex.Resolved = rs
+		resolved = append(resolved, ex)
+	}
+
+	return resolved
+}
+
+// isExtractCandidate returns true if a reference may start at the given
+// position of the input. A reference must start at the beginning of a word
+// with a capital letter or an ordinal digit.
+func isExtractCandidate(input []rune, i int) bool {
+	if i > 0 {
+		prev := input[i-1]
+		if unicode.IsLetter(prev) || unicode.IsDigit(prev) {
+			return false
+		}
+	}
+
+	r := input[i]
+	return unicode.IsUpper(r) || (r >= '1' && r <= '9')
+}
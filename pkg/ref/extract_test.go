@@ -0,0 +1,131 @@
+package ref_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zostay/today/pkg/ref"
+)
+
+func TestExtract(t *testing.T) {
+	t.Parallel()
+
+	text := "see Jn 3:16 and Rom. 8:28-30"
+	exs := ref.Extract(text)
+	require.Len(t, exs, 2)
+
+	assert.Equal(t, 4, exs[0].Start)
+	assert.Equal(t, 11, exs[0].End)
+	assert.Equal(t, "Jn 3:16", exs[0].Text)
+	assert.Equal(t, &ref.Proper{
+		Book:  "Jn",
+		Verse: &ref.Single{Verse: ref.CV{Chapter: 3, Verse: 16}},
+	}, exs[0].Ref)
+	assert.Nil(t, exs[0].Resolved)
+
+	assert.Equal(t, 16, exs[1].Start)
+	assert.Equal(t, 28, exs[1].End)
+	assert.Equal(t, "Rom. 8:28-30", exs[1].Text)
+	assert.Equal(t, &ref.Proper{
+		Book: "Rom.",
+		Verse: &ref.Range{
+			First: ref.CV{Chapter: 8, Verse: 28},
+			Last:  ref.N{Number: 30},
+		},
+	}, exs[1].Ref)
+}
+
+func TestExtract_Prose(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		text string
+		want []string
+	}{
+		{"empty", "", nil},
+		{"no references", "In the beginning was the Word.", nil},
+		{"bare book name", "We read from Romans today.", nil},
+		{"lowercase book", "we were in rom 8:28", nil},
+		{"trailing comma", "Read John 3:16, and then pray.", []string{"John 3:16"}},
+		{"trailing semicolon", "Read John 3:16; then pray.", []string{"John 3:16"}},
+		{"related list", "Consider Gen 1:1, 3, 5-7.", []string{"Gen 1:1, 3, 5-7"}},
+		{"multiple", "(Gen 1:1; 2:4; Ex 3:14)", []string{"Gen 1:1; 2:4; Ex 3:14"}},
+		{"numbered book", "as in 1 Cor 13:4-7 and 2 Tim 3:16", []string{"1 Cor 13:4-7", "2 Tim 3:16"}},
+		{"unicode", "“Ps 23” – he said", []string{"Ps 23"}},
+		{"and following", "Look at Mt 5:3ff for more", []string{"Mt 5:3ff"}},
+		{"ambiguous", "Jo 3:16 is ambiguous", nil},
+		{"partial verse", "Read Rom 8:28b–29 and Jn 3:16a.", []string{"Rom 8:28b–29", "Jn 3:16a"}},
+		{"span", "Tonight: Mal 4 – Mt 2.", []string{"Mal 4 – Mt 2"}},
+		{"span without verse", "From Genesis-Exodus 2:3 we", []string{"Exodus 2:3"}},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			exs := ref.Extract(tt.text)
+
+			var got []string
+			for _, ex := range exs {
+				got = append(got, ex.Text)
+				assert.Equal(t, ex.Text, tt.text[ex.Start:ex.End])
+			}
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestExtract_WithoutAbbreviations(t *testing.T) {
+	t.Parallel()
+
+	exs := ref.Extract("Sterling 2:2 is a joke, John 3:16 is not", ref.WithoutAbbreviations())
+	require.Len(t, exs, 2)
+	assert.Equal(t, "Sterling 2:2", exs[0].Text)
+	assert.Equal(t, "John 3:16", exs[1].Text)
+
+	// without abbreviations, the book name may take in preceding words
+	exs = ref.Extract("See Sterling 2:2", ref.WithoutAbbreviations())
+	require.Len(t, exs, 1)
+	assert.Equal(t, "See Sterling 2:2", exs[0].Text)
+}
+
+func TestCanon_Extract(t *testing.T) {
+	t.Parallel()
+
+	exs := ref.Canonical.Extract("see Jn 3:16 and Rom. 8:28-30, but not Ps 200:1")
+	require.Len(t, exs, 2)
+
+	assert.Equal(t, []ref.Resolved{
+		{
+			Book:  &ref.Canonical.Books[42],
+			First: ref.CV{Chapter: 3, Verse: 16},
+			Last:  ref.CV{Chapter: 3, Verse: 16},
+		},
+	}, exs[0].Resolved)
+
+	assert.Equal(t, []ref.Resolved{
+		{
+			Book:  &ref.Canonical.Books[44],
+			First: ref.CV{Chapter: 8, Verse: 28},
+			Last:  ref.CV{Chapter: 8, Verse: 30},
+		},
+	}, exs[1].Resolved)
+}
+
+func TestExtract_Locale(t *testing.T) {
+	t.Parallel()
+
+	l, err := ref.GetLocale("de")
+	require.NoError(t, err)
+
+	exs := ref.Canonical.Extract("Lies Joh 3,16. Dann Röm 8,28-29 und 1. Mose 1,1.", l.ResolveOptions()...)
+	require.Len(t, exs, 3)
+	assert.Equal(t, "Joh 3,16", exs[0].Text)
+	assert.Equal(t, "Röm 8,28-29", exs[1].Text)
+	assert.Equal(t, "1. Mose 1,1", exs[2].Text)
+	assert.Equal(t, &ref.Canonical.Books[0], exs[2].Resolved[0].Book)
+}
@@ -2,10 +2,14 @@ package ref_test
 
 import (
 	"fmt"
+	"math/rand"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
+	"github.com/zostay/today/pkg/ost"
 	"github.com/zostay/today/pkg/ref"
 )
 
@@ -75,6 +79,17 @@ func TestCanon_Filtered_ByRef(t *testing.T) {
 	}, toRefs)
 }
 
+func TestCanon_Filtered_Pericopes(t *testing.T) {
+	t.Parallel()
+
+	c, err := ref.Canonical.Filtered("Luke 15:20")
+	require.NoError(t, err)
+
+	assert.NotContains(t, c.Pericopes, "The Parable of the Prodigal Son")
+	assert.Contains(t, c.Pericopes, "The Parable of the Lost Coin")
+	assert.Contains(t, ref.Canonical.Pericopes, "The Parable of the Prodigal Son")
+}
+
 func TestCanon_Filtered_Overlaps(t *testing.T) {
 	t.Parallel()
 
@@ -126,3 +141,142 @@ func TestCanon_Filtered_Overlaps(t *testing.T) {
 			})
 	}
 }
+
+func BenchmarkCanon_Filtered(b *testing.B) {
+	b.ReportAllocs()
+	for b.Loop() {
+		_, err := ref.Canonical.Filtered("Genesis 1-11", "Psalm 23", "John 3:16-21", "Romans 8")
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+// multiYearIndex returns an OST index with a passage for every day of the
+// given number of years. The passages are picked at random, but the same
+// passages are picked every time.
+func multiYearIndex(tb testing.TB, years int) *ost.Index {
+	tb.Helper()
+
+	rng := rand.New(rand.NewSource(1)) //nolint:gosec // weak random is fine here
+
+	start := time.Date(2021, time.January, 1, 0, 0, 0, 0, time.UTC)
+	end := start.AddDate(years, 0, 0)
+
+	idx := &ost.Index{
+		Description: fmt.Sprintf("Index of verses for %d years", years),
+		Verses:      map[string]ost.IndexEntry{},
+	}
+
+	for day := start; day.Before(end); day = day.AddDate(0, 0, 1) {
+		b := &ref.Canonical.Books[rng.Intn(len(ref.Canonical.Books))]
+		vs := bookVerses(b)
+		first := rng.Intn(len(vs))
+		last := min(first+rng.Intn(12), len(vs)-1)
+
+		r := &ref.Resolved{Book: b, First: vs[first], Last: vs[last]}
+		s, err := r.CompactRef()
+		require.NoError(tb, err)
+
+		idx.Verses[day.Format("2006/01/02")] = ost.IndexEntry{Reference: s}
+	}
+
+	return idx
+}
+
+// indexReferences returns the references in the index, just as they are
+// gathered for the --exclude-index option of today random.
+func indexReferences(idx *ost.Index) []string {
+	refs := make([]string, 0, len(idx.Verses))
+	for _, v := range idx.Verses {
+		refs = append(refs, v.Reference)
+	}
+	return refs
+}
+
+func TestCanon_Filtered_Runs(t *testing.T) {
+	t.Parallel()
+
+	c, err := ref.Canonical.Filtered("Matthew 12:40-13:2", "Matthew 12:20-30", "Matthew 12:45-49")
+	require.NoError(t, err)
+
+	b, err := c.Book("Matthew")
+	require.NoError(t, err)
+
+	assert.Equal(t, []ref.VerseRun{
+		{Chapter: 11, First: 1, Last: 30},
+		{Chapter: 12, First: 1, Last: 19},
+		{Chapter: 12, First: 31, Last: 39},
+		{Chapter: 13, First: 3, Last: 58},
+		{Chapter: 14, First: 1, Last: 36},
+	}, b.Runs[10:15])
+}
+
+func TestCanon_Filtered_Index(t *testing.T) {
+	t.Parallel()
+
+	refs := indexReferences(multiYearIndex(t, 3))
+
+	c, err := ref.Canonical.Filtered(refs...)
+	require.NoError(t, err)
+
+	var rs []ref.Resolved
+	for _, s := range refs {
+		p, err := ref.ParseProper(s)
+		require.NoError(t, err)
+
+		prs, err := ref.Canonical.Resolve(p)
+		require.NoError(t, err)
+
+		rs = append(rs, prs...)
+	}
+
+	excluded, err := ref.Canonical.Set(rs...)
+	require.NoError(t, err)
+
+	total, remaining := 0, 0
+	for _, b := range ref.Canonical.Books {
+		total += b.VerseCount()
+	}
+
+	for i := range c.Books {
+		b := &c.Books[i]
+		remaining += b.VerseCount()
+
+		for _, v := range bookVerses(b) {
+			orig, err := ref.Canonical.Book(b.Name)
+			require.NoError(t, err)
+			assert.False(t, excluded.Contains(orig, v), "%s %s is excluded", b.Name, v.Ref())
+		}
+	}
+
+	assert.Equal(t, total-excluded.Len(), remaining)
+}
+
+func BenchmarkCanon_Filtered_Index(b *testing.B) {
+	for _, years := range []int{1, 3, 5} {
+		refs := indexReferences(multiYearIndex(b, years))
+		b.Run(fmt.Sprintf("%d years", years), func(b *testing.B) {
+			b.ReportAllocs()
+			for b.Loop() {
+				_, err := ref.Canonical.Filtered(refs...)
+				if err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
+
+func TestCanon_Filtered_OmittedVerses(t *testing.T) {
+	t.Parallel()
+
+	c, err := ref.Canonical.Filtered("Matthew", "Mark 9")
+	require.NoError(t, err)
+
+	omitted := c.OmittedVerses["ESV"]
+	assert.NotContains(t, omitted, "Matthew 17:21")
+	assert.NotContains(t, omitted, "Mark 9:44")
+	assert.Contains(t, omitted, "Mark 7:16")
+	assert.Contains(t, omitted, "John 5:4")
+}
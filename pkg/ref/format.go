@@ -10,92 +10,288 @@ type RefFormatter interface {
 	Format(resolved []*Resolved) (string, error)
 }
 
-// GetFormatter returns a formatter for the given style name.
-func GetFormatter(style string) (RefFormatter, error) {
+// GetFormatter returns a formatter for the given style name. The
+// WithAbbreviations option may be given to select the book names and
+// abbreviations to use (e.g., AbbreviationsDE to output German book names) and
+// the WithNotation option may be given to select the punctuation used. The
+// WithRangeDash, WithBookSpace, WithAndFollowing, and WithElidedChapters
+// options select the typography of the references written, except in the osis
+// and usfm styles, which write references in the fixed form of those formats.
+//
+// The style may also name one of the StyleGuides (e.g., "sbl"), in which case
+// the abbreviations and notation of the style guide are always used, or a
+// template registered with RegisterTemplate. A style that starts with
+// TemplateStylePrefix is the text of a template for NewTemplateFormatter (e.g.,
+// "template:{{.Abbr}} {{.Chapter}}.{{.Verse}}").
+//
+// The md-link and html-link styles write each reference as a Markdown or HTML
+// link to its page on the website selected by WithLinkProvider.
+//
+// The Grouped and SortedIn options cause the references to be merged and sorted
+// before they are written in the style.
+func GetFormatter(style string, opt ...ResolveOption) (RefFormatter, error) {
+	f, err := styleFormatter(style, opt)
+	if err != nil {
+		return nil, err
+	}
+
+	o := makeResolveOpts(opt)
+	if o.Grouped || o.SortIn != nil {
+		return &groupFormatter{f: f, merge: o.Grouped, sortIn: o.SortIn}, nil
+	}
+
+	return f, nil
+}
+
+// styleFormatter returns the formatter for the given style name.
+func styleFormatter(style string, opt []ResolveOption) (RefFormatter, error) {
+	if text, isTemplate := strings.CutPrefix(style, TemplateStylePrefix); isTemplate {
+		return NewTemplateFormatter(text, opt...)
+	}
+
+	o := makeResolveOpts(opt)
 	switch style {
 	case "canonical":
-		return &canonicalFormatter{}, nil
+		return &canonicalFormatter{o: o}, nil
 	case "abbr":
-		return &abbrFormatter{}, nil
+		return &abbrFormatter{o: o}, nil
 	case "2letter":
-		return &nLetterFormatter{n: 2, withPeriod: false}, nil
+		return &nLetterFormatter{o: o, n: 2, withPeriod: false}, nil
 	case "3letter":
-		return &nLetterFormatter{n: 3, withPeriod: false}, nil
+		return &nLetterFormatter{o: o, n: 3, withPeriod: false}, nil
 	case "2letter.":
-		return &nLetterFormatter{n: 2, withPeriod: true}, nil
+		return &nLetterFormatter{o: o, n: 2, withPeriod: true}, nil
 	case "3letter.":
-		return &nLetterFormatter{n: 3, withPeriod: true}, nil
+		return &nLetterFormatter{o: o, n: 3, withPeriod: true}, nil
+	case "osis":
+		return &osisFormatter{}, nil
+	case "usfm":
+		return &usfmFormatter{}, nil
+	case "md-link":
+		return newLinkFormatter(o, markdownAnchor)
+	case "html-link":
+		return newLinkFormatter(o, htmlAnchor)
 	default:
+		if g, ok := StyleGuides[style]; ok {
+			return &styleGuideFormatter{g: g, t: o.Typography}, nil
+		}
+		if text, ok := registeredTemplate(style); ok {
+			return NewTemplateFormatter(text, opt...)
+		}
 		return nil, fmt.Errorf("unknown style %q", style)
 	}
 }
 
-// GetAvailableStyles returns a list of all available style names.
+// GetAvailableStyles returns a list of all available style names, including
+// the names of the templates registered with RegisterTemplate.
 func GetAvailableStyles() []string {
-	return []string{
+	return append(builtinStyles(), TemplateNames()...)
+}
+
+// builtinStyles returns the names of the styles built into GetFormatter.
+func builtinStyles() []string {
+	return append([]string{
 		"canonical",
 		"abbr",
 		"2letter",
 		"3letter",
 		"2letter.",
 		"3letter.",
+		"osis",
+		"usfm",
+		"md-link",
+		"html-link",
+	}, StyleGuideNames()...)
+}
+
+// formatResolved formats each resolved reference using the book name returned
+// by name and joins them with semicolons. Consecutive segments of a Span (those
+// marked Continued) are formatted together as a single reference. If the
+// typography elides chapters, repeated book names and chapters are left out as
+// described by formatElided.
+func formatResolved(
+	resolved []*Resolved,
+	n Notation,
+	t Typography,
+	name func(*Resolved) (string, error),
+) (string, error) {
+	var (
+		buf  strings.Builder
+		prev *Resolved
+	)
+
+	err := eachSpan(resolved, func(first, last *Resolved) error {
+		sep, ref, err := formatElided(prev, first, last, n, t, name)
+		if err != nil {
+			return err
+		}
+
+		if prev != nil {
+			buf.WriteString(sep)
+		}
+		buf.WriteString(ref)
+
+		prev = last
+		return nil
+	})
+	if err != nil {
+		return "", err
 	}
+
+	return buf.String(), nil
 }
 
-// canonicalFormatter formats references with full book names.
-type canonicalFormatter struct{}
+// formatElided formats the reference running from the start of first to the
+// end of last and returns it along with the separator to write between it and
+// prev, the reference before it, which is nil for the first reference.
+//
+// The separator is normally a semicolon. When the typography elides chapters
+// and the reference is to the same book as prev, the book name is left out
+// (e.g., the "4:1" of "John 3:16; 4:1"). If it is also to verses of the chapter
+// prev ends in, the chapter is left out and the list separator of the notation
+// is used instead (e.g., the "18" of "John 3:16, 18").
+func formatElided(
+	prev, first, last *Resolved,
+	n Notation,
+	t Typography,
+	name func(*Resolved) (string, error),
+) (sep, ref string, err error) {
+	sep = "; "
+	if t.ElideChapters && prev != nil && first == last &&
+		prev.Book == first.Book && !first.isWholeBook() {
+		pc, _, _ := prev.Book.position(prev.Last)
+		fc, _, _ := first.Book.position(first.First)
+		lc, _, _ := first.Book.position(first.Last)
 
-func (f *canonicalFormatter) Format(resolved []*Resolved) (string, error) {
+		inChapter := pc == fc && fc == lc &&
+			!prev.isWholeChapters() && !first.isWholeChapters()
+		if inChapter {
+			sep = n.listSep()
+		}
+
+		ref, err = first.versesRef(n, t, inChapter)
+		return sep, ref, err
+	}
+
+	firstName, err := name(first)
+	if err != nil {
+		return "", "", err
+	}
+
+	if first == last {
+		ref, err = first.compactRef(firstName, n, t)
+		return sep, ref, err
+	}
+
+	lastName, err := name(last)
+	if err != nil {
+		return "", "", err
+	}
+
+	ref, err = spanRef(first, last, firstName, lastName, n, t)
+	return sep, ref, err
+}
+
+// formatSpans formats each resolved reference using ref and joins them with
+// sep. Consecutive segments of a Span (those marked Continued) are passed to ref
+// together as the first and last segment. Otherwise, first and last are the
+// same reference.
+func formatSpans(
+	resolved []*Resolved,
+	sep string,
+	ref func(first, last *Resolved) (string, error),
+) (string, error) {
 	refs := make([]string, 0, len(resolved))
-	for _, r := range resolved {
-		ref, err := r.CompactRef()
+	err := eachSpan(resolved, func(first, last *Resolved) error {
+		r, err := ref(first, last)
 		if err != nil {
-			return "", err
+			return err
 		}
-		refs = append(refs, ref)
+		refs = append(refs, r)
+		return nil
+	})
+	if err != nil {
+		return "", err
 	}
-	return strings.Join(refs, "; "), nil
+	return strings.Join(refs, sep), nil
+}
+
+// eachSpan calls fn with the first and last segment of each reference, in
+// order. Consecutive segments of a Span (those marked Continued) are passed to
+// fn together. Otherwise, first and last are the same reference. It stops at
+// and returns the first error returned by fn.
+func eachSpan(resolved []*Resolved, fn func(first, last *Resolved) error) error {
+	for i := 0; i < len(resolved); i++ {
+		first := resolved[i]
+		for i < len(resolved)-1 && resolved[i].Continued {
+			i++
+		}
+
+		if err := fn(first, resolved[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// canonicalFormatter formats references with full book names.
+type canonicalFormatter struct {
+	o *resolveOpts
+}
+
+func (f *canonicalFormatter) Format(resolved []*Resolved) (string, error) {
+	return formatResolved(resolved, f.o.Notation, f.o.Typography, func(r *Resolved) (string, error) {
+		return r.fullName(f.o)
+	})
 }
 
 // abbrFormatter formats references with preferred abbreviations.
-type abbrFormatter struct{}
+type abbrFormatter struct {
+	o *resolveOpts
+}
 
 func (f *abbrFormatter) Format(resolved []*Resolved) (string, error) {
-	refs := make([]string, 0, len(resolved))
-	for _, r := range resolved {
-		ref, err := r.AbbreviatedRef(WithAbbreviations(Abbreviations))
-		if err != nil {
-			return "", err
+	return formatResolved(resolved, f.o.Notation, f.o.Typography, func(r *Resolved) (string, error) {
+		if f.o.Abbreviations == nil {
+			return r.Book.Name, nil
 		}
-		refs = append(refs, ref)
-	}
-	return strings.Join(refs, "; "), nil
+		return f.o.Abbreviations.PreferredAbbreviation(r.Book.Name)
+	})
 }
 
 // nLetterFormatter formats references with N-letter abbreviations.
 type nLetterFormatter struct {
+	o          *resolveOpts
 	n          int
 	withPeriod bool
 }
 
 func (f *nLetterFormatter) Format(resolved []*Resolved) (string, error) {
-	refs := make([]string, 0, len(resolved))
-	for _, r := range resolved {
-		abbr, err := Abbreviations.NLetterAbbreviation(r.Book.Name, f.n, f.withPeriod)
-		if err != nil {
-			return "", err
+	return formatResolved(resolved, f.o.Notation, f.o.Typography, func(r *Resolved) (string, error) {
+		if f.o.Abbreviations == nil {
+			return r.Book.Name, nil
 		}
-		ref, err := formatResolvedWithName(r, abbr)
-		if err != nil {
-			return "", err
-		}
-		refs = append(refs, ref)
-	}
-	return strings.Join(refs, "; "), nil
+		return f.o.Abbreviations.NLetterAbbreviation(r.Book.Name, f.n, f.withPeriod)
+	})
 }
 
-// formatResolvedWithName formats a resolved reference with a custom book name.
-// It delegates to Resolved.compactRef to avoid duplicating formatting logic.
-func formatResolvedWithName(r *Resolved, name string) (string, error) {
-	return r.compactRef(name)
+// osisFormatter formats references as OSIS references (e.g., "Gen.1.1-Gen.1.5").
+// Multiple references are separated by spaces.
+type osisFormatter struct{}
+
+func (f *osisFormatter) Format(resolved []*Resolved) (string, error) {
+	return formatSpans(resolved, " ", osisRef)
+}
+
+// usfmFormatter formats references with USFM book codes (e.g., "JHN 3:16").
+// References are always written using StandardNotation and plain typography.
+type usfmFormatter struct{}
+
+func (f *usfmFormatter) Format(resolved []*Resolved) (string, error) {
+	return formatResolved(resolved, StandardNotation, Typography{}, func(r *Resolved) (string, error) {
+		if r.Book.USFM == "" {
+			return "", fmt.Errorf("%w: no USFM code for book %s", ErrNotFound, r.Book.Name)
+		}
+		return r.Book.USFM, nil
+	})
 }
@@ -12,15 +12,26 @@ import (
 func TestGetAvailableStyles(t *testing.T) {
 	t.Parallel()
 
+	// registered templates follow the built-in styles
 	styles := ref.GetAvailableStyles()
-	assert.Equal(t, []string{
+	builtin := []string{
 		"canonical",
 		"abbr",
 		"2letter",
 		"3letter",
 		"2letter.",
 		"3letter.",
-	}, styles)
+		"osis",
+		"usfm",
+		"md-link",
+		"html-link",
+		"apa",
+		"chicago",
+		"mla",
+		"sbl",
+	}
+	require.GreaterOrEqual(t, len(styles), len(builtin))
+	assert.Equal(t, builtin, styles[:len(builtin)])
 }
 
 func TestGetFormatter(t *testing.T) {
@@ -37,6 +48,14 @@ func TestGetFormatter(t *testing.T) {
 		{"3letter style", "3letter", false},
 		{"2letter. style", "2letter.", false},
 		{"3letter. style", "3letter.", false},
+		{"osis style", "osis", false},
+		{"usfm style", "usfm", false},
+		{"md-link style", "md-link", false},
+		{"html-link style", "html-link", false},
+		{"sbl style", "sbl", false},
+		{"chicago style", "chicago", false},
+		{"mla style", "mla", false},
+		{"apa style", "apa", false},
 		{"invalid style", "invalid", true},
 		{"empty style", "", true},
 	}
@@ -76,6 +95,16 @@ func TestCanonicalFormatter_Format(t *testing.T) {
 		{"multiple verses", "John 3:16; Romans 8:28", "John 3:16; Romans 8:28"},
 		{"numbered book", "1 John 3:16", "1 John 3:16"},
 		{"single chapter book", "Philemon 5", "Philemon 5"},
+		{"span chapters", "Genesis 50 – Exodus 2", "Genesis 50-Exodus 2"},
+		{"span verse to chapter", "Ruth 4:18-1 Samuel 2", "Ruth 4:18-1 Samuel 2"},
+		{"span verses", "Gen 49:5-Ex 1:5; 3:1-2", "Genesis 49:5-Exodus 1:5; Exodus 3:1-2"},
+		{"span single verse", "Gen 49:5-Ex 1:5; 3:1", "Genesis 49:5-Exodus 1:5; Exodus 3:1"},
+		{"span whole books", "Genesis-Leviticus", "Genesis-Leviticus"},
+		{"span single chapter book", "3 John 13-Jude 4", "3 John 13-Jude 4"},
+		{"partial verse", "John 3:16a", "John 3:16a"},
+		{"partial verse range", "Romans 8:28b–29", "Romans 8:28b-29"},
+		{"partial verse chapter end", "Psalm 23:1-6a", "Psalm 23:1-6a"},
+		{"partial verse span", "Mal 4:6b-Mt 1:1a", "Malachi 4:6b-Matthew 1:1a"},
 	}
 
 	for _, tt := range tests {
@@ -122,6 +151,61 @@ func TestAbbrFormatter_Format(t *testing.T) {
 		{"chapter", "Psalm 23", "Ps. 23"},
 		{"multiple verses", "Genesis 1:1; Romans 8:28", "Gen. 1:1; Rom. 8:28"},
 		{"numbered book", "1 John 3:16", "1 John 3:16"},
+		{"span", "Malachi 4-Matthew 2", "Mal. 4-Matt. 2"},
+		{"partial verse", "Philemon 6b", "Philem. 6b"},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var parsed ref.Absolute
+			var err error
+			parsed, err = ref.ParseProper(tt.input)
+			if err != nil {
+				parsed, err = ref.ParseMultiple(tt.input)
+				require.NoError(t, err)
+			}
+
+			resolved, err := ref.Canonical.Resolve(parsed)
+			require.NoError(t, err)
+
+			resolvedPtrs := make([]*ref.Resolved, len(resolved))
+			for i := range resolved {
+				resolvedPtrs[i] = &resolved[i]
+			}
+
+			result, err := formatter.Format(resolvedPtrs)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func TestOSISFormatter_Format(t *testing.T) {
+	t.Parallel()
+
+	formatter, err := ref.GetFormatter("osis")
+	require.NoError(t, err)
+
+	tests := []struct {
+		name     string
+		input    string
+		expected string
+	}{
+		{"single verse", "John 3:16", "John.3.16"},
+		{"verse range", "Genesis 1:1-5", "Gen.1.1-Gen.1.5"},
+		{"chapter", "1 Corinthians 13", "1Cor.13"},
+		{"chapter range", "Genesis 1-2", "Gen.1-Gen.2"},
+		{"cross chapter verses", "Genesis 1:3-2:4", "Gen.1.3-Gen.2.4"},
+		{"whole book", "Ruth", "Ruth"},
+		{"multiple", "John 3:16; Romans 8:28", "John.3.16 Rom.8.28"},
+		{"single chapter book", "Philemon 5", "Phlm.1.5"},
+		{"span chapters", "Genesis 50 – Exodus 2", "Gen.50-Exod.2"},
+		{"span verses", "Ruth 4:18-1 Samuel 2:3", "Ruth.4.18-1Sam.2.3"},
+		{"span whole books", "Genesis-Leviticus", "Gen-Lev"},
+		{"partial verse", "John 3:16a", "John.3.16!a"},
 	}
 
 	for _, tt := range tests {
@@ -152,6 +236,53 @@ func TestAbbrFormatter_Format(t *testing.T) {
 	}
 }
 
+func TestUSFMFormatter_Format(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		input    string
+		opts     []ref.ResolveOption
+		expected string
+	}{
+		{"single verse", "John 3:16", nil, "JHN 3:16"},
+		{"numbered book", "1 Corinthians 13", nil, "1CO 13"},
+		{"multiple", "Genesis 1:1; Song of Solomon 2:1-4", nil, "GEN 1:1; SNG 2:1-4"},
+		{"span", "Ruth 4:18-1 Samuel 2", nil, "RUT 4:18-1SA 2"},
+		{"single chapter book", "Jude 3", nil, "JUD 3"},
+		{"european notation ignored", "Romans 8:28", []ref.ResolveOption{ref.WithNotation(ref.EuropeanNotation)}, "ROM 8:28"},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			formatter, err := ref.GetFormatter("usfm", tt.opts...)
+			require.NoError(t, err)
+
+			var parsed ref.Absolute
+			parsed, err = ref.ParseProper(tt.input)
+			if err != nil {
+				parsed, err = ref.ParseMultiple(tt.input)
+				require.NoError(t, err)
+			}
+
+			resolved, err := ref.Canonical.Resolve(parsed)
+			require.NoError(t, err)
+
+			resolvedPtrs := make([]*ref.Resolved, len(resolved))
+			for i := range resolved {
+				resolvedPtrs[i] = &resolved[i]
+			}
+
+			result, err := formatter.Format(resolvedPtrs)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
 func TestNLetterFormatter_Format(t *testing.T) {
 	t.Parallel()
 
@@ -174,6 +305,8 @@ func TestNLetterFormatter_Format(t *testing.T) {
 		{"2letter multiple", "2letter", "Genesis 1:1; Romans 8:28", "Gn 1:1; Rm 8:28"},
 		{"2letter verse range", "2letter", "John 3:16-18", "Jn 3:16-18"},
 		{"3letter chapter", "3letter", "Psalm 23", "Psm 23"},
+		{"3letter partial verse", "3letter", "Romans 8:28b-29", "Rom 8:28b-29"},
+		{"2letter. partial verse", "2letter.", "John 3:16a", "Jn. 3:16a"},
 	}
 
 	for _, tt := range tests {
@@ -206,6 +339,61 @@ func TestNLetterFormatter_Format(t *testing.T) {
 	}
 }
 
+func TestStyleGuideFormatter_Format(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		style    string
+		input    string
+		expected string
+	}{
+		{"sbl", "John 3:16", "John 3:16"},
+		{"sbl", "1 Corinthians 13:1-3", "1 Cor 13:1–3"},
+		{"sbl", "Philemon 4-7", "Phlm 4–7"},
+		{"sbl", "Psalm 23", "Ps 23"},
+		{"sbl", "Psalms 120-134", "Pss 120–134"},
+		{"sbl", "Genesis 1:1-2:3", "Gen 1:1–2:3"},
+		{"sbl", "Genesis", "Genesis"},
+		{"sbl", "Ruth 4:18-1 Samuel 2", "Ruth 4:18–1 Sam 2"},
+		{"sbl", "John 3:16; Romans 8:28", "John 3:16; Rom 8:28"},
+		{"chicago", "1 Kings 18:20-40", "1 Kings 18:20–40"},
+		{"chicago", "Psalm 23:1-3", "Ps. 23:1–3"},
+		{"chicago", "Psalms 120-134", "Pss. 120–134"},
+		{"chicago", "Song of Solomon 2:1", "Song of Sol. 2:1"},
+		{"mla", "1 Kings 18:20-40", "1 Kgs. 18.20-40"},
+		{"mla", "Psalms 120-134", "Ps. 120-134"},
+		{"mla", "Matthew 5:3-7:29", "Matt. 5.3-7.29"},
+		{"apa", "1 Corinthians 13:1-3", "1 Corinthians 13:1–3"},
+		{"apa", "Psalm 23", "Psalm 23"},
+		{"apa", "Psalms 120-134", "Psalms 120–134"},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.style+" "+tt.input, func(t *testing.T) {
+			t.Parallel()
+
+			formatter, err := ref.GetFormatter(tt.style)
+			require.NoError(t, err)
+
+			parsed, err := ref.ParseMultiple(tt.input)
+			require.NoError(t, err)
+
+			resolved, err := ref.Canonical.Resolve(parsed)
+			require.NoError(t, err)
+
+			resolvedPtrs := make([]*ref.Resolved, len(resolved))
+			for i := range resolved {
+				resolvedPtrs[i] = &resolved[i]
+			}
+
+			result, err := formatter.Format(resolvedPtrs)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
 func TestFormatResolvedWithName_SingleChapterBook(t *testing.T) {
 	t.Parallel()
 
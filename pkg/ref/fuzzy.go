@@ -0,0 +1,182 @@
+package ref
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/agnivade/levenshtein"
+)
+
+// LenientConfidence is the least confidence with which a misspelled book name
+// is accepted when the Lenient option is given.
+const LenientConfidence = 0.75
+
+// BookMatch is a book matched by a book name or abbreviation.
+type BookMatch struct {
+	// Name is the name of the book.
+	Name string
+
+	// Accepted is the accepted name or abbreviation of the book most like the
+	// input. It is the input itself for an exact match.
+	Accepted string
+
+	// Distance is the edit distance between the input and Accepted, ignoring
+	// case, spaces, and punctuation. It is 0 for an exact match.
+	Distance int
+
+	// Confidence is how likely it is that the input names this book, from 0
+	// for no likeness to 1 for an exact match.
+	Confidence float64
+}
+
+// UnknownBookError is returned when a book name matches no book. It matches
+// ErrNotFound when checked with errors.Is.
+type UnknownBookError struct {
+	// Input is the book name that matched no book.
+	Input string
+
+	// Suggestions lists the books with a name or abbreviation similar to the
+	// input, most similar first. It is empty if there are none.
+	Suggestions []BookMatch
+}
+
+// Error implements error.
+func (e *UnknownBookError) Error() string {
+	msg := fmt.Sprintf("%v: %s", ErrNotFound, e.Input)
+	if len(e.Suggestions) == 0 {
+		return msg
+	}
+
+	suggestions := make([]string, len(e.Suggestions))
+	for i, m := range e.Suggestions {
+		suggestions[i] = fmt.Sprintf("%s (%.0f%%)", m.Name, m.Confidence*100)
+	}
+	return msg + ". Did you mean " + strings.Join(suggestions, ", ") + "?"
+}
+
+// Unwrap returns ErrNotFound.
+func (e *UnknownBookError) Unwrap() error {
+	return ErrNotFound
+}
+
+// Strict will cause a misspelled book name to be rejected with an
+// UnknownBookError suggesting the books that might have been meant. This is the
+// default.
+func Strict() ResolveOption {
+	return func(o *resolveOpts) {
+		o.Lenient = false
+	}
+}
+
+// Lenient will cause a misspelled book name (e.g., "Revelations" or
+// "Habbakuk") to be accepted as the book it most resembles, so long as the
+// match has a confidence of at least LenientConfidence and no other book
+// matches as well.
+func Lenient() ResolveOption {
+	return func(o *resolveOpts) {
+		o.Lenient = true
+	}
+}
+
+// MatchBookName returns the book that matches the given book name or
+// abbreviation, along with the confidence of the match. A name that matches an
+// accepted name or abbreviation, or a prefix of one, is an exact match with a
+// confidence of 1.
+//
+// Otherwise, the name is compared to the accepted names and abbreviations by
+// edit distance. If the Lenient option is given and the closest book is a
+// confident enough match, it is returned. If not, an UnknownBookError is
+// returned suggesting the closest books. If the name matches more than one
+// book exactly, a MultipleMatchError is returned.
+func (b *BookAbbreviations) MatchBookName(in string, opt ...ResolveOption) (*BookMatch, error) {
+	return b.matchBookName(in, makeResolveOpts(opt))
+}
+
+// matchBookName implements MatchBookName.
+func (b *BookAbbreviations) matchBookName(in string, o *resolveOpts) (*BookMatch, error) {
+	name, err := b.exactBookName(in)
+	if err == nil {
+		return &BookMatch{Name: name, Accepted: in, Confidence: 1}, nil
+	}
+
+	if !errors.Is(err, ErrNotFound) {
+		return nil, err
+	}
+
+	near := b.NearBookNames(in)
+	if o.Lenient && isConfident(near) {
+		return &near[0], nil
+	}
+
+	return nil, &UnknownBookError{
+		Input:       in,
+		Suggestions: near,
+	}
+}
+
+// isConfident returns true if the first of the near matches is confident
+// enough to be accepted in place of an exact match and no other book is as
+// close.
+func isConfident(near []BookMatch) bool {
+	if len(near) == 0 || near[0].Confidence < LenientConfidence {
+		return false
+	}
+	return len(near) == 1 || near[1].Distance > near[0].Distance
+}
+
+// NearBookNames returns up to five books with an accepted name or abbreviation
+// similar to the given book name, most similar first. Books whose names are
+// more than a few edits away are left out, so the list is empty when nothing
+// is similar.
+func (b *BookAbbreviations) NearBookNames(in string) []BookMatch {
+	in = cleanAbbreviation(in)
+	inLen := len([]rune(in))
+
+	// permit roughly one edit for every two letters, but no more than four
+	limit := min(max(inLen/2, 1), 4)
+
+	near := make([]BookMatch, 0, maxBookSuggestions)
+	for i := range b.Abbreviations {
+		abbr := &b.Abbreviations[i]
+
+		var best *BookMatch
+		for _, acc := range abbr.Accepts {
+			cleanAcc := cleanAbbreviation(acc)
+			d := levenshtein.ComputeDistance(in, cleanAcc)
+			if best != nil && d >= best.Distance {
+				continue
+			}
+
+			best = &BookMatch{
+				Name:       abbr.Name,
+				Accepted:   acc,
+				Distance:   d,
+				Confidence: 1 - float64(d)/float64(max(inLen, len([]rune(cleanAcc)), 1)),
+			}
+		}
+
+		if best != nil && best.Distance <= limit {
+			near = append(near, *best)
+		}
+	}
+
+	sort.SliceStable(near, func(i, j int) bool {
+		return near[i].Distance < near[j].Distance
+	})
+
+	// drop the matches that are much worse than the best
+	for i := range near {
+		if near[i].Distance > near[0].Distance+1 {
+			near = near[:i]
+			break
+		}
+	}
+
+	if len(near) > maxBookSuggestions {
+		near = near[:maxBookSuggestions]
+	}
+
+	return near
+}
@@ -0,0 +1,131 @@
+package ref_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zostay/today/pkg/ref"
+)
+
+func TestBookAbbreviations_MatchBookName(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		in         string
+		expect     string
+		confidence float64
+		lenientErr bool
+	}{
+		{"Revelation", "Revelation", 1, false},
+		{"Rev", "Revelation", 1, false},
+		{"Revelations", "Revelation", 0.91, false},
+		{"Phillipians", "Philippians", 0.82, false},
+		{"Ecclesiates", "Ecclesiastes", 0.92, false},
+		{"Habbakuk", "Habakkuk", 0.75, false},
+		{"Exodsu", "Exodus", 0.67, true},
+		{"Xyzzy", "", 0, true},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.in, func(t *testing.T) {
+			t.Parallel()
+
+			m, err := ref.Abbreviations.MatchBookName(tt.in, ref.Lenient())
+			if tt.lenientErr {
+				assert.ErrorIs(t, err, ref.ErrNotFound)
+				assert.Nil(t, m)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.expect, m.Name)
+				assert.InDelta(t, tt.confidence, m.Confidence, 0.01)
+			}
+
+			m, err = ref.Abbreviations.MatchBookName(tt.in, ref.Strict())
+			if tt.confidence == 1 {
+				require.NoError(t, err)
+				assert.Equal(t, tt.expect, m.Name)
+				return
+			}
+
+			var uberr *ref.UnknownBookError
+			require.ErrorAs(t, err, &uberr)
+			assert.ErrorIs(t, err, ref.ErrNotFound)
+			assert.Equal(t, tt.in, uberr.Input)
+			if tt.expect == "" {
+				assert.Empty(t, uberr.Suggestions)
+				return
+			}
+
+			require.NotEmpty(t, uberr.Suggestions)
+			assert.Equal(t, tt.expect, uberr.Suggestions[0].Name)
+			assert.InDelta(t, tt.confidence, uberr.Suggestions[0].Confidence, 0.01)
+		})
+	}
+}
+
+func TestBookAbbreviations_MatchBookName_Ambiguous(t *testing.T) {
+	t.Parallel()
+
+	// an ambiguous abbreviation is never corrected
+	_, err := ref.Abbreviations.MatchBookName("Jo", ref.Lenient())
+	var mmerr *ref.MultipleMatchError
+	assert.ErrorAs(t, err, &mmerr)
+
+	// nor is a misspelling that resembles two books equally
+	abbrs, err := ref.LoadAbbreviations(strings.NewReader(`
+books:
+  - name: Mark
+    accept: [Mark]
+  - name: Mary
+    usfm: MRY
+    accept: [Mary]
+`))
+	require.NoError(t, err)
+
+	_, err = abbrs.MatchBookName("Marz", ref.Lenient())
+	var uberr *ref.UnknownBookError
+	require.ErrorAs(t, err, &uberr)
+	assert.Len(t, uberr.Suggestions, 2)
+}
+
+func TestBookAbbreviations_NearBookNames(t *testing.T) {
+	t.Parallel()
+
+	near := ref.Abbreviations.NearBookNames("Jhon")
+	names := make([]string, len(near))
+	for i := range near {
+		names[i] = near[i].Name
+	}
+	assert.Equal(t, []string{"John", "Job", "1 John", "2 John", "3 John"}, names)
+	assert.Equal(t, "Jhn", near[0].Accepted)
+	assert.Equal(t, 1, near[0].Distance)
+}
+
+func TestCanon_Resolve_Lenient(t *testing.T) {
+	t.Parallel()
+
+	p, err := ref.ParseProper("Revelations 21:1-4",
+		ref.ParseWithAbbreviations(ref.Abbreviations),
+		ref.ParseLeniently(),
+	)
+	require.NoError(t, err)
+
+	_, err = ref.Canonical.Resolve(p)
+	assert.ErrorIs(t, err, ref.ErrNotFound)
+
+	rs, err := ref.Canonical.Resolve(p, ref.Lenient())
+	require.NoError(t, err)
+	require.Len(t, rs, 1)
+	compact, err := rs[0].CompactRef()
+	require.NoError(t, err)
+	assert.Equal(t, "Revelation 21:1-4", compact)
+
+	_, err = ref.ParseProper("Revelations 21:1-4",
+		ref.ParseWithAbbreviations(ref.Abbreviations),
+	)
+	assert.ErrorIs(t, err, ref.ErrParseFail)
+}
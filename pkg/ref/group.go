@@ -0,0 +1,130 @@
+package ref
+
+import "sort"
+
+// Grouped will cause a formatter to merge references to verses that overlap or
+// adjoin before writing them and to leave out repeated book names and chapters,
+// as WithElidedChapters does. For example, "John 3:16; John 3:17-18; John
+// 4:1-6" is written "John 3:16-18; 4:1-6".
+//
+// Only a reference that follows the one it overlaps or adjoins is merged,
+// unless SortedIn is also given, in which case every such reference is merged.
+// References to parts of verses are never merged.
+func Grouped() ResolveOption {
+	return func(o *resolveOpts) {
+		o.Grouped = true
+		o.Typography.ElideChapters = true
+	}
+}
+
+// SortedIn will cause a formatter to sort references into the order of the
+// books of the given canon and the order of the verses of each book before
+// writing them. The references must belong to the canon.
+func SortedIn(c *Canon) ResolveOption {
+	return func(o *resolveOpts) {
+		o.SortIn = c
+	}
+}
+
+// groupFormatter sorts and merges references before writing them with
+// another formatter.
+type groupFormatter struct {
+	f      RefFormatter
+	merge  bool
+	sortIn *Canon
+}
+
+func (f *groupFormatter) Format(resolved []*Resolved) (string, error) {
+	grouped, err := groupResolved(resolved, f.merge, f.sortIn)
+	if err != nil {
+		return "", err
+	}
+	return f.f.Format(grouped)
+}
+
+// groupedRef is a reference being grouped along with the interval of verses
+// it covers. The book of the interval is only set when sorting.
+type groupedRef struct {
+	r  Resolved
+	iv interval
+}
+
+// touches returns true if the references are to verses of the same book that
+// overlap or adjoin and neither is to part of a verse.
+func (g *groupedRef) touches(o *groupedRef) bool {
+	return g.r.Book.Name == o.r.Book.Name && g.iv.touches(o.iv) &&
+		!g.r.hasPart() && !o.r.hasPart()
+}
+
+// extend extends the reference to cover the verses of the other reference,
+// which it touches.
+func (g *groupedRef) extend(o *groupedRef) {
+	if o.iv.first < g.iv.first {
+		g.iv.first, g.r.First = o.iv.first, o.r.First
+	}
+
+	if o.iv.last > g.iv.last {
+		g.iv.last, g.r.Last = o.iv.last, o.r.Last
+		g.r.Continued = o.r.Continued
+	}
+}
+
+// groupResolved returns the references sorted into the order of the canon, if
+// one is given, and with the references that touch merged, if merge is true.
+//
+// Once sorted, a reference that runs to the end of a book is marked Continued
+// if the next reference starts at the beginning of the following book, as the
+// ranges returned by Set.Ranges are, so that the two are written as a span.
+func groupResolved(resolved []*Resolved, merge bool, c *Canon) ([]*Resolved, error) {
+	refs := make([]groupedRef, len(resolved))
+	for i, r := range resolved {
+		refs[i].r = *r
+
+		if c == nil {
+			refs[i].iv.first, refs[i].iv.last = r.verseIndexes()
+			continue
+		}
+
+		iv, err := c.interval(r)
+		if err != nil {
+			return nil, err
+		}
+		refs[i].iv = iv
+	}
+
+	if c != nil {
+		sort.SliceStable(refs, func(i, j int) bool {
+			return refs[i].iv.startsBefore(refs[j].iv)
+		})
+	}
+
+	if merge {
+		merged := refs[:0]
+		for i := range refs {
+			if n := len(merged); n > 0 && merged[n-1].touches(&refs[i]) {
+				merged[n-1].extend(&refs[i])
+				continue
+			}
+			merged = append(merged, refs[i])
+		}
+		refs = merged
+	}
+
+	grouped := make([]*Resolved, len(refs))
+	for i := range refs {
+		grouped[i] = &refs[i].r
+
+		if c != nil && i > 0 {
+			prev, iv := refs[i-1].iv, refs[i].iv
+			refs[i-1].r.Continued = prev.book+1 == iv.book &&
+				prev.last == c.Books[prev.book].VerseCount()-1 && iv.first == 0 &&
+				!refs[i-1].r.hasPart() && !refs[i].r.hasPart()
+		}
+	}
+
+	if c != nil && len(refs) > 0 {
+		refs[len(refs)-1].r.Continued = false
+	}
+
+	return grouped, nil
+}
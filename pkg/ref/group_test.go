@@ -0,0 +1,141 @@
+package ref_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zostay/today/pkg/ref"
+)
+
+func TestFormatter_Grouped(t *testing.T) {
+	t.Parallel()
+
+	grouped := []ref.ResolveOption{ref.Grouped()}
+	sorted := []ref.ResolveOption{ref.Grouped(), ref.SortedIn(ref.Canonical)}
+
+	tests := []struct {
+		name     string
+		style    string
+		input    string
+		opts     []ref.ResolveOption
+		expected string
+	}{
+		{"merge adjacent", "canonical", "John 3:16; John 3:17-18; John 4:1-6", grouped, "John 3:16-18; 4:1-6"},
+		{"merge overlapping", "canonical", "John 3:16-18; John 3:17-20", grouped, "John 3:16-20"},
+		{"merge reversed", "canonical", "John 3:18; John 3:16-17", grouped, "John 3:16-18"},
+		{"merge across chapters", "canonical", "John 3:36; John 4:1", grouped, "John 3:36-4:1"},
+		{"merge whole chapters", "canonical", "John 3; John 4", grouped, "John 3-4"},
+		{"elide", "canonical", "John 3:16; John 3:18; John 4:1-6; Romans 8:28", grouped, "John 3:16, 18; 4:1-6; Romans 8:28"},
+		{"unsorted", "canonical", "John 3:16; Romans 8:28; John 3:17", grouped, "John 3:16; Romans 8:28; John 3:17"},
+		{"sorted", "canonical", "John 3:16; Romans 8:28; John 3:17", sorted, "John 3:16-17; Romans 8:28"},
+		{"sorted citation", "abbr", "Romans 8:28; John 4:1-3; John 3:16; John 4:4-6; John 3:18", sorted, "John 3:16, 18; 4:1-6; Rom. 8:28"},
+		{"sorted books", "canonical", "Matthew 1-28; Malachi 1-4; Genesis 1", sorted, "Genesis 1; Malachi-Matthew"},
+		{"sorted span", "canonical", "Matthew 1:1-2:23; Malachi 4; Matthew 3", sorted, "Malachi 4-Matthew 3"},
+		{"sort only", "canonical", "Romans 8:28; John 3:17; John 3:16", []ref.ResolveOption{ref.SortedIn(ref.Canonical)}, "John 3:16; John 3:17; Romans 8:28"},
+		{"parts", "canonical", "John 3:16a; John 3:16b-17", sorted, "John 3:16a, 16b-17"},
+		{"just verse", "canonical", "Jude 4-5; Jude 3; Jude 7", sorted, "Jude 3-5, 7"},
+		{"style guide", "sbl", "1 Corinthians 13:4-7; 1 Corinthians 13:1-3; 1 Corinthians 15:1", sorted, "1 Cor 13:1–7; 15:1"},
+		{"osis", "osis", "John 3:17; John 3:16", sorted, "John.3.16-John.3.17"},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			formatter, err := ref.GetFormatter(tt.style, tt.opts...)
+			require.NoError(t, err)
+
+			parsed, err := ref.ParseMultiple(tt.input)
+			require.NoError(t, err)
+
+			resolved, err := ref.Canonical.Resolve(parsed)
+			require.NoError(t, err)
+
+			resolvedPtrs := make([]*ref.Resolved, len(resolved))
+			for i := range resolved {
+				resolvedPtrs[i] = &resolved[i]
+			}
+
+			result, err := formatter.Format(resolvedPtrs)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+
+			// the references given are left as they were
+			assert.Equal(t, &resolved[0], resolvedPtrs[0])
+		})
+	}
+}
+
+func TestFormatter_SortedIn_NotInCanon(t *testing.T) {
+	t.Parallel()
+
+	tobit, err := ref.CatholicCanon.Book("Tobit")
+	require.NoError(t, err)
+
+	formatter, err := ref.GetFormatter("canonical", ref.SortedIn(ref.Canonical))
+	require.NoError(t, err)
+
+	_, err = formatter.Format([]*ref.Resolved{{
+		Book:  tobit,
+		First: ref.CV{Chapter: 1, Verse: 1},
+		Last:  ref.CV{Chapter: 1, Verse: 1},
+	}})
+	assert.ErrorIs(t, err, ref.ErrNotFound)
+}
+
+func TestFormatter_Grouped_RoundTrip(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		style string
+		input string
+	}{
+		{"elide", "canonical", "John 3:16; John 3:18; John 4:1-6; Romans 8:28"},
+		{"citation", "abbr", "Romans 8:28; John 4:1-3; John 3:16; John 4:4-6; John 3:18"},
+		{"after range", "canonical", "John 3:16-4:2; John 4:5; John 4:7-9"},
+		{"just verse", "canonical", "Jude 4-5; Jude 3; Jude 7"},
+		{"style guide", "sbl", "1 Corinthians 13:4-7; 1 Corinthians 13:1-3; 1 Corinthians 15:1"},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			formatter, err := ref.GetFormatter(tt.style, ref.Grouped(), ref.SortedIn(ref.Canonical))
+			require.NoError(t, err)
+
+			parsed, err := ref.ParseMultiple(tt.input)
+			require.NoError(t, err)
+
+			resolved, err := ref.Canonical.Resolve(parsed)
+			require.NoError(t, err)
+
+			resolvedPtrs := make([]*ref.Resolved, len(resolved))
+			for i := range resolved {
+				resolvedPtrs[i] = &resolved[i]
+			}
+
+			result, err := formatter.Format(resolvedPtrs)
+			require.NoError(t, err)
+
+			// the citation reads back as the same verses
+			reparsed, err := ref.ParseMultiple(result)
+			require.NoError(t, err)
+
+			reresolved, err := ref.Canonical.Resolve(reparsed)
+			require.NoError(t, err)
+
+			want, err := ref.Canonical.Set(resolved...)
+			require.NoError(t, err)
+
+			got, err := ref.Canonical.Set(reresolved...)
+			require.NoError(t, err)
+			assert.Equal(t, want.Ranges(), got.Ranges(), result)
+		})
+	}
+}
@@ -0,0 +1,236 @@
+package ref
+
+import (
+	"database/sql/driver"
+	"encoding"
+	"errors"
+	"fmt"
+)
+
+// Verse IDs are integers of the form BBCCCVVV, where BB is the position of the
+// book in the canon (starting with 1), CCC is the chapter, and VVV is the verse.
+// For example, John 3:16 is 43003016 in the Canonical canon. Books without
+// chapters are given a chapter of 1. Verse IDs sort in canon order.
+const (
+	idBookFactor    = 1_000_000
+	idChapterFactor = 1_000
+	idMaxBook       = 99
+	idMaxChapter    = 999
+	idMaxVerse      = 999
+)
+
+// ErrBadVerseID is returned when a verse ID cannot be encoded or decoded.
+var ErrBadVerseID = errors.New("bad verse ID")
+
+// VerseID returns the integer ID of the verse v in the book b, which must be a
+// book of this canon. The ID has the form BBCCCVVV, where BB is the position of
+// the book in the canon (starting with 1), CCC is the chapter, and VVV is the
+// verse. Books without chapters are given a chapter of 1, so Jude 5 is
+// 65001005. Verse parts are not encoded, so John 3:16a has the same ID as John
+// 3:16.
+//
+// The book is matched by name, so it may also be taken from a clone of this
+// canon or a canon filtered from it. It returns ErrNotFound if there is no book
+// of that name in this canon or the verse is not part of the book in this
+// canon.
+func (c *Canon) VerseID(b *Book, v Verse) (int, error) {
+	bi := c.bookIndex(b)
+	if bi < 0 {
+		return 0, fmt.Errorf("%w: book %s is not in canon %s", ErrNotFound, b.Name, c.Name)
+	}
+
+	b = &c.Books[bi]
+	if !b.Contains(v) {
+		return 0, fmt.Errorf("%w: %s %s", ErrNotFound, b.Name, v.Ref())
+	}
+
+	chapter, verse := 1, 0
+	switch v := v.(type) {
+	case CV:
+		chapter, verse = v.Chapter, v.Verse
+	case N:
+		verse = v.Number
+	default:
+		return 0, fmt.Errorf("%w: unknown verse type %T", ErrBadVerseID, v)
+	}
+
+	if bi+1 > idMaxBook || chapter > idMaxChapter || verse > idMaxVerse {
+		return 0, fmt.Errorf("%w: %s %s is out of range", ErrBadVerseID, b.Name, v.Ref())
+	}
+
+	return (bi+1)*idBookFactor + chapter*idChapterFactor + verse, nil
+}
+
+// VerseFromID returns the book and verse identified by the given verse ID. See
+// VerseID for a description of the ID.
+//
+// It returns ErrBadVerseID if the ID is malformed and ErrNotFound if the ID
+// does not name a verse of this canon.
+func (c *Canon) VerseFromID(id int) (*Book, Verse, error) {
+	if id < idBookFactor {
+		return nil, nil, fmt.Errorf("%w: %d", ErrBadVerseID, id)
+	}
+
+	bi := id/idBookFactor - 1
+	chapter := id % idBookFactor / idChapterFactor
+	verse := id % idChapterFactor
+
+	if bi >= len(c.Books) {
+		return nil, nil, fmt.Errorf("%w: no book %d in verse ID %d", ErrNotFound, bi+1, id)
+	}
+
+	b := &c.Books[bi]
+
+	var v Verse = CV{Chapter: chapter, Verse: verse}
+	if b.JustVerse {
+		if chapter != 1 {
+			return nil, nil, fmt.Errorf("%w: %s has only one chapter", ErrNotFound, b.Name)
+		}
+		v = N{Number: verse}
+	}
+
+	if !b.Contains(v) {
+		return nil, nil, fmt.Errorf("%w: no verse %s %s", ErrNotFound, b.Name, v.Ref())
+	}
+
+	return b, v, nil
+}
+
+// IDRange returns the verse IDs of the first and last verses of the reference,
+// which must be resolved against the given canon. See Canon.VerseID for a
+// description of the IDs.
+func (r *Resolved) IDRange(c *Canon) (first, last int, err error) {
+	first, err = c.VerseID(r.Book, r.First)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	last, err = c.VerseID(r.Book, r.Last)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	return first, last, nil
+}
+
+// ResolvedFromIDRange returns the reference running from the verse with the
+// first ID to the verse with the last ID. Both verses must be in the same book.
+// This is the inverse of Resolved.IDRange.
+func (c *Canon) ResolvedFromIDRange(first, last int) (*Resolved, error) {
+	fb, fv, err := c.VerseFromID(first)
+	if err != nil {
+		return nil, err
+	}
+
+	lb, lv, err := c.VerseFromID(last)
+	if err != nil {
+		return nil, err
+	}
+
+	if fb != lb {
+		return nil, fmt.Errorf("%w: verse IDs %d and %d are in different books", ErrBadVerseID, first, last)
+	}
+
+	r := &Resolved{Book: fb, First: fv, Last: lv}
+	if err := r.Validate(); err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
+
+var (
+	_ encoding.TextMarshaler   = Resolved{}
+	_ encoding.TextUnmarshaler = (*Resolved)(nil)
+	_ driver.Valuer            = Resolved{}
+)
+
+// MarshalText returns the reference as returned by CompactRef (e.g., "John
+// 3:16-18"). This allows a Resolved reference to be stored as a string in
+// JSON, YAML, and other text formats.
+func (r Resolved) MarshalText() ([]byte, error) {
+	if r.Book == nil {
+		return nil, errors.New("cannot marshal a reference without a book")
+	}
+
+	ref, err := r.CompactRef()
+	if err != nil {
+		return nil, err
+	}
+
+	return []byte(ref), nil
+}
+
+// UnmarshalText parses and resolves the reference against the built-in canons,
+// trying the Canonical canon first and then the others of Canons in the order
+// of CanonNames. This reads back the references of every built-in canon, such
+// as Tobit 1:1 or Psalm 151. The Book of the reference is that of the first
+// canon in which it resolves, so a reference to a book shared by the canons
+// (e.g., Genesis) is always given the book of the Canonical canon. The
+// reference must resolve to a single range of verses within one book.
+func (r *Resolved) UnmarshalText(text []byte) error {
+	p, err := ParseProper(string(text))
+	if err != nil {
+		return err
+	}
+
+	var rs []Resolved
+	for _, c := range textCanons() {
+		var cerr error
+		rs, cerr = c.Resolve(p)
+		if cerr == nil {
+			err = nil
+			break
+		}
+
+		if err == nil {
+			err = cerr
+		}
+	}
+	if err != nil {
+		return err
+	}
+
+	if len(rs) != 1 {
+		return fmt.Errorf("reference %q does not resolve to a single range of verses", text)
+	}
+
+	*r = rs[0]
+	return nil
+}
+
+// textCanons returns the canons that UnmarshalText resolves references
+// against, in the order they are tried.
+func textCanons() []*Canon {
+	cs := []*Canon{Canonical}
+	for _, name := range CanonNames() {
+		if c := Canons[name]; c != Canonical {
+			cs = append(cs, c)
+		}
+	}
+	return cs
+}
+
+// Value stores the reference in a database as the string returned by
+// MarshalText.
+func (r Resolved) Value() (driver.Value, error) {
+	text, err := r.MarshalText()
+	if err != nil {
+		return nil, err
+	}
+	return string(text), nil
+}
+
+// Scan reads a reference stored by Value from a database, as with
+// UnmarshalText. Verse IDs are not read, as they depend on the canon; use
+// Canon.ResolvedFromIDRange to read those.
+func (r *Resolved) Scan(src any) error {
+	switch src := src.(type) {
+	case string:
+		return r.UnmarshalText([]byte(src))
+	case []byte:
+		return r.UnmarshalText(src)
+	default:
+		return fmt.Errorf("cannot scan %T into a reference", src)
+	}
+}
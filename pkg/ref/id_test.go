@@ -0,0 +1,249 @@
+package ref_test
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+
+	"github.com/zostay/today/pkg/ref"
+)
+
+func TestCanon_VerseID(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		book  string
+		verse ref.Verse
+		id    int
+	}{
+		{"Genesis", ref.CV{Chapter: 1, Verse: 1}, 1001001},
+		{"John", ref.CV{Chapter: 3, Verse: 16}, 43003016},
+		{"John", ref.CV{Chapter: 3, Verse: 16, Part: "a"}, 43003016},
+		{"Psalms", ref.CV{Chapter: 119, Verse: 176}, 19119176},
+		{"Jude", ref.N{Number: 5}, 65001005},
+		{"Revelation", ref.CV{Chapter: 22, Verse: 21}, 66022021},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.book+" "+tt.verse.Ref(), func(t *testing.T) {
+			t.Parallel()
+
+			b, err := ref.Canonical.Book(tt.book)
+			require.NoError(t, err)
+
+			id, err := ref.Canonical.VerseID(b, tt.verse)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.id, id)
+
+			vb, v, err := ref.Canonical.VerseFromID(id)
+			assert.NoError(t, err)
+			assert.Same(t, b, vb)
+			assert.True(t, tt.verse.Equal(v))
+		})
+	}
+}
+
+func TestCanon_VerseID_FilteredBook(t *testing.T) {
+	t.Parallel()
+
+	filtered, err := ref.Canonical.Filtered("John 4")
+	require.NoError(t, err)
+
+	b, err := filtered.Book("John")
+	require.NoError(t, err)
+
+	id, err := ref.Canonical.VerseID(b, ref.CV{Chapter: 3, Verse: 16})
+	assert.NoError(t, err)
+	assert.Equal(t, 43003016, id)
+
+	id, err = ref.Canonical.VerseID(b, ref.CV{Chapter: 4, Verse: 1})
+	assert.NoError(t, err)
+	assert.Equal(t, 43004001, id)
+
+	_, err = filtered.VerseID(b, ref.CV{Chapter: 4, Verse: 1})
+	assert.ErrorIs(t, err, ref.ErrNotFound)
+
+	clone := ref.Canonical.Clone()
+	id, err = ref.Canonical.VerseID(&clone.Books[0], ref.CV{Chapter: 1, Verse: 1})
+	assert.NoError(t, err)
+	assert.Equal(t, 1001001, id)
+}
+
+func TestCanon_VerseID_Errors(t *testing.T) {
+	t.Parallel()
+
+	b, err := ref.Canonical.Book("Genesis")
+	require.NoError(t, err)
+
+	_, err = ref.Canonical.VerseID(b, ref.CV{Chapter: 51, Verse: 1})
+	assert.ErrorIs(t, err, ref.ErrNotFound)
+
+	_, err = ref.Canonical.VerseID(&ref.Book{Name: "Tobit"}, ref.CV{Chapter: 1, Verse: 1})
+	assert.ErrorIs(t, err, ref.ErrNotFound)
+
+	_, _, err = ref.Canonical.VerseFromID(1234)
+	assert.ErrorIs(t, err, ref.ErrBadVerseID)
+
+	_, _, err = ref.Canonical.VerseFromID(67001001)
+	assert.ErrorIs(t, err, ref.ErrNotFound)
+
+	_, _, err = ref.Canonical.VerseFromID(1051001)
+	assert.ErrorIs(t, err, ref.ErrNotFound)
+
+	_, _, err = ref.Canonical.VerseFromID(65002001)
+	assert.ErrorIs(t, err, ref.ErrNotFound)
+}
+
+func TestResolved_IDRange(t *testing.T) {
+	t.Parallel()
+
+	p, err := ref.ParseProper("John 3:16-4:2")
+	require.NoError(t, err)
+
+	rs, err := ref.Canonical.Resolve(p)
+	require.NoError(t, err)
+	require.Len(t, rs, 1)
+
+	first, last, err := rs[0].IDRange(ref.Canonical)
+	assert.NoError(t, err)
+	assert.Equal(t, 43003016, first)
+	assert.Equal(t, 43004002, last)
+
+	r, err := ref.Canonical.ResolvedFromIDRange(first, last)
+	assert.NoError(t, err)
+	assert.Equal(t, &rs[0], r)
+
+	_, err = ref.Canonical.ResolvedFromIDRange(43003016, 44001001)
+	assert.ErrorIs(t, err, ref.ErrBadVerseID)
+
+	_, err = ref.Canonical.ResolvedFromIDRange(43004002, 43003016)
+	assert.Error(t, err)
+}
+
+func TestResolved_Text(t *testing.T) {
+	t.Parallel()
+
+	for _, in := range []string{
+		"John 3:16",
+		"Romans 8:28b-30",
+		"Psalm 23",
+		"Ruth",
+		"Jude 3-5",
+	} {
+		p, err := ref.ParseProper(in)
+		require.NoError(t, err)
+
+		rs, err := ref.Canonical.Resolve(p)
+		require.NoError(t, err)
+		require.Len(t, rs, 1)
+
+		text, err := rs[0].MarshalText()
+		assert.NoError(t, err)
+		assert.Equal(t, in, string(text))
+
+		var r ref.Resolved
+		err = r.UnmarshalText(text)
+		assert.NoError(t, err)
+		assert.Equal(t, rs[0], r)
+	}
+
+	_, err := ref.Resolved{}.MarshalText()
+	assert.Error(t, err)
+
+	var r ref.Resolved
+	assert.Error(t, r.UnmarshalText([]byte("Hezekiah 3:16")))
+	assert.Error(t, r.UnmarshalText([]byte("John 3:16; 4:1")))
+}
+
+func TestResolved_Text_Canons(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		canon *ref.Canon
+		in    string
+	}{
+		{ref.CatholicCanon, "Tobit 1:1"},
+		{ref.CatholicCanon, "Baruch 6:1-3"},
+		{ref.OrthodoxCanon, "Psalm 151"},
+		{ref.OrthodoxCanon, "3 Maccabees 1:1"},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.in, func(t *testing.T) {
+			t.Parallel()
+
+			p, err := ref.ParseProper(tt.in)
+			require.NoError(t, err)
+
+			rs, err := tt.canon.Resolve(p)
+			require.NoError(t, err)
+			require.Len(t, rs, 1)
+
+			text, err := rs[0].MarshalText()
+			assert.NoError(t, err)
+			assert.Equal(t, tt.in, string(text))
+
+			var r ref.Resolved
+			require.NoError(t, r.UnmarshalText(text))
+			assert.Equal(t, rs[0], r)
+
+			var db ref.Resolved
+			v, err := rs[0].Value()
+			require.NoError(t, err)
+			require.NoError(t, db.Scan(v))
+			assert.Equal(t, rs[0], db)
+		})
+	}
+}
+
+func TestResolved_JSONAndYAML(t *testing.T) {
+	t.Parallel()
+
+	type reading struct {
+		Ref ref.Resolved `json:"ref" yaml:"ref"`
+	}
+
+	var in reading
+	require.NoError(t, in.Ref.UnmarshalText([]byte("John 3:16-18")))
+
+	data, err := json.Marshal(in)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"ref":"John 3:16-18"}`, string(data))
+
+	var out reading
+	require.NoError(t, json.Unmarshal(data, &out))
+	assert.Equal(t, in, out)
+
+	data, err = yaml.Marshal(in)
+	require.NoError(t, err)
+	assert.Equal(t, "ref: John 3:16-18\n", string(data))
+
+	out = reading{}
+	require.NoError(t, yaml.Unmarshal(data, &out))
+	assert.Equal(t, in, out)
+}
+
+func TestResolved_SQL(t *testing.T) {
+	t.Parallel()
+
+	var r ref.Resolved
+	require.NoError(t, r.Scan("Genesis 1:1-3"))
+
+	v, err := r.Value()
+	assert.NoError(t, err)
+	assert.Equal(t, driver.Value("Genesis 1:1-3"), v)
+
+	var b ref.Resolved
+	require.NoError(t, b.Scan([]byte("Genesis 1:1-3")))
+	assert.Equal(t, r, b)
+
+	var id ref.Resolved
+	assert.Error(t, id.Scan(nil))
+	assert.Error(t, id.Scan(int64(43003016)))
+}
@@ -0,0 +1,255 @@
+package ref
+
+import (
+	"fmt"
+	"html"
+	"net/url"
+	"sort"
+	"strings"
+	"text/template"
+)
+
+// LinkProvider describes a website with a page for each reference that the
+// md-link and html-link styles may link to.
+type LinkProvider struct {
+	// Name is the short name of the provider (e.g., "esv").
+	Name string
+
+	// Title is the name of the website (e.g., "ESV.org").
+	Title string
+
+	// URL is a text/template that writes the URL of the page for a reference.
+	// It is executed with a LinkRef and may use the pathEscape and queryEscape
+	// functions to escape the reference (e.g., "{{pathEscape .Whole}}").
+	URL string
+
+	// Dated is true if the pages of the website are found by date rather
+	// than by reference, in which case each reference linked must be given a
+	// date by the function passed to WithLinkDate.
+	Dated bool
+}
+
+var (
+	// ESVLink links to the reference on esv.org, as esv.Resolver does.
+	ESVLink = &LinkProvider{
+		Name:  "esv",
+		Title: "ESV.org",
+		URL:   `https://www.esv.org/{{pathEscape .Whole}}`,
+	}
+
+	// BibleGatewayLink links to the reference in the ESV on BibleGateway.
+	BibleGatewayLink = &LinkProvider{
+		Name:  "biblegateway",
+		Title: "BibleGateway",
+		URL:   `https://www.biblegateway.com/passage/?search={{queryEscape .Ref}}&version=ESV`,
+	}
+
+	// OSTLink links to the page of openscripture.today for the date on which
+	// the reference was the scripture of the day.
+	OSTLink = &LinkProvider{
+		Name:  "ost",
+		Title: "openscripture.today",
+		URL:   `https://openscripture.today/verses/{{.Date}}/`,
+		Dated: true,
+	}
+
+	// LinkProviders names the providers that may be given to
+	// WithLinkProvider.
+	LinkProviders = map[string]*LinkProvider{
+		ESVLink.Name:          ESVLink,
+		BibleGatewayLink.Name: BibleGatewayLink,
+		OSTLink.Name:          OSTLink,
+	}
+)
+
+// LinkRef is the data passed to the URL template of a LinkProvider for each
+// reference.
+type LinkRef struct {
+	TemplateRef
+
+	// Whole is the reference to the whole verses, written with the chapter
+	// given for both ends of a range (e.g., "John 3:16-3:18").
+	Whole string
+
+	// OSIS is the OSIS reference (e.g., "John.3.16-John.3.18").
+	OSIS string
+
+	// Date is the date given to the reference by the function passed to
+	// WithLinkDate (e.g., "2023/12/20"). It is empty if there is none.
+	Date string
+}
+
+// linkFuncs are the functions available to the URL template of a
+// LinkProvider.
+var linkFuncs = template.FuncMap{
+	"pathEscape":  url.PathEscape,
+	"queryEscape": url.QueryEscape,
+}
+
+// WithLinkProvider selects the website that the md-link and html-link styles
+// link references to. The default is ESVLink.
+func WithLinkProvider(p *LinkProvider) ResolveOption {
+	return func(o *resolveOpts) {
+		o.LinkProvider = p
+	}
+}
+
+// WithLinkDate sets the function that returns the date of each reference for
+// the Date of the LinkRef. It returns false if the reference has no date.
+func WithLinkDate(date func(*Resolved) (string, bool)) ResolveOption {
+	return func(o *resolveOpts) {
+		o.LinkDate = date
+	}
+}
+
+// GetLinkProvider returns the named provider from LinkProviders. It returns
+// ErrNotFound if there is no such provider.
+func GetLinkProvider(name string) (*LinkProvider, error) {
+	if p, ok := LinkProviders[name]; ok {
+		return p, nil
+	}
+	return nil, fmt.Errorf("%w: link provider %q", ErrNotFound, name)
+}
+
+// LinkProviderNames returns the names of the providers in LinkProviders,
+// sorted.
+func LinkProviderNames() []string {
+	names := make([]string, 0, len(LinkProviders))
+	for name := range LinkProviders {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Link returns the URL of the page of the provider for the reference. The
+// WithAbbreviations and WithNotation options select the book names and
+// notation of the LinkRef and WithLinkDate gives its date. If the provider is
+// Dated and the reference has no date, it returns ErrNotFound.
+func (p *LinkProvider) Link(r *Resolved, opt ...ResolveOption) (string, error) {
+	tmpl, err := p.template()
+	if err != nil {
+		return "", err
+	}
+
+	return p.link(tmpl, makeResolveOpts(opt), r)
+}
+
+// template parses the URL template of the provider.
+func (p *LinkProvider) template() (*template.Template, error) {
+	tmpl, err := template.New(p.Name).
+		Option("missingkey=error").
+		Funcs(linkFuncs).
+		Parse(p.URL)
+	if err != nil {
+		return nil, fmt.Errorf("link provider %q: %w", p.Name, err)
+	}
+	return tmpl, nil
+}
+
+// link executes the parsed URL template of the provider for the reference.
+func (p *LinkProvider) link(tmpl *template.Template, o *resolveOpts, r *Resolved) (string, error) {
+	tr, err := newTemplateRef(o, r, r)
+	if err != nil {
+		return "", err
+	}
+
+	osis, err := osisRef(r, r)
+	if err != nil {
+		return "", err
+	}
+
+	data := &LinkRef{
+		TemplateRef: *tr,
+		Whole:       r.Whole().Ref(),
+		OSIS:        osis,
+	}
+
+	if o.LinkDate != nil {
+		if date, ok := o.LinkDate(r); ok {
+			data.Date = date
+		}
+	}
+
+	if p.Dated && data.Date == "" {
+		return "", fmt.Errorf("%w: no %s page for %q", ErrNotFound, p.Title, r.Ref())
+	}
+
+	var buf strings.Builder
+	if err := tmpl.Execute(&buf, data); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
+
+// linkFormatter formats each reference as a link to the page of a
+// LinkProvider, writing the text of the link as the canonical style does.
+type linkFormatter struct {
+	o      *resolveOpts
+	p      *LinkProvider
+	tmpl   *template.Template
+	anchor func(text, href string) string
+}
+
+// newLinkFormatter returns a link formatter that writes each link with the
+// anchor function.
+func newLinkFormatter(o *resolveOpts, anchor func(text, href string) string) (RefFormatter, error) {
+	p := o.LinkProvider
+	if p == nil {
+		p = ESVLink
+	}
+
+	tmpl, err := p.template()
+	if err != nil {
+		return nil, err
+	}
+
+	return &linkFormatter{o: o, p: p, tmpl: tmpl, anchor: anchor}, nil
+}
+
+// Format writes a separate link for each segment, even those of a Span, as
+// each is a separate page of the provider.
+func (f *linkFormatter) Format(resolved []*Resolved) (string, error) {
+	var (
+		buf  strings.Builder
+		prev *Resolved
+	)
+
+	name := func(r *Resolved) (string, error) { return r.fullName(f.o) }
+	for _, r := range resolved {
+		sep, text, err := formatElided(prev, r, r, f.o.Notation, f.o.Typography, name)
+		if err != nil {
+			return "", err
+		}
+
+		href, err := f.p.link(f.tmpl, f.o, r)
+		if err != nil {
+			return "", err
+		}
+
+		if prev != nil {
+			buf.WriteString(sep)
+		}
+		buf.WriteString(f.anchor(text, href))
+
+		prev = r
+	}
+
+	return buf.String(), nil
+}
+
+var (
+	markdownTextEscaper = strings.NewReplacer(`\`, `\\`, `[`, `\[`, `]`, `\]`)
+	markdownURLEscaper  = strings.NewReplacer(` `, `%20`, `(`, `%28`, `)`, `%29`, `<`, `%3C`, `>`, `%3E`)
+)
+
+// markdownAnchor writes a Markdown link (e.g., "[John 3:16](https://...)").
+func markdownAnchor(text, href string) string {
+	return "[" + markdownTextEscaper.Replace(text) + "](" + markdownURLEscaper.Replace(href) + ")"
+}
+
+// htmlAnchor writes an HTML anchor (e.g., `<a href="https://...">John
+// 3:16</a>`).
+func htmlAnchor(text, href string) string {
+	return `<a href="` + html.EscapeString(href) + `">` + html.EscapeString(text) + `</a>`
+}
@@ -0,0 +1,107 @@
+package ref_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zostay/today/pkg/ref"
+)
+
+func resolveLinks(t *testing.T, input string) []*ref.Resolved {
+	t.Helper()
+
+	parsed, err := ref.ParseMultiple(input)
+	require.NoError(t, err)
+
+	resolved, err := ref.Canonical.Resolve(parsed)
+	require.NoError(t, err)
+
+	resolvedPtrs := make([]*ref.Resolved, len(resolved))
+	for i := range resolved {
+		resolvedPtrs[i] = &resolved[i]
+	}
+
+	return resolvedPtrs
+}
+
+func TestLinkFormatter_Format(t *testing.T) {
+	t.Parallel()
+
+	dates := ref.WithLinkDate(func(r *ref.Resolved) (string, bool) {
+		if r.Ref() == "John 3:16" {
+			return "2023/12/22", true
+		}
+		return "", false
+	})
+
+	tests := []struct {
+		name     string
+		style    string
+		input    string
+		opts     []ref.ResolveOption
+		expected string
+	}{
+		{"markdown", "md-link", "John 3:16-18", nil, "[John 3:16-18](https://www.esv.org/John%203:16-3:18)"},
+		{"html", "html-link", "John 3:16-18", nil, `<a href="https://www.esv.org/John%203:16-3:18">John 3:16-18</a>`},
+		{"list", "md-link", "John 3:16; Genesis 1", nil, "[John 3:16](https://www.esv.org/John%203:16); [Genesis 1](https://www.esv.org/Genesis%201:1-1:31)"},
+		{"span segments", "md-link", "Malachi 4-Matthew 1", nil, "[Malachi 4](https://www.esv.org/Malachi%204:1-4:6); [Matthew 1](https://www.esv.org/Matthew%201:1-1:25)"},
+		{"whole verses", "md-link", "John 3:16a", nil, "[John 3:16a](https://www.esv.org/John%203:16)"},
+		{"biblegateway", "html-link", "1 John 1:9", []ref.ResolveOption{ref.WithLinkProvider(ref.BibleGatewayLink)}, `<a href="https://www.biblegateway.com/passage/?search=1+John+1%3A9&amp;version=ESV">1 John 1:9</a>`},
+		{"ost", "md-link", "John 3:16", []ref.ResolveOption{ref.WithLinkProvider(ref.OSTLink), dates}, "[John 3:16](https://openscripture.today/verses/2023/12/22/)"},
+		{"elided", "md-link", "John 3:16; John 3:18", []ref.ResolveOption{ref.WithElidedChapters()}, "[John 3:16](https://www.esv.org/John%203:16), [18](https://www.esv.org/John%203:18)"},
+		{"abbreviations", "html-link", "Genesis 1:1", []ref.ResolveOption{ref.WithAbbreviations(ref.AbbreviationsDE)}, `<a href="https://www.esv.org/Genesis%201:1">1. Mose 1:1</a>`},
+		{"custom", "md-link", "Romans 8:28", []ref.ResolveOption{ref.WithLinkProvider(&ref.LinkProvider{Name: "osis", URL: "https://example.com/{{.OSIS}} ({{.Abbr}})"})}, "[Romans 8:28](https://example.com/Rom.8.28%20%28Rom.%29)"},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			formatter, err := ref.GetFormatter(tt.style, tt.opts...)
+			require.NoError(t, err)
+
+			result, err := formatter.Format(resolveLinks(t, tt.input))
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func TestLinkFormatter_Errors(t *testing.T) {
+	t.Parallel()
+
+	_, err := ref.GetFormatter("md-link",
+		ref.WithLinkProvider(&ref.LinkProvider{Name: "bad", URL: "{{.Ref"}))
+	assert.ErrorContains(t, err, `link provider "bad"`)
+
+	formatter, err := ref.GetFormatter("md-link", ref.WithLinkProvider(ref.OSTLink))
+	require.NoError(t, err)
+
+	_, err = formatter.Format(resolveLinks(t, "John 3:16"))
+	assert.ErrorIs(t, err, ref.ErrNotFound)
+}
+
+func TestLinkProvider_Link(t *testing.T) {
+	t.Parallel()
+
+	r := resolveLinks(t, "Psalm 23")[0]
+	link, err := ref.ESVLink.Link(r)
+	assert.NoError(t, err)
+	assert.Equal(t, "https://www.esv.org/Psalms%2023:1-23:6", link)
+}
+
+func TestGetLinkProvider(t *testing.T) {
+	t.Parallel()
+
+	p, err := ref.GetLinkProvider("biblegateway")
+	assert.NoError(t, err)
+	assert.Equal(t, ref.BibleGatewayLink, p)
+
+	_, err = ref.GetLinkProvider("blueletter")
+	assert.ErrorIs(t, err, ref.ErrNotFound)
+
+	assert.Equal(t, []string{"biblegateway", "esv", "ost"}, ref.LinkProviderNames())
+}
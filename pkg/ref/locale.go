@@ -0,0 +1,296 @@
+package ref
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"sort"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Locale pairs the book names and abbreviations of a language with the
+// notation usually used to write references in that language.
+type Locale struct {
+	// Name is the name of the locale (e.g., "de").
+	Name string
+
+	// Abbreviations are the book names and abbreviations of the locale.
+	Abbreviations *BookAbbreviations
+
+	// Notation is the notation usually used for references in the locale.
+	Notation Notation
+}
+
+// Locales lists the built-in locales by name.
+var Locales = map[string]*Locale{
+	"en": {Name: "en", Abbreviations: Abbreviations, Notation: StandardNotation},
+	"es": {Name: "es", Abbreviations: AbbreviationsES, Notation: EuropeanNotation},
+	"de": {Name: "de", Abbreviations: AbbreviationsDE, Notation: EuropeanNotation},
+}
+
+// GetLocale returns the named locale from Locales. It returns ErrNotFound if
+// there is no such locale.
+func GetLocale(name string) (*Locale, error) {
+	if l, ok := Locales[name]; ok {
+		return l, nil
+	}
+	return nil, fmt.Errorf("%w: locale %q", ErrNotFound, name)
+}
+
+// LocaleNames returns the names of the built-in locales, sorted.
+func LocaleNames() []string {
+	names := make([]string, 0, len(Locales))
+	for name := range Locales {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// ResolveOptions returns the options to use to resolve and format references
+// using the book names, abbreviations, and notation of this locale.
+func (l *Locale) ResolveOptions() []ResolveOption {
+	return []ResolveOption{
+		WithAbbreviations(l.Abbreviations),
+		WithNotation(l.Notation),
+	}
+}
+
+// ErrBadAbbreviations is returned when abbreviations loaded by
+// LoadAbbreviations are invalid.
+var ErrBadAbbreviations = errors.New("bad abbreviations")
+
+type ordinalConfig struct {
+	Standard int      `yaml:"standard"`
+	Accept   []string `yaml:"accept"`
+}
+
+type bookAbbrConfig struct {
+	Name     string   `yaml:"name"`
+	Local    string   `yaml:"local"`
+	USFM     string   `yaml:"usfm"`
+	Standard string   `yaml:"standard"`
+	Singular string   `yaml:"singular"`
+	Ordinal  int      `yaml:"ordinal"`
+	Accept   []string `yaml:"accept"`
+}
+
+type abbreviationsConfig struct {
+	Ordinals []ordinalConfig  `yaml:"ordinals"`
+	Books    []bookAbbrConfig `yaml:"books"`
+}
+
+// LoadAbbreviations reads a set of book names and abbreviations from YAML.
+// This uses the same format as the abbr.yaml file used to generate
+// Abbreviations:
+//
+//	ordinals:
+//	  - standard: 1
+//	    accept: [1, I, Erste]
+//	books:
+//	  - name: Romans
+//	    local: Römer
+//	    standard: Röm
+//	    accept: [Römer, Röm]
+//	  - name: 1 Corinthians
+//	    local: 1. Korinther
+//	    standard: 1Kor
+//	    ordinal: 1
+//	    accept: [Korinther, Kor]
+//
+// The name must be the name of the book in the canon. The accepted names of a
+// book with an ordinal are combined with each accepted form of the ordinal. A
+// book may set a usfm code, but the USFM code of the book in Abbreviations is
+// used if it does not. A book that is not in Abbreviations (e.g., Enoch) must
+// set a usfm code, so that a misspelled name (e.g., "Phillipians") is not
+// mistaken for a new book.
+//
+// It returns an error matching ErrBadAbbreviations if the abbreviations are
+// invalid, including when they use a key not shown above.
+func LoadAbbreviations(r io.Reader) (*BookAbbreviations, error) {
+	var cfg abbreviationsConfig
+	dec := yaml.NewDecoder(r)
+	dec.KnownFields(true)
+	if err := dec.Decode(&cfg); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrBadAbbreviations, err)
+	}
+
+	ordinals := make(map[int][]string, len(cfg.Ordinals))
+	for _, ord := range cfg.Ordinals {
+		ordinals[ord.Standard] = ord.Accept
+	}
+
+	abbrs := &BookAbbreviations{
+		Abbreviations: make([]BookAbbreviation, 0, len(cfg.Books)),
+	}
+	for _, b := range cfg.Books {
+		if b.Name == "" {
+			return nil, fmt.Errorf("%w: book abbreviation is missing a name", ErrBadAbbreviations)
+		}
+
+		accepts := b.Accept
+		if b.Ordinal != 0 {
+			ordAccepts, ok := ordinals[b.Ordinal]
+			if !ok {
+				return nil, fmt.Errorf("%w: book named %q has bad ordinal configuration", ErrBadAbbreviations, b.Name)
+			}
+
+			accepts = make([]string, 0, len(ordAccepts)*len(b.Accept))
+			for _, acc := range b.Accept {
+				for _, ordAcc := range ordAccepts {
+					accepts = append(accepts, ordAcc+acc)
+				}
+			}
+		}
+
+		usfm := b.USFM
+		if usfm == "" {
+			usfm = defaultUSFM(b.Name)
+		}
+		if usfm == "" {
+			return nil, fmt.Errorf("%w: book named %q is not a known book and has no usfm code", ErrBadAbbreviations, b.Name)
+		}
+
+		abbrs.Abbreviations = append(abbrs.Abbreviations, BookAbbreviation{
+			Name:      b.Name,
+			Local:     b.Local,
+			USFM:      usfm,
+			Preferred: b.Standard,
+			Singular:  b.Singular,
+			Ordinal:   b.Ordinal,
+			Accepts:   accepts,
+		})
+	}
+
+	return abbrs, nil
+}
+
+// Merge returns a copy of these abbreviations with the given abbreviations
+// merged over them, such as house abbreviations read with LoadAbbreviations.
+// The accepted names of each book in over are added to those of the book with
+// the same name, and its local name, USFM code, preferred abbreviation,
+// singular form, and ordinal replace those of the book, if set. A book that is
+// not found is added.
+//
+// A name accepted for a book in over is no longer accepted for any other book,
+// so that it always resolves to the book it is given for.
+func (b *BookAbbreviations) Merge(over *BookAbbreviations) *BookAbbreviations {
+	claimed := map[string]string{}
+	for _, o := range over.Abbreviations {
+		for _, acc := range o.Accepts {
+			claimed[cleanAbbreviation(acc)] = o.Name
+		}
+	}
+
+	merged := &BookAbbreviations{
+		Abbreviations: make([]BookAbbreviation, 0, len(b.Abbreviations)+len(over.Abbreviations)),
+	}
+
+	index := make(map[string]int, len(b.Abbreviations))
+	for _, abbr := range b.Abbreviations {
+		accepts := make([]string, 0, len(abbr.Accepts))
+		for _, acc := range abbr.Accepts {
+			if name, isClaimed := claimed[cleanAbbreviation(acc)]; isClaimed && name != abbr.Name {
+				continue
+			}
+			accepts = append(accepts, acc)
+		}
+
+		abbr.Accepts = accepts
+		index[abbr.Name] = len(merged.Abbreviations)
+		merged.Abbreviations = append(merged.Abbreviations, abbr)
+	}
+
+	for _, o := range over.Abbreviations {
+		i, found := index[o.Name]
+		if !found {
+			o.Accepts = slices.Clone(o.Accepts)
+			index[o.Name] = len(merged.Abbreviations)
+			merged.Abbreviations = append(merged.Abbreviations, o)
+			continue
+		}
+
+		abbr := &merged.Abbreviations[i]
+		for _, acc := range o.Accepts {
+			if !slices.Contains(abbr.Accepts, acc) {
+				abbr.Accepts = append(abbr.Accepts, acc)
+			}
+		}
+
+		if o.Local != "" {
+			abbr.Local = o.Local
+		}
+		if o.USFM != "" {
+			abbr.USFM = o.USFM
+		}
+		if o.Preferred != "" {
+			abbr.Preferred = o.Preferred
+		}
+		if o.Singular != "" {
+			abbr.Singular = o.Singular
+		}
+		if o.Ordinal != 0 {
+			abbr.Ordinal = o.Ordinal
+		}
+	}
+
+	return merged
+}
+
+// WithCanon returns these abbreviations with the names of the books of the
+// given canon added, so that books the abbreviations do not know, such as those
+// of a canon read with LoadCanon, can be referenced. The name and alternate
+// names of each book are accepted unless another book already accepts them.
+// These abbreviations are returned as they are if there is nothing to add.
+func (b *BookAbbreviations) WithCanon(c *Canon) *BookAbbreviations {
+	known := make(map[string]bool, len(b.Abbreviations))
+	for _, abbr := range b.Abbreviations {
+		known[abbr.Name] = true
+	}
+
+	over := &BookAbbreviations{}
+	for i := range c.Books {
+		book := &c.Books[i]
+
+		var accepts []string
+		for _, name := range append([]string{book.Name}, book.AlternateNames...) {
+			if _, err := b.exactBookName(name); errors.Is(err, ErrNotFound) {
+				accepts = append(accepts, name)
+			}
+		}
+
+		switch {
+		case !known[book.Name]:
+			over.Abbreviations = append(over.Abbreviations, BookAbbreviation{
+				Name:      book.Name,
+				Preferred: book.Name,
+				Accepts:   accepts,
+				USFM:      book.USFM,
+			})
+		case len(accepts) > 0:
+			over.Abbreviations = append(over.Abbreviations, BookAbbreviation{
+				Name:    book.Name,
+				Accepts: accepts,
+			})
+		}
+	}
+
+	if len(over.Abbreviations) == 0 {
+		return b
+	}
+
+	return b.Merge(over)
+}
+
+// defaultUSFM returns the USFM code of the named book in Abbreviations or an
+// empty string if the book is not found.
+func defaultUSFM(name string) string {
+	for i := range Abbreviations.Abbreviations {
+		if Abbreviations.Abbreviations[i].Name == name {
+			return Abbreviations.Abbreviations[i].USFM
+		}
+	}
+	return ""
+}
@@ -0,0 +1,268 @@
+package ref_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zostay/today/pkg/ref"
+)
+
+func TestGetLocale(t *testing.T) {
+	t.Parallel()
+
+	l, err := ref.GetLocale("de")
+	assert.NoError(t, err)
+	assert.Equal(t, "de", l.Name)
+	assert.Equal(t, ref.AbbreviationsDE, l.Abbreviations)
+	assert.Equal(t, ref.EuropeanNotation, l.Notation)
+
+	l, err = ref.GetLocale("tlh")
+	assert.ErrorIs(t, err, ref.ErrNotFound)
+	assert.Nil(t, l)
+
+	assert.Equal(t, []string{"de", "en", "es"}, ref.LocaleNames())
+}
+
+func TestLocales_BookNames(t *testing.T) {
+	t.Parallel()
+
+	for _, name := range ref.LocaleNames() {
+		name := name
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			l, err := ref.GetLocale(name)
+			require.NoError(t, err)
+
+			// every book of every canon has a name in every locale
+			books := map[string]bool{}
+			for _, c := range ref.Canons {
+				for _, b := range c.Books {
+					books[b.Name] = true
+				}
+			}
+
+			require.Len(t, l.Abbreviations.Abbreviations, len(books))
+			for _, abbr := range l.Abbreviations.Abbreviations {
+				assert.True(t, books[abbr.Name], abbr.Name)
+
+				if name == "en" {
+					continue
+				}
+
+				for _, in := range []string{abbr.Local, abbr.Preferred} {
+					got, err := l.Abbreviations.BookName(in)
+					assert.NoError(t, err, in)
+					assert.Equal(t, abbr.Name, got, in)
+				}
+			}
+		})
+	}
+}
+
+func TestLocale_Resolve(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		locale   string
+		style    string
+		input    string
+		expected string
+	}{
+		{"de", "canonical", "Joh 3,16", "Johannes 3,16"},
+		{"de", "canonical", "1. Mose 1,1-2,3", "1. Mose 1,1-2,3"},
+		{"de", "canonical", "Römer 8", "Römer 8"},
+		{"de", "canonical", "Roemer 8,28b-30", "Römer 8,28b-30"},
+		{"de", "canonical", "Ps 23", "Psalm 23"},
+		{"de", "canonical", "Ps 1-2", "Psalmen 1-2"},
+		{"de", "canonical", "Mal 3,2-Mt 1,1", "Maleachi 3,2-Matthäus 1,1"},
+		{"de", "abbr", "Johannes 3,16; 1. Korinther 13", "Joh 3,16; 1Kor 13"},
+		{"de", "3letter", "Römer 1,1", "Röm 1,1"},
+		{"es", "canonical", "Juan 3,16", "Juan 3,16"},
+		{"es", "canonical", "Gn 1,1", "Génesis 1,1"},
+		{"es", "canonical", "Sal 23", "Salmo 23"},
+		{"es", "abbr", "1 Corintios 13,4-7", "1 Co 13,4-7"},
+		{"es", "abbr", "Apocalipsis 22,21", "Ap 22,21"},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.locale+" "+tt.input, func(t *testing.T) {
+			t.Parallel()
+
+			l, err := ref.GetLocale(tt.locale)
+			require.NoError(t, err)
+
+			m, err := ref.ParseMultiple(tt.input, ref.ParseWithNotation(l.Notation))
+			require.NoError(t, err)
+
+			resolved, err := ref.Canonical.Resolve(m, ref.WithAbbreviations(l.Abbreviations))
+			require.NoError(t, err)
+
+			resolvedPtrs := make([]*ref.Resolved, len(resolved))
+			for i := range resolved {
+				resolvedPtrs[i] = &resolved[i]
+			}
+
+			formatter, err := ref.GetFormatter(tt.style, l.ResolveOptions()...)
+			require.NoError(t, err)
+
+			result, err := formatter.Format(resolvedPtrs)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+const testAbbreviations = `---
+ordinals:
+  - standard: 1
+    accept:
+      - 1
+      - I
+books:
+  - name: Romans
+    local: Römer
+    usfm: ROM
+    standard: Röm
+    accept:
+      - Römer
+      - Röm
+  - name: 1 Corinthians
+    local: 1. Korinther
+    standard: 1Kor
+    ordinal: 1
+    accept:
+      - Korinther
+      - Kor
+`
+
+func TestLoadAbbreviations(t *testing.T) {
+	t.Parallel()
+
+	abbrs, err := ref.LoadAbbreviations(strings.NewReader(testAbbreviations))
+	require.NoError(t, err)
+
+	assert.Equal(t, []ref.BookAbbreviation{
+		{
+			Name:      "Romans",
+			Local:     "Römer",
+			USFM:      "ROM",
+			Preferred: "Röm",
+			Accepts:   []string{"Römer", "Röm"},
+		},
+		{
+			Name:      "1 Corinthians",
+			Local:     "1. Korinther",
+			USFM:      "1CO",
+			Preferred: "1Kor",
+			Ordinal:   1,
+			Accepts:   []string{"1Korinther", "IKorinther", "1Kor", "IKor"},
+		},
+	}, abbrs.Abbreviations)
+
+	name, err := abbrs.BookName("I Kor")
+	assert.NoError(t, err)
+	assert.Equal(t, "1 Corinthians", name)
+
+	name, err = abbrs.BookName("1CO")
+	assert.NoError(t, err)
+	assert.Equal(t, "1 Corinthians", name)
+
+	name, err = abbrs.LocalName("Romans")
+	assert.NoError(t, err)
+	assert.Equal(t, "Römer", name)
+
+	_, err = ref.LoadAbbreviations(strings.NewReader(`books: [{name: 2 Kings, ordinal: 2, accept: [Kings]}]`))
+	assert.Error(t, err)
+
+	_, err = ref.LoadAbbreviations(strings.NewReader(`books: [{standard: Kgs}]`))
+	assert.Error(t, err)
+
+	_, err = ref.LoadAbbreviations(strings.NewReader(`books: {`))
+	assert.Error(t, err)
+}
+
+func TestLoadAbbreviations_Errors(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		in   string
+	}{
+		{"no name", `books: [{standard: Kgs}]`},
+		{"bad ordinal", `books: [{name: 2 Kings, ordinal: 2, accept: [Kings]}]`},
+		{"unknown book", `books: [{name: Phillipians, accept: [Phl]}]`},
+		{"unknown key", `abbreviations: [{name: Philippians, accept: [Phl]}]`},
+		{"unknown book key", `books: [{name: Philippians, accepts: [Phl]}]`},
+		{"bad yaml", `books: {`},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := ref.LoadAbbreviations(strings.NewReader(tt.in))
+			assert.ErrorIs(t, err, ref.ErrBadAbbreviations)
+		})
+	}
+}
+
+func TestBookAbbreviations_Merge(t *testing.T) {
+	t.Parallel()
+
+	house, err := ref.LoadAbbreviations(strings.NewReader(`
+books:
+  - name: Philippians
+    standard: Phl
+    accept: [Phl]
+  - name: Jude
+    accept: [Jn]
+  - name: Enoch
+    usfm: ENO
+    standard: En
+    accept: [Enoch, En]
+`))
+	require.NoError(t, err)
+
+	abbrs := ref.Abbreviations.Merge(house)
+
+	tests := []struct {
+		in     string
+		expect string
+	}{
+		{"Phl", "Philippians"},
+		{"Php", "Philippians"},
+		{"Phlm", "Philemon"},
+		{"Jn", "Jude"},
+		{"John", "John"},
+		{"Jude", "Jude"},
+		{"Enoch", "Enoch"},
+	}
+
+	for _, tt := range tests {
+		name, err := abbrs.BookName(tt.in)
+		assert.NoError(t, err, tt.in)
+		assert.Equal(t, tt.expect, name, tt.in)
+	}
+
+	preferred, err := abbrs.PreferredAbbreviation("Philippians")
+	assert.NoError(t, err)
+	assert.Equal(t, "Phl", preferred)
+
+	preferred, err = abbrs.PreferredAbbreviation("Jude")
+	assert.NoError(t, err)
+	assert.Equal(t, "Jude", preferred)
+
+	// the original abbreviations are unchanged
+	name, err := ref.Abbreviations.BookName("Jn")
+	assert.NoError(t, err)
+	assert.Equal(t, "John", name)
+
+	_, err = ref.Abbreviations.BookName("Enoch")
+	assert.ErrorIs(t, err, ref.ErrNotFound)
+}
@@ -0,0 +1,133 @@
+package ref
+
+import "strconv"
+
+// Notation describes the punctuation used to write references. English
+// speakers typically write "John 3:16, 18", whereas much of Europe writes the
+// same reference as "Joh 3,16.18".
+type Notation struct {
+	// ChapterVerse separates the chapter from the verse (e.g., the ":" in
+	// "3:16").
+	ChapterVerse rune
+
+	// AltChapterVerse is an alternate chapter and verse separator that is
+	// accepted when parsing, but never output. It is zero if there is no
+	// alternate.
+	AltChapterVerse rune
+
+	// List separates related references within the same book (e.g., the ","
+	// in "3:16, 18").
+	List rune
+
+	// Range separates the first and last verses of a range when references
+	// are written (e.g., the "-" in "3:16-18"). It is zero to write a hyphen.
+	// Any dash is accepted when parsing.
+	Range rune
+}
+
+var (
+	// StandardNotation is the notation used in English: "John 3:16, 18". When
+	// parsing, a period may also be used to separate chapter from verse, as in
+	// "John 3.16".
+	StandardNotation = Notation{
+		ChapterVerse:    ':',
+		AltChapterVerse: '.',
+		List:            ',',
+	}
+
+	// EuropeanNotation is the notation used in German and many other European
+	// languages: "Joh 3,16.18".
+	EuropeanNotation = Notation{
+		ChapterVerse: ',',
+		List:         '.',
+	}
+)
+
+// isChapterVerse returns true if the rune separates chapter from verse in this
+// notation.
+func (n Notation) isChapterVerse(r rune) bool {
+	return r == n.ChapterVerse || (n.AltChapterVerse != 0 && r == n.AltChapterVerse)
+}
+
+// rangeSep returns the separator to write between the first and last verses of
+// a range in this notation.
+func (n Notation) rangeSep() string {
+	if n.Range == 0 {
+		return "-"
+	}
+	return string(n.Range)
+}
+
+// listSep returns the separator to write between related references in this
+// notation. A comma is followed by a space (e.g., "3:16, 18"), but other
+// separators are not (e.g., "3,16.18").
+func (n Notation) listSep() string {
+	if n.List == 0 || n.List == ',' {
+		return ", "
+	}
+	return string(n.List)
+}
+
+// verseRef returns the reference for the given verse written in this notation.
+func (n Notation) verseRef(v Verse) string {
+	if cv, isCV := v.(CV); isCV {
+		return strconv.Itoa(cv.Chapter) + string(n.ChapterVerse) + cv.verseRef()
+	}
+	return v.Ref()
+}
+
+type parseOpts struct {
+	Notation      Notation
+	Abbreviations *BookAbbreviations
+	Lenient       bool
+}
+
+// ParseOption is an option that may be passed to the Parse functions to
+// control how references are parsed.
+type ParseOption func(*parseOpts)
+
+// ParseWithNotation will parse references written using the given notation.
+// The default is StandardNotation.
+func ParseWithNotation(n Notation) ParseOption {
+	return func(o *parseOpts) {
+		o.Notation = n
+	}
+}
+
+// ParseWithAbbreviations will reject book names that do not match any of the
+// given abbreviations. The ParseError returned for an unknown book name will
+// suggest similar book names. By default, any book name is accepted.
+func ParseWithAbbreviations(abbrs *BookAbbreviations) ParseOption {
+	return func(o *parseOpts) {
+		o.Abbreviations = abbrs
+	}
+}
+
+// ParseLeniently will accept a misspelled book name (e.g., "Revelations") that
+// resembles one book closely enough, as described by the Lenient option, when
+// used with ParseWithAbbreviations. The book name is kept as given, so the
+// reference must also be resolved with the Lenient option. Misspellings are
+// never accepted while extracting references from text.
+func ParseLeniently() ParseOption {
+	return func(o *parseOpts) {
+		o.Lenient = true
+	}
+}
+
+func makeParseOpts(opts []ParseOption) *parseOpts {
+	o := &parseOpts{
+		Notation: StandardNotation,
+	}
+	for i := range opts {
+		opts[i](o)
+	}
+	return o
+}
+
+// WithNotation will cause references to be written (or, in the case of
+// Extract, read) using the given notation. The default is StandardNotation.
+func WithNotation(n Notation) ResolveOption {
+	return func(o *resolveOpts) {
+		o.Notation = n
+	}
+}
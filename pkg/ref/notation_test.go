@@ -0,0 +1,133 @@
+package ref_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zostay/today/pkg/ref"
+)
+
+func TestParseWithNotation(t *testing.T) {
+	t.Parallel()
+
+	eu := ref.ParseWithNotation(ref.EuropeanNotation)
+
+	cv, err := ref.ParseCV("3,16", eu)
+	assert.NoError(t, err)
+	assert.Equal(t, ref.CV{Chapter: 3, Verse: 16}, cv)
+
+	cv, err = ref.ParseCV("3.16", eu)
+	assert.ErrorIs(t, err, ref.ErrParseFail)
+	assert.Zero(t, cv)
+
+	cv, err = ref.ParseCV("3,16")
+	assert.ErrorIs(t, err, ref.ErrParseFail)
+	assert.Zero(t, cv)
+
+	p, err := ref.ParseProper("Joh 3,16.18-20", eu)
+	assert.NoError(t, err)
+	assert.Equal(t, &ref.Proper{
+		Book: "Joh",
+		Verse: &ref.Related{
+			Refs: []ref.Relative{
+				&ref.Single{Verse: ref.CV{Chapter: 3, Verse: 16}},
+				&ref.Range{First: ref.N{Number: 18}, Last: ref.N{Number: 20}},
+			},
+		},
+	}, p)
+
+	p, err = ref.ParseProper("1. Mose 1,1", eu)
+	assert.NoError(t, err)
+	assert.Equal(t, &ref.Proper{
+		Book:  "1. Mose",
+		Verse: &ref.Single{Verse: ref.CV{Chapter: 1, Verse: 1}},
+	}, p)
+
+	m, err := ref.ParseMultiple("Juan 3,16; Romanos 8,28b", eu)
+	assert.NoError(t, err)
+	assert.Equal(t, &ref.Multiple{
+		Refs: []ref.Ref{
+			&ref.Proper{
+				Book:  "Juan",
+				Verse: &ref.Single{Verse: ref.CV{Chapter: 3, Verse: 16}},
+			},
+			&ref.Proper{
+				Book:  "Romanos",
+				Verse: &ref.Single{Verse: ref.CV{Chapter: 8, Verse: 28, Part: "b"}},
+			},
+		},
+	}, m)
+}
+
+func TestCanon_Resolve_EuropeanNotation(t *testing.T) {
+	t.Parallel()
+
+	john := &ref.Canonical.Books[42]
+	gen := &ref.Canonical.Books[0]
+
+	tests := []struct {
+		name     string
+		input    string
+		expected []ref.Resolved
+	}{
+		{"related verses", "Joh 3,16.18", []ref.Resolved{
+			{Book: john, First: ref.CV{Chapter: 3, Verse: 16}, Last: ref.CV{Chapter: 3, Verse: 16}},
+			{Book: john, First: ref.CV{Chapter: 3, Verse: 18}, Last: ref.CV{Chapter: 3, Verse: 18}},
+		}},
+		{"related range", "1. Mose 1,1-3.5", []ref.Resolved{
+			{Book: gen, First: ref.CV{Chapter: 1, Verse: 1}, Last: ref.CV{Chapter: 1, Verse: 3}},
+			{Book: gen, First: ref.CV{Chapter: 1, Verse: 5}, Last: ref.CV{Chapter: 1, Verse: 5}},
+		}},
+		{"next chapter", "Joh 3,16.18-20.4,1.3", []ref.Resolved{
+			{Book: john, First: ref.CV{Chapter: 3, Verse: 16}, Last: ref.CV{Chapter: 3, Verse: 16}},
+			{Book: john, First: ref.CV{Chapter: 3, Verse: 18}, Last: ref.CV{Chapter: 3, Verse: 20}},
+			{Book: john, First: ref.CV{Chapter: 4, Verse: 1}, Last: ref.CV{Chapter: 4, Verse: 1}},
+			{Book: john, First: ref.CV{Chapter: 4, Verse: 3}, Last: ref.CV{Chapter: 4, Verse: 3}},
+		}},
+		{"chapters", "Joh 3.5", []ref.Resolved{
+			{Book: john, First: ref.CV{Chapter: 3, Verse: 1}, Last: ref.CV{Chapter: 3, Verse: 36}},
+			{Book: john, First: ref.CV{Chapter: 5, Verse: 1}, Last: ref.CV{Chapter: 5, Verse: 47}},
+		}},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			p, err := ref.ParseProper(tt.input, ref.ParseWithNotation(ref.EuropeanNotation))
+			require.NoError(t, err)
+
+			rs, err := ref.Canonical.Resolve(p, ref.WithAbbreviations(ref.AbbreviationsDE))
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, rs)
+		})
+	}
+}
+
+func TestWithNotation(t *testing.T) {
+	t.Parallel()
+
+	rom, err := ref.Canonical.Book("Romans")
+	require.NoError(t, err)
+
+	r := &ref.Resolved{
+		Book:  rom,
+		First: ref.CV{Chapter: 8, Verse: 28},
+		Last:  ref.CV{Chapter: 9, Verse: 1},
+	}
+
+	cr, err := r.CompactRef(ref.WithNotation(ref.EuropeanNotation))
+	assert.NoError(t, err)
+	assert.Equal(t, "Romans 8,28-9,1", cr)
+
+	cr, err = r.CompactRef()
+	assert.NoError(t, err)
+	assert.Equal(t, "Romans 8:28-9:1", cr)
+
+	ar, err := r.AbbreviatedRef(ref.WithNotation(ref.EuropeanNotation))
+	assert.NoError(t, err)
+	assert.Equal(t, "Rom. 8,28-9,1", ar)
+}
@@ -0,0 +1,61 @@
+package ref
+
+import (
+	"fmt"
+	"sort"
+)
+
+// Translations returns the names of the translations for which the canon
+// records omitted verses, sorted.
+func (c *Canon) Translations() []string {
+	names := make([]string, 0, len(c.OmittedVerses))
+	for name := range c.OmittedVerses {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Omissions returns the set of verses of the canon omitted from the text of the
+// named translation (e.g., "ESV"), as recorded in OmittedVerses. If the
+// translation is empty, it returns the verses omitted by any translation. The
+// set is empty if the canon records no omitted verses for the translation.
+func (c *Canon) Omissions(translation string) (*Set, error) {
+	names := []string{translation}
+	if translation == "" {
+		names = c.Translations()
+	}
+
+	var refs []string
+	for _, name := range names {
+		refs = append(refs, c.OmittedVerses[name]...)
+	}
+
+	rs, err := c.resolveAll(refs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve omitted verses: %w", err)
+	}
+
+	return c.Set(rs...)
+}
+
+// OmittedIn returns the ranges of verses within the given references that
+// are omitted from the text of the named translation (or any translation, if
+// the translation is empty). It returns nil if none of the verses are omitted.
+func (c *Canon) OmittedIn(translation string, rs ...Resolved) ([]Resolved, error) {
+	omitted, err := c.Omissions(translation)
+	if err != nil {
+		return nil, err
+	}
+
+	in, err := c.Set(rs...)
+	if err != nil {
+		return nil, err
+	}
+
+	found := in.Intersect(omitted)
+	if found.Len() == 0 {
+		return nil, nil
+	}
+	return found.Ranges(), nil
+}
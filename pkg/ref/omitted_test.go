@@ -0,0 +1,71 @@
+package ref_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zostay/today/pkg/ref"
+)
+
+func TestCanon_Translations(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, []string{"ESV"}, ref.Canonical.Translations())
+}
+
+func TestCanon_Omissions(t *testing.T) {
+	t.Parallel()
+
+	s, err := ref.Canonical.Omissions("ESV")
+	require.NoError(t, err)
+	assert.Equal(t, 16, s.Len())
+
+	all, err := ref.Canonical.Omissions("")
+	require.NoError(t, err)
+	assert.Equal(t, s.Ranges(), all.Ranges())
+
+	none, err := ref.Canonical.Omissions("KJV")
+	require.NoError(t, err)
+	assert.Equal(t, 0, none.Len())
+}
+
+func TestCanon_OmittedIn(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		translation string
+		in          string
+		expect      []string
+	}{
+		{"spans one", "ESV", "Matthew 17:20-22", []string{"Matthew 17:21"}},
+		{"lands on one", "ESV", "John 5:4", []string{"John 5:4"}},
+		{"spans two", "ESV", "Mark 9:43-47", []string{"Mark 9:44", "Mark 9:46"}},
+		{"any translation", "", "Acts 8", []string{"Acts 8:37"}},
+		{"none omitted", "ESV", "John 3:16", nil},
+		{"other translation", "KJV", "Matthew 17:20-22", nil},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			p, err := ref.Lookup(ref.Canonical, tt.in, "")
+			require.NoError(t, err)
+
+			omitted, err := ref.Canonical.OmittedIn(tt.translation, *p.Ref)
+			require.NoError(t, err)
+
+			var refs []string
+			for i := range omitted {
+				s, err := omitted[i].CompactRef()
+				require.NoError(t, err)
+				refs = append(refs, s)
+			}
+			assert.Equal(t, tt.expect, refs)
+		})
+	}
+}
@@ -0,0 +1,324 @@
+package ref
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// BookCode pairs the name of a book with a standard code used to identify it.
+type BookCode struct {
+	Name string
+	Code string
+}
+
+// BookCodes is a table of standard codes used to identify books, such as the
+// OSIS book IDs in OSISBookCodes.
+type BookCodes []BookCode
+
+// Code returns the code for the book with the given name. It returns
+// ErrNotFound if the book has no code.
+func (c BookCodes) Code(name string) (string, error) {
+	for _, bc := range c {
+		if bc.Name == name {
+			return bc.Code, nil
+		}
+	}
+	return "", fmt.Errorf("%w: no code for book %s", ErrNotFound, name)
+}
+
+// BookName returns the name of the book with the given code. Codes are matched
+// without regard to case. It returns ErrNotFound if no book has the code.
+func (c BookCodes) BookName(code string) (string, error) {
+	for _, bc := range c {
+		if strings.EqualFold(bc.Code, code) {
+			return bc.Name, nil
+		}
+	}
+	return "", fmt.Errorf("%w: no book with code %s", ErrNotFound, code)
+}
+
+// OSISBookCodes are the OSIS book IDs of the books of the Protestant canon
+// followed by those of the deuterocanonical books of the Catholic and Eastern
+// Orthodox canons.
+var OSISBookCodes = BookCodes{
+	{Name: "Genesis", Code: "Gen"},
+	{Name: "Exodus", Code: "Exod"},
+	{Name: "Leviticus", Code: "Lev"},
+	{Name: "Numbers", Code: "Num"},
+	{Name: "Deuteronomy", Code: "Deut"},
+	{Name: "Joshua", Code: "Josh"},
+	{Name: "Judges", Code: "Judg"},
+	{Name: "Ruth", Code: "Ruth"},
+	{Name: "1 Samuel", Code: "1Sam"},
+	{Name: "2 Samuel", Code: "2Sam"},
+	{Name: "1 Kings", Code: "1Kgs"},
+	{Name: "2 Kings", Code: "2Kgs"},
+	{Name: "1 Chronicles", Code: "1Chr"},
+	{Name: "2 Chronicles", Code: "2Chr"},
+	{Name: "Ezra", Code: "Ezra"},
+	{Name: "Nehemiah", Code: "Neh"},
+	{Name: "Esther", Code: "Esth"},
+	{Name: "Job", Code: "Job"},
+	{Name: "Psalms", Code: "Ps"},
+	{Name: "Proverbs", Code: "Prov"},
+	{Name: "Ecclesiastes", Code: "Eccl"},
+	{Name: "Song of Solomon", Code: "Song"},
+	{Name: "Isaiah", Code: "Isa"},
+	{Name: "Jeremiah", Code: "Jer"},
+	{Name: "Lamentations", Code: "Lam"},
+	{Name: "Ezekiel", Code: "Ezek"},
+	{Name: "Daniel", Code: "Dan"},
+	{Name: "Hosea", Code: "Hos"},
+	{Name: "Joel", Code: "Joel"},
+	{Name: "Amos", Code: "Amos"},
+	{Name: "Obadiah", Code: "Obad"},
+	{Name: "Jonah", Code: "Jonah"},
+	{Name: "Micah", Code: "Mic"},
+	{Name: "Nahum", Code: "Nah"},
+	{Name: "Habakkuk", Code: "Hab"},
+	{Name: "Zephaniah", Code: "Zeph"},
+	{Name: "Haggai", Code: "Hag"},
+	{Name: "Zechariah", Code: "Zech"},
+	{Name: "Malachi", Code: "Mal"},
+	{Name: "Matthew", Code: "Matt"},
+	{Name: "Mark", Code: "Mark"},
+	{Name: "Luke", Code: "Luke"},
+	{Name: "John", Code: "John"},
+	{Name: "Acts", Code: "Acts"},
+	{Name: "Romans", Code: "Rom"},
+	{Name: "1 Corinthians", Code: "1Cor"},
+	{Name: "2 Corinthians", Code: "2Cor"},
+	{Name: "Galatians", Code: "Gal"},
+	{Name: "Ephesians", Code: "Eph"},
+	{Name: "Philippians", Code: "Phil"},
+	{Name: "Colossians", Code: "Col"},
+	{Name: "1 Thessalonians", Code: "1Thess"},
+	{Name: "2 Thessalonians", Code: "2Thess"},
+	{Name: "1 Timothy", Code: "1Tim"},
+	{Name: "2 Timothy", Code: "2Tim"},
+	{Name: "Titus", Code: "Titus"},
+	{Name: "Philemon", Code: "Phlm"},
+	{Name: "Hebrews", Code: "Heb"},
+	{Name: "James", Code: "Jas"},
+	{Name: "1 Peter", Code: "1Pet"},
+	{Name: "2 Peter", Code: "2Pet"},
+	{Name: "1 John", Code: "1John"},
+	{Name: "2 John", Code: "2John"},
+	{Name: "3 John", Code: "3John"},
+	{Name: "Jude", Code: "Jude"},
+	{Name: "Revelation", Code: "Rev"},
+	{Name: "Tobit", Code: "Tob"},
+	{Name: "Judith", Code: "Jdt"},
+	{Name: "Additions to Esther", Code: "AddEsth"},
+	{Name: "Wisdom of Solomon", Code: "Wis"},
+	{Name: "Sirach", Code: "Sir"},
+	{Name: "Baruch", Code: "Bar"},
+	{Name: "Letter of Jeremiah", Code: "EpJer"},
+	{Name: "Prayer of Azariah", Code: "PrAzar"},
+	{Name: "Susanna", Code: "Sus"},
+	{Name: "Bel and the Dragon", Code: "Bel"},
+	{Name: "1 Maccabees", Code: "1Macc"},
+	{Name: "2 Maccabees", Code: "2Macc"},
+	{Name: "3 Maccabees", Code: "3Macc"},
+	{Name: "1 Esdras", Code: "1Esd"},
+	{Name: "Prayer of Manasseh", Code: "PrMan"},
+}
+
+// ParseOSIS parses an OSIS reference and resolves it against the Canonical
+// canon. See Canon.ParseOSIS for details.
+func ParseOSIS(osisRef string) ([]Resolved, error) {
+	return Canonical.ParseOSIS(osisRef)
+}
+
+// ParseOSIS parses an OSIS reference, such as "Gen.1.1-Gen.1.5" or "1Cor.13",
+// and resolves it against this canon. Each OSIS ID names a book, a book and
+// chapter, or a book, chapter, and verse. A verse may be followed by a grain
+// naming part of the verse (e.g., "John.3.16!a"). Two IDs joined by a dash
+// form a range, which may cross books, in which case one Resolved reference is
+// returned per book, as with a Span. Multiple references may be separated by
+// whitespace.
+//
+// Books with no chapters are given a chapter of 1 (e.g., "Jude.1.5").
+//
+// If the reference is malformed, an error matching ErrParseFail is returned.
+func (c *Canon) ParseOSIS(osisRef string) ([]Resolved, error) {
+	ids := strings.Fields(osisRef)
+	if len(ids) == 0 {
+		return nil, fmt.Errorf("%w: empty OSIS reference", ErrParseFail)
+	}
+
+	var rs []Resolved
+	for _, id := range ids {
+		start, end, isRange := strings.Cut(id, "-")
+
+		fb, fv, err := c.parseOSISID(start)
+		if err != nil {
+			return nil, err
+		}
+
+		lb, lv := fb, fv
+		if isRange {
+			lb, lv, err = c.parseOSISID(end)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		first, err := spanStartVerse(fb, fv)
+		if err != nil {
+			return nil, err
+		}
+
+		last, err := spanEndVerse(lb, lv)
+		if err != nil {
+			return nil, err
+		}
+
+		fi, li := c.bookIndex(fb), c.bookIndex(lb)
+		switch {
+		case fi > li:
+			return nil, fmt.Errorf("%w: %s-%s", ErrBackwardSpan, fb.Name, lb.Name)
+		case fi == li:
+			r := Resolved{Book: fb, First: first, Last: last}
+			if err := r.Validate(); err != nil {
+				return nil, err
+			}
+			rs = append(rs, r)
+		default:
+			rs = append(rs, c.spanSegments(fi, li, first, last)...)
+		}
+	}
+
+	return rs, nil
+}
+
+// parseOSISID parses a single OSIS ID, returning the book it names and the
+// verse within that book. The verse is nil if the ID names the whole book and
+// an N naming a chapter if the ID names a chapter of a book with chapters.
+func (c *Canon) parseOSISID(id string) (*Book, Verse, error) {
+	parts := strings.Split(id, ".")
+	if len(parts) > 3 {
+		return nil, nil, fmt.Errorf("%w: bad OSIS ID %q", ErrParseFail, id)
+	}
+
+	name, err := OSISBookCodes.BookName(parts[0])
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w: unknown OSIS book in %q: %w", ErrParseFail, id, err)
+	}
+
+	b, err := c.Book(name)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if len(parts) == 1 {
+		return b, nil, nil
+	}
+
+	chapter, err := strconv.Atoi(parts[1])
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w: bad chapter in OSIS ID %q", ErrParseFail, id)
+	}
+
+	if b.JustVerse && chapter != 1 {
+		return nil, nil, fmt.Errorf("%w: %s has only one chapter", ErrNotFound, b.Name)
+	}
+
+	if len(parts) == 2 {
+		if b.JustVerse {
+			return b, nil, nil
+		}
+		return b, N{Number: chapter}, nil
+	}
+
+	vs, grain, _ := strings.Cut(parts[2], "!")
+	verse, err := strconv.Atoi(vs)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w: bad verse in OSIS ID %q", ErrParseFail, id)
+	}
+
+	var v Verse = CV{Chapter: chapter, Verse: verse, Part: grain}
+	if b.JustVerse {
+		v = N{Number: verse, Part: grain}
+	}
+
+	if err := v.Validate(); err != nil {
+		return nil, nil, err
+	}
+
+	return b, v, nil
+}
+
+// osisID returns the OSIS ID of the verse v in the book with the given code.
+func osisID(code string, v Verse) string {
+	var id string
+	switch v := v.(type) {
+	case CV:
+		id = fmt.Sprintf("%s.%d.%d", code, v.Chapter, v.Verse)
+	case N:
+		id = fmt.Sprintf("%s.1.%d", code, v.Number)
+	}
+
+	if part := versePart(v); part != "" {
+		id += "!" + part
+	}
+
+	return id
+}
+
+// osisRef returns the OSIS reference for the verses running from the start of
+// first to the end of last, which may be the same reference or the first and
+// last segments of a resolved Span. Whole books are given by book code alone
+// and whole chapters by book code and chapter. A range ending at the end of a
+// later chapter omits the verse of the end (e.g., "Ruth.4.18-1Sam.2").
+func osisRef(first, last *Resolved) (string, error) {
+	fb, lb := first.Book, last.Book
+
+	fc, err := OSISBookCodes.Code(fb.Name)
+	if err != nil {
+		return "", err
+	}
+
+	lc, err := OSISBookCodes.Code(lb.Name)
+	if err != nil {
+		return "", err
+	}
+
+	fPart, lPart := versePart(first.First), versePart(last.Last)
+	if fPart == "" && lPart == "" &&
+		first.First.Equal(fb.FirstVerse()) && last.Last.Equal(lb.LastVerse()) {
+		if fb == lb {
+			return fc, nil
+		}
+		return fc + "-" + lc, nil
+	}
+
+	start, end := osisID(fc, first.First), osisID(lc, last.Last)
+
+	fcv, isFCV := first.First.(CV)
+	lcv, isLCV := last.Last.(CV)
+	if isFCV && isLCV && lPart == "" {
+		lvInC, err := lb.LastVerseInChapter(lcv.Chapter)
+		if err != nil {
+			return "", err
+		}
+
+		if lcv.Verse == lvInC {
+			sameChapter := fb == lb && fcv.Chapter == lcv.Chapter
+			switch {
+			case fcv.Verse == 1 && fPart == "":
+				start = fmt.Sprintf("%s.%d", fc, fcv.Chapter)
+				end = fmt.Sprintf("%s.%d", lc, lcv.Chapter)
+			case !sameChapter:
+				end = fmt.Sprintf("%s.%d", lc, lcv.Chapter)
+			}
+		}
+	}
+
+	if start == end {
+		return start, nil
+	}
+
+	return start + "-" + end, nil
+}
@@ -0,0 +1,146 @@
+package ref_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zostay/today/pkg/ref"
+)
+
+func TestBookCodes(t *testing.T) {
+	t.Parallel()
+
+	code, err := ref.OSISBookCodes.Code("1 Corinthians")
+	assert.NoError(t, err)
+	assert.Equal(t, "1Cor", code)
+
+	code, err = ref.OSISBookCodes.Code("Letter of Jeremiah")
+	assert.NoError(t, err)
+	assert.Equal(t, "EpJer", code)
+
+	_, err = ref.OSISBookCodes.Code("Enoch")
+	assert.ErrorIs(t, err, ref.ErrNotFound)
+
+	name, err := ref.OSISBookCodes.BookName("phlm")
+	assert.NoError(t, err)
+	assert.Equal(t, "Philemon", name)
+
+	_, err = ref.OSISBookCodes.BookName("1En")
+	assert.ErrorIs(t, err, ref.ErrNotFound)
+
+	// every book of every canon has an OSIS code
+	for _, c := range ref.Canons {
+		for _, b := range c.Books {
+			_, err := ref.OSISBookCodes.Code(b.Name)
+			assert.NoError(t, err, b.Name)
+		}
+	}
+}
+
+func TestParseOSIS(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		osis     string
+		expected string
+	}{
+		{"Gen.1.1", "Genesis 1:1"},
+		{"Gen.1.1-Gen.1.5", "Genesis 1:1-5"},
+		{"1Cor.13", "1 Corinthians 13"},
+		{"Gen.1-Gen.2", "Genesis 1-2"},
+		{"Gen.1.3-Gen.2", "Genesis 1:3-2:25"},
+		{"Ruth", "Ruth"},
+		{"ps.23", "Psalm 23"},
+		{"Jude.1.5", "Jude 5"},
+		{"Jude.1", "Jude"},
+		{"John.3.16!a", "John 3:16a"},
+		{"John.3.16 Rom.8.28-Rom.8.30", "John 3:16; Romans 8:28-30"},
+		{"Gen.50-Exod.2", "Genesis 50-Exodus 2"},
+		{"Ruth.4.18-1Sam.2.3", "Ruth 4:18-1 Samuel 2:3"},
+	}
+
+	formatter, err := ref.GetFormatter("canonical")
+	require.NoError(t, err)
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.osis, func(t *testing.T) {
+			t.Parallel()
+
+			resolved, err := ref.ParseOSIS(tt.osis)
+			require.NoError(t, err)
+
+			resolvedPtrs := make([]*ref.Resolved, len(resolved))
+			for i := range resolved {
+				resolvedPtrs[i] = &resolved[i]
+			}
+
+			result, err := formatter.Format(resolvedPtrs)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func TestParseOSIS_RoundTrip(t *testing.T) {
+	t.Parallel()
+
+	formatter, err := ref.GetFormatter("osis")
+	require.NoError(t, err)
+
+	for _, osis := range []string{
+		"Gen.1.1-Gen.1.5",
+		"1Cor.13",
+		"Gen-Lev",
+		"Ruth.4.18-1Sam.2",
+		"Gen.1.3-Gen.2",
+		"Gen.1.3-Gen.1.31",
+		"John.3.16!a-John.3.17!b",
+		"Phlm.1.5 Jude.1.3-Jude.1.4",
+	} {
+		resolved, err := ref.Canonical.ParseOSIS(osis)
+		require.NoError(t, err, osis)
+
+		resolvedPtrs := make([]*ref.Resolved, len(resolved))
+		for i := range resolved {
+			resolvedPtrs[i] = &resolved[i]
+		}
+
+		result, err := formatter.Format(resolvedPtrs)
+		require.NoError(t, err)
+		assert.Equal(t, osis, result)
+	}
+}
+
+func TestParseOSIS_Errors(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		osis string
+		err  error
+	}{
+		{"", ref.ErrParseFail},
+		{"1En.1.1", ref.ErrParseFail},
+		{"Tob.1.1", ref.ErrNotFound},
+		{"Gen.x", ref.ErrParseFail},
+		{"Gen.1.x", ref.ErrParseFail},
+		{"Gen.1.1.1", ref.ErrParseFail},
+		{"Gen.51", ref.ErrNotFound},
+		{"Gen.1.32", ref.ErrNotFound},
+		{"Jude.2.1", ref.ErrNotFound},
+		{"Exod.1-Gen.2", ref.ErrBackwardSpan},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.osis, func(t *testing.T) {
+			t.Parallel()
+
+			resolved, err := ref.ParseOSIS(tt.osis)
+			assert.ErrorIs(t, err, tt.err)
+			assert.Nil(t, resolved)
+		})
+	}
+}
@@ -4,17 +4,40 @@ import (
 	"errors"
 	"fmt"
 	"strconv"
+	"strings"
 	"unicode"
 )
 
 type parseState struct {
 	input []rune
 	pos   int
+
+	// scanning is set when looking for references embedded in other text.
+	// While scanning, a trailing separator that is not followed by another
+	// reference is left unconsumed and a bare book name is not treated as a
+	// reference to the whole book.
+	scanning bool
+
+	// abbreviations, when set, is used to reject book names that do not name
+	// a book.
+	abbreviations *BookAbbreviations
+
+	// lenient, when set, accepts book names that closely resemble the name of
+	// a book in abbreviations.
+	lenient bool
+
+	// notation is the punctuation used to separate chapters, verses, and
+	// lists.
+	notation Notation
 }
 
-func makeParseState(input string) *parseState {
+func makeParseState(input string, opt []ParseOption) *parseState {
+	o := makeParseOpts(opt)
 	return &parseState{
-		input: []rune(input),
+		input:         []rune(input),
+		notation:      o.Notation,
+		abbreviations: o.Abbreviations,
+		lenient:       o.Lenient,
 	}
 }
 
@@ -90,13 +113,48 @@ func (p *parseState) endOfInput() bool {
 }
 
 func (p *parseState) remainderError() error {
-	return &MoreInputError{Remaining: string(p.input[p.pos:])}
+	return &MoreInputError{
+		Offset:    p.pos,
+		Remaining: string(p.input[p.pos:]),
+	}
+}
+
+// fail returns a ParseError reporting that the expected token was not found at
+// the next non-space rune of input.
+func (p *parseState) fail(expected TokenClass) *ParseError {
+	pos := p.pos
+	for pos < len(p.input) && unicode.IsSpace(p.input[pos]) {
+		pos++
+	}
+
+	return p.failAt(pos, expected)
+}
+
+// failAt returns a ParseError reporting that the expected token was not found
+// at the given rune offset.
+func (p *parseState) failAt(pos int, expected TokenClass) *ParseError {
+	e := &ParseError{
+		Offset:   pos,
+		Expected: expected,
+	}
+
+	if pos < len(p.input) {
+		e.Found = string(p.input[pos])
+	}
+
+	// errors are discarded while scanning, so don't bother copying the input
+	if !p.scanning {
+		e.Input = string(p.input)
+	}
+
+	return e
 }
 
-// ParseN will parse a single verse number. If there's trailing input after the
-// verse number, the ref.V will be returned along with a MoreInputError.
-func ParseN(ref string) (N, error) {
-	n, ps, err := expectN(*makeParseState(ref))
+// ParseN will parse a single verse number, which may be followed by a part
+// letter (e.g., "16b"). If there's trailing input after the verse number, the
+// ref.V will be returned along with a MoreInputError.
+func ParseN(ref string, opt ...ParseOption) (N, error) {
+	n, ps, err := expectN(*makeParseState(ref, opt))
 	if err != nil {
 		return N{}, err
 	}
@@ -108,11 +166,11 @@ func ParseN(ref string) (N, error) {
 	return n, nil
 }
 
-// ParseCV will parse a chapter and verse number. If there's trailing input
-// after the verse number, the ref.CV will be returned along with a
-// MoreInputError.
-func ParseCV(ref string) (CV, error) {
-	cv, ps, err := expectCV(*makeParseState(ref))
+// ParseCV will parse a chapter and verse number, which may be followed by a
+// part letter (e.g., "3:16a"). If there's trailing input after the verse
+// number, the ref.CV will be returned along with a MoreInputError.
+func ParseCV(ref string, opt ...ParseOption) (CV, error) {
+	cv, ps, err := expectCV(*makeParseState(ref, opt))
 	if err != nil {
 		return CV{}, err
 	}
@@ -127,8 +185,8 @@ func ParseCV(ref string) (CV, error) {
 // ParseSingle will parse a single verse reference, which may be a verse number
 // or a chapter-and-verse reference. If there's trailing input after the verse
 // number, the ref.Single will be returned along with a MoreInputError.
-func ParseSingle(ref string) (*Single, error) {
-	s, ps, err := expectSingle(*makeParseState(ref))
+func ParseSingle(ref string, opt ...ParseOption) (*Single, error) {
+	s, ps, err := expectSingle(*makeParseState(ref, opt))
 	if err != nil {
 		return nil, err
 	}
@@ -143,8 +201,8 @@ func ParseSingle(ref string) (*Single, error) {
 // ParseAndFollowing will parse a verse reference followed by "ff" and then
 // (optionally) either "b" or "c". If there's trailing input after the verse
 // number, the ref.AndFollowing will be returned along with a MoreInputError.
-func ParseAndFollowing(ref string) (*AndFollowing, error) {
-	af, ps, err := expectAndFollowing(*makeParseState(ref))
+func ParseAndFollowing(ref string, opt ...ParseOption) (*AndFollowing, error) {
+	af, ps, err := expectAndFollowing(*makeParseState(ref, opt))
 	if err != nil {
 		return nil, err
 	}
@@ -158,8 +216,8 @@ func ParseAndFollowing(ref string) (*AndFollowing, error) {
 
 // ParseRange will parse a range of verses. If there's trailing input after the
 // verse number, the ref.Range will be returned along with a MoreInputError.
-func ParseRange(ref string) (*Range, error) {
-	r, ps, err := expectRange(*makeParseState(ref))
+func ParseRange(ref string, opt ...ParseOption) (*Range, error) {
+	r, ps, err := expectRange(*makeParseState(ref, opt))
 	if err != nil {
 		return nil, err
 	}
@@ -177,8 +235,8 @@ func ParseRange(ref string) (*Range, error) {
 // ref.Relative will be returned along with a MoreInputError.
 //
 // A trailing comma is considered an error.
-func ParseRelated(ref string) (*Related, error) {
-	r, ps, err := expectRelated(*makeParseState(ref))
+func ParseRelated(ref string, opt ...ParseOption) (*Related, error) {
+	r, ps, err := expectRelated(*makeParseState(ref, opt))
 	if err != nil {
 		return nil, err
 	}
@@ -194,8 +252,8 @@ func ParseRelated(ref string) (*Related, error) {
 // abbreviation followed by a relative reference. If there's trailing input
 // after the verse number, the ref.Proper will be returned along with a
 // MoreInputError.
-func ParseProper(ref string) (*Proper, error) {
-	p, ps, err := expectProper(*makeParseState(ref))
+func ParseProper(ref string, opt ...ParseOption) (*Proper, error) {
+	p, ps, err := expectProper(*makeParseState(ref, opt))
 	if err != nil {
 		return nil, err
 	}
@@ -207,16 +265,35 @@ func ParseProper(ref string) (*Proper, error) {
 	return p, nil
 }
 
+// ParseSpan will parse a span reference, which is a book name or abbreviation
+// optionally followed by a single verse, a dash, and then another book name or
+// abbreviation optionally followed by a single verse (e.g., "Genesis 50 –
+// Exodus 2" or "Ruth 4:18-1 Samuel 2"). If there's trailing input after the
+// span, the ref.Span will be returned along with a MoreInputError.
+func ParseSpan(ref string, opt ...ParseOption) (*Span, error) {
+	s, ps, err := expectSpan(*makeParseState(ref, opt))
+	if err != nil {
+		return nil, err
+	}
+
+	if !ps.endOfInput() {
+		return s, ps.remainderError()
+	}
+
+	return s, nil
+}
+
 // ParseMultiple will parse a multiple reference, which is a semicolon separated
 // list of proper references. There must be at least one reference. The first
-// reference must be a proper reference (book and related verse reference), but
-// subsequence references may be relative to the first proper reference. If
+// reference must be a proper reference (book and related verse reference) or a
+// span, but subsequence references may be relative to the book of the previous
+// reference. If
 // there's trailing input after the verse number, the ref.Multiple will be
 // returned along with a MoreInputError.
 //
 // A trailing semi-colon is allowed and will be rolled into the MoreInputError.
-func ParseMultiple(ref string) (*Multiple, error) {
-	m, ps, err := expectMultiple(*makeParseState(ref))
+func ParseMultiple(ref string, opt ...ParseOption) (*Multiple, error) {
+	m, ps, err := expectMultiple(*makeParseState(ref, opt))
 	if err != nil {
 		return nil, err
 	}
@@ -233,13 +310,15 @@ func expectNumber(ref parseState) (int, parseState, error) {
 		return r >= '0' && r <= '9'
 	})
 	if len(numr) == 0 {
-		return 0, ref, fmt.Errorf("%w: expected a number", ErrParseFail)
+		return 0, ref, ref.fail(TokenNumber)
 	}
 
 	num, err := strconv.Atoi(string(numr))
 	if err != nil {
 		// should be unreachable
-		return 0, ref, fmt.Errorf("%w: expected a number: %w", ErrParseFail, err)
+		e := ref.fail(TokenNumber)
+		e.Err = err
+		return 0, ref, e
 	}
 
 	return num, ref, nil
@@ -249,27 +328,130 @@ var (
 	ErrParseFail = errors.New("parse failed")
 )
 
+// TokenClass names the kind of token the parser expected to find when a parse
+// fails.
+type TokenClass string
+
+const (
+	// TokenNumber is a chapter or verse number.
+	TokenNumber TokenClass = "number"
+
+	// TokenChapterVerse is the separator between chapter and verse (e.g., the
+	// ":" in "3:16").
+	TokenChapterVerse TokenClass = "chapter-verse separator"
+
+	// TokenDash is the dash separating the ends of a range or span.
+	TokenDash TokenClass = "dash"
+
+	// TokenAndFollowing is the "ff" following a verse, as in "3:16ff".
+	TokenAndFollowing TokenClass = `"ff"`
+
+	// TokenBookName is a book name or abbreviation.
+	TokenBookName TokenClass = "book name"
+)
+
+// maxBookSuggestions is the most suggestions reported for an unknown book.
+const maxBookSuggestions = 5
+
+// ParseError describes where and why a parse failed. It matches ErrParseFail
+// when checked with errors.Is.
+type ParseError struct {
+	// Input is the input that was being parsed.
+	Input string
+
+	// Offset is the offset, in runes, into Input where the parse failed.
+	Offset int
+
+	// Expected is the class of token that was expected at Offset.
+	Expected TokenClass
+
+	// Found is the offending input found at Offset. For an unknown book name,
+	// this is the complete name. It is empty at the end of input.
+	Found string
+
+	// Suggestions lists book names that might have been intended when Found
+	// is an unknown or ambiguous book name, most likely first.
+	Suggestions []string
+
+	// Err is the underlying cause of the failure, if any. For example, it
+	// will be a MultipleMatchError if a book name is ambiguous.
+	Err error
+}
+
+// Error implements error.
+func (e *ParseError) Error() string {
+	found := "end of input"
+	if e.Found != "" {
+		found = strconv.Quote(e.Found)
+	}
+
+	msg := fmt.Sprintf("%v at offset %d: expected %s, but found %s",
+		ErrParseFail, e.Offset, e.Expected, found)
+	if len(e.Suggestions) > 0 {
+		msg += ". Did you mean " + strings.Join(e.Suggestions, ", ") + "?"
+	}
+
+	return msg
+}
+
+// Is returns true when target is ErrParseFail.
+func (e *ParseError) Is(target error) bool {
+	return target == ErrParseFail
+}
+
+// Unwrap returns the underlying cause of the failure.
+func (e *ParseError) Unwrap() error {
+	return e.Err
+}
+
+var _ error = (*ParseError)(nil)
+
 // MoreInputError indicates that parse completed partially but there's additional
 // input present.
 type MoreInputError struct {
+	// Offset is the offset, in runes, into the input where Remaining begins.
+	Offset int
+
 	// Remaining is the trailing input.
 	Remaining string
 }
 
 // Error implements error.
 func (e *MoreInputError) Error() string {
-	return "parse completed, but more input remains"
+	return fmt.Sprintf("parse completed, but more input remains at offset %d: %q",
+		e.Offset, e.Remaining)
 }
 
 var _ error = (*MoreInputError)(nil)
 
+// expectPart expects an optional verse part letter immediately following a
+// verse number (e.g., the "a" in "3:16a"). The letter must not be separated
+// from the number by whitespace and must not be followed by another letter.
+func expectPart(ref parseState) (string, parseState) {
+	ps := ref
+	if ps.endOfInput() || !isPartLetter(ps.input[ps.pos]) {
+		return "", ref
+	}
+
+	if ps.pos+1 < len(ps.input) && unicode.IsLetter(ps.input[ps.pos+1]) {
+		return "", ref
+	}
+
+	part := string(ps.input[ps.pos])
+	ps.pos++
+	return part, ps
+}
+
 func expectN(ref parseState) (N, parseState, error) {
 	num, ps, err := expectNumber(ref)
 	if err != nil {
 		return N{}, ref, err
 	}
 
-	n := N{Number: num}
+	var part string
+	part, ps = expectPart(ps)
+
+	n := N{Number: num, Part: part}
 	if err := n.Validate(); err != nil {
 		return n, ps, err
 	}
@@ -283,12 +465,8 @@ func expectCV(ref parseState) (CV, parseState, error) {
 		return CV{}, ref, err
 	}
 
-	cvBreak := func(r rune) bool {
-		return r == ':' || r == '.'
-	}
-
-	if _, ok := ps.expect(cvBreak); !ok {
-		return CV{}, ref, fmt.Errorf("%w: expected a colon", ErrParseFail)
+	if _, ok := ps.expect(ps.notation.isChapterVerse); !ok {
+		return CV{}, ref, ps.fail(TokenChapterVerse)
 	}
 
 	vnum, ps, err := expectNumber(ps)
@@ -296,7 +474,10 @@ func expectCV(ref parseState) (CV, parseState, error) {
 		return CV{}, ref, err
 	}
 
-	cv := CV{Chapter: cnum, Verse: vnum}
+	var part string
+	part, ps = expectPart(ps)
+
+	cv := CV{Chapter: cnum, Verse: vnum, Part: part}
 	if err := cv.Validate(); err != nil {
 		return cv, ps, err
 	}
@@ -329,11 +510,11 @@ func expectAndFollowing(ref parseState) (*AndFollowing, parseState, error) {
 	}
 
 	if !ps.expectRune('f') {
-		return nil, ref, fmt.Errorf("%w: %w", ErrParseFail, errors.New(`expected "ff"`))
+		return nil, ref, ps.fail(TokenAndFollowing)
 	}
 
 	if !ps.expectRune('f') {
-		return nil, ref, fmt.Errorf("%w: %w", ErrParseFail, errors.New(`expected "ff"`))
+		return nil, ref, ps.fail(TokenAndFollowing)
 	}
 
 	untilLetters := func(r rune) bool {
@@ -369,7 +550,7 @@ func expectRange(ref parseState) (*Range, parseState, error) {
 	}
 
 	if !expectDash(&ps) {
-		return nil, ref, fmt.Errorf("%w: expected a dash", ErrParseFail)
+		return nil, ref, ps.fail(TokenDash)
 	}
 
 	sl, ps, err := expectSingle(ps)
@@ -418,9 +599,17 @@ func expectRelated(ref parseState) (*Related, parseState, error) {
 
 		rels = append(rels, rel)
 
-		if !ps.expectRune(',') {
+		sepPs := ps
+		if !ps.expectRune(ps.notation.List) {
 			break
 		}
+
+		if ps.scanning {
+			if _, _, err := expectRelative(ps); err != nil {
+				ps = sepPs
+				break
+			}
+		}
 	}
 
 	r := &Related{Refs: rels}
@@ -434,26 +623,53 @@ func expectRelated(ref parseState) (*Related, parseState, error) {
 func expectBookName(ref parseState) (string, parseState, error) {
 	ps := ref
 	firstLetter, ok := ps.expect(func(r rune) bool {
-		return r >= 'a' && r <= 'z' ||
-			r >= 'A' && r <= 'Z' ||
-			r >= '1' && r <= '9'
+		return unicode.IsLetter(r) || r >= '1' && r <= '9'
 	})
 
 	if !ok {
-		return "", ref, fmt.Errorf("%w: expected a letter or number to start book name or abbreviation", ErrParseFail)
+		return "", ref, ref.fail(TokenBookName)
 	}
 
+	start := ps.pos - 1
 	rest := ps.expectWhilePreserveWS(func(r rune) bool {
-		return r >= 'a' && r <= 'z' ||
-			r >= 'A' && r <= 'Z' ||
-			r == '.'
+		return unicode.IsLetter(r) || r == '.'
 	})
 
 	if len(rest) == 0 {
-		return "", ref, fmt.Errorf("%w: expected a letter or number to start book name or abbreviation", ErrParseFail)
+		return "", ref, ref.fail(TokenBookName)
+	}
+
+	name := string(append([]rune{firstLetter}, rest...))
+	if ps.abbreviations != nil {
+		if _, err := ps.abbreviations.exactBookName(name); err != nil && !ps.acceptsNear(name) {
+			e := ref.failAt(start, TokenBookName)
+			e.Found = name
+			e.Err = err
+
+			// suggestions are costly and unused while scanning
+			var mmerr *MultipleMatchError
+			switch {
+			case ps.scanning:
+			case errors.As(err, &mmerr):
+				e.Suggestions = mmerr.Matches
+			default:
+				e.Suggestions = ps.abbreviations.suggestBookNames(name)
+			}
+
+			return "", ref, e
+		}
 	}
 
-	return string(append([]rune{firstLetter}, rest...)), ps, nil
+	return name, ps, nil
+}
+
+// acceptsNear returns true if a misspelled book name should be accepted
+// because it closely resembles the name of one book.
+func (p *parseState) acceptsNear(name string) bool {
+	if !p.lenient || p.scanning {
+		return false
+	}
+	return isConfident(p.abbreviations.NearBookNames(name))
 }
 
 func expectProper(ref parseState) (*Proper, parseState, error) {
@@ -462,7 +678,7 @@ func expectProper(ref parseState) (*Proper, parseState, error) {
 		return nil, ref, err
 	}
 
-	if ps.endOfInput() {
+	if ps.endOfInput() && !ps.scanning {
 		return &Proper{
 			Book: name,
 			Verse: &AndFollowing{
@@ -490,9 +706,60 @@ func expectProper(ref parseState) (*Proper, parseState, error) {
 	return p, ps, nil
 }
 
+// expectSpanOrProper expects either a span or, failing that, a proper
+// reference.
+func expectSpanOrProper(ref parseState) (Absolute, parseState, error) {
+	s, ps, err := expectSpan(ref)
+	if errors.Is(err, ErrParseFail) {
+		return expectProper(ref)
+	}
+
+	return s, ps, err
+}
+
+func expectSpan(ref parseState) (*Span, parseState, error) {
+	firstBook, ps, err := expectBookName(ref)
+	if err != nil {
+		return nil, ref, err
+	}
+
+	var first Verse
+	if s, sps, err := expectSingle(ps); err == nil {
+		first, ps = s.Verse, sps
+	} else if ps.scanning {
+		return nil, ref, ps.fail(TokenNumber)
+	}
+
+	if !expectDash(&ps) {
+		return nil, ref, ps.fail(TokenDash)
+	}
+
+	lastBook, ps, err := expectBookName(ps)
+	if err != nil {
+		return nil, ref, err
+	}
+
+	var last Verse
+	if s, sps, err := expectSingle(ps); err == nil {
+		last, ps = s.Verse, sps
+	}
+
+	s := &Span{
+		FirstBook: firstBook,
+		First:     first,
+		LastBook:  lastBook,
+		Last:      last,
+	}
+	if err := s.Validate(); err != nil {
+		return s, ps, err
+	}
+
+	return s, ps, nil
+}
+
 func expectMultiple(ref parseState) (*Multiple, parseState, error) {
 	refs := make([]Ref, 0, 10)
-	pref, ps, err := expectProper(ref)
+	pref, ps, err := expectSpanOrProper(ref)
 	if err != nil {
 		return nil, ref, err
 	}
@@ -504,7 +771,7 @@ func expectMultiple(ref parseState) (*Multiple, parseState, error) {
 			break
 		}
 
-		pref, ps, err = expectProper(ps)
+		pref, ps, err = expectSpanOrProper(ps)
 		if errors.Is(err, ErrParseFail) {
 			var rel Relative
 			rel, ps, err = expectRelated(ps)
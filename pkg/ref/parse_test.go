@@ -28,6 +28,20 @@ func TestParseN(t *testing.T) {
 	assert.ErrorAs(t, err, &moreInputErr)
 	assert.Equal(t, ":2", moreInputErr.Remaining)
 	assert.Equal(t, ref.N{Number: 1}, v)
+
+	v, err = ref.ParseN("6b")
+	assert.NoError(t, err)
+	assert.Equal(t, ref.N{Number: 6, Part: "b"}, v)
+
+	v, err = ref.ParseN("6 b")
+	assert.ErrorAs(t, err, &moreInputErr)
+	assert.Equal(t, " b", moreInputErr.Remaining)
+	assert.Equal(t, ref.N{Number: 6}, v)
+
+	v, err = ref.ParseN("6and")
+	assert.ErrorAs(t, err, &moreInputErr)
+	assert.Equal(t, "and", moreInputErr.Remaining)
+	assert.Equal(t, ref.N{Number: 6}, v)
 }
 
 func TestParseCV(t *testing.T) {
@@ -67,6 +81,15 @@ func TestParseCV(t *testing.T) {
 	assert.ErrorAs(t, err, &moreInputErr)
 	assert.Equal(t, "ff", moreInputErr.Remaining)
 	assert.Equal(t, ref.CV{Chapter: 23, Verse: 45}, cv)
+
+	cv, err = ref.ParseCV("3:16a")
+	assert.NoError(t, err)
+	assert.Equal(t, ref.CV{Chapter: 3, Verse: 16, Part: "a"}, cv)
+
+	cv, err = ref.ParseCV("3:16aff")
+	assert.ErrorAs(t, err, &moreInputErr)
+	assert.Equal(t, "aff", moreInputErr.Remaining)
+	assert.Equal(t, ref.CV{Chapter: 3, Verse: 16}, cv)
 }
 
 func TestParseSingle(t *testing.T) {
@@ -440,3 +463,206 @@ func TestParseMultiple(t *testing.T) {
 		},
 	}, m)
 }
+
+func TestParseProper_Part(t *testing.T) {
+	t.Parallel()
+
+	p, err := ref.ParseProper("Romans 8:28b–29")
+	assert.NoError(t, err)
+	assert.Equal(t, &ref.Proper{
+		Book: "Romans",
+		Verse: &ref.Range{
+			First: ref.CV{Chapter: 8, Verse: 28, Part: "b"},
+			Last:  ref.N{Number: 29},
+		},
+	}, p)
+	assert.Equal(t, "Romans 8:28b-29", p.Ref())
+
+	p, err = ref.ParseProper("John 3:16a, 17c")
+	assert.NoError(t, err)
+	assert.Equal(t, &ref.Proper{
+		Book: "John",
+		Verse: &ref.Related{
+			Refs: []ref.Relative{
+				&ref.Single{Verse: ref.CV{Chapter: 3, Verse: 16, Part: "a"}},
+				&ref.Single{Verse: ref.N{Number: 17, Part: "c"}},
+			},
+		},
+	}, p)
+	assert.Equal(t, "John 3:16a, 17c", p.Ref())
+}
+
+func TestParseProper_Unicode(t *testing.T) {
+	t.Parallel()
+
+	p, err := ref.ParseProper("Römer 8")
+	assert.NoError(t, err)
+	assert.Equal(t, &ref.Proper{
+		Book:  "Römer",
+		Verse: &ref.Single{Verse: ref.N{Number: 8}},
+	}, p)
+
+	p, err = ref.ParseProper("Éxodo 3:14")
+	assert.NoError(t, err)
+	assert.Equal(t, &ref.Proper{
+		Book:  "Éxodo",
+		Verse: &ref.Single{Verse: ref.CV{Chapter: 3, Verse: 14}},
+	}, p)
+}
+
+func TestParseSpan(t *testing.T) {
+	t.Parallel()
+
+	s, err := ref.ParseSpan("Genesis 50 – Exodus 2")
+	assert.NoError(t, err)
+	assert.Equal(t, &ref.Span{
+		FirstBook: "Genesis",
+		First:     ref.N{Number: 50},
+		LastBook:  "Exodus",
+		Last:      ref.N{Number: 2},
+	}, s)
+
+	s, err = ref.ParseSpan("Ruth 4:18-1 Samuel 2")
+	assert.NoError(t, err)
+	assert.Equal(t, &ref.Span{
+		FirstBook: "Ruth",
+		First:     ref.CV{Chapter: 4, Verse: 18},
+		LastBook:  "1 Samuel",
+		Last:      ref.N{Number: 2},
+	}, s)
+
+	s, err = ref.ParseSpan("Genesis-Exodus")
+	assert.NoError(t, err)
+	assert.Equal(t, &ref.Span{
+		FirstBook: "Genesis",
+		LastBook:  "Exodus",
+	}, s)
+
+	s, err = ref.ParseSpan("Mal. 4:5-Mt. 1:17; ")
+	var moreInputErr *ref.MoreInputError
+	assert.ErrorAs(t, err, &moreInputErr)
+	assert.Equal(t, "; ", moreInputErr.Remaining)
+	assert.Equal(t, &ref.Span{
+		FirstBook: "Mal.",
+		First:     ref.CV{Chapter: 4, Verse: 5},
+		LastBook:  "Mt.",
+		Last:      ref.CV{Chapter: 1, Verse: 17},
+	}, s)
+
+	s, err = ref.ParseSpan("Genesis 1:2-3:4")
+	assert.ErrorIs(t, err, ref.ErrParseFail)
+	assert.Nil(t, s)
+
+	s, err = ref.ParseSpan("Genesis 1-Genesis 3")
+	var validationErr *ref.ValidationError
+	assert.ErrorAs(t, err, &validationErr)
+	assert.Nil(t, s)
+}
+
+func TestParseMultiple_Span(t *testing.T) {
+	t.Parallel()
+
+	m, err := ref.ParseMultiple("Genesis 50-Exodus 2; 3:1; Lev. 1")
+	assert.NoError(t, err)
+	assert.Equal(t, &ref.Multiple{
+		Refs: []ref.Ref{
+			&ref.Span{
+				FirstBook: "Genesis",
+				First:     ref.N{Number: 50},
+				LastBook:  "Exodus",
+				Last:      ref.N{Number: 2},
+			},
+			&ref.Single{Verse: ref.CV{Chapter: 3, Verse: 1}},
+			&ref.Proper{
+				Book:  "Lev.",
+				Verse: &ref.Single{Verse: ref.N{Number: 1}},
+			},
+		},
+	}, m)
+
+	m, err = ref.ParseMultiple("John 3:16; Malachi 4-Matthew 2")
+	assert.NoError(t, err)
+	assert.Equal(t, &ref.Multiple{
+		Refs: []ref.Ref{
+			&ref.Proper{
+				Book:  "John",
+				Verse: &ref.Single{Verse: ref.CV{Chapter: 3, Verse: 16}},
+			},
+			&ref.Span{
+				FirstBook: "Malachi",
+				First:     ref.N{Number: 4},
+				LastBook:  "Matthew",
+				Last:      ref.N{Number: 2},
+			},
+		},
+	}, m)
+}
+
+func TestParseError(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		input       string
+		offset      int
+		expected    ref.TokenClass
+		found       string
+		suggestions []string
+	}{
+		{"bad chapter", "John *", 5, ref.TokenNumber, "*", nil},
+		{"missing book", "3:16", 0, ref.TokenBookName, "3", nil},
+		{"unknown book", "Jhon 3:16", 0, ref.TokenBookName, "Jhon", []string{"John", "Job", "1 John", "2 John", "3 John"}},
+		{"misspelled book", "  Rvelation 3:16", 2, ref.TokenBookName, "Rvelation", []string{"Revelation"}},
+		{"ambiguous book", "Ph 1:1", 0, ref.TokenBookName, "Ph", []string{"Philemon", "Philippians"}},
+		{"no suggestions", "Xyzzy 1:1", 0, ref.TokenBookName, "Xyzzy", []string{}},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			p, err := ref.ParseProper(tt.input, ref.ParseWithAbbreviations(ref.Abbreviations))
+			assert.Nil(t, p)
+			assert.ErrorIs(t, err, ref.ErrParseFail)
+
+			var perr *ref.ParseError
+			if assert.ErrorAs(t, err, &perr) {
+				assert.Equal(t, tt.input, perr.Input)
+				assert.Equal(t, tt.offset, perr.Offset)
+				assert.Equal(t, tt.expected, perr.Expected)
+				assert.Equal(t, tt.found, perr.Found)
+				assert.Equal(t, tt.suggestions, perr.Suggestions)
+			}
+		})
+	}
+}
+
+func TestParseError_Error(t *testing.T) {
+	t.Parallel()
+
+	_, err := ref.ParseProper("Jhon 3:16", ref.ParseWithAbbreviations(ref.Abbreviations))
+	assert.EqualError(t, err,
+		`parse failed at offset 0: expected book name, but found "Jhon". Did you mean John, Job, 1 John, 2 John, 3 John?`)
+
+	_, err = ref.ParseCV("3:")
+	assert.EqualError(t, err,
+		`parse failed at offset 2: expected number, but found end of input`)
+
+	_, err = ref.ParseProper("Ph 1:1", ref.ParseWithAbbreviations(ref.Abbreviations))
+	var mmerr *ref.MultipleMatchError
+	assert.ErrorAs(t, err, &mmerr)
+}
+
+func TestMoreInputError_Offset(t *testing.T) {
+	t.Parallel()
+
+	_, err := ref.ParseProper("Romans 8:28 and more")
+	var moreInputErr *ref.MoreInputError
+	if assert.ErrorAs(t, err, &moreInputErr) {
+		assert.Equal(t, 11, moreInputErr.Offset)
+		assert.Equal(t, " and more", moreInputErr.Remaining)
+	}
+	assert.EqualError(t, err,
+		`parse completed, but more input remains at offset 11: " and more"`)
+}
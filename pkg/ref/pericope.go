@@ -1,6 +1,13 @@
 package ref
 
-import "fmt"
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/agnivade/levenshtein"
+)
 
 // Pericope represents a resolved extract from a canon.
 type Pericope struct {
@@ -46,3 +53,154 @@ func (p *Pericope) Verses() (<-chan Verse, error) {
 
 	return ch, nil
 }
+
+// UnknownPericopeError is returned by PericopeByTitle when no title matches or
+// when more than one title matches equally well.
+type UnknownPericopeError struct {
+	Title         string
+	Possibilities []string
+}
+
+func (o *UnknownPericopeError) Error() string {
+	return unknownNameMessage("pericope", o.Title, o.Possibilities)
+}
+
+// PericopeByTitle returns the named pericope of the canon with the title that
+// best matches the given title. Titles are matched without regard to case or
+// punctuation and may match any part of a title, with a few typos allowed, so
+// "prodigal son" finds "The Parable of the Prodigal Son".
+//
+// It returns an UnknownPericopeError if no title matches or if more than one
+// title matches equally well, in which case the matching titles are listed as
+// possibilities.
+func (c *Canon) PericopeByTitle(title string) (*Pericope, error) {
+	if sr, ok := c.Pericopes[title]; ok {
+		return Lookup(c, sr, title)
+	}
+
+	q := titleWords(title)
+	if len(q) == 0 {
+		return nil, &UnknownPericopeError{Title: title}
+	}
+
+	// allow about one typo for every four letters
+	limit := len(strings.Join(q, " ")) / 4
+
+	best := limit + 1
+	var matches, whole []string
+	for name := range c.Pericopes {
+		t := titleWords(name)
+		d := titleDistance(q, t)
+		if d > best {
+			continue
+		}
+
+		if d < best {
+			best = d
+			matches, whole = nil, nil
+		}
+
+		matches = append(matches, name)
+
+		// a match of the whole title beats a match of part of one
+		if d == 0 && len(t) == len(q) {
+			whole = append(whole, name)
+		}
+	}
+
+	if best > limit {
+		return nil, &UnknownPericopeError{Title: title}
+	}
+
+	if len(whole) == 1 {
+		matches = whole
+	}
+
+	if len(matches) != 1 {
+		sort.Strings(matches)
+		return nil, &UnknownPericopeError{
+			Title:         title,
+			Possibilities: matches,
+		}
+	}
+
+	return Lookup(c, c.Pericopes[matches[0]], matches[0])
+}
+
+// titleWords returns the words of the title in lowercase with any punctuation
+// removed.
+func titleWords(title string) []string {
+	title = strings.Map(func(r rune) rune {
+		switch {
+		case unicode.IsLetter(r) || unicode.IsDigit(r):
+			return unicode.ToLower(r)
+		case unicode.IsSpace(r) || r == '-':
+			return ' '
+		default:
+			return -1
+		}
+	}, title)
+	return strings.Fields(title)
+}
+
+// titleDistance returns the smallest edit distance between the words of the
+// query and any run of the same number of words in the title.
+func titleDistance(q, t []string) int {
+	query := strings.Join(q, " ")
+	if len(t) <= len(q) {
+		return levenshtein.ComputeDistance(query, strings.Join(t, " "))
+	}
+
+	best := -1
+	for i := 0; i+len(q) <= len(t); i++ {
+		d := levenshtein.ComputeDistance(query, strings.Join(t[i:i+len(q)], " "))
+		if best < 0 || d < best {
+			best = d
+		}
+	}
+	return best
+}
+
+// PericopesCovering returns the named pericopes of the canon that include
+// every verse of the given reference, from the shortest to the longest.
+func (c *Canon) PericopesCovering(r *Resolved) ([]*Pericope, error) {
+	in, err := c.Set(*r)
+	if err != nil {
+		return nil, err
+	}
+
+	type covering struct {
+		p     *Pericope
+		count int
+	}
+
+	var cs []covering
+	for title, sr := range c.Pericopes {
+		p, err := Lookup(c, sr, title)
+		if err != nil {
+			return nil, fmt.Errorf("failed to lookup pericope %q: %w", title, err)
+		}
+
+		s, err := c.Set(*p.Ref)
+		if err != nil {
+			return nil, err
+		}
+
+		if in.Difference(s).Len() == 0 {
+			cs = append(cs, covering{p, s.Len()})
+		}
+	}
+
+	sort.Slice(cs, func(i, j int) bool {
+		if cs[i].count != cs[j].count {
+			return cs[i].count < cs[j].count
+		}
+		return cs[i].p.Title < cs[j].p.Title
+	})
+
+	ps := make([]*Pericope, len(cs))
+	for i := range cs {
+		ps[i] = cs[i].p
+	}
+	return ps, nil
+}
@@ -1,6 +1,7 @@
 package ref_test
 
 import (
+	"errors"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -52,3 +53,94 @@ func TestPericope(t *testing.T) {
 	assert.Nil(t, nextV)
 	assert.False(t, ok)
 }
+
+func TestCanon_PericopeByTitle(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		in    string
+		title string
+		ref   string
+	}{
+		{"The Parable of the Prodigal Son", "The Parable of the Prodigal Son", "Luke 15:11-32"},
+		{"Prodigal Son", "The Parable of the Prodigal Son", "Luke 15:11-32"},
+		{"prodigle son", "The Parable of the Prodigal Son", "Luke 15:11-32"},
+		{"Sermon on the Mount", "The Sermon on the Mount", "Matthew 5-7"},
+		{"lords prayer", "The Lord's Prayer", "Matthew 6:9-13"},
+		{"The Fall", "The Fall", "Genesis 3"},
+		{"Dry Bones", "The Valley of Dry Bones", "Ezekiel 37:1-14"},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.in, func(t *testing.T) {
+			t.Parallel()
+
+			p, err := ref.Canonical.PericopeByTitle(tt.in)
+			require.NoError(t, err)
+			assert.Equal(t, tt.title, p.Title)
+			assert.Same(t, ref.Canonical, p.Canon)
+
+			cs, err := p.Ref.CompactRef()
+			assert.NoError(t, err)
+			assert.Equal(t, tt.ref, cs)
+		})
+	}
+}
+
+func TestCanon_PericopeByTitle_Unknown(t *testing.T) {
+	t.Parallel()
+
+	_, err := ref.Canonical.PericopeByTitle("Parable of the Unforgiving Servant Who Owed Much")
+	var uperr *ref.UnknownPericopeError
+	require.True(t, errors.As(err, &uperr))
+	assert.Nil(t, uperr.Possibilities)
+
+	_, err = ref.Canonical.PericopeByTitle("Lost")
+	require.True(t, errors.As(err, &uperr))
+	assert.Equal(t, []string{
+		"The Parable of the Lost Coin",
+		"The Parable of the Lost Sheep",
+	}, uperr.Possibilities)
+}
+
+func TestCanon_PericopesCovering(t *testing.T) {
+	t.Parallel()
+
+	titles := func(r string) []string {
+		t.Helper()
+
+		p, err := ref.Lookup(ref.Canonical, r, "")
+		require.NoError(t, err)
+
+		ps, err := ref.Canonical.PericopesCovering(p.Ref)
+		require.NoError(t, err)
+
+		ts := make([]string, len(ps))
+		for i, p := range ps {
+			ts[i] = p.Title
+		}
+		return ts
+	}
+
+	assert.Equal(t, []string{
+		"For God So Loved the World",
+		"Jesus and Nicodemus",
+	}, titles("John 3:16"))
+	assert.Equal(t, []string{
+		"The Lord's Prayer",
+		"The Sermon on the Mount",
+	}, titles("Matthew 6:9-13"))
+	assert.Equal(t, []string{"The Sermon on the Mount"}, titles("Matthew 6:9-14"))
+	assert.Empty(t, titles("Matthew 4:25-5:2"))
+}
+
+func TestUnknownPericopeError_Error(t *testing.T) {
+	t.Parallel()
+
+	err := &ref.UnknownPericopeError{Title: "The Lost Sheep"}
+	assert.Equal(t, "unknown pericope: The Lost Sheep", err.Error())
+
+	err.Possibilities = []string{"The Parable of the Lost Sheep", "The Parable of the Lost Coin"}
+	assert.Equal(t, "unknown pericope: The Lost Sheep. Did you mean?\n\n - The Parable of the Lost Sheep\n - The Parable of the Lost Coin", err.Error())
+}
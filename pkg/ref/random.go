@@ -5,6 +5,7 @@ import (
 	"math"
 	"math/rand"
 	"strings"
+	"sync"
 
 	"github.com/agnivade/levenshtein"
 )
@@ -67,19 +68,26 @@ type UnknownCategoryError struct {
 }
 
 func (o *UnknownCategoryError) Error() string {
+	return unknownNameMessage("category", o.Category, o.Possibilities)
+}
+
+// unknownNameMessage returns the message of an error for an unknown name of the
+// given kind (e.g., "category"), listing the possibilities, if any, as the
+// names that might have been meant.
+func unknownNameMessage(kind, name string, possibilities []string) string {
 	alternates := ""
-	if o.Possibilities != nil {
+	if possibilities != nil {
 		alternates = fmt.Sprintf(". Did you mean?\n\n - %s",
-			strings.Join(o.Possibilities, "\n - "))
+			strings.Join(possibilities, "\n - "))
 	}
 
-	return fmt.Sprintf("unknown category: %s%s",
-		o.Category,
-		alternates)
+	return fmt.Sprintf("unknown %s: %s%s", kind, name, alternates)
 }
 
 // Random pulls a random reference from the Bible and returns it. You can use the
-// options to help narrow down where the passages are selected from.
+// options to help narrow down where the passages are selected from. The
+// passage never includes a verse omitted by any translation listed in the
+// OmittedVerses of the canon.
 func Random(opt ...RandomReferenceOption) (*Resolved, error) {
 	o := &randomOpts{
 		canon: Canonical,
@@ -98,16 +106,46 @@ func Random(opt ...RandomReferenceOption) (*Resolved, error) {
 		}
 	}
 
-	var (
-		b  *Book
-		vs []Verse
-	)
+	rs, err := o.candidates()
+	if err != nil {
+		return nil, err
+	}
+
+	omitted, err := o.canon.anyOmissions()
+	if err != nil {
+		return nil, err
+	}
+
+	rs, err = o.canon.withoutOmitted(rs, omitted)
+	if err != nil {
+		return nil, err
+	}
+
+	pr := pickWeighted(rs)
+	if pr == nil {
+		if o.category != "" {
+			return nil, fmt.Errorf("%w: category %q has no verses to pick from", ErrNotFound, o.category)
+		}
+		return nil, fmt.Errorf("%w: no verses to pick from", ErrNotFound)
+	}
+
+	first, last := pr.verseIndexes()
+	x, y := pickVerses(last-first+1, o.min, o.max)
 
+	return &Resolved{
+		Book:  pr.Book,
+		First: pr.Book.verseAt(first + x),
+		Last:  pr.Book.verseAt(first + y - 1),
+	}, nil
+}
+
+// candidates returns the references the passage may be picked from: the
+// pericopes of the category, the book, or a random book of the canon.
+func (o *randomOpts) candidates() ([]Resolved, error) {
 	if o.category != "" {
-		_, hasCategory := o.canon.Categories[o.category]
-		if !hasCategory {
+		if !o.canon.HasCategory(o.category) {
 			var possibilities []string
-			for cat := range o.canon.Categories {
+			for _, cat := range o.canon.CategoryNames() {
 				if levenshtein.ComputeDistance(o.category, cat) <= 4 {
 					possibilities = append(possibilities, cat)
 				}
@@ -124,45 +162,130 @@ func Random(opt ...RandomReferenceOption) (*Resolved, error) {
 			return nil, fmt.Errorf("error getting category pericopes %q: %w", o.category, err)
 		}
 
-		// lazy way to weight the books by the number of verses they have
-		bag := make([]*Pericope, 0, len(ps))
+		rs := make([]Resolved, len(ps))
 		for i := range ps {
-			for range ps[i].Ref.Verses() {
-				bag = append(bag, ps[i])
-			}
+			rs[i] = *ps[i].Ref
 		}
+		return rs, nil
+	}
 
-		be := bag[rand.Int()%len(bag)] //nolint:gosec // weak random is fine here
-		b = be.Ref.Book
-		vs = RandomPassageFromRef(be.Ref, o.min, o.max)
+	var b *Book
+	if o.book != "" {
+		var err error
+		b, err = o.canon.Book(o.book)
+		if err != nil {
+			return nil, fmt.Errorf("error looking up book %q: %w", o.book, err)
+		}
 	} else {
-		if o.book != "" {
-			b, err = o.canon.Book(o.book)
-			if err != nil {
-				return nil, fmt.Errorf("error looking up book %q: %w", o.book, err)
+		b = RandomCanonical(o.canon)
+	}
+
+	first := b.FirstVerse()
+	if first == nil {
+		return nil, nil
+	}
+
+	return []Resolved{{
+		Book:  b,
+		First: first,
+		Last:  b.LastVerse(),
+	}}, nil
+}
+
+// builtinOmissions returns the verses omitted by any translation for each of
+// the built-in Canons. These are resolved only once, on first use.
+var builtinOmissions = sync.OnceValues(func() (map[*Canon]*Set, error) {
+	sets := make(map[*Canon]*Set, len(Canons))
+	for _, c := range Canons {
+		s, err := c.Omissions("")
+		if err != nil {
+			return nil, err
+		}
+		sets[c] = s
+	}
+	return sets, nil
+})
+
+// anyOmissions returns the set of verses omitted by any translation. The set
+// of a built-in canon is only resolved once, so later changes to its
+// OmittedVerses are not seen.
+func (c *Canon) anyOmissions() (*Set, error) {
+	sets, err := builtinOmissions()
+	if err != nil {
+		return nil, err
+	}
+
+	if s, isBuiltin := sets[c]; isBuiltin {
+		return s, nil
+	}
+
+	return c.Omissions("")
+}
+
+// withoutOmitted returns the ranges of verses left in the given references
+// once the omitted verses are removed. Each reference is kept apart from the
+// others, so no range crosses from one into the next. The references are
+// returned as they are if none of them include an omitted verse.
+func (c *Canon) withoutOmitted(rs []Resolved, omitted *Set) ([]Resolved, error) {
+	var remaining []Resolved
+	for i := range rs {
+		first, last := rs[i].verseIndexes()
+		if first < 0 || last < first {
+			if remaining == nil {
+				remaining = append(make([]Resolved, 0, len(rs)), rs[:i]...)
 			}
+			continue
+		}
+
+		iv, err := c.interval(&rs[i])
+		if err != nil {
+			return nil, err
+		}
 
-			firstVerse := b.Verses[0]
-			ex, err := Lookup(o.canon, o.book+" "+firstVerse.Ref()+"ffb", "")
-			if err != nil {
-				return nil, fmt.Errorf("error looking up book %q: %w", o.book, err)
+		if !omitted.overlapsInterval(iv) {
+			if remaining != nil {
+				remaining = append(remaining, rs[i])
 			}
+			continue
+		}
 
-			b = ex.Ref.Book
-		} else {
-			b = RandomCanonical(o.canon)
+		if remaining == nil {
+			remaining = append(make([]Resolved, 0, len(rs)), rs[:i]...)
 		}
 
-		vs = RandomPassage(b, o.min, o.max)
+		s := &Set{canon: c, intervals: []interval{iv}}
+		remaining = append(remaining, s.Difference(omitted).Ranges()...)
 	}
 
-	v1, v2 := vs[0], vs[len(vs)-1]
+	if remaining == nil {
+		return rs, nil
+	}
 
-	return &Resolved{
-		Book:  b,
-		First: v1,
-		Last:  v2,
-	}, nil
+	return remaining, nil
+}
+
+// pickWeighted picks one of the given references at random, weighting each by
+// the number of verses it has. It returns nil if none of them have any verses.
+func pickWeighted(rs []Resolved) *Resolved {
+	total := 0
+	for i := range rs {
+		total += rs[i].verseCount()
+	}
+
+	if total == 0 {
+		return nil
+	}
+
+	pick := rand.Int() % total //nolint:gosec // weak random is fine here
+	for i := range rs {
+		n := rs[i].verseCount()
+		if pick < n {
+			return &rs[i]
+		}
+		pick -= n
+	}
+
+	return nil
 }
 
 // RandomCanonical returns a random book of the Bible.
@@ -189,7 +312,8 @@ func RandomCanonical(c *Canon) *Book {
 // The values will be automatically capped to the number of verses in the book
 // and automatically set to 1 if they are less than 1.
 func RandomPassage(b *Book, mn, mx int) []Verse {
-	return pickVerses(b.Verses, mn, mx)
+	x, y := pickVerses(b.VerseCount(), mn, mx)
+	return b.versesBetween(x, y-1)
 }
 
 // RandomPassageFromRef returns a random passage from the given ref.Resolved of
@@ -211,10 +335,15 @@ func RandomPassage(b *Book, mn, mx int) []Verse {
 // The values will be automatically capped to the number of verses in the book
 // and automatically set to 1 if they are less than 1.
 func RandomPassageFromRef(b *Resolved, mn, mx int) []Verse {
-	return pickVerses(b.Verses(), mn, mx)
+	first, last := b.verseIndexes()
+	x, y := pickVerses(last-first+1, mn, mx)
+	return b.Book.versesBetween(first+x, first+y-1)
 }
 
-func pickVerses(verses []Verse, mn, mx int) []Verse {
+// pickVerses picks a random passage from a list of the given number of verses.
+// It returns the position of the first verse of the passage and the position
+// just after the last.
+func pickVerses(count, mn, mx int) (int, int) {
 	// This is a little convoluted, but let me explain:
 	//
 	// * User selects the minimum and maximum length of the passage to return in
@@ -242,10 +371,10 @@ func pickVerses(verses []Verse, mn, mx int) []Verse {
 	// This guarantees every passage selection is just as probably to be selected
 	// as every other possible selection.
 
-	// cap the minimum mn such that 1 <= mn <= mx <= len(b.Verses)
+	// cap the minimum mn such that 1 <= mn <= mx <= count
 	// mx = mn if mx < mn
-	mn = max(1, min(mn, len(verses)))
-	mx = max(1, min(mx, len(verses)))
+	mn = max(1, min(mn, count))
+	mx = max(1, min(mx, count))
 	mx = max(mx, mn)
 
 	// pick a passage length
@@ -258,14 +387,11 @@ func pickVerses(verses []Verse, mn, mx int) []Verse {
 
 	// pick a starting verse
 	var x int
-	if n >= len(verses) {
+	if n >= count {
 		x = 0
 	} else {
-		x = rand.Int() % (len(verses) - n) //nolint:gosec // weak random is fine here
+		x = rand.Int() % (count - n) //nolint:gosec // weak random is fine here
 	}
-	y := x + n
 
-	// return the selected verses
-	vs := verses[x:y]
-	return vs
+	return x, x + n
 }
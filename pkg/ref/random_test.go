@@ -1,6 +1,7 @@
 package ref_test
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -182,7 +183,7 @@ func TestCanon_Random_Filtered_BugFix_Colossians(t *testing.T) {
 		expected = append(expected, ref.CV{Chapter: 4, Verse: i})
 	}
 
-	assert.Equal(t, expected, b.Verses)
+	assert.Equal(t, expected, bookVerses(b))
 
 	r, err := ref.Random(
 		ref.FromCanon(c),
@@ -192,3 +193,79 @@ func TestCanon_Random_Filtered_BugFix_Colossians(t *testing.T) {
 	assert.NoError(t, err)
 	assert.NotNil(t, r)
 }
+
+func BenchmarkRandom(b *testing.B) {
+	b.ReportAllocs()
+	for b.Loop() {
+		_, err := ref.Random(ref.FromBook("Psalms"))
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkRandom_Category(b *testing.B) {
+	b.ReportAllocs()
+	for b.Loop() {
+		_, err := ref.Random(ref.FromCategory("Gospels"))
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func TestRandom_SkipsOmitted(t *testing.T) {
+	t.Parallel()
+
+	omitted, err := ref.Canonical.Omissions("")
+	require.NoError(t, err)
+
+	for range 200 {
+		r, err := ref.Random(ref.FromBook("Acts"), ref.WithAtLeast(5), ref.WithAtMost(20))
+		require.NoError(t, err)
+
+		picked, err := ref.Canonical.Set(*r)
+		require.NoError(t, err)
+		assert.False(t, picked.Overlaps(omitted), "picked %s", r.Ref())
+	}
+}
+
+func TestUnknownCategoryError_Error(t *testing.T) {
+	t.Parallel()
+
+	err := &ref.UnknownCategoryError{Category: "Prophet"}
+	assert.Equal(t, "unknown category: Prophet", err.Error())
+
+	err.Possibilities = []string{"Major Prophets", "Minor Prophets"}
+	assert.Equal(t, "unknown category: Prophet. Did you mean?\n\n - Major Prophets\n - Minor Prophets", err.Error())
+}
+
+func TestRandom_NearlyAllOmitted(t *testing.T) {
+	t.Parallel()
+
+	c, err := ref.LoadCanon(strings.NewReader(`
+books:
+  - name: Ruth
+    verses: [[1, 1], [1, 2], [1, 3], [1, 4], [1, 5], [1, 6]]
+categories:
+  Short: [Ruth]
+omitted_verses:
+  ESV: [Ruth 1:1-3, Ruth 1:5-6]
+`))
+	require.NoError(t, err)
+
+	for range 50 {
+		r, err := ref.Random(ref.FromCanon(c), ref.FromCategory("Short"), ref.WithAtMost(3))
+		require.NoError(t, err)
+		assert.Equal(t, "Ruth 1:4", r.Ref())
+
+		r, err = ref.Random(ref.FromCanon(c), ref.FromBook("Ruth"), ref.WithAtMost(3))
+		require.NoError(t, err)
+		assert.Equal(t, "Ruth 1:4", r.Ref())
+	}
+
+	c.OmittedVerses["ESV"] = append(c.OmittedVerses["ESV"], "Ruth 1:4")
+
+	_, err = ref.Random(ref.FromCanon(c), ref.FromCategory("Short"))
+	assert.ErrorIs(t, err, ref.ErrNotFound)
+}
@@ -120,14 +120,24 @@ type Absolute interface {
 type CV struct {
 	Chapter int
 	Verse   int
+
+	// Part is an optional letter identifying part of a verse, such as the "a"
+	// in "John 3:16a". It is empty when the whole verse is meant.
+	Part string
 }
 
 // Ref returns the Chapter:Verse reference string.
 func (v CV) Ref() string {
-	return strconv.Itoa(v.Chapter) + ":" + strconv.Itoa(v.Verse)
+	return strconv.Itoa(v.Chapter) + ":" + v.verseRef()
+}
+
+// verseRef returns the verse number with any part suffix, without the chapter.
+func (v CV) verseRef() string {
+	return strconv.Itoa(v.Verse) + v.Part
 }
 
-// Validate returns true iff the chapter and verse are both positive.
+// Validate returns true iff the chapter and verse are both positive and the
+// part, if any, is valid.
 func (v CV) Validate() error {
 	if v.Chapter <= 0 {
 		return invalid("chapter must be positive")
@@ -135,7 +145,7 @@ func (v CV) Validate() error {
 	if v.Verse <= 0 {
 		return invalid("verse must be positive")
 	}
-	return nil
+	return validatePart(v.Part)
 }
 
 // InBook turns this relative reference into a proper reference for the given
@@ -148,16 +158,21 @@ func (v CV) Before(ov Verse) bool {
 	switch sv := ov.(type) {
 	case CV:
 		return v.Chapter < sv.Chapter ||
-			(v.Chapter == sv.Chapter && v.Verse < sv.Verse)
+			(v.Chapter == sv.Chapter && v.Verse < sv.Verse) ||
+			(v.Chapter == sv.Chapter && v.Verse == sv.Verse && partBefore(v.Part, sv.Part))
 	default:
 		return v.Before(sv.RelativeTo(v))
 	}
 }
 
+// Equal returns true if the given verse refers to the same chapter and verse.
+// The parts are only compared if both verses have one, so John 3:16a is equal
+// to John 3:16, but not to John 3:16b.
 func (v CV) Equal(ov Verse) bool {
 	switch sv := ov.(type) {
 	case CV:
-		return v.Chapter == sv.Chapter && v.Verse == sv.Verse
+		return v.Chapter == sv.Chapter && v.Verse == sv.Verse &&
+			partEqual(v.Part, sv.Part)
 	default:
 		return v.Equal(sv.RelativeTo(v))
 	}
@@ -186,19 +201,25 @@ func (v CV) RelativeTo(ov Verse) Verse {
 // N represents all the of the above cases.
 type N struct {
 	Number int
+
+	// Part is an optional letter identifying part of a verse, such as the "b"
+	// in "Philemon 6b". It is empty when the whole verse is meant. A chapter
+	// may not have a part.
+	Part string
 }
 
 // Ref returns the Verse reference string (no Chapter:).
 func (n N) Ref() string {
-	return strconv.Itoa(n.Number)
+	return strconv.Itoa(n.Number) + n.Part
 }
 
-// Validate returns true iff the verse is positive.
+// Validate returns true iff the verse is positive and the part, if any, is
+// valid.
 func (n N) Validate() error {
 	if n.Number <= 0 {
 		return invalid("chapter or verse must be positive")
 	}
-	return nil
+	return validatePart(n.Part)
 }
 
 // InBook turns this relative reference into a proper reference for the given
@@ -210,16 +231,19 @@ func (n N) InBook(b string) *Proper {
 func (n N) Before(ov Verse) bool {
 	switch sv := ov.(type) {
 	case N:
-		return n.Number < sv.Number
+		return n.Number < sv.Number ||
+			(n.Number == sv.Number && partBefore(n.Part, sv.Part))
 	default:
 		return n.Before(sv.RelativeTo(n))
 	}
 }
 
+// Equal returns true if the given verse refers to the same number. The parts
+// are only compared if both verses have one.
 func (n N) Equal(ov Verse) bool {
 	switch sv := ov.(type) {
 	case N:
-		return n.Number == sv.Number
+		return n.Number == sv.Number && partEqual(n.Part, sv.Part)
 	default:
 		return n.Equal(sv.RelativeTo(n))
 	}
@@ -230,11 +254,65 @@ func (n N) RelativeTo(ov Verse) Verse {
 	case N:
 		return n
 	case CV:
-		return CV{Chapter: sv.Chapter, Verse: n.Number}
+		return CV{Chapter: sv.Chapter, Verse: n.Number, Part: n.Part}
 	}
 	panic("unable to convert N to unknown verse type")
 }
 
+// isPartLetter returns true if the rune may be used to identify part of a
+// verse.
+func isPartLetter(r rune) bool {
+	return r >= 'a' && r <= 'e'
+}
+
+// validatePart returns an error if the part is not empty or a single part
+// letter.
+func validatePart(part string) error {
+	if part == "" {
+		return nil
+	}
+
+	if len(part) != 1 || !isPartLetter(rune(part[0])) {
+		return invalid("verse part must be a single letter from a to e")
+	}
+
+	return nil
+}
+
+// partEqual returns true if both parts are the same or either part is empty.
+func partEqual(a, b string) bool {
+	return a == "" || b == "" || a == b
+}
+
+// partBefore returns true if both parts are given and a comes before b.
+func partBefore(a, b string) bool {
+	return a != "" && b != "" && a < b
+}
+
+// versePart returns the part of the given verse, if any.
+func versePart(v Verse) string {
+	switch v := v.(type) {
+	case CV:
+		return v.Part
+	case N:
+		return v.Part
+	}
+	return ""
+}
+
+// wholeVerse returns the given verse with any part removed.
+func wholeVerse(v Verse) Verse {
+	switch v := v.(type) {
+	case CV:
+		v.Part = ""
+		return v
+	case N:
+		v.Part = ""
+		return v
+	}
+	return v
+}
+
 // Single is a relative reference to a single verse. It wraps a single verse.
 type Single struct {
 	Verse Verse
@@ -437,7 +515,7 @@ func (p *Proper) FullNameRef(opt ...ResolveOption) (string, error) {
 		return p.Ref(), nil
 	}
 
-	fullName, err := abbrs.BookName(p.Book)
+	fullName, err := abbrs.BookName(p.Book, opt...)
 	if err != nil {
 		return "", err
 	}
@@ -460,7 +538,7 @@ func (p *Proper) AbbreviatedRef(opt ...ResolveOption) (string, error) {
 		return p.Ref(), nil
 	}
 
-	fullName, err := abbrs.BookName(p.Book)
+	fullName, err := abbrs.BookName(p.Book, opt...)
 	if err != nil {
 		return "", err
 	}
@@ -495,6 +573,117 @@ func (p *Proper) IsSingleRange() bool {
 	return isSingle || isRange || isAndFollowing
 }
 
+// Span is a reference to a range of verses that starts in one book and ends in
+// another, such as "Genesis 50-Exodus 2" or "Ruth 4:18-1 Samuel 2". Either
+// verse may be nil, in which case the span starts at the beginning of the first
+// book or runs to the end of the last book, respectively.
+//
+// When resolved, a Span is expanded into one Resolved reference per book it
+// crosses.
+type Span struct {
+	FirstBook string
+	First     Verse
+	LastBook  string
+	Last      Verse
+}
+
+func spanEnd(book string, v Verse) string {
+	if v == nil {
+		return book
+	}
+	return fmt.Sprintf("%s %s", book, v.Ref())
+}
+
+func (s *Span) Ref() string {
+	return fmt.Sprintf("%s-%s",
+		spanEnd(s.FirstBook, s.First),
+		spanEnd(s.LastBook, s.Last))
+}
+
+func (s *Span) Validate() error {
+	if s.FirstBook == "" {
+		return invalid("span is incorrect: first book is required")
+	}
+	if s.LastBook == "" {
+		return invalid("span is incorrect: last book is required")
+	}
+	if s.FirstBook == s.LastBook {
+		return invalid("span is incorrect: first and last book must differ")
+	}
+
+	if s.First != nil {
+		if err := s.First.Validate(); err != nil {
+			return invalid("span is incorrect: %w", unravelInvalid(err))
+		}
+	}
+	if s.Last != nil {
+		if err := s.Last.Validate(); err != nil {
+			return invalid("span is incorrect: %w", unravelInvalid(err))
+		}
+	}
+
+	return nil
+}
+
+func (s *Span) Names() []string {
+	return []string{s.FirstBook, s.LastBook}
+}
+
+// IsSingleRange returns false. Though a span is a single range of verses, it
+// crosses more than one book.
+func (s *Span) IsSingleRange() bool {
+	return false
+}
+
+func (s *Span) FullNameRef(opt ...ResolveOption) (string, error) {
+	o := makeResolveOpts(opt)
+	abbrs := o.Abbreviations
+
+	if abbrs == nil {
+		return s.Ref(), nil
+	}
+
+	firstName, err := abbrs.BookName(s.FirstBook, opt...)
+	if err != nil {
+		return "", err
+	}
+
+	lastName, err := abbrs.BookName(s.LastBook, opt...)
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("%s-%s",
+		spanEnd(firstName, s.First),
+		spanEnd(lastName, s.Last)), nil
+}
+
+func (s *Span) AbbreviatedRef(opt ...ResolveOption) (string, error) {
+	o := makeResolveOpts(opt)
+	abbrs := o.Abbreviations
+
+	if abbrs == nil {
+		return s.Ref(), nil
+	}
+
+	names := make([]string, 2)
+	for i, name := range s.Names() {
+		fullName, err := abbrs.BookName(name, opt...)
+		if err != nil {
+			return "", err
+		}
+
+		names[i], err = abbrs.PreferredAbbreviation(fullName)
+		if err != nil {
+			return "", err
+		}
+	}
+
+	return fmt.Sprintf("%s-%s",
+		spanEnd(names[0], s.First),
+		spanEnd(names[1], s.Last)), nil
+}
+
 // Multiple is a list of references to verses relative to a Book of the Bible. These
 // rendered as a set of references separated by semi-colon. A List may not be the
 // child of another List. All references in a list must be of the same type.
@@ -567,16 +756,19 @@ func (m *Multiple) Validate() error {
 		return invalid("multiple list of references is incorrect: no references")
 	}
 
-	if _, isProper := m.Refs[0].(*Proper); !isProper {
-		return invalid("multiple list of references is incorrect: first reference must be a proper reference")
+	switch m.Refs[0].(type) {
+	case *Proper, *Span:
+	default:
+		return invalid("multiple list of references is incorrect: first reference must be a proper reference or span")
 	}
 
 	for i := range m.Refs {
 		switch m.Refs[i].(type) {
 		case Relative:
 		case *Proper:
+		case *Span:
 		default:
-			return fmt.Errorf("multiple list of references is incorrect: only relative or proper references are permitted in multiple reference lists")
+			return fmt.Errorf("multiple list of references is incorrect: only relative, proper, or span references are permitted in multiple reference lists")
 		}
 
 		if err := m.Refs[i].Validate(); err != nil {
@@ -590,8 +782,11 @@ func (m *Multiple) Validate() error {
 func (m *Multiple) Names() []string {
 	var names []string
 	for i := range m.Refs {
-		if p, isProper := m.Refs[i].(*Proper); isProper {
-			names = append(names, p.Book)
+		switch r := m.Refs[i].(type) {
+		case *Proper:
+			names = append(names, r.Book)
+		case *Span:
+			names = append(names, r.Names()...)
 		}
 	}
 	return names
@@ -620,6 +815,12 @@ type Resolved struct {
 	Book  *Book
 	First Verse
 	Last  Verse
+
+	// Continued is set when this reference is one segment of a Span that
+	// continues on into the next book. The next segment of the span immediately
+	// follows this one in the list of Resolved references returned by
+	// Canon.Resolve.
+	Continued bool
 }
 
 func (r *Resolved) Ref() string {
@@ -675,60 +876,151 @@ func (r *Resolved) IsSingleRange() bool {
 	return true
 }
 
+// Verses returns every verse of the book from the first verse to the last.
 func (r *Resolved) Verses() []Verse {
-	verses := make([]Verse, 0, len(r.Book.Verses))
-	started := false
-	for _, verse := range r.Book.Verses {
-		if verse.Equal(r.First) {
-			started = true
-		}
-		if started {
-			verses = append(verses, verse)
-		}
-		if verse.Equal(r.Last) {
-			break
-		}
+	first, last := r.verseIndexes()
+	return r.Book.versesBetween(first, last)
+}
+
+// verseCount returns the number of verses from the first verse to the last.
+func (r *Resolved) verseCount() int {
+	first, last := r.verseIndexes()
+	return max(0, last-first+1)
+}
+
+// verseIndexes returns the positions of the first and last verses among the
+// verses of the book. If the first verse is not in the book, first is -1. If
+// the last verse is not in the book, last is the position of the last verse of
+// the book.
+func (r *Resolved) verseIndexes() (first, last int) {
+	first = r.Book.verseIndex(r.First)
+	if first < 0 {
+		return -1, -1
+	}
+
+	last = r.Book.verseIndex(r.Last)
+	if last < 0 {
+		last = r.Book.VerseCount() - 1
 	}
 
-	return verses
+	return first, last
 }
 
-func (r *Resolved) compactRef(name string) (string, error) {
-	if r.First.Equal(r.Last) {
-		return fmt.Sprintf("%s %s", name, r.First.Ref()), nil
+func (r *Resolved) compactRef(name string, n Notation, t Typography) (string, error) {
+	verses, err := r.versesRef(n, t, false)
+	if err != nil {
+		return "", err
 	}
 
-	if r.First.Equal(r.Book.Verses[0]) && r.Last.Equal(r.Book.Verses[len(r.Book.Verses)-1]) {
+	if verses == "" {
 		return name, nil
 	}
 
+	return name + t.space() + verses, nil
+}
+
+// versesRef returns the chapters and verses of the reference as written after
+// the book name by compactRef, which is empty for a reference to the whole
+// book. If inChapter is true, the reference is to verses of a single chapter
+// that is already known to the reader, so the chapter is left out.
+func (r *Resolved) versesRef(n Notation, t Typography, inChapter bool) (string, error) {
+	verseRef := n.verseRef
+	if inChapter {
+		verseRef = verseOnlyRef
+	}
+
+	if r.First.Equal(r.Last) && versePart(r.First) == versePart(r.Last) {
+		return verseRef(r.First), nil
+	}
+
+	if r.isWholeBook() {
+		return "", nil
+	}
+
 	fcv, isFCV := r.First.(CV)
 	lcv, isLCV := r.Last.(CV)
 	if isFCV && isLCV {
-		if fcv.Chapter == lcv.Chapter {
-			lvInC, err := r.Book.LastVerseInChapter(fcv.Chapter)
-			if err != nil {
-				return "", err
-			}
+		lvInC, err := r.Book.LastVerseInChapter(lcv.Chapter)
+		if err != nil {
+			return "", err
+		}
 
-			if fcv.Verse == 1 && lcv.Verse == lvInC {
-				return fmt.Sprintf("%s %d", name, fcv.Chapter), nil
+		if !r.hasPart() && fcv.Verse == 1 && lcv.Verse == lvInC {
+			if fcv.Chapter == lcv.Chapter {
+				return strconv.Itoa(fcv.Chapter), nil
 			}
+			return strconv.Itoa(fcv.Chapter) + t.dash(n) + strconv.Itoa(lcv.Chapter), nil
+		}
 
-			return fmt.Sprintf("%s %d:%d-%d", name, fcv.Chapter, fcv.Verse, lcv.Verse), nil
-		} else {
-			lvInC, err := r.Book.LastVerseInChapter(lcv.Chapter)
-			if err != nil {
-				return "", err
+		if fcv.Chapter == lcv.Chapter {
+			if ff := t.following(fcv.Verse, lcv.Verse, lvInC); ff != "" && !r.hasPart() {
+				return verseRef(fcv) + ff, nil
 			}
+			return verseRef(fcv) + t.dash(n) + lcv.verseRef(), nil
+		}
+	}
 
-			if fcv.Verse == 1 && lcv.Verse == lvInC {
-				return fmt.Sprintf("%s %d-%d", name, fcv.Chapter, lcv.Chapter), nil
-			}
+	fn, isFN := r.First.(N)
+	ln, isLN := r.Last.(N)
+	if isFN && isLN && !r.hasPart() {
+		lvInC, err := r.Book.LastVerseInChapter(1)
+		if err != nil {
+			return "", err
+		}
+
+		if ff := t.following(fn.Number, ln.Number, lvInC); ff != "" {
+			return verseRef(fn) + ff, nil
 		}
 	}
 
-	return fmt.Sprintf("%s %s-%s", name, r.First.Ref(), r.Last.Ref()), nil
+	return verseRef(r.First) + t.dash(n) + verseRef(r.Last), nil
+}
+
+// verseOnlyRef returns the verse number and part of the verse without the
+// chapter.
+func verseOnlyRef(v Verse) string {
+	if cv, isCV := v.(CV); isCV {
+		return cv.verseRef()
+	}
+	return v.Ref()
+}
+
+// isWholeBook returns true if the reference is to every verse of the book.
+func (r *Resolved) isWholeBook() bool {
+	return !r.hasPart() && r.First.Equal(r.Book.FirstVerse()) && r.Last.Equal(r.Book.LastVerse())
+}
+
+// isWholeChapters returns true if the reference is to every verse of one or
+// more chapters, in which case it is written without verses.
+func (r *Resolved) isWholeChapters() bool {
+	if r.isWholeBook() {
+		return true
+	}
+
+	fcv, isFCV := r.First.(CV)
+	lcv, isLCV := r.Last.(CV)
+	if !isFCV || !isLCV || r.hasPart() || fcv.Verse != 1 {
+		return false
+	}
+
+	lvInC, err := r.Book.LastVerseInChapter(lcv.Chapter)
+	return err == nil && lcv.Verse == lvInC
+}
+
+// hasPart returns true if either the first or last verse refers to only part
+// of a verse.
+func (r *Resolved) hasPart() bool {
+	return versePart(r.First) != "" || versePart(r.Last) != ""
+}
+
+// Whole returns a copy of this reference with any verse parts removed, so that
+// the reference covers whole verses. This is useful when fetching the text of
+// a reference such as John 3:16a, which is retrieved as John 3:16.
+func (r *Resolved) Whole() *Resolved {
+	w := *r
+	w.First = wholeVerse(r.First)
+	w.Last = wholeVerse(r.Last)
+	return &w
 }
 
 // CompactRef returns a compact representation of the resolved reference. If the
@@ -742,15 +1034,68 @@ func (r *Resolved) compactRef(name string) (string, error) {
 // knows if it is a single chapter via IsSingleChapter.
 func (r *Resolved) CompactRef(opt ...ResolveOption) (string, error) {
 	o := makeResolveOpts(opt)
-	name := r.Book.Name
-	if o.Abbreviations != nil && r.IsSingleChapter() {
-		var err error
-		name, err = o.Abbreviations.SingularName(name)
+	name, err := r.fullName(o)
+	if err != nil {
+		return "", err
+	}
+	return r.compactRef(name, o.Notation, o.Typography)
+}
+
+// fullName returns the full name of the book for this reference, using the
+// singular form of the name if the reference is to a single chapter.
+//
+// If the abbreviations in use are for another language, the localized name of
+// the book is returned.
+func (r *Resolved) fullName(o *resolveOpts) (string, error) {
+	if o.Abbreviations == nil {
+		return r.Book.Name, nil
+	}
+
+	if r.IsSingleChapter() {
+		return o.Abbreviations.SingularName(r.Book.Name)
+	}
+
+	if name, err := o.Abbreviations.LocalName(r.Book.Name); err == nil {
+		return name, nil
+	}
+
+	return r.Book.Name, nil
+}
+
+// spanRef returns a compact representation of a span of verses running from
+// the start of first to the end of last, which are expected to be the first and
+// last segments of a resolved Span. The names to use for each book are given.
+//
+// If the span covers the whole of both books, only the names are returned
+// (e.g., Genesis-Exodus). If the span starts at the beginning of a chapter or
+// ends at the end of a chapter, the verse is omitted for that end (e.g.,
+// Genesis 50-Exodus 2 or Ruth 4:18-1 Samuel 2).
+func spanRef(first, last *Resolved, firstName, lastName string, n Notation, t Typography) (string, error) {
+	fb, lb := first.Book, last.Book
+	fPart, lPart := versePart(first.First), versePart(last.Last)
+	if fPart == "" && lPart == "" &&
+		first.First.Equal(fb.FirstVerse()) && last.Last.Equal(lb.LastVerse()) {
+		return firstName + t.dash(n) + lastName, nil
+	}
+
+	start := n.verseRef(first.First)
+	if fcv, isFCV := first.First.(CV); isFCV && fcv.Verse == 1 && fPart == "" {
+		start = strconv.Itoa(fcv.Chapter)
+	}
+
+	end := n.verseRef(last.Last)
+	if lcv, isLCV := last.Last.(CV); isLCV && lPart == "" {
+		lvInC, err := lb.LastVerseInChapter(lcv.Chapter)
 		if err != nil {
 			return "", err
 		}
+
+		if lcv.Verse == lvInC {
+			end = strconv.Itoa(lcv.Chapter)
+		}
 	}
-	return r.compactRef(name)
+
+	return firstName + t.space() + start + t.dash(n) + lastName + t.space() + end, nil
 }
 
 // FullNameRef is a synonym for CompactRef.
@@ -787,7 +1132,7 @@ func (r *Resolved) AbbreviatedRef(opt ...ResolveOption) (string, error) {
 	abbrs := o.Abbreviations
 
 	if abbrs == nil {
-		return r.compactRef(r.Book.Name)
+		return r.compactRef(r.Book.Name, o.Notation, o.Typography)
 	}
 
 	abbrName, err := abbrs.PreferredAbbreviation(r.Book.Name)
@@ -795,7 +1140,7 @@ func (r *Resolved) AbbreviatedRef(opt ...ResolveOption) (string, error) {
 		return "", err
 	}
 
-	return r.compactRef(abbrName)
+	return r.compactRef(abbrName, o.Notation, o.Typography)
 }
 
 // Subtract takes two Resolved references and returns a slice containing either
@@ -822,13 +1167,7 @@ func (r *Resolved) Subtract(s *Resolved) []Resolved {
 
 	// subtracted is at the start of the original, return original without start
 	case vCmp(r.First, s.First) >= 0 && vCmp(r.First, s.Last) <= 0 && vCmp(r.Last, s.Last) >= 0:
-		var firstVerse Verse
-		for _, v := range r.Verses() {
-			if s.Last.Before(v) {
-				firstVerse = v
-				break
-			}
-		}
+		firstVerse := r.Book.verseAfter(s.Last)
 
 		return []Resolved{
 			{
@@ -840,13 +1179,7 @@ func (r *Resolved) Subtract(s *Resolved) []Resolved {
 
 	// subtracted is at the end of the original, return original without end
 	case vCmp(r.First, s.First) <= 0 && vCmp(r.Last, s.First) >= 0 && vCmp(r.Last, s.Last) <= 0:
-		var lastVerse Verse
-		for _, v := range r.Verses() {
-			if v.Equal(s.First) {
-				break
-			}
-			lastVerse = v
-		}
+		lastVerse := r.Book.verseBefore(s.First)
 
 		return []Resolved{
 			{
@@ -858,20 +1191,8 @@ func (r *Resolved) Subtract(s *Resolved) []Resolved {
 
 	// subtracted is in the middle of the original, split
 	case vCmp(r.First, s.First) < 0 && vCmp(r.Last, s.Last) > 0:
-		var firstLastVerse, lastFirstVerse Verse
-		lookingForFirst := true
-		for _, v := range r.Verses() {
-			if v.Equal(s.First) {
-				lookingForFirst = false
-			}
-			if lookingForFirst {
-				firstLastVerse = v
-			}
-			if s.Last.Before(v) {
-				lastFirstVerse = v
-				break
-			}
-		}
+		firstLastVerse := r.Book.verseBefore(s.First)
+		lastFirstVerse := r.Book.verseAfter(s.Last)
 
 		return []Resolved{
 			{
@@ -898,5 +1219,6 @@ var _ Relative = (*AndFollowing)(nil)
 var _ Relative = (*Range)(nil)
 var _ Relative = (*Related)(nil)
 var _ Absolute = (*Proper)(nil)
+var _ Absolute = (*Span)(nil)
 var _ Absolute = (*Multiple)(nil)
 var _ Absolute = (*Resolved)(nil)
@@ -44,6 +44,31 @@ func TestCV(t *testing.T) {
 	assert.Error(t, ref.CV{Chapter: 1}.Validate())
 }
 
+func TestCV_Part(t *testing.T) {
+	t.Parallel()
+
+	cv := ref.CV{Chapter: 3, Verse: 16, Part: "a"}
+	assert.Equal(t, "3:16a", cv.Ref())
+	assert.NoError(t, cv.Validate())
+
+	assert.True(t, cv.Before(ref.CV{Chapter: 3, Verse: 16, Part: "b"}))
+	assert.False(t, cv.Before(ref.CV{Chapter: 3, Verse: 16, Part: "a"}))
+	assert.False(t, cv.Before(ref.CV{Chapter: 3, Verse: 16}))
+	assert.True(t, cv.Before(ref.CV{Chapter: 3, Verse: 17}))
+	assert.False(t, (ref.CV{Chapter: 3, Verse: 16, Part: "b"}).Before(cv))
+
+	assert.True(t, cv.Equal(ref.CV{Chapter: 3, Verse: 16}))
+	assert.True(t, cv.Equal(ref.CV{Chapter: 3, Verse: 16, Part: "a"}))
+	assert.False(t, cv.Equal(ref.CV{Chapter: 3, Verse: 16, Part: "b"}))
+	assert.True(t, (ref.CV{Chapter: 3, Verse: 16}).Equal(cv))
+
+	assert.Equal(t, ref.CV{Chapter: 8, Verse: 29, Part: "b"}, ref.N{Number: 29, Part: "b"}.RelativeTo(ref.CV{Chapter: 8, Verse: 28}))
+
+	assert.Error(t, ref.CV{Chapter: 3, Verse: 16, Part: "f"}.Validate())
+	assert.Error(t, ref.CV{Chapter: 3, Verse: 16, Part: "ab"}.Validate())
+	assert.Error(t, ref.CV{Chapter: 3, Verse: 16, Part: "A"}.Validate())
+}
+
 func TestN(t *testing.T) {
 	t.Parallel()
 
@@ -76,6 +101,23 @@ func TestN(t *testing.T) {
 	assert.Error(t, ref.N{}.Validate())
 }
 
+func TestN_Part(t *testing.T) {
+	t.Parallel()
+
+	n := ref.N{Number: 6, Part: "b"}
+	assert.Equal(t, "6b", n.Ref())
+	assert.NoError(t, n.Validate())
+
+	assert.True(t, n.Before(ref.N{Number: 6, Part: "c"}))
+	assert.False(t, n.Before(ref.N{Number: 6, Part: "a"}))
+	assert.False(t, n.Before(ref.N{Number: 6}))
+
+	assert.True(t, n.Equal(ref.N{Number: 6}))
+	assert.False(t, n.Equal(ref.N{Number: 6, Part: "a"}))
+
+	assert.Error(t, ref.N{Number: 6, Part: "z"}.Validate())
+}
+
 func TestSingle(t *testing.T) {
 	t.Parallel()
 
@@ -251,6 +293,59 @@ func TestProper(t *testing.T) {
 	}).IsSingleRange())
 }
 
+func TestSpan(t *testing.T) {
+	t.Parallel()
+
+	s := &ref.Span{
+		FirstBook: "Ruth",
+		First:     ref.CV{Chapter: 4, Verse: 18},
+		LastBook:  "1 Sam.",
+		Last:      ref.N{Number: 2},
+	}
+
+	assert.Equal(t, "Ruth 4:18-1 Sam. 2", s.Ref())
+	assert.NoError(t, s.Validate())
+	assert.Equal(t, []string{"Ruth", "1 Sam."}, s.Names())
+	assert.False(t, s.IsSingleRange())
+
+	name, err := s.FullNameRef()
+	assert.NoError(t, err)
+	assert.Equal(t, "Ruth 4:18-1 Samuel 2", name)
+
+	name, err = s.AbbreviatedRef()
+	assert.NoError(t, err)
+	assert.Equal(t, "Ruth 4:18-1 Sam. 2", name)
+
+	name, err = s.FullNameRef(ref.WithoutAbbreviations())
+	assert.NoError(t, err)
+	assert.Equal(t, "Ruth 4:18-1 Sam. 2", name)
+
+	whole := &ref.Span{FirstBook: "Genesis", LastBook: "Exodus"}
+	assert.Equal(t, "Genesis-Exodus", whole.Ref())
+	assert.NoError(t, whole.Validate())
+
+	assert.Error(t, (&ref.Span{LastBook: "Exodus"}).Validate())
+	assert.Error(t, (&ref.Span{FirstBook: "Genesis"}).Validate())
+	assert.Error(t, (&ref.Span{FirstBook: "Genesis", LastBook: "Genesis"}).Validate())
+	assert.Error(t, (&ref.Span{
+		FirstBook: "Genesis",
+		First:     ref.CV{},
+		LastBook:  "Exodus",
+	}).Validate())
+	assert.Error(t, (&ref.Span{
+		FirstBook: "Genesis",
+		LastBook:  "Exodus",
+		Last:      ref.N{},
+	}).Validate())
+
+	assert.NoError(t, (&ref.Multiple{
+		Refs: []ref.Ref{s, ref.CV{Chapter: 3, Verse: 1}},
+	}).Validate())
+	assert.Equal(t, []string{"Ruth", "1 Sam.", "Exodus"}, (&ref.Multiple{
+		Refs: []ref.Ref{s, ref.NewProper("Exodus", &ref.Single{Verse: ref.N{Number: 12}})},
+	}).Names())
+}
+
 func TestMultiple(t *testing.T) {
 	t.Parallel()
 
@@ -486,6 +581,71 @@ func TestResolved_CompactRef(t *testing.T) {
 
 	assert.NoError(t, err)
 	assert.Equal(t, "Psalms 12-13", cr)
+
+	cr, err = (&ref.Resolved{
+		Book:  gen,
+		First: ref.CV{Chapter: 12, Verse: 4, Part: "b"},
+		Last:  ref.CV{Chapter: 12, Verse: 6},
+	}).CompactRef()
+
+	assert.NoError(t, err)
+	assert.Equal(t, "Genesis 12:4b-6", cr)
+
+	cr, err = (&ref.Resolved{
+		Book:  gen,
+		First: ref.CV{Chapter: 12, Verse: 4, Part: "a"},
+		Last:  ref.CV{Chapter: 12, Verse: 4, Part: "a"},
+	}).CompactRef()
+
+	assert.NoError(t, err)
+	assert.Equal(t, "Genesis 12:4a", cr)
+
+	cr, err = (&ref.Resolved{
+		Book:  gen,
+		First: ref.CV{Chapter: 12, Verse: 4, Part: "a"},
+		Last:  ref.CV{Chapter: 12, Verse: 4, Part: "c"},
+	}).CompactRef()
+
+	assert.NoError(t, err)
+	assert.Equal(t, "Genesis 12:4a-4c", cr)
+
+	cr, err = (&ref.Resolved{
+		Book:  gen,
+		First: ref.CV{Chapter: 12, Verse: 1},
+		Last:  ref.CV{Chapter: 12, Verse: 20, Part: "a"},
+	}).CompactRef()
+
+	assert.NoError(t, err)
+	assert.Equal(t, "Genesis 12:1-20a", cr)
+
+	cr, err = (&ref.Resolved{
+		Book:  gen,
+		First: ref.CV{Chapter: 1, Verse: 1, Part: "b"},
+		Last:  ref.CV{Chapter: 50, Verse: 26},
+	}).CompactRef()
+
+	assert.NoError(t, err)
+	assert.Equal(t, "Genesis 1:1b-50:26", cr)
+}
+
+func TestResolved_Whole(t *testing.T) {
+	t.Parallel()
+
+	gen, err := ref.Canonical.Book("Genesis")
+	require.NoError(t, err)
+
+	r := &ref.Resolved{
+		Book:  gen,
+		First: ref.CV{Chapter: 12, Verse: 4, Part: "b"},
+		Last:  ref.CV{Chapter: 12, Verse: 6, Part: "a"},
+	}
+
+	assert.Equal(t, &ref.Resolved{
+		Book:  gen,
+		First: ref.CV{Chapter: 12, Verse: 4},
+		Last:  ref.CV{Chapter: 12, Verse: 6},
+	}, r.Whole())
+	assert.Equal(t, ref.CV{Chapter: 12, Verse: 4, Part: "b"}, r.First)
 }
 
 func TestResolved_Subtract(t *testing.T) {
@@ -0,0 +1,337 @@
+package ref
+
+import "sort"
+
+// VerseRun is a run of consecutive verses within a single chapter of a book.
+// The verses of a book are recorded as a list of runs, normally one per chapter
+// giving the number of verses in that chapter. A chapter with verses missing,
+// such as a chapter of a filtered canon, is recorded as more than one run.
+//
+// Books without chapters record their verses in chapter 1.
+type VerseRun struct {
+	Chapter int
+	First   int
+	Last    int
+}
+
+// Len returns the number of verses in the run.
+func (r VerseRun) Len() int {
+	return r.Last - r.First + 1
+}
+
+// verse returns the verse with the given chapter and verse number in this book.
+func (b *Book) verse(chapter, verse int) Verse {
+	if b.JustVerse {
+		return N{Number: verse}
+	}
+	return CV{Chapter: chapter, Verse: verse}
+}
+
+// position returns the chapter and verse numbers of the verse in this book. It
+// returns false if the verse is not of the kind used by this book. Any verse
+// part is ignored.
+func (b *Book) position(v Verse) (chapter, verse int, ok bool) {
+	switch v := v.(type) {
+	case CV:
+		if b.JustVerse {
+			return 0, 0, false
+		}
+		return v.Chapter, v.Verse, true
+	case N:
+		if !b.JustVerse {
+			return 0, 0, false
+		}
+		return 1, v.Number, true
+	}
+	return 0, 0, false
+}
+
+// findRun returns the index of the run holding the given chapter and verse
+// number or -1 if the book has no such verse.
+func (b *Book) findRun(chapter, verse int) int {
+	runs := b.verseRuns()
+	i := sort.Search(len(runs), func(i int) bool {
+		r := runs[i]
+		return r.Chapter > chapter || (r.Chapter == chapter && r.Last >= verse)
+	})
+
+	if i < len(runs) && runs[i].Chapter == chapter && runs[i].First <= verse {
+		return i
+	}
+
+	return -1
+}
+
+// chapterRuns returns the indexes of the first and last runs of the given
+// chapter or -1, -1 if the book has no verses in that chapter.
+func (b *Book) chapterRuns(chapter int) (first, last int) {
+	runs := b.verseRuns()
+	first = sort.Search(len(runs), func(i int) bool {
+		return runs[i].Chapter >= chapter
+	})
+
+	if first == len(runs) || runs[first].Chapter != chapter {
+		return -1, -1
+	}
+
+	last = first
+	for last+1 < len(runs) && runs[last+1].Chapter == chapter {
+		last++
+	}
+
+	return first, last
+}
+
+// allVerses returns every verse of the book in order, generated from the runs.
+func (b *Book) allVerses() []Verse {
+	return b.versesBetween(0, b.VerseCount()-1)
+}
+
+// verseRuns returns the runs of the book. A book built with only the
+// deprecated Verses field has its runs worked out from those verses.
+func (b *Book) verseRuns() []VerseRun {
+	if len(b.Runs) > 0 || len(b.Verses) == 0 {
+		return b.Runs
+	}
+
+	var runs []VerseRun
+	for _, v := range b.Verses {
+		if chapter, verse, ok := b.position(v); ok {
+			runs = appendRun(runs, chapter, verse)
+		}
+	}
+	return runs
+}
+
+// appendRun adds the verse to the end of the runs, extending the last run if
+// the verse follows it in the same chapter. Verses must be added in order.
+func appendRun(runs []VerseRun, chapter, verse int) []VerseRun {
+	if n := len(runs); n > 0 {
+		last := &runs[n-1]
+		if last.Chapter == chapter && last.Last >= verse {
+			return runs
+		}
+		if last.Chapter == chapter && last.Last+1 == verse {
+			last.Last = verse
+			return runs
+		}
+	}
+
+	return append(runs, VerseRun{Chapter: chapter, First: verse, Last: verse})
+}
+
+// VerseCount returns the number of verses in the book.
+func (b Book) VerseCount() int {
+	runs := b.verseRuns()
+	n := 0
+	for _, r := range runs {
+		n += r.Len()
+	}
+	return n
+}
+
+// ChapterCount returns the number of chapters in the book. A book without
+// chapters counts as a single chapter.
+func (b Book) ChapterCount() int {
+	runs := b.verseRuns()
+	n := 0
+	for i, r := range runs {
+		if i == 0 || r.Chapter != runs[i-1].Chapter {
+			n++
+		}
+	}
+	return n
+}
+
+// FirstVerse returns the first verse of the book or nil if the book has no
+// verses.
+func (b Book) FirstVerse() Verse {
+	runs := b.verseRuns()
+	if len(runs) == 0 {
+		return nil
+	}
+
+	r := runs[0]
+	return b.verse(r.Chapter, r.First)
+}
+
+// LastVerse returns the last verse of the book or nil if the book has no
+// verses.
+func (b Book) LastVerse() Verse {
+	runs := b.verseRuns()
+	if len(runs) == 0 {
+		return nil
+	}
+
+	r := runs[len(runs)-1]
+	return b.verse(r.Chapter, r.Last)
+}
+
+// verseIndex returns the position of the verse among all the verses of the
+// book or -1 if the book does not contain the verse. Any verse part is ignored.
+func (b *Book) verseIndex(v Verse) int {
+	chapter, verse, ok := b.position(v)
+	if !ok {
+		return -1
+	}
+
+	ri := b.findRun(chapter, verse)
+	if ri < 0 {
+		return -1
+	}
+
+	runs := b.verseRuns()
+	n := 0
+	for _, r := range runs[:ri] {
+		n += r.Len()
+	}
+
+	return n + verse - runs[ri].First
+}
+
+// verseAfter returns the first verse of the book after the verse v or nil if
+// there is none. The verse v need not be in the book.
+func (b *Book) verseAfter(v Verse) Verse {
+	chapter, verse, ok := b.position(v)
+	if !ok {
+		return nil
+	}
+
+	runs := b.verseRuns()
+	i := sort.Search(len(runs), func(i int) bool {
+		r := runs[i]
+		return r.Chapter > chapter || (r.Chapter == chapter && r.Last > verse)
+	})
+
+	if i == len(runs) {
+		return nil
+	}
+
+	r := runs[i]
+	if r.Chapter == chapter && r.First <= verse {
+		return b.verse(chapter, verse+1)
+	}
+
+	return b.verse(r.Chapter, r.First)
+}
+
+// verseBefore returns the last verse of the book before the verse v or nil if
+// there is none. The verse v need not be in the book.
+func (b *Book) verseBefore(v Verse) Verse {
+	chapter, verse, ok := b.position(v)
+	if !ok {
+		return nil
+	}
+
+	runs := b.verseRuns()
+	i := sort.Search(len(runs), func(i int) bool {
+		r := runs[i]
+		return r.Chapter > chapter || (r.Chapter == chapter && r.First >= verse)
+	})
+
+	if i == 0 {
+		return nil
+	}
+
+	r := runs[i-1]
+	if r.Chapter == chapter && r.Last >= verse {
+		return b.verse(chapter, verse-1)
+	}
+
+	return b.verse(r.Chapter, r.Last)
+}
+
+// verseAt returns the verse at the given position among all the verses of the
+// book or nil if there is no such position.
+func (b *Book) verseAt(i int) Verse {
+	if i < 0 {
+		return nil
+	}
+
+	for _, r := range b.verseRuns() {
+		if i < r.Len() {
+			return b.verse(r.Chapter, r.First+i)
+		}
+		i -= r.Len()
+	}
+
+	return nil
+}
+
+// versesBetween returns the verses from position first to position last,
+// inclusive, among all the verses of the book.
+func (b *Book) versesBetween(first, last int) []Verse {
+	if first < 0 || last < first {
+		return []Verse{}
+	}
+
+	verses := make([]Verse, 0, last-first+1)
+	i := 0
+	for _, r := range b.verseRuns() {
+		if i+r.Len() <= first {
+			i += r.Len()
+			continue
+		}
+
+		for vn := r.First; vn <= r.Last; vn++ {
+			if i > last {
+				return verses
+			}
+
+			if i >= first {
+				verses = append(verses, b.verse(r.Chapter, vn))
+			}
+			i++
+		}
+	}
+
+	return verses
+}
+
+// excluding returns the runs of the book with the verses at the positions
+// given by the intervals removed. The intervals must be sorted and must not
+// overlap, as in a Set.
+func (b *Book) excluding(ivs []interval) []VerseRun {
+	src := b.verseRuns()
+	runs := make([]VerseRun, 0, len(src)+len(ivs))
+
+	start, j := 0, 0
+	for _, r := range src {
+		end := start + r.Len() - 1
+
+		// next is the position of the first verse of the run not yet kept or
+		// removed
+		next := start
+		for j < len(ivs) && ivs[j].first <= end {
+			iv := ivs[j]
+			if iv.first > next {
+				runs = append(runs, VerseRun{
+					Chapter: r.Chapter,
+					First:   r.First + next - start,
+					Last:    r.First + iv.first - 1 - start,
+				})
+			}
+
+			next = max(next, iv.last+1)
+
+			// the interval continues into the next run
+			if iv.last > end {
+				break
+			}
+
+			j++
+		}
+
+		if next <= end {
+			runs = append(runs, VerseRun{
+				Chapter: r.Chapter,
+				First:   r.First + next - start,
+				Last:    r.Last,
+			})
+		}
+
+		start = end + 1
+	}
+
+	return runs
+}
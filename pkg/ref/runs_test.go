@@ -0,0 +1,202 @@
+package ref_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zostay/today/pkg/ref"
+)
+
+func TestVerseRun_Len(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, 31, ref.VerseRun{Chapter: 1, First: 1, Last: 31}.Len())
+	assert.Equal(t, 1, ref.VerseRun{Chapter: 12, First: 48, Last: 48}.Len())
+}
+
+// bookVerses returns every verse of the book, listed through a reference to the
+// whole book.
+func bookVerses(b *ref.Book) []ref.Verse {
+	r := ref.Resolved{Book: b, First: b.FirstVerse(), Last: b.LastVerse()}
+	return r.Verses()
+}
+
+func TestBook_Verses(t *testing.T) {
+	t.Parallel()
+
+	total := 0
+	for _, b := range ref.Canonical.Books {
+		vs := bookVerses(&b)
+		assert.Len(t, vs, b.VerseCount(), b.Name)
+		assert.Equal(t, b.FirstVerse(), vs[0], b.Name)
+		assert.Equal(t, b.LastVerse(), vs[len(vs)-1], b.Name)
+
+		for i := 1; i < len(vs); i++ {
+			assert.True(t, vs[i-1].Before(vs[i]), "%s %s before %s", b.Name, vs[i-1].Ref(), vs[i].Ref())
+		}
+
+		for _, v := range vs {
+			assert.True(t, b.Contains(v), "%s contains %s", b.Name, v.Ref())
+		}
+
+		total += len(vs)
+	}
+
+	assert.Equal(t, 31101, total)
+}
+
+func TestBook_ChapterCount(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		canon *ref.Canon
+		book  string
+		count int
+	}{
+		{ref.Canonical, "Genesis", 50},
+		{ref.Canonical, "Psalms", 150},
+		{ref.Canonical, "Jude", 1},
+		{ref.CatholicCanon, "Additions to Esther", 7},
+		{ref.CatholicCanon, "Baruch", 6},
+		{ref.OrthodoxCanon, "Psalms", 151},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.book, func(t *testing.T) {
+			t.Parallel()
+
+			b, err := tt.canon.Book(tt.book)
+			require.NoError(t, err)
+			assert.Equal(t, tt.count, b.ChapterCount())
+		})
+	}
+}
+
+func TestBook_Contains(t *testing.T) {
+	t.Parallel()
+
+	matt, err := ref.Canonical.Book("Matthew")
+	require.NoError(t, err)
+
+	jude, err := ref.Canonical.Book("Jude")
+	require.NoError(t, err)
+
+	tests := []struct {
+		name string
+		book *ref.Book
+		v    ref.Verse
+		want bool
+	}{
+		{"first", matt, ref.CV{Chapter: 1, Verse: 1}, true},
+		{"last", matt, ref.CV{Chapter: 28, Verse: 20}, true},
+		{"part", matt, ref.CV{Chapter: 5, Verse: 3, Part: "a"}, true},
+		{"before hole", matt, ref.CV{Chapter: 12, Verse: 46}, true},
+		{"hole", matt, ref.CV{Chapter: 12, Verse: 47}, false},
+		{"after hole", matt, ref.CV{Chapter: 12, Verse: 48}, true},
+		{"verse zero", matt, ref.CV{Chapter: 1, Verse: 0}, false},
+		{"past chapter", matt, ref.CV{Chapter: 1, Verse: 26}, false},
+		{"past book", matt, ref.CV{Chapter: 29, Verse: 1}, false},
+		{"N in book with chapters", matt, ref.N{Number: 1}, false},
+		{"N", jude, ref.N{Number: 25}, true},
+		{"N past book", jude, ref.N{Number: 26}, false},
+		{"CV in book without chapters", jude, ref.CV{Chapter: 1, Verse: 1}, false},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.want, tt.book.Contains(tt.v))
+		})
+	}
+}
+
+func TestBook_LastVerseInChapter_Filtered(t *testing.T) {
+	t.Parallel()
+
+	c, err := ref.Canonical.Filtered("John 3:16-21", "John 4:1-5", "John 5:40-47")
+	require.NoError(t, err)
+
+	b, err := c.Book("John")
+	require.NoError(t, err)
+
+	assert.Equal(t, []ref.VerseRun{
+		{Chapter: 3, First: 1, Last: 15},
+		{Chapter: 3, First: 22, Last: 36},
+		{Chapter: 4, First: 6, Last: 54},
+		{Chapter: 5, First: 1, Last: 39},
+	}, b.Runs[2:6])
+
+	lv, err := b.LastVerseInChapter(3)
+	assert.NoError(t, err)
+	assert.Equal(t, 36, lv)
+
+	lv, err = b.LastVerseInChapter(4)
+	assert.NoError(t, err)
+	assert.Equal(t, 54, lv)
+
+	lv, err = b.LastVerseInChapter(5)
+	assert.NoError(t, err)
+	assert.Equal(t, 39, lv)
+
+	_, err = b.LastVerseInChapter(22)
+	assert.ErrorIs(t, err, ref.ErrNotFound)
+
+	rs, err := c.Resolve(&ref.Proper{Book: "John", Verse: &ref.Single{Verse: ref.N{Number: 4}}})
+	require.NoError(t, err)
+	require.Len(t, rs, 1)
+	assert.Equal(t, ref.CV{Chapter: 4, Verse: 6}, rs[0].First)
+	assert.Equal(t, ref.CV{Chapter: 4, Verse: 54}, rs[0].Last)
+
+	// the original canon is untouched
+	assert.True(t, ref.Canonical.Books[42].Contains(ref.CV{Chapter: 3, Verse: 16}))
+}
+
+func TestBook_VersesOnly(t *testing.T) {
+	t.Parallel()
+
+	b := ref.Book{
+		Name: "Ruth",
+		Verses: []ref.Verse{
+			ref.CV{Chapter: 1, Verse: 1},
+			ref.CV{Chapter: 1, Verse: 2},
+			ref.CV{Chapter: 1, Verse: 3},
+			ref.CV{Chapter: 2, Verse: 1},
+			ref.CV{Chapter: 2, Verse: 2},
+			ref.CV{Chapter: 2, Verse: 4},
+		},
+	}
+
+	assert.Equal(t, 6, b.VerseCount())
+	assert.Equal(t, 2, b.ChapterCount())
+	assert.Equal(t, ref.CV{Chapter: 1, Verse: 1}, b.FirstVerse())
+	assert.Equal(t, ref.CV{Chapter: 2, Verse: 4}, b.LastVerse())
+	assert.True(t, b.Contains(ref.CV{Chapter: 2, Verse: 1}))
+	assert.False(t, b.Contains(ref.CV{Chapter: 2, Verse: 3}))
+
+	lv, err := b.LastVerseInChapter(1)
+	assert.NoError(t, err)
+	assert.Equal(t, 3, lv)
+
+	c := &ref.Canon{Name: "Tiny", Books: []ref.Book{b}}
+	p, err := ref.ParseProper("Ruth 2")
+	require.NoError(t, err)
+
+	rs, err := c.Resolve(p)
+	require.NoError(t, err)
+	require.Len(t, rs, 1)
+	assert.Equal(t, ref.CV{Chapter: 2, Verse: 1}, rs[0].First)
+	assert.Equal(t, ref.CV{Chapter: 2, Verse: 4}, rs[0].Last)
+
+	clone := b.Clone()
+	assert.Nil(t, clone.Verses)
+	assert.Equal(t, []ref.VerseRun{
+		{Chapter: 1, First: 1, Last: 3},
+		{Chapter: 2, First: 1, Last: 2},
+		{Chapter: 2, First: 4, Last: 4},
+	}, clone.Runs)
+}
@@ -0,0 +1,265 @@
+package ref
+
+import (
+	"fmt"
+	"sort"
+)
+
+// interval is a range of verses within a single book of a canon. The book is
+// given by its index in the canon and the verses by their positions among the
+// verses of that book.
+type interval struct {
+	book, first, last int
+}
+
+// Set is a set of verses in a canon. The verses are held as a list of ranges,
+// which are kept merged and in canon order, so that no two ranges overlap or
+// touch. Verse parts are ignored, so a Set always holds whole verses.
+//
+// Sets combined with Union, Intersect, Difference, or Overlaps must belong to
+// the same canon.
+//
+// The zero value is not usable. Use NewSet or Canon.Set to create a Set.
+type Set struct {
+	canon     *Canon
+	intervals []interval
+}
+
+// NewSet returns the set of verses in the given references, which must be
+// resolved against the given canon (or a canon with the same book names and
+// verses). It returns ErrNotFound if a reference names a book or verse not in
+// the canon.
+func NewSet(c *Canon, rs ...Resolved) (*Set, error) {
+	s := &Set{
+		canon:     c,
+		intervals: make([]interval, 0, len(rs)),
+	}
+
+	for i := range rs {
+		iv, err := c.interval(&rs[i])
+		if err != nil {
+			return nil, err
+		}
+		s.intervals = append(s.intervals, iv)
+	}
+
+	s.normalize()
+	return s, nil
+}
+
+// Set is a synonym for NewSet using this canon.
+func (c *Canon) Set(rs ...Resolved) (*Set, error) {
+	return NewSet(c, rs...)
+}
+
+// interval returns the interval of the resolved reference in this canon.
+func (c *Canon) interval(r *Resolved) (interval, error) {
+	bi := c.bookIndex(r.Book)
+	if bi < 0 && r.Book != nil {
+		for i := range c.Books {
+			if c.Books[i].Name == r.Book.Name {
+				bi = i
+				break
+			}
+		}
+	}
+
+	if bi < 0 {
+		cs, _ := r.CompactRef()
+		return interval{}, fmt.Errorf("%w: book of %q is not in canon %s", ErrNotFound, cs, c.Name)
+	}
+
+	b := &c.Books[bi]
+	first, last := b.verseIndex(r.First), b.verseIndex(r.Last)
+	if first < 0 || last < 0 || last < first {
+		cs, _ := r.CompactRef()
+		return interval{}, fmt.Errorf("%w: %q is not in canon %s", ErrNotFound, cs, c.Name)
+	}
+
+	return interval{book: bi, first: first, last: last}, nil
+}
+
+// normalize sorts the intervals into canon order and merges any that overlap
+// or touch.
+func (s *Set) normalize() {
+	sort.Slice(s.intervals, func(i, j int) bool {
+		return s.intervals[i].startsBefore(s.intervals[j])
+	})
+
+	merged := s.intervals[:0]
+	for _, iv := range s.intervals {
+		if n := len(merged); n > 0 && merged[n-1].touches(iv) {
+			merged[n-1].last = max(merged[n-1].last, iv.last)
+			continue
+		}
+		merged = append(merged, iv)
+	}
+
+	s.intervals = merged
+}
+
+// startsBefore returns true if this interval starts before the other interval
+// in canon order.
+func (iv interval) startsBefore(o interval) bool {
+	if iv.book != o.book {
+		return iv.book < o.book
+	}
+	return iv.first < o.first
+}
+
+// touches returns true if the two intervals are in the same book and overlap
+// or adjoin, so that they may be merged into one.
+func (iv interval) touches(o interval) bool {
+	return iv.book == o.book && o.first <= iv.last+1 && iv.first <= o.last+1
+}
+
+// with returns a new set in the same canon holding the given intervals.
+func (s *Set) with(intervals []interval) *Set {
+	return &Set{canon: s.canon, intervals: intervals}
+}
+
+// Ranges returns the ranges of verses in the set in canon order. When a range
+// runs to the end of a book and the next range starts at the beginning of the
+// following book, the range is marked Continued, just as the segments of a
+// resolved Span are.
+func (s *Set) Ranges() []Resolved {
+	rs := make([]Resolved, len(s.intervals))
+	for i, iv := range s.intervals {
+		b := &s.canon.Books[iv.book]
+		rs[i] = Resolved{
+			Book:  b,
+			First: b.verseAt(iv.first),
+			Last:  b.verseAt(iv.last),
+		}
+
+		if i > 0 {
+			prev := s.intervals[i-1]
+			pb := &s.canon.Books[prev.book]
+			rs[i-1].Continued = prev.book+1 == iv.book &&
+				prev.last == pb.VerseCount()-1 && iv.first == 0
+		}
+	}
+	return rs
+}
+
+// Len returns the number of verses in the set.
+func (s *Set) Len() int {
+	n := 0
+	for _, iv := range s.intervals {
+		n += iv.last - iv.first + 1
+	}
+	return n
+}
+
+// Union returns a new set holding the verses that are in either set.
+func (s *Set) Union(o *Set) *Set {
+	intervals := make([]interval, 0, len(s.intervals)+len(o.intervals))
+	intervals = append(intervals, s.intervals...)
+	intervals = append(intervals, o.intervals...)
+
+	u := s.with(intervals)
+	u.normalize()
+	return u
+}
+
+// Intersect returns a new set holding the verses that are in both sets.
+func (s *Set) Intersect(o *Set) *Set {
+	var intervals []interval
+	for i, j := 0, 0; i < len(s.intervals) && j < len(o.intervals); {
+		a, b := s.intervals[i], o.intervals[j]
+		if a.book == b.book {
+			first, last := max(a.first, b.first), min(a.last, b.last)
+			if first <= last {
+				intervals = append(intervals, interval{book: a.book, first: first, last: last})
+			}
+		}
+
+		// advance whichever interval ends first
+		if a.book < b.book || (a.book == b.book && a.last < b.last) {
+			i++
+		} else {
+			j++
+		}
+	}
+
+	return s.with(intervals)
+}
+
+// Difference returns a new set holding the verses that are in this set, but
+// not in the other set.
+func (s *Set) Difference(o *Set) *Set {
+	if len(s.intervals) == 0 {
+		return s.with(nil)
+	}
+
+	// skip straight to the first interval that could overlap
+	j := sort.Search(len(o.intervals), func(k int) bool {
+		return !o.intervals[k].before(s.intervals[0])
+	})
+
+	var intervals []interval
+	for _, a := range s.intervals {
+		// skip the intervals that end before this one starts
+		for j < len(o.intervals) && o.intervals[j].before(a) {
+			j++
+		}
+
+		first := a.first
+		for k := j; k < len(o.intervals); k++ {
+			b := o.intervals[k]
+			if b.book != a.book || b.first > a.last {
+				break
+			}
+
+			if b.first > first {
+				intervals = append(intervals, interval{book: a.book, first: first, last: b.first - 1})
+			}
+
+			if b.last+1 > first {
+				first = b.last + 1
+			}
+		}
+
+		if first <= a.last {
+			intervals = append(intervals, interval{book: a.book, first: first, last: a.last})
+		}
+	}
+
+	return s.with(intervals)
+}
+
+// before returns true if this interval ends before the other interval begins.
+func (iv interval) before(o interval) bool {
+	return iv.book < o.book || (iv.book == o.book && iv.last < o.first)
+}
+
+// Contains returns true if the given verse of the given book is in the set.
+// Any verse part is ignored.
+func (s *Set) Contains(b *Book, v Verse) bool {
+	iv, err := s.canon.interval(&Resolved{Book: b, First: v, Last: v})
+	if err != nil {
+		return false
+	}
+
+	i := sort.Search(len(s.intervals), func(i int) bool {
+		return !s.intervals[i].before(iv)
+	})
+
+	return i < len(s.intervals) &&
+		s.intervals[i].book == iv.book &&
+		s.intervals[i].first <= iv.first
+}
+
+// overlapsInterval returns true if any verse of the interval is in the set.
+func (s *Set) overlapsInterval(iv interval) bool {
+	i := sort.Search(len(s.intervals), func(i int) bool {
+		return !s.intervals[i].before(iv)
+	})
+
+	return i < len(s.intervals) && !iv.before(s.intervals[i])
+}
+
+// Overlaps returns true if the two sets have any verses in common.
+func (s *Set) Overlaps(o *Set) bool {
+	return len(s.Intersect(o).intervals) > 0
+}
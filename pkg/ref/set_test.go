@@ -0,0 +1,206 @@
+package ref_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zostay/today/pkg/ref"
+)
+
+// testSet resolves the references and returns them as a set.
+func testSet(t *testing.T, refs ...string) *ref.Set {
+	t.Helper()
+
+	var rs []ref.Resolved
+	for _, r := range refs {
+		m, err := ref.ParseMultiple(r)
+		require.NoError(t, err)
+
+		mrs, err := ref.Canonical.Resolve(m)
+		require.NoError(t, err)
+
+		rs = append(rs, mrs...)
+	}
+
+	s, err := ref.Canonical.Set(rs...)
+	require.NoError(t, err)
+	return s
+}
+
+// setRef formats the set using the canonical style.
+func setRef(t *testing.T, s *ref.Set) string {
+	t.Helper()
+
+	f, err := ref.GetFormatter("canonical")
+	require.NoError(t, err)
+
+	rs := s.Ranges()
+	ptrs := make([]*ref.Resolved, len(rs))
+	for i := range rs {
+		ptrs[i] = &rs[i]
+	}
+
+	out, err := f.Format(ptrs)
+	require.NoError(t, err)
+	return out
+}
+
+func TestNewSet(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		refs     []string
+		expected string
+		len      int
+	}{
+		{"empty", nil, "", 0},
+		{"single", []string{"John 3:16"}, "John 3:16", 1},
+		{"sorted", []string{"Romans 8:28", "Genesis 1:1"}, "Genesis 1:1; Romans 8:28", 2},
+		{"overlapping", []string{"John 3:1-10", "John 3:5-20"}, "John 3:1-20", 20},
+		{"touching", []string{"John 3:1-10", "John 3:11-20"}, "John 3:1-20", 20},
+		{"across chapters", []string{"Genesis 1:31", "Genesis 2:1"}, "Genesis 1:31-2:1", 2},
+		{"contained", []string{"John 3", "John 3:16"}, "John 3", 36},
+		{"parts ignored", []string{"John 3:16a", "John 3:17b"}, "John 3:16-17", 2},
+		{"whole books", []string{"Exodus", "Genesis"}, "Genesis-Exodus", 2746},
+		{"span", []string{"Malachi 4-Matthew 1:5"}, "Malachi 4-Matthew 1:5", 11},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			s := testSet(t, tt.refs...)
+			assert.Equal(t, tt.expected, setRef(t, s))
+			assert.Equal(t, tt.len, s.Len())
+		})
+	}
+}
+
+func TestNewSet_NotFound(t *testing.T) {
+	t.Parallel()
+
+	_, err := ref.NewSet(ref.Canonical, ref.Resolved{
+		Book:  &ref.Book{Name: "Tobit"},
+		First: ref.CV{Chapter: 1, Verse: 1},
+		Last:  ref.CV{Chapter: 1, Verse: 1},
+	})
+	assert.ErrorIs(t, err, ref.ErrNotFound)
+
+	b, err := ref.Canonical.Book("John")
+	require.NoError(t, err)
+
+	_, err = ref.NewSet(ref.Canonical, ref.Resolved{
+		Book:  b,
+		First: ref.CV{Chapter: 3, Verse: 16},
+		Last:  ref.CV{Chapter: 30, Verse: 1},
+	})
+	assert.ErrorIs(t, err, ref.ErrNotFound)
+}
+
+func TestSet_Algebra(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		a, b      []string
+		union     string
+		intersect string
+		minus     string
+		overlaps  bool
+	}{
+		{
+			name:      "disjoint",
+			a:         []string{"John 3:16"},
+			b:         []string{"Romans 8:28"},
+			union:     "John 3:16; Romans 8:28",
+			intersect: "",
+			minus:     "John 3:16",
+			overlaps:  false,
+		},
+		{
+			name:      "overlapping",
+			a:         []string{"John 3:1-20"},
+			b:         []string{"John 3:16-4:2"},
+			union:     "John 3:1-4:2",
+			intersect: "John 3:16-20",
+			minus:     "John 3:1-15",
+			overlaps:  true,
+		},
+		{
+			name:      "hole",
+			a:         []string{"John 3"},
+			b:         []string{"John 3:16-17"},
+			union:     "John 3",
+			intersect: "John 3:16-17",
+			minus:     "John 3:1-15; John 3:18-36",
+			overlaps:  true,
+		},
+		{
+			name:      "many holes",
+			a:         []string{"Matthew-John"},
+			b:         []string{"Matthew 1:1", "Mark", "Luke 2:1-3:1", "John 21:25"},
+			union:     "Matthew-John",
+			intersect: "Matthew 1:1; Mark; Luke 2:1-3:1; John 21:25",
+			minus:     "Matthew 1:2-28:20; Luke 1; Luke 3:2-John 21:24",
+			overlaps:  true,
+		},
+		{
+			name:      "subtract everything",
+			a:         []string{"Jude 3-5"},
+			b:         []string{"Jude"},
+			union:     "Jude",
+			intersect: "Jude 3-5",
+			minus:     "",
+			overlaps:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			a, b := testSet(t, tt.a...), testSet(t, tt.b...)
+			assert.Equal(t, tt.union, setRef(t, a.Union(b)))
+			assert.Equal(t, tt.intersect, setRef(t, a.Intersect(b)))
+			assert.Equal(t, tt.minus, setRef(t, a.Difference(b)))
+			assert.Equal(t, tt.overlaps, a.Overlaps(b))
+			assert.Equal(t, tt.overlaps, b.Overlaps(a))
+
+			// union and intersection are commutative
+			assert.Equal(t, setRef(t, a.Union(b)), setRef(t, b.Union(a)))
+			assert.Equal(t, setRef(t, a.Intersect(b)), setRef(t, b.Intersect(a)))
+
+			// the parts add up
+			assert.Equal(t, a.Len(), a.Intersect(b).Len()+a.Difference(b).Len())
+		})
+	}
+}
+
+func TestSet_Contains(t *testing.T) {
+	t.Parallel()
+
+	s := testSet(t, "John 3:16-18", "Jude 5", "Romans 8")
+
+	john, err := ref.Canonical.Book("John")
+	require.NoError(t, err)
+	jude, err := ref.Canonical.Book("Jude")
+	require.NoError(t, err)
+	romans, err := ref.Canonical.Book("Romans")
+	require.NoError(t, err)
+
+	assert.True(t, s.Contains(john, ref.CV{Chapter: 3, Verse: 16}))
+	assert.True(t, s.Contains(john, ref.CV{Chapter: 3, Verse: 18, Part: "b"}))
+	assert.False(t, s.Contains(john, ref.CV{Chapter: 3, Verse: 15}))
+	assert.False(t, s.Contains(john, ref.CV{Chapter: 3, Verse: 19}))
+	assert.True(t, s.Contains(jude, ref.N{Number: 5}))
+	assert.False(t, s.Contains(jude, ref.N{Number: 4}))
+	assert.True(t, s.Contains(romans, ref.CV{Chapter: 8, Verse: 39}))
+	assert.False(t, s.Contains(romans, ref.CV{Chapter: 9, Verse: 1}))
+	assert.False(t, s.Contains(romans, ref.CV{Chapter: 99, Verse: 1}))
+	assert.False(t, s.Contains(&ref.Book{Name: "Tobit"}, ref.CV{Chapter: 1, Verse: 1}))
+}
@@ -45,8 +45,7 @@ func CalculateRefStats(resolved []*Resolved) (*RefStats, error) {
 
 	for _, r := range resolved {
 		// Count verses
-		verses := r.Verses()
-		verseCount += len(verses)
+		verseCount += r.verseCount()
 
 		// Build verse range string for this resolved reference
 		if isJustVerse {
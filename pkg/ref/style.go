@@ -0,0 +1,114 @@
+package ref
+
+import (
+	"fmt"
+	"sort"
+)
+
+// StyleGuide describes how an academic style guide writes references: the book
+// abbreviations it prescribes and the punctuation it uses.
+type StyleGuide struct {
+	// Name is the name of the style (e.g., "sbl").
+	Name string
+
+	// Title is the title of the style guide (e.g., "The SBL Handbook of
+	// Style").
+	Title string
+
+	// Abbreviations are the book names and abbreviations of the style guide.
+	// The preferred abbreviation is used for each book, or the singular form,
+	// if there is one, when a single chapter is cited.
+	Abbreviations *BookAbbreviations
+
+	// Abbreviate is true if the style guide abbreviates book names. If false,
+	// the full book names are written.
+	Abbreviate bool
+
+	// Notation is the punctuation used by the style guide.
+	Notation Notation
+}
+
+// StyleGuides lists the built-in style guides by name.
+var StyleGuides = map[string]*StyleGuide{
+	"sbl": {
+		Name:          "sbl",
+		Title:         "The SBL Handbook of Style",
+		Abbreviations: AbbreviationsSBL,
+		Abbreviate:    true,
+		Notation:      Notation{ChapterVerse: ':', AltChapterVerse: '.', List: ',', Range: '–'},
+	},
+	"chicago": {
+		Name:          "chicago",
+		Title:         "The Chicago Manual of Style",
+		Abbreviations: AbbreviationsChicago,
+		Abbreviate:    true,
+		Notation:      Notation{ChapterVerse: ':', AltChapterVerse: '.', List: ',', Range: '–'},
+	},
+	"mla": {
+		Name:          "mla",
+		Title:         "MLA Handbook",
+		Abbreviations: AbbreviationsMLA,
+		Abbreviate:    true,
+		Notation:      Notation{ChapterVerse: '.', AltChapterVerse: ':', List: ',', Range: '-'},
+	},
+	"apa": {
+		Name:          "apa",
+		Title:         "Publication Manual of the American Psychological Association",
+		Abbreviations: Abbreviations,
+		Notation:      Notation{ChapterVerse: ':', AltChapterVerse: '.', List: ',', Range: '–'},
+	},
+}
+
+// GetStyleGuide returns the named style guide from StyleGuides. It returns
+// ErrNotFound if there is no such style guide.
+func GetStyleGuide(name string) (*StyleGuide, error) {
+	if g, ok := StyleGuides[name]; ok {
+		return g, nil
+	}
+	return nil, fmt.Errorf("%w: style guide %q", ErrNotFound, name)
+}
+
+// StyleGuideNames returns the names of the built-in style guides, sorted.
+func StyleGuideNames() []string {
+	names := make([]string, 0, len(StyleGuides))
+	for name := range StyleGuides {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// bookName returns the name of the book of the reference as written by the
+// style guide. Like the style guides themselves, this only abbreviates the
+// name when chapters or verses are cited, so a reference to a whole book is
+// written with the full name of the book.
+func (g *StyleGuide) bookName(r *Resolved) (string, error) {
+	if !g.Abbreviate || r.isWholeBook() {
+		return r.fullName(&resolveOpts{Abbreviations: g.Abbreviations})
+	}
+
+	for i := range g.Abbreviations.Abbreviations {
+		abbr := &g.Abbreviations.Abbreviations[i]
+		if abbr.Name != r.Book.Name {
+			continue
+		}
+
+		if abbr.Singular != "" && r.IsSingleChapter() {
+			return abbr.Singular, nil
+		}
+		return abbr.Preferred, nil
+	}
+
+	return "", fmt.Errorf("%w: no %s abbreviation for book %s", ErrNotFound, g.Name, r.Book.Name)
+}
+
+// styleGuideFormatter formats references as prescribed by a style guide, with
+// the typography given.
+type styleGuideFormatter struct {
+	g *StyleGuide
+	t Typography
+}
+
+func (f *styleGuideFormatter) Format(resolved []*Resolved) (string, error) {
+	return formatResolved(resolved, f.g.Notation, f.t, f.g.bookName)
+}
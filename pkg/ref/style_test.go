@@ -0,0 +1,55 @@
+package ref_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zostay/today/pkg/ref"
+)
+
+func TestGetStyleGuide(t *testing.T) {
+	t.Parallel()
+
+	g, err := ref.GetStyleGuide("sbl")
+	assert.NoError(t, err)
+	assert.Equal(t, "sbl", g.Name)
+	assert.Equal(t, ref.AbbreviationsSBL, g.Abbreviations)
+
+	g, err = ref.GetStyleGuide("turabian")
+	assert.ErrorIs(t, err, ref.ErrNotFound)
+	assert.Nil(t, g)
+
+	assert.Equal(t, []string{"apa", "chicago", "mla", "sbl"}, ref.StyleGuideNames())
+}
+
+func TestStyleGuides_BookNames(t *testing.T) {
+	t.Parallel()
+
+	for _, name := range ref.StyleGuideNames() {
+		name := name
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			g, err := ref.GetStyleGuide(name)
+			require.NoError(t, err)
+
+			if !g.Abbreviate {
+				return
+			}
+
+			// every book of every canon has an abbreviation in every style guide
+			for _, c := range ref.Canons {
+				for _, b := range c.Books {
+					abbr, err := g.Abbreviations.PreferredAbbreviation(b.Name)
+					assert.NoError(t, err, b.Name)
+
+					got, err := g.Abbreviations.BookName(abbr)
+					assert.NoError(t, err, abbr)
+					assert.Equal(t, b.Name, got, abbr)
+				}
+			}
+		})
+	}
+}
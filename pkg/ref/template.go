@@ -0,0 +1,289 @@
+package ref
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"sync"
+	"text/template"
+
+	"gopkg.in/yaml.v3"
+)
+
+// TemplateStylePrefix is the prefix of a style given to GetFormatter that is
+// the text of a template rather than the name of a style (e.g.,
+// "template:{{.Abbr}} {{.Chapter}}.{{.Verse}}").
+const TemplateStylePrefix = "template:"
+
+// TemplateRef is the data passed to the template of a template formatter for
+// each reference. A reference that spans books is passed as one TemplateRef,
+// with the book of the end of the range given in the End fields.
+type TemplateRef struct {
+	// Name is the full name of the book, in the singular form when the
+	// reference is to a single chapter (e.g., "Psalm").
+	Name string
+
+	// Abbr is the preferred abbreviation of the book (e.g., "Gen.").
+	Abbr string
+
+	// TwoLetter and ThreeLetter are the two- and three-letter abbreviations
+	// of the book, without periods (e.g., "Gn" and "Gen").
+	TwoLetter   string
+	ThreeLetter string
+
+	// USFM is the USFM code of the book (e.g., "GEN").
+	USFM string
+
+	// JustVerse is true if the book has no chapters (e.g., Jude), in which
+	// case Chapter and EndChapter are always 1.
+	JustVerse bool
+
+	// Chapter, Verse, and Part are the chapter, verse, and verse part (e.g.,
+	// "a") of the start of the reference.
+	Chapter int
+	Verse   int
+	Part    string
+
+	// EndName, EndAbbr, EndChapter, EndVerse, and EndPart are the book
+	// name, abbreviation, chapter, verse, and verse part of the end of the
+	// reference. These are the same as the start for a single verse.
+	EndName    string
+	EndAbbr    string
+	EndChapter int
+	EndVerse   int
+	EndPart    string
+
+	// IsRange is true if the reference is to more than one verse.
+	IsRange bool
+
+	// WholeChapter is true if the reference is to one or more whole chapters
+	// and WholeBook is true if it is to one or more whole books.
+	WholeChapter bool
+	WholeBook    bool
+
+	// Ref is the compact reference written with full book names (e.g.,
+	// "Genesis 1:1-3").
+	Ref string
+
+	// Dash and Space are the range dash and the space after a book name
+	// selected by the WithRangeDash and WithBookSpace options (e.g.,
+	// "{{.Abbr}}{{.Space}}{{.Chapter}}").
+	Dash  string
+	Space string
+
+	book  string
+	abbrs *BookAbbreviations
+}
+
+// NLetter returns the n-letter abbreviation of the book, with a period if
+// withPeriod is true (e.g., {{.NLetter 4 true}}). See
+// BookAbbreviations.NLetterAbbreviation.
+func (t TemplateRef) NLetter(n int, withPeriod bool) (string, error) {
+	return t.abbrs.NLetterAbbreviation(t.book, n, withPeriod)
+}
+
+// templateFormatter formats references by executing a template.
+type templateFormatter struct {
+	o    *resolveOpts
+	tmpl *template.Template
+}
+
+// NewTemplateFormatter returns a formatter that writes each reference by
+// executing the given text/template with a TemplateRef and joins the
+// references with semicolons. For example, the template
+//
+//	{{.Abbr}} {{.Chapter}}.{{.Verse}}{{if .IsRange}}-{{.EndVerse}}{{end}}
+//
+// writes Romans 8:28-30 as "Rom. 8.28-30". The WithAbbreviations and
+// WithNotation options select the book names and notation used. The typography
+// options apply to the Ref, Dash, and Space of the TemplateRef, but chapters are
+// never elided, as each reference is written by the template on its own.
+func NewTemplateFormatter(text string, opt ...ResolveOption) (RefFormatter, error) {
+	tmpl, err := template.New("ref").Option("missingkey=error").Parse(text)
+	if err != nil {
+		return nil, err
+	}
+
+	return &templateFormatter{o: makeResolveOpts(opt), tmpl: tmpl}, nil
+}
+
+func (f *templateFormatter) Format(resolved []*Resolved) (string, error) {
+	return formatSpans(resolved, "; ", func(first, last *Resolved) (string, error) {
+		data, err := newTemplateRef(f.o, first, last)
+		if err != nil {
+			return "", err
+		}
+
+		var buf strings.Builder
+		if err := f.tmpl.Execute(&buf, data); err != nil {
+			return "", err
+		}
+		return buf.String(), nil
+	})
+}
+
+// newTemplateRef returns the data for a template for the reference running
+// from the start of first to the end of last, written using the given options.
+func newTemplateRef(o *resolveOpts, first, last *Resolved) (*TemplateRef, error) {
+	abbrs := o.Abbreviations
+	if abbrs == nil {
+		abbrs = Abbreviations
+	}
+
+	fb, lb := first.Book, last.Book
+	chapter, verse, _ := fb.position(first.First)
+	endChapter, endVerse, _ := lb.position(last.Last)
+
+	whole := &Resolved{Book: fb, First: first.First, Last: last.Last}
+	wholeBook := !first.hasPart() && !last.hasPart() &&
+		first.First.Equal(fb.FirstVerse()) && last.Last.Equal(lb.LastVerse())
+
+	wholeChapter := wholeBook
+	if !wholeChapter && fb == lb && !whole.hasPart() && verse == 1 {
+		lvInC, err := lb.LastVerseInChapter(endChapter)
+		if err != nil {
+			return nil, err
+		}
+		wholeChapter = endVerse == lvInC
+	}
+
+	t := &TemplateRef{
+		USFM:         fb.USFM,
+		JustVerse:    fb.JustVerse,
+		Chapter:      chapter,
+		Verse:        verse,
+		Part:         versePart(first.First),
+		EndChapter:   endChapter,
+		EndVerse:     endVerse,
+		EndPart:      versePart(last.Last),
+		IsRange:      fb != lb || chapter != endChapter || verse != endVerse || first.First != last.Last,
+		WholeChapter: wholeChapter,
+		WholeBook:    wholeBook,
+		Dash:         o.Typography.dash(o.Notation),
+		Space:        o.Typography.space(),
+		book:         fb.Name,
+		abbrs:        abbrs,
+	}
+
+	var err error
+	if fb == lb {
+		t.Name, err = whole.fullName(o)
+		if err != nil {
+			return nil, err
+		}
+		t.EndName = t.Name
+
+		t.Ref, err = whole.compactRef(t.Name, o.Notation, o.Typography)
+	} else {
+		if t.Name, err = first.fullName(o); err != nil {
+			return nil, err
+		}
+		if t.EndName, err = last.fullName(o); err != nil {
+			return nil, err
+		}
+
+		t.Ref, err = spanRef(first, last, t.Name, t.EndName, o.Notation, o.Typography)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	t.Abbr = abbreviation(abbrs, fb)
+	t.EndAbbr = abbreviation(abbrs, lb)
+
+	if t.TwoLetter, err = abbrs.NLetterAbbreviation(fb.Name, 2, false); err != nil {
+		return nil, err
+	}
+	if t.ThreeLetter, err = abbrs.NLetterAbbreviation(fb.Name, 3, false); err != nil {
+		return nil, err
+	}
+
+	return t, nil
+}
+
+// abbreviation returns the preferred abbreviation of the book or its name if
+// the abbreviations do not include the book.
+func abbreviation(abbrs *BookAbbreviations, b *Book) string {
+	if abbr, err := abbrs.PreferredAbbreviation(b.Name); err == nil {
+		return abbr
+	}
+	return b.Name
+}
+
+var (
+	templatesMu sync.RWMutex
+	templates   = map[string]string{}
+)
+
+// RegisterTemplate adds a named template style, which GetFormatter will return
+// a template formatter for. See NewTemplateFormatter for the template data.
+// It returns an error if the template cannot be parsed or the name is already
+// the name of a built-in style.
+func RegisterTemplate(name, text string) error {
+	if _, err := NewTemplateFormatter(text); err != nil {
+		return fmt.Errorf("template %q: %w", name, err)
+	}
+
+	for _, style := range builtinStyles() {
+		if style == name {
+			return fmt.Errorf("template %q: %q is a built-in style", name, name)
+		}
+	}
+
+	templatesMu.Lock()
+	defer templatesMu.Unlock()
+	templates[name] = text
+	return nil
+}
+
+// registeredTemplate returns the text of the named template registered with
+// RegisterTemplate.
+func registeredTemplate(name string) (string, bool) {
+	templatesMu.RLock()
+	defer templatesMu.RUnlock()
+	text, ok := templates[name]
+	return text, ok
+}
+
+// TemplateNames returns the names of the templates registered with
+// RegisterTemplate, sorted.
+func TemplateNames() []string {
+	templatesMu.RLock()
+	defer templatesMu.RUnlock()
+
+	names := make([]string, 0, len(templates))
+	for name := range templates {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// LoadTemplates reads named templates from JSON or YAML and registers each
+// with RegisterTemplate:
+//
+//	templates:
+//	  journal: "{{.Abbr}} {{.Chapter}}.{{.Verse}}"
+//	  usfm-dotted: "{{.USFM}}.{{.Chapter}}.{{.Verse}}"
+//
+// It returns the names of the templates loaded, sorted.
+func LoadTemplates(r io.Reader) ([]string, error) {
+	var cfg struct {
+		Templates map[string]string `yaml:"templates"`
+	}
+	if err := yaml.NewDecoder(r).Decode(&cfg); err != nil {
+		return nil, err
+	}
+
+	names := make([]string, 0, len(cfg.Templates))
+	for name, text := range cfg.Templates {
+		if err := RegisterTemplate(name, text); err != nil {
+			return nil, err
+		}
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names, nil
+}
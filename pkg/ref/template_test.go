@@ -0,0 +1,117 @@
+package ref_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zostay/today/pkg/ref"
+)
+
+func formatTemplate(t *testing.T, style, input string) (string, error) {
+	t.Helper()
+
+	formatter, err := ref.GetFormatter(style)
+	require.NoError(t, err)
+
+	parsed, err := ref.ParseMultiple(input)
+	require.NoError(t, err)
+
+	resolved, err := ref.Canonical.Resolve(parsed)
+	require.NoError(t, err)
+
+	resolvedPtrs := make([]*ref.Resolved, len(resolved))
+	for i := range resolved {
+		resolvedPtrs[i] = &resolved[i]
+	}
+
+	return formatter.Format(resolvedPtrs)
+}
+
+func TestTemplateFormatter_Format(t *testing.T) {
+	t.Parallel()
+
+	const verse = `template:{{.Abbr}} {{.Chapter}}.{{.Verse}}{{if .IsRange}}-{{.EndVerse}}{{end}}`
+
+	tests := []struct {
+		name     string
+		style    string
+		input    string
+		expected string
+	}{
+		{"verse", verse, "Romans 8:28", "Rom. 8.28"},
+		{"range", verse, "Romans 8:28-30", "Rom. 8.28-30"},
+		{"list", verse, "Romans 8:28; Genesis 1:1", "Rom. 8.28; Gen. 1.1"},
+		{"just verse", "template:{{.Name}} {{if .JustVerse}}{{.Verse}}{{else}}{{.Chapter}}:{{.Verse}}{{end}}", "Jude 3", "Jude 3"},
+		{"singular name", "template:{{.Name}} {{.Chapter}}", "Psalm 23", "Psalm 23"},
+		{"whole chapter", "template:{{.WholeChapter}} {{.WholeBook}}", "Psalm 23", "true false"},
+		{"whole book", "template:{{.WholeChapter}} {{.WholeBook}}", "Genesis", "true true"},
+		{"part of chapter", "template:{{.WholeChapter}} {{.WholeBook}}", "Psalm 23:1-3", "false false"},
+		{"part", "template:{{.Verse}}{{.Part}}-{{.EndVerse}}{{.EndPart}} {{.IsRange}}", "John 3:16a", "16a-16a false"},
+		{"span", "template:{{.Abbr}} {{.Chapter}}:{{.Verse}}-{{.EndAbbr}} {{.EndChapter}}:{{.EndVerse}}", "Ruth 4:18-1 Samuel 2:36", "Ruth 4:18-1 Sam. 2:36"},
+		{"codes", "template:{{.USFM}} {{.TwoLetter}} {{.ThreeLetter}}", "Genesis 1:1", "GEN Gn Gen"},
+		{"n-letter", "template:{{.NLetter 3 true}} {{.Chapter}}", "John 3", "Jhn. 3"},
+		{"ref", "template:<{{.Ref}}>", "Genesis 1:1-3", "<Genesis 1:1-3>"},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			result, err := formatTemplate(t, tt.style, tt.input)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func TestTemplateFormatter_Errors(t *testing.T) {
+	t.Parallel()
+
+	_, err := ref.NewTemplateFormatter("{{.Abbr")
+	assert.Error(t, err)
+
+	_, err = formatTemplate(t, "template:{{.Nope}}", "John 3:16")
+	assert.ErrorContains(t, err, "Nope")
+}
+
+func TestRegisterTemplate(t *testing.T) {
+	t.Parallel()
+
+	require.NoError(t, ref.RegisterTemplate("test-register", "{{.USFM}}.{{.Chapter}}.{{.Verse}}"))
+	assert.Contains(t, ref.TemplateNames(), "test-register")
+	assert.Contains(t, ref.GetAvailableStyles(), "test-register")
+
+	result, err := formatTemplate(t, "test-register", "John 3:16")
+	require.NoError(t, err)
+	assert.Equal(t, "JHN.3.16", result)
+
+	assert.ErrorContains(t, ref.RegisterTemplate("abbr", "{{.Abbr}}"), "built-in style")
+	assert.ErrorContains(t, ref.RegisterTemplate("sbl", "{{.Abbr}}"), "built-in style")
+	assert.Error(t, ref.RegisterTemplate("test-register-bad", "{{.Abbr"))
+	assert.NotContains(t, ref.TemplateNames(), "test-register-bad")
+}
+
+func TestLoadTemplates(t *testing.T) {
+	t.Parallel()
+
+	names, err := ref.LoadTemplates(strings.NewReader(`templates:
+  test-load-journal: "{{.Abbr}} {{.Chapter}}.{{.Verse}}"
+  test-load-name: "{{.Name}}"
+`))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"test-load-journal", "test-load-name"}, names)
+
+	result, err := formatTemplate(t, "test-load-journal", "Romans 8:28")
+	require.NoError(t, err)
+	assert.Equal(t, "Rom. 8.28", result)
+
+	_, err = ref.LoadTemplates(strings.NewReader("templates:\n  osis: \"{{.Abbr}}\"\n"))
+	assert.ErrorContains(t, err, "built-in style")
+
+	_, err = ref.LoadTemplates(strings.NewReader("templates: ["))
+	assert.Error(t, err)
+}
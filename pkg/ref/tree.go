@@ -17,7 +17,7 @@ func isSkipped(c rune) bool {
 func NewAbbrTree(abbrs *BookAbbreviations) *AbbrTree {
 	root := AbbrTree{}
 	for i, abbr := range abbrs.Abbreviations {
-		for _, acc := range abbr.Accepts {
+		for _, acc := range abbr.accepted() {
 			cur := &root
 			for _, c := range acc {
 				if isSkipped(c) {
@@ -42,6 +42,18 @@ func NewAbbrTree(abbrs *BookAbbreviations) *AbbrTree {
 	return &root
 }
 
+// accepted returns all the names accepted for the book when parsing, which are
+// the Accepts and the USFM code.
+func (a *BookAbbreviation) accepted() []string {
+	if a.USFM == "" {
+		return a.Accepts
+	}
+
+	accepts := make([]string, 0, len(a.Accepts)+1)
+	accepts = append(accepts, a.Accepts...)
+	return append(accepts, a.USFM)
+}
+
 func cleanAbbreviation(abbr string) string {
 	var cleaned string
 	for _, c := range abbr {
@@ -77,7 +89,7 @@ func (t *AbbrTree) Get(abbr string) map[string]*BookAbbreviation {
 		completeNames := map[string]*BookAbbreviation{}
 		allOrdinals, someOrdinals := true, false
 		for name, finalAbbr := range cur.Final {
-			for _, acc := range finalAbbr.Accepts {
+			for _, acc := range finalAbbr.accepted() {
 				allOrdinals = allOrdinals && finalAbbr.Ordinal != 0
 				someOrdinals = someOrdinals || finalAbbr.Ordinal != 0
 				cleanAcc := cleanAbbreviation(acc)
@@ -0,0 +1,164 @@
+package ref
+
+import (
+	"fmt"
+	"sort"
+)
+
+// Dashes that may be written between the first and last verses of a range.
+const (
+	Hyphen = '-'
+	EnDash = '\u2013'
+	EmDash = '\u2014'
+)
+
+// Spaces that may be written between a book name and the chapter and verse.
+const (
+	NormalSpace  = ' '
+	NoBreakSpace = '\u00a0'
+	ThinSpace    = '\u2009'
+)
+
+var (
+	// RangeDashes names the dashes that may be given to WithRangeDash.
+	RangeDashes = map[string]rune{
+		"hyphen": Hyphen,
+		"en":     EnDash,
+		"em":     EmDash,
+	}
+
+	// BookSpaces names the spaces that may be given to WithBookSpace.
+	BookSpaces = map[string]rune{
+		"normal": NormalSpace,
+		"nbsp":   NoBreakSpace,
+		"thin":   ThinSpace,
+	}
+)
+
+// Typography describes the typographic details of references written by a
+// RefFormatter, beyond the punctuation of the Notation.
+type Typography struct {
+	// Dash is written between the first and last verses of a range (e.g.,
+	// EnDash). It is zero to use the Range of the notation.
+	Dash rune
+
+	// Space is written between a book name and the chapter and verse (e.g.,
+	// NoBreakSpace). It is zero to write a normal space.
+	Space rune
+
+	// AndFollowing is true to write a range of two verses as "f." (e.g., "John
+	// 3:16f.") and a range that runs to the end of the chapter as "ff." (e.g.,
+	// "John 3:16ff.").
+	AndFollowing bool
+
+	// ElideChapters is true to leave out the book name of a reference to the
+	// same book as the reference before it and the chapter of a reference to
+	// the same chapter, so that a list of references is written as "John
+	// 3:16, 18-20; 4:1" rather than "John 3:16; John 3:18-20; John 4:1".
+	ElideChapters bool
+}
+
+// WithRangeDash will cause references to be written with the given dash
+// between the first and last verses of a range (e.g., EnDash), in place of
+// the Range of the notation.
+func WithRangeDash(dash rune) ResolveOption {
+	return func(o *resolveOpts) {
+		o.Typography.Dash = dash
+	}
+}
+
+// WithBookSpace will cause references to be written with the given space
+// between the book name and the chapter and verse (e.g., NoBreakSpace).
+func WithBookSpace(space rune) ResolveOption {
+	return func(o *resolveOpts) {
+		o.Typography.Space = space
+	}
+}
+
+// WithAndFollowing will cause a range of two verses to be written with "f."
+// and a range that runs to the end of the chapter with "ff." (e.g., "John
+// 3:16ff." rather than "John 3:16-36").
+func WithAndFollowing() ResolveOption {
+	return func(o *resolveOpts) {
+		o.Typography.AndFollowing = true
+	}
+}
+
+// WithElidedChapters will cause the book name to be left out of a reference
+// to the same book as the reference before it and the chapter to be left out
+// of a reference to the same chapter (e.g., "John 3:16, 18; 4:1").
+func WithElidedChapters() ResolveOption {
+	return func(o *resolveOpts) {
+		o.Typography.ElideChapters = true
+	}
+}
+
+// GetRangeDash returns the named dash from RangeDashes. It returns ErrNotFound
+// if there is no such dash.
+func GetRangeDash(name string) (rune, error) {
+	if dash, ok := RangeDashes[name]; ok {
+		return dash, nil
+	}
+	return 0, fmt.Errorf("%w: range dash %q", ErrNotFound, name)
+}
+
+// GetBookSpace returns the named space from BookSpaces. It returns ErrNotFound
+// if there is no such space.
+func GetBookSpace(name string) (rune, error) {
+	if space, ok := BookSpaces[name]; ok {
+		return space, nil
+	}
+	return 0, fmt.Errorf("%w: book space %q", ErrNotFound, name)
+}
+
+// RangeDashNames returns the names of the dashes in RangeDashes, sorted.
+func RangeDashNames() []string {
+	return sortedNames(RangeDashes)
+}
+
+// BookSpaceNames returns the names of the spaces in BookSpaces, sorted.
+func BookSpaceNames() []string {
+	return sortedNames(BookSpaces)
+}
+
+// sortedNames returns the keys of the map, sorted.
+func sortedNames(m map[string]rune) []string {
+	names := make([]string, 0, len(m))
+	for name := range m {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// dash returns the dash to write between the first and last verses of a
+// range written in the given notation.
+func (t Typography) dash(n Notation) string {
+	if t.Dash == 0 {
+		return n.rangeSep()
+	}
+	return string(t.Dash)
+}
+
+// space returns the space to write after a book name.
+func (t Typography) space() string {
+	if t.Space == 0 {
+		return string(NormalSpace)
+	}
+	return string(t.Space)
+}
+
+// following returns "f." if the range runs from the first verse to the next,
+// "ff." if it runs from the first verse to the last verse of the chapter, or
+// an empty string if neither or AndFollowing is not set.
+func (t Typography) following(first, last, lastInChapter int) string {
+	switch {
+	case !t.AndFollowing:
+		return ""
+	case last == first+1:
+		return "f."
+	case last == lastInChapter:
+		return "ff."
+	}
+	return ""
+}
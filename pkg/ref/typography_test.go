@@ -0,0 +1,190 @@
+package ref_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zostay/today/pkg/ref"
+)
+
+func TestGetRangeDash(t *testing.T) {
+	t.Parallel()
+
+	dash, err := ref.GetRangeDash("en")
+	assert.NoError(t, err)
+	assert.Equal(t, ref.EnDash, dash)
+
+	_, err = ref.GetRangeDash("swung")
+	assert.ErrorIs(t, err, ref.ErrNotFound)
+
+	assert.Equal(t, []string{"em", "en", "hyphen"}, ref.RangeDashNames())
+}
+
+func TestGetBookSpace(t *testing.T) {
+	t.Parallel()
+
+	space, err := ref.GetBookSpace("thin")
+	assert.NoError(t, err)
+	assert.Equal(t, ref.ThinSpace, space)
+
+	_, err = ref.GetBookSpace("hair")
+	assert.ErrorIs(t, err, ref.ErrNotFound)
+
+	assert.Equal(t, []string{"nbsp", "normal", "thin"}, ref.BookSpaceNames())
+}
+
+func TestFormatter_Typography(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		style    string
+		input    string
+		opts     []ref.ResolveOption
+		expected string
+	}{
+		{"en dash", "canonical", "John 3:16-18", []ref.ResolveOption{ref.WithRangeDash(ref.EnDash)}, "John 3:16–18"},
+		{"em dash chapters", "canonical", "John 3-4", []ref.ResolveOption{ref.WithRangeDash(ref.EmDash)}, "John 3—4"},
+		{"dash over style guide", "mla", "John 3:16-18", []ref.ResolveOption{ref.WithRangeDash(ref.EnDash)}, "John 3.16–18"},
+		{"dash span", "abbr", "Ruth 4:18-1 Samuel 2", []ref.ResolveOption{ref.WithRangeDash(ref.EnDash)}, "Ruth 4:18–1 Sam. 2"},
+		{"no-break space", "abbr", "Genesis 1:1", []ref.ResolveOption{ref.WithBookSpace(ref.NoBreakSpace)}, "Gen. 1:1"},
+		{"thin space span", "canonical", "Ruth 4:18-1 Samuel 2", []ref.ResolveOption{ref.WithBookSpace(ref.ThinSpace)}, "Ruth 4:18-1 Samuel 2"},
+		{"whole book", "canonical", "Genesis", []ref.ResolveOption{ref.WithBookSpace(ref.ThinSpace)}, "Genesis"},
+		{"ff", "canonical", "John 3:16ff", []ref.ResolveOption{ref.WithAndFollowing()}, "John 3:16ff."},
+		{"f", "canonical", "John 3:16-17", []ref.ResolveOption{ref.WithAndFollowing()}, "John 3:16f."},
+		{"f at end of chapter", "canonical", "John 3:35-36", []ref.ResolveOption{ref.WithAndFollowing()}, "John 3:35f."},
+		{"ff just verse", "canonical", "Jude 3ff", []ref.ResolveOption{ref.WithAndFollowing()}, "Jude 3ff."},
+		{"ff whole chapter", "canonical", "John 3:1ff", []ref.ResolveOption{ref.WithAndFollowing()}, "John 3"},
+		{"ff not following", "canonical", "John 3:16-18", []ref.ResolveOption{ref.WithAndFollowing()}, "John 3:16-18"},
+		{"ff part", "canonical", "John 3:35b-36", []ref.ResolveOption{ref.WithAndFollowing()}, "John 3:35b-36"},
+		{"without ff", "canonical", "John 3:16ff", nil, "John 3:16-36"},
+		{"elide verses", "canonical", "John 3:16; John 3:18-20", []ref.ResolveOption{ref.WithElidedChapters()}, "John 3:16, 18-20"},
+		{"elide book", "abbr", "Romans 8:28; Romans 12:1-2", []ref.ResolveOption{ref.WithElidedChapters()}, "Rom. 8:28; 12:1-2"},
+		{"elide after range", "canonical", "John 3:16-4:2; John 4:5", []ref.ResolveOption{ref.WithElidedChapters()}, "John 3:16-4:2, 5"},
+		{"elide just verse", "canonical", "Jude 3; Jude 5-6", []ref.ResolveOption{ref.WithElidedChapters()}, "Jude 3, 5-6"},
+		{"elide after whole chapter", "canonical", "John 3; John 3:5", []ref.ResolveOption{ref.WithElidedChapters()}, "John 3; 3:5"},
+		{"elide whole chapter", "canonical", "John 3:16; John 4", []ref.ResolveOption{ref.WithElidedChapters()}, "John 3:16; 4"},
+		{"elide other book", "canonical", "John 3:16; Romans 8:28; Romans 8:30", []ref.ResolveOption{ref.WithElidedChapters()}, "John 3:16; Romans 8:28, 30"},
+		{"elide european", "canonical", "John 3:16; John 3:18", []ref.ResolveOption{ref.WithNotation(ref.EuropeanNotation), ref.WithElidedChapters()}, "John 3,16.18"},
+		{"without elision", "canonical", "John 3:16; John 3:18", nil, "John 3:16; John 3:18"},
+		{"all", "sbl", "John 3:16-17; John 3:19-21; John 4:1-54", []ref.ResolveOption{
+			ref.WithBookSpace(ref.NoBreakSpace),
+			ref.WithAndFollowing(),
+			ref.WithElidedChapters(),
+		}, "John 3:16f., 19–21; 4"},
+		{"template", "template:{{.Abbr}}{{.Space}}{{.Chapter}}{{.Dash}}{{.EndChapter}} ({{.Ref}})", "Genesis 1:1-2:3", []ref.ResolveOption{
+			ref.WithRangeDash(ref.EnDash),
+			ref.WithBookSpace(ref.ThinSpace),
+		}, "Gen. 1–2 (Genesis 1:1–2:3)"},
+		{"osis unchanged", "osis", "John 3:16-18", []ref.ResolveOption{ref.WithRangeDash(ref.EnDash)}, "John.3.16-John.3.18"},
+		{"usfm unchanged", "usfm", "John 3:16-18", []ref.ResolveOption{ref.WithRangeDash(ref.EnDash), ref.WithAndFollowing()}, "JHN 3:16-18"},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			formatter, err := ref.GetFormatter(tt.style, tt.opts...)
+			require.NoError(t, err)
+
+			parsed, err := ref.ParseMultiple(tt.input)
+			require.NoError(t, err)
+
+			resolved, err := ref.Canonical.Resolve(parsed)
+			require.NoError(t, err)
+
+			resolvedPtrs := make([]*ref.Resolved, len(resolved))
+			for i := range resolved {
+				resolvedPtrs[i] = &resolved[i]
+			}
+
+			result, err := formatter.Format(resolvedPtrs)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func TestResolved_CompactRef_Typography(t *testing.T) {
+	t.Parallel()
+
+	john, err := ref.Canonical.Book("John")
+	require.NoError(t, err)
+
+	r := &ref.Resolved{
+		Book:  john,
+		First: ref.CV{Chapter: 3, Verse: 16},
+		Last:  ref.CV{Chapter: 3, Verse: 36},
+	}
+
+	s, err := r.CompactRef(ref.WithRangeDash(ref.EnDash))
+	assert.NoError(t, err)
+	assert.Equal(t, "John 3:16–36", s)
+
+	s, err = r.CompactRef(ref.WithAndFollowing(), ref.WithBookSpace(ref.NoBreakSpace))
+	assert.NoError(t, err)
+	assert.Equal(t, "John 3:16ff.", s)
+
+	s, err = r.AbbreviatedRef(ref.WithAndFollowing())
+	assert.NoError(t, err)
+	assert.Equal(t, "John 3:16ff.", s)
+}
+
+func TestWithElidedChapters_RoundTrip(t *testing.T) {
+	t.Parallel()
+
+	eu := ref.EuropeanNotation
+
+	tests := []struct {
+		name     string
+		style    string
+		input    string
+		notation ref.Notation
+	}{
+		{"verses", "canonical", "John 3:16; John 3:18-20; John 4:1; John 4:3", ref.StandardNotation},
+		{"after range", "canonical", "John 3:16-4:2; John 4:5", ref.StandardNotation},
+		{"just verse", "canonical", "Jude 3; Jude 5-6", ref.StandardNotation},
+		{"whole chapters", "canonical", "John 3; John 3:5; John 4", ref.StandardNotation},
+		{"abbreviated", "abbr", "Romans 8:28; Romans 8:30; Romans 12:1-2", ref.StandardNotation},
+		{"ranges", "canonical", "John 3:16-17; John 3:19-21; John 5", ref.StandardNotation},
+		{"european", "canonical", "John 3:16; John 3:18-20; John 4:1", eu},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			formatter, err := ref.GetFormatter(tt.style,
+				ref.WithElidedChapters(),
+				ref.WithNotation(tt.notation),
+				ref.WithRangeDash(ref.EnDash))
+			require.NoError(t, err)
+
+			parsed, err := ref.ParseMultiple(tt.input)
+			require.NoError(t, err)
+
+			resolved, err := ref.Canonical.Resolve(parsed)
+			require.NoError(t, err)
+
+			resolvedPtrs := make([]*ref.Resolved, len(resolved))
+			for i := range resolved {
+				resolvedPtrs[i] = &resolved[i]
+			}
+
+			result, err := formatter.Format(resolvedPtrs)
+			require.NoError(t, err)
+
+			// the elided references read back as the references written
+			reparsed, err := ref.ParseMultiple(result, ref.ParseWithNotation(tt.notation))
+			require.NoError(t, err)
+
+			reresolved, err := ref.Canonical.Resolve(reparsed)
+			require.NoError(t, err)
+			assert.Equal(t, resolved, reresolved, result)
+		})
+	}
+}
@@ -0,0 +1,615 @@
+package ref
+
+import (
+	"fmt"
+	"sort"
+)
+
+// Versification is a scheme for numbering the chapters and verses of the
+// Bible. English Bibles mostly follow the numbering of the King James Version,
+// but the Hebrew text numbers some chapters differently (e.g., Malachi 4 in
+// English is Malachi 3:19-24 in Hebrew) and counts the superscriptions of many
+// Psalms as verse 1. The Septuagint and Vulgate also number most of the Psalms
+// one lower than the Hebrew and English.
+//
+// Each versification records how it differs from the English numbering, which
+// is used to map references from any versification to any other.
+type Versification struct {
+	// Name is the short name of the versification (e.g., "mt").
+	Name string
+
+	// Description describes the versification (e.g., "Hebrew (Masoretic
+	// Text)").
+	Description string
+
+	// mappings are the ranges of verses numbered differently from the English
+	// numbering, by book name and in English order.
+	mappings map[string][]verseMapping
+
+	// superscriptions gives the number of verses at the start of each chapter
+	// of the Psalms in this numbering that are superscriptions, which have no
+	// verse number in English.
+	superscriptions map[int]int
+}
+
+// verseMapping renumbers the verses first through last of a chapter in the
+// English numbering as the verses toFirst through toLast of chapter toChapter.
+// Either the two ranges are the same length or the English verses are all
+// numbered as the single verse toFirst (e.g., two English verses that are one
+// verse in Hebrew).
+type verseMapping struct {
+	chapter, first, last       int
+	toChapter, toFirst, toLast int
+}
+
+// shift returns a mapping moving the English verses first through last of the
+// chapter to the verses starting at toFirst of chapter toChapter.
+func shift(chapter, first, last, toChapter, toFirst int) verseMapping {
+	return verseMapping{
+		chapter:   chapter,
+		first:     first,
+		last:      last,
+		toChapter: toChapter,
+		toFirst:   toFirst,
+		toLast:    toFirst + last - first,
+	}
+}
+
+// merge returns a mapping numbering all the English verses first through last
+// of the chapter as the verse to of chapter toChapter.
+func merge(chapter, first, last, toChapter, to int) verseMapping {
+	return verseMapping{
+		chapter:   chapter,
+		first:     first,
+		last:      last,
+		toChapter: toChapter,
+		toFirst:   to,
+		toLast:    to,
+	}
+}
+
+// hebrewChapterMappings lists the chapters numbered differently in the Hebrew
+// text. The Septuagint shares this numbering outside of the Psalms.
+var hebrewChapterMappings = map[string][]verseMapping{
+	"Genesis": {
+		shift(31, 55, 55, 32, 1),
+		shift(32, 1, 32, 32, 2),
+	},
+	"Exodus": {
+		shift(8, 1, 4, 7, 26),
+		shift(8, 5, 32, 8, 1),
+		shift(22, 1, 1, 21, 37),
+		shift(22, 2, 31, 22, 1),
+	},
+	"Leviticus": {
+		shift(6, 1, 7, 5, 20),
+		shift(6, 8, 30, 6, 1),
+	},
+	"Numbers": {
+		shift(16, 36, 50, 17, 1),
+		shift(17, 1, 13, 17, 16),
+		shift(29, 40, 40, 30, 1),
+		shift(30, 1, 16, 30, 2),
+	},
+	"Deuteronomy": {
+		shift(12, 32, 32, 13, 1),
+		shift(13, 1, 18, 13, 2),
+		shift(22, 30, 30, 23, 1),
+		shift(23, 1, 25, 23, 2),
+		shift(29, 1, 1, 28, 69),
+		shift(29, 2, 29, 29, 1),
+	},
+	"1 Samuel": {
+		shift(21, 1, 15, 21, 2),
+		shift(23, 29, 29, 24, 1),
+		shift(24, 1, 22, 24, 2),
+	},
+	"2 Samuel": {
+		shift(18, 33, 33, 19, 1),
+		shift(19, 1, 43, 19, 2),
+	},
+	"1 Kings": {
+		shift(4, 21, 34, 5, 1),
+		shift(5, 1, 18, 5, 15),
+		shift(22, 44, 53, 22, 45),
+	},
+	"2 Kings": {
+		shift(11, 21, 21, 12, 1),
+		shift(12, 1, 21, 12, 2),
+	},
+	"1 Chronicles": {
+		shift(6, 1, 15, 5, 27),
+		shift(6, 16, 81, 6, 1),
+	},
+	"2 Chronicles": {
+		shift(2, 1, 1, 1, 18),
+		shift(2, 2, 18, 2, 1),
+		shift(14, 1, 1, 13, 23),
+		shift(14, 2, 15, 14, 1),
+	},
+	"Nehemiah": {
+		shift(4, 1, 6, 3, 33),
+		shift(4, 7, 23, 4, 1),
+		shift(9, 38, 38, 10, 1),
+		shift(10, 1, 39, 10, 2),
+	},
+	"Job": {
+		shift(41, 1, 8, 40, 25),
+		shift(41, 9, 34, 41, 1),
+	},
+	"Ecclesiastes": {
+		shift(5, 1, 1, 4, 17),
+		shift(5, 2, 20, 5, 1),
+	},
+	"Song of Solomon": {
+		shift(6, 13, 13, 7, 1),
+		shift(7, 1, 13, 7, 2),
+	},
+	"Isaiah": {
+		shift(9, 1, 1, 8, 23),
+		shift(9, 2, 21, 9, 1),
+		shift(64, 1, 1, 63, 19),
+		shift(64, 2, 12, 64, 1),
+	},
+	"Jeremiah": {
+		shift(9, 1, 1, 8, 23),
+		shift(9, 2, 26, 9, 1),
+	},
+	"Ezekiel": {
+		shift(20, 45, 49, 21, 1),
+		shift(21, 1, 32, 21, 6),
+	},
+	"Daniel": {
+		shift(4, 1, 3, 3, 31),
+		shift(4, 4, 37, 4, 1),
+		shift(5, 31, 31, 6, 1),
+		shift(6, 1, 28, 6, 2),
+	},
+	"Hosea": {
+		shift(1, 10, 11, 2, 1),
+		shift(2, 1, 23, 2, 3),
+		shift(11, 12, 12, 12, 1),
+		shift(12, 1, 14, 12, 2),
+		shift(13, 16, 16, 14, 1),
+		shift(14, 1, 9, 14, 2),
+	},
+	"Joel": {
+		shift(2, 28, 32, 3, 1),
+		shift(3, 1, 21, 4, 1),
+	},
+	"Jonah": {
+		shift(1, 17, 17, 2, 1),
+		shift(2, 1, 10, 2, 2),
+	},
+	"Micah": {
+		shift(5, 1, 1, 4, 14),
+		shift(5, 2, 15, 5, 1),
+	},
+	"Nahum": {
+		shift(1, 15, 15, 2, 1),
+		shift(2, 1, 13, 2, 2),
+	},
+	"Zechariah": {
+		shift(1, 18, 21, 2, 1),
+		shift(2, 1, 13, 2, 5),
+	},
+	"Malachi": {
+		shift(4, 1, 6, 3, 19),
+	},
+}
+
+// psalmSuperscriptions gives the number of verses the superscription adds to
+// the start of each English Psalm in the Hebrew numbering. The verses of Psalm
+// 13 are special cased by psalmVerseMappings.
+var psalmSuperscriptions = map[int]int{
+	3: 1, 4: 1, 5: 1, 6: 1, 7: 1, 8: 1, 9: 1, 12: 1, 18: 1, 19: 1, 20: 1,
+	21: 1, 22: 1, 30: 1, 31: 1, 34: 1, 36: 1, 38: 1, 39: 1, 40: 1, 41: 1,
+	42: 1, 44: 1, 45: 1, 46: 1, 47: 1, 48: 1, 49: 1, 51: 2, 52: 2, 53: 1,
+	13: 1, 54: 2, 55: 1, 56: 1, 57: 1, 58: 1, 59: 1, 60: 2, 61: 1, 62: 1, 63: 1,
+	64: 1, 65: 1, 67: 1, 68: 1, 69: 1, 70: 1, 75: 1, 76: 1, 77: 1, 80: 1,
+	81: 1, 83: 1, 84: 1, 85: 1, 88: 1, 89: 1, 92: 1, 102: 1, 108: 1, 140: 1,
+	142: 1,
+}
+
+// psalmVerseMappings returns the mappings of English Psalm chapter to the
+// Hebrew numbering of the verses of the chapter, which is the numbering used
+// within each Psalm by the Septuagint as well. The chapters are renumbered by
+// the given function, which returns the chapter and the verse offset to apply.
+func psalmVerseMappings(lastVerses []int, renumber func(int) (int, int)) []verseMapping {
+	ms := make([]verseMapping, 0, len(lastVerses))
+	for i, last := range lastVerses {
+		chapter := i + 1
+		toChapter, offset := renumber(chapter)
+
+		if chapter == 13 {
+			ms = append(ms,
+				shift(13, 1, 4, toChapter, 2+offset),
+				merge(13, 5, 6, toChapter, 6+offset),
+			)
+			continue
+		}
+
+		offset += psalmSuperscriptions[chapter]
+		if toChapter == chapter && offset == 0 {
+			continue
+		}
+
+		ms = append(ms, shift(chapter, 1, last, toChapter, 1+offset))
+	}
+	return ms
+}
+
+// septuagintPsalm returns the chapter of the Septuagint numbering for the
+// given English Psalm and the offset to add to the verses. Psalms 9-10 and
+// 114-115 are joined and Psalms 116 and 147 are split.
+func septuagintPsalm(lastVerses []int) func(int) (int, int) {
+	return func(chapter int) (int, int) {
+		switch {
+		case chapter <= 9, chapter >= 148:
+			return chapter, 0
+		case chapter == 10:
+			// Psalm 10 continues Psalm 9 in the Septuagint
+			return 9, lastVerses[8] + psalmSuperscriptions[9]
+		case chapter <= 113:
+			return chapter - 1, 0
+		case chapter == 114:
+			return 113, 0
+		case chapter == 115:
+			return 113, lastVerses[113]
+		case chapter == 116:
+			// split by septuagintSplitPsalms
+			return 114, 0
+		case chapter <= 146:
+			return chapter - 1, 0
+		default:
+			// Psalm 147, split by septuagintSplitPsalms
+			return 146, 0
+		}
+	}
+}
+
+// septuagintSplitPsalms fixes the mappings of the Psalms the Septuagint
+// splits in two.
+func septuagintSplitPsalms(ms []verseMapping) []verseMapping {
+	fixed := make([]verseMapping, 0, len(ms)+2)
+	for _, m := range ms {
+		switch m.chapter {
+		case 116:
+			fixed = append(fixed, shift(116, 1, 9, 114, 1), shift(116, 10, m.last, 115, 1))
+		case 147:
+			fixed = append(fixed, shift(147, 1, 11, 146, 1), shift(147, 12, m.last, 147, 1))
+		default:
+			fixed = append(fixed, m)
+		}
+	}
+	return fixed
+}
+
+// psalmLastVerses returns the last verse of each chapter of the Psalms in the
+// English numbering.
+func psalmLastVerses() []int {
+	var lastVerses []int
+	for _, b := range Canonical.Books {
+		if b.Name != "Psalms" {
+			continue
+		}
+
+		lastVerses = make([]int, 0, len(b.Runs))
+		for _, r := range b.Runs {
+			lastVerses = append(lastVerses, r.Last)
+		}
+	}
+	return lastVerses
+}
+
+// renumberSuperscriptions returns the superscriptions of the Psalms keyed by
+// the chapters given by the function, which renumbers the English chapters as
+// for psalmVerseMappings.
+func renumberSuperscriptions(renumber func(int) (int, int)) map[int]int {
+	supers := make(map[int]int, len(psalmSuperscriptions))
+	for chapter, n := range psalmSuperscriptions {
+		toChapter, _ := renumber(chapter)
+		supers[toChapter] = n
+	}
+	return supers
+}
+
+// hebrewPsalm returns the chapter of the Hebrew numbering for the given
+// English Psalm, which is always the same.
+func hebrewPsalm(chapter int) (int, int) {
+	return chapter, 0
+}
+
+// hebrewMappings returns the mappings of the Hebrew versification.
+func hebrewMappings() map[string][]verseMapping {
+	ms := make(map[string][]verseMapping, len(hebrewChapterMappings)+1)
+	for name, m := range hebrewChapterMappings {
+		ms[name] = m
+	}
+
+	ms["Psalms"] = psalmVerseMappings(psalmLastVerses(), hebrewPsalm)
+
+	return ms
+}
+
+// septuagintMappings returns the mappings of the Septuagint versification.
+func septuagintMappings() map[string][]verseMapping {
+	ms := make(map[string][]verseMapping, len(hebrewChapterMappings)+1)
+	for name, m := range hebrewChapterMappings {
+		ms[name] = m
+	}
+
+	lastVerses := psalmLastVerses()
+	ms["Psalms"] = septuagintSplitPsalms(
+		psalmVerseMappings(lastVerses, septuagintPsalm(lastVerses)))
+
+	return ms
+}
+
+var (
+	// EnglishVersification is the numbering of the King James Version, which
+	// is followed by most English Bibles and by the built-in canons.
+	EnglishVersification = &Versification{
+		Name:        "kjv",
+		Description: "English (King James Version)",
+	}
+
+	// HebrewVersification is the numbering of the Hebrew Masoretic Text,
+	// which counts the superscriptions of many Psalms as verses.
+	HebrewVersification = &Versification{
+		Name:            "mt",
+		Description:     "Hebrew (Masoretic Text)",
+		mappings:        hebrewMappings(),
+		superscriptions: renumberSuperscriptions(hebrewPsalm),
+	}
+
+	// SeptuagintVersification is the numbering of the Septuagint and the
+	// Vulgate, which number most of the Psalms one lower than the Hebrew and
+	// English. Outside of the Psalms, this follows the chapters of the Hebrew
+	// numbering.
+	SeptuagintVersification = &Versification{
+		Name:            "lxx",
+		Description:     "Greek (Septuagint) and Latin (Vulgate)",
+		mappings:        septuagintMappings(),
+		superscriptions: renumberSuperscriptions(septuagintPsalm(psalmLastVerses())),
+	}
+)
+
+// Versifications lists the built-in versifications by name.
+var Versifications = map[string]*Versification{
+	"kjv": EnglishVersification,
+	"mt":  HebrewVersification,
+	"lxx": SeptuagintVersification,
+}
+
+// GetVersification returns the named versification from Versifications. An
+// empty name is the same as "kjv". It returns ErrNotFound if there is no such
+// versification.
+func GetVersification(name string) (*Versification, error) {
+	if name == "" {
+		return EnglishVersification, nil
+	}
+	if v, ok := Versifications[name]; ok {
+		return v, nil
+	}
+	return nil, fmt.Errorf("%w: versification %q", ErrNotFound, name)
+}
+
+// VersificationNames returns the names of the built-in versifications, sorted.
+func VersificationNames() []string {
+	names := make([]string, 0, len(Versifications))
+	for name := range Versifications {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// fromEnglish returns the verse numbered as the given English verse of the
+// book in this versification.
+func (vs *Versification) fromEnglish(book string, v CV) CV {
+	for _, m := range vs.mappings[book] {
+		if m.chapter == v.Chapter && m.first <= v.Verse && v.Verse <= m.last {
+			to := m.toFirst
+			if m.toLast != m.toFirst {
+				to += v.Verse - m.first
+			}
+			return CV{Chapter: m.toChapter, Verse: to}
+		}
+	}
+	return v
+}
+
+// toEnglish returns the first and last English verses numbered as the given
+// verse of the book in this versification. These are the same unless the verse
+// is numbered as more than one verse in English.
+func (vs *Versification) toEnglish(book string, v CV) (first, last CV) {
+	for _, m := range vs.mappings[book] {
+		if m.toChapter == v.Chapter && m.toFirst <= v.Verse && v.Verse <= m.toLast {
+			if m.toLast == m.toFirst {
+				return CV{Chapter: m.chapter, Verse: m.first}, CV{Chapter: m.chapter, Verse: m.last}
+			}
+
+			cv := CV{Chapter: m.chapter, Verse: m.first + v.Verse - m.toFirst}
+			return cv, cv
+		}
+	}
+	return v, v
+}
+
+// isSuperscription returns true if the verse of the book is the superscription
+// of a Psalm in this numbering.
+func (vs *Versification) isSuperscription(book string, v CV) bool {
+	return book == "Psalms" && v.Verse <= vs.superscriptions[v.Chapter]
+}
+
+// mapVerse returns the verses numbered in the versification to as the given
+// verse of the book in this versification. This is usually a single verse, but
+// may be several verses or none.
+func (vs *Versification) mapVerse(book string, v CV, to *Versification) []CV {
+	if vs.isSuperscription(book, v) {
+		// the superscription is numbered in the other versification only if
+		// it also numbers the superscription of the same Psalm
+		first, _ := vs.toEnglish(book, CV{Chapter: v.Chapter, Verse: vs.superscriptions[v.Chapter] + 1})
+		sv := to.fromEnglish(book, first)
+		sv.Verse = v.Verse
+		if to.isSuperscription(book, sv) {
+			return []CV{sv}
+		}
+		return nil
+	}
+
+	first, last := vs.toEnglish(book, v)
+
+	cvs := make([]CV, 0, last.Verse-first.Verse+1)
+	for n := first.Verse; n <= last.Verse; n++ {
+		cv := to.fromEnglish(book, CV{Chapter: first.Chapter, Verse: n})
+		if len(cvs) == 0 || cvs[len(cvs)-1] != cv {
+			cvs = append(cvs, cv)
+		}
+	}
+	return cvs
+}
+
+// mapBook returns a copy of the book, which is numbered according to this
+// versification, with its verses renumbered according to the versification to.
+// In the Psalms, the superscriptions numbered by to are included.
+func (vs *Versification) mapBook(b *Book, to *Versification) *Book {
+	var cvs []CV
+	for _, v := range b.allVerses() {
+		if cv, isCV := v.(CV); isCV {
+			cvs = append(cvs, vs.mapVerse(b.Name, cv, to)...)
+		}
+	}
+
+	if b.Name == "Psalms" {
+		for chapter, n := range to.superscriptions {
+			if _, last := b.chapterRuns(chapter); last < 0 {
+				continue
+			}
+
+			for verse := 1; verse <= n; verse++ {
+				cvs = append(cvs, CV{Chapter: chapter, Verse: verse})
+			}
+		}
+	}
+
+	sort.Slice(cvs, func(i, j int) bool {
+		return cvs[i].Chapter < cvs[j].Chapter ||
+			(cvs[i].Chapter == cvs[j].Chapter && cvs[i].Verse < cvs[j].Verse)
+	})
+
+	mb := &Book{Name: b.Name, USFM: b.USFM, JustVerse: b.JustVerse}
+	for _, cv := range cvs {
+		mb.Runs = appendRun(mb.Runs, cv.Chapter, cv.Verse)
+	}
+
+	return mb
+}
+
+// renumbers returns true if this versification and the versification to number
+// any verses of the book differently.
+func (vs *Versification) renumbers(b *Book, to *Versification) bool {
+	if vs == to || b.JustVerse {
+		return false
+	}
+
+	return len(vs.mappings[b.Name]) > 0 || len(to.mappings[b.Name]) > 0
+}
+
+// Map returns the references, which are numbered according to this
+// versification, renumbered according to the versification to. A reference may
+// be split into several references when its verses are no longer consecutive
+// (e.g., Joel 2:27-29 in English is Joel 2:27 and Joel 3:1-2 in Hebrew).
+//
+// The references returned for books that are numbered differently are resolved
+// against a copy of the book renumbered according to the versification to, so
+// they may be formatted as usual, but they do not belong to the canon of the
+// original references.
+func (vs *Versification) Map(rs []Resolved, to *Versification) []Resolved {
+	books := map[*Book]*Book{}
+
+	mapped := make([]Resolved, 0, len(rs))
+	for i := range rs {
+		r := &rs[i]
+		if !vs.renumbers(r.Book, to) {
+			mapped = append(mapped, *r)
+			continue
+		}
+
+		mb, ok := books[r.Book]
+		if !ok {
+			mb = vs.mapBook(r.Book, to)
+			books[r.Book] = mb
+		}
+
+		mapped = append(mapped, vs.mapResolved(r, mb, to)...)
+	}
+	return mapped
+}
+
+// mapResolved renumbers a single reference according to the versification to,
+// resolving it against the book mb, which has already been renumbered.
+func (vs *Versification) mapResolved(r *Resolved, mb *Book, to *Versification) []Resolved {
+	var rs []Resolved
+	for _, v := range r.Verses() {
+		cv, isCV := v.(CV)
+		if !isCV {
+			continue
+		}
+
+		for _, mv := range vs.mapVerse(r.Book.Name, cv, to) {
+			if n := len(rs); n > 0 {
+				cur := &rs[n-1]
+				if cur.Last.Equal(mv) {
+					continue
+				}
+
+				if next := mb.verseAfter(cur.Last); next != nil && next.Equal(mv) {
+					cur.Last = mv
+					continue
+				}
+			}
+
+			rs = append(rs, Resolved{Book: mb, First: mv, Last: mv})
+		}
+	}
+
+	if len(rs) == 0 {
+		return nil
+	}
+
+	// keep the verse parts of the original reference
+	if cv, isCV := r.First.(CV); isCV && cv.Part != "" {
+		first := rs[0].First.(CV)
+		first.Part = cv.Part
+		rs[0].First = first
+	}
+	if cv, isCV := r.Last.(CV); isCV && cv.Part != "" {
+		last := rs[len(rs)-1].Last.(CV)
+		last.Part = cv.Part
+		rs[len(rs)-1].Last = last
+	}
+
+	rs[len(rs)-1].Continued = r.Continued
+
+	return rs
+}
+
+// ToVersification returns the references, which must be resolved against this
+// canon, renumbered according to the named versification. See
+// Versification.Map for details. It returns ErrNotFound if either this canon's
+// versification or the named versification is unknown.
+func (c *Canon) ToVersification(rs []Resolved, name string) ([]Resolved, error) {
+	from, err := GetVersification(c.Versification)
+	if err != nil {
+		return nil, err
+	}
+
+	to, err := GetVersification(name)
+	if err != nil {
+		return nil, err
+	}
+
+	return from.Map(rs, to), nil
+}
@@ -0,0 +1,127 @@
+package ref_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zostay/today/pkg/ref"
+)
+
+func TestGetVersification(t *testing.T) {
+	t.Parallel()
+
+	v, err := ref.GetVersification("mt")
+	assert.NoError(t, err)
+	assert.Same(t, ref.HebrewVersification, v)
+
+	v, err = ref.GetVersification("")
+	assert.NoError(t, err)
+	assert.Same(t, ref.EnglishVersification, v)
+
+	v, err = ref.GetVersification("nrsv")
+	assert.ErrorIs(t, err, ref.ErrNotFound)
+	assert.Nil(t, v)
+
+	assert.Equal(t, []string{"kjv", "lxx", "mt"}, ref.VersificationNames())
+
+	for _, c := range ref.Canons {
+		assert.Equal(t, "kjv", c.Versification, c.Name)
+	}
+}
+
+func TestCanon_ToVersification(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		in       string
+		to       string
+		expected string
+	}{
+		{"Malachi 4", "mt", "Malachi 3:19-24"},
+		{"Malachi 3:16-4:6", "mt", "Malachi 3:16-24"},
+		{"Joel 2:28", "mt", "Joel 3:1"},
+		{"Joel 2:27-29", "mt", "Joel 2:27-3:2"},
+		{"Joel 2:28-3:21", "mt", "Joel 3-4"},
+		{"Psalm 2:12-3:1", "mt", "Psalm 2:12; Psalm 3:2"},
+		{"Psalm 9-10", "lxx", "Psalm 9:2-39"},
+		{"Psalm 3", "mt", "Psalm 3:2-9"},
+		{"Psalm 51:1-4", "mt", "Psalm 51:3-6"},
+		{"Psalm 1-2", "mt", "Psalms 1-2"},
+		{"Psalm 13:4-6", "mt", "Psalm 13:5-6"},
+		{"Psalm 23", "lxx", "Psalm 22"},
+		{"Psalm 10:1", "lxx", "Psalm 9:22"},
+		{"Psalm 116:10", "lxx", "Psalm 115:1"},
+		{"Psalm 51:1", "lxx", "Psalm 50:3"},
+		{"John 3:16", "mt", "John 3:16"},
+		{"Genesis 1-3", "lxx", "Genesis 1-3"},
+		{"Malachi 4:2a", "mt", "Malachi 3:20a"},
+		{"Jude 3", "mt", "Jude 3"},
+		{"Malachi 4", "kjv", "Malachi 4"},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.in+" "+tt.to, func(t *testing.T) {
+			t.Parallel()
+
+			p, err := ref.ParseProper(tt.in)
+			require.NoError(t, err)
+
+			rs, err := ref.Canonical.Resolve(p)
+			require.NoError(t, err)
+
+			mapped, err := ref.Canonical.ToVersification(rs, tt.to)
+			require.NoError(t, err)
+
+			fmt, err := ref.GetFormatter("canonical")
+			require.NoError(t, err)
+
+			ptrs := make([]*ref.Resolved, len(mapped))
+			for i := range mapped {
+				ptrs[i] = &mapped[i]
+			}
+
+			got, err := fmt.Format(ptrs)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, got)
+		})
+	}
+
+	_, err := ref.Canonical.ToVersification(nil, "nrsv")
+	assert.ErrorIs(t, err, ref.ErrNotFound)
+}
+
+func TestVersification_Map_RoundTrip(t *testing.T) {
+	t.Parallel()
+
+	ps, err := ref.Canonical.Book("Psalms")
+	require.NoError(t, err)
+
+	joel, err := ref.Canonical.Book("Joel")
+	require.NoError(t, err)
+
+	for _, b := range []*ref.Book{ps, joel} {
+		verses := bookVerses(b)
+		rs := make([]ref.Resolved, len(verses))
+		for i, v := range verses {
+			rs[i] = ref.Resolved{Book: b, First: v, Last: v}
+		}
+
+		for _, to := range []*ref.Versification{ref.HebrewVersification, ref.SeptuagintVersification} {
+			there := ref.EnglishVersification.Map(rs, to)
+			back := to.Map(there, ref.EnglishVersification)
+
+			// two English verses may be one verse in another numbering, so
+			// only the whole book is sure to come back the same
+			require.NotEmpty(t, back)
+			assert.Equal(t, b.FirstVerse(), back[0].First, to.Name)
+			assert.Equal(t, b.LastVerse(), back[len(back)-1].Last, to.Name)
+
+			set, err := ref.Canonical.Set(back...)
+			require.NoError(t, err)
+			assert.Equal(t, b.VerseCount(), set.Len(), to.Name)
+		}
+	}
+}
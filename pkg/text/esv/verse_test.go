@@ -104,3 +104,46 @@ func TestResolver_VersionInformation(t *testing.T) {
 	assert.Equal(t, "ESV", v.Name)
 	assert.Equal(t, "https://www.esv.org/", v.Link)
 }
+
+func TestResolver_VerseText_Part(t *testing.T) {
+	t.Parallel()
+
+	var query string
+	ts := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			query = r.URL.Query().Get("q")
+
+			data, err := json.Marshal(map[string]any{
+				"passages": []any{jn11},
+			})
+			if err != nil {
+				panic(err)
+			}
+
+			_, _ = w.Write(data)
+		},
+	))
+	defer ts.Close()
+
+	u, err := url.Parse(ts.URL)
+	require.NoError(t, err)
+
+	res := &esv.Resolver{
+		Client: &esvc.Client{
+			BaseURL: u,
+			Client:  http.DefaultClient,
+			Token:   "abc123",
+		},
+	}
+
+	p, err := ref.ParseProper("John 1:1a")
+	require.NoError(t, err)
+
+	ref, err := ref.Canonical.Resolve(p)
+	require.NoError(t, err)
+
+	txt, err := res.VerseText(context.Background(), &ref[0])
+	assert.NoError(t, err)
+	assert.Equal(t, jn11, txt)
+	assert.Equal(t, "John 1:1", query)
+}
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"html/template"
+	"strings"
 
 	"github.com/zostay/today/pkg/ref"
 )
@@ -13,10 +14,45 @@ var (
 	ErrMultiVerse = errors.New("multiple verses not supported")
 )
 
+// OmittedVerseWarning is passed to the warning function of the service when a
+// reference lands on or spans verses the translation omits from its text.
+type OmittedVerseWarning struct {
+	// Ref is the reference that was looked up.
+	Ref *ref.Resolved
+
+	// Omitted lists the ranges of verses of Ref that the translation omits.
+	Omitted []ref.Resolved
+
+	// Translation is the name of the translation (e.g., "ESV").
+	Translation string
+}
+
+// Error describes the omitted verses.
+func (w *OmittedVerseWarning) Error() string {
+	refs := make([]string, len(w.Omitted))
+	for i := range w.Omitted {
+		refs[i] = compactRef(&w.Omitted[i])
+	}
+	return fmt.Sprintf("%s omits %s from the text of %s", w.Translation, strings.Join(refs, ", "), compactRef(w.Ref))
+}
+
+// compactRef returns the compact reference of r or its full reference if it
+// has no compact form.
+func compactRef(r *ref.Resolved) string {
+	if s, err := r.CompactRef(); err == nil {
+		return s
+	}
+	return r.Ref()
+}
+
 type Service struct {
 	Resolver
 	Abbreviations *ref.BookAbbreviations
 	Canon         *ref.Canon
+
+	// Warn is called with warnings about the verses looked up, such as an
+	// OmittedVerseWarning. Warnings are ignored if it is nil.
+	Warn func(error)
 }
 
 type ServiceOption func(*Service)
@@ -39,6 +75,15 @@ func WithCanon(c *ref.Canon) ServiceOption {
 	}
 }
 
+// WithWarnings sets the function called with warnings about the verses looked
+// up, such as an *OmittedVerseWarning when a reference includes verses the
+// translation omits.
+func WithWarnings(warn func(error)) ServiceOption {
+	return func(s *Service) {
+		s.Warn = warn
+	}
+}
+
 func NewService(r Resolver, opt ...ServiceOption) *Service {
 	s := &Service{
 		Resolver:      r,
@@ -53,7 +98,57 @@ func NewService(r Resolver, opt ...ServiceOption) *Service {
 	return s
 }
 
+// parseToResolved resolves the verse reference. If it is not a reference of
+// the canon, it may be the title of one of the named pericopes of the canon
+// instead (e.g., "Prodigal Son").
 func (s *Service) parseToResolved(vr string) (*ref.Resolved, error) {
+	res, err := s.parseReference(vr)
+	if err == nil {
+		return res, nil
+	}
+
+	p, perr := s.Canon.PericopeByTitle(vr)
+	if perr != nil {
+		// report the near misses, if there are any
+		var uperr *ref.UnknownPericopeError
+		if errors.As(perr, &uperr) && uperr.Possibilities != nil {
+			return nil, perr
+		}
+		return nil, err
+	}
+
+	return p.Ref, nil
+}
+
+// warnOmitted reports an OmittedVerseWarning if the resolved reference includes
+// verses omitted by the translation of the resolver.
+func (s *Service) warnOmitted(ctx context.Context, res *ref.Resolved) {
+	if s.Warn == nil {
+		return
+	}
+
+	v, err := s.Resolver.VersionInformation(ctx)
+	if err != nil {
+		s.Warn(fmt.Errorf("unable to check for omitted verses: %w", err))
+		return
+	}
+
+	omitted, err := s.Canon.OmittedIn(v.Name, *res)
+	if err != nil {
+		s.Warn(fmt.Errorf("unable to check for omitted verses: %w", err))
+		return
+	}
+
+	if omitted != nil {
+		s.Warn(&OmittedVerseWarning{
+			Ref:         res,
+			Omitted:     omitted,
+			Translation: v.Name,
+		})
+	}
+}
+
+func (s *Service) parseReference(vr string) (*ref.Resolved, error) {
 	pr, err := ref.ParseProper(vr)
 	if err != nil {
 		return nil, err
@@ -86,6 +181,8 @@ func (s *Service) Verse(ctx context.Context, vr string) (*Verse, error) {
 		return nil, err
 	}
 
+	s.warnOmitted(ctx, res)
+
 	return s.Resolver.Verse(ctx, res)
 }
 
@@ -95,6 +192,8 @@ func (s *Service) VerseText(ctx context.Context, vr string) (string, error) {
 		return "", err
 	}
 
+	s.warnOmitted(ctx, res)
+
 	return s.Resolver.VerseText(ctx, res)
 }
 
@@ -104,6 +203,8 @@ func (s *Service) VerseHTML(ctx context.Context, vr string) (template.HTML, erro
 		return "", err
 	}
 
+	s.warnOmitted(ctx, res)
+
 	return s.Resolver.VerseHTML(ctx, res)
 }
 
@@ -113,6 +113,56 @@ func TestService(t *testing.T) {
 	assert.NoError(t, r.Validate())
 }
 
+func TestService_Title(t *testing.T) {
+	t.Parallel()
+
+	tr := &testResolver{}
+	svc := text.NewService(tr)
+
+	b, err := ref.Canonical.Book("Luke")
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	txt, err := svc.VerseText(ctx, "Prodigal Son")
+	assert.NoError(t, err)
+	assert.Equal(t, fjn41, txt)
+	assert.Equal(t, &ref.Resolved{
+		Book:  b,
+		First: ref.CV{Chapter: 15, Verse: 11},
+		Last:  ref.CV{Chapter: 15, Verse: 32},
+	}, tr.lastRef)
+
+	_, err = svc.VerseText(ctx, "Parable of the Lost")
+	var uperr *ref.UnknownPericopeError
+	assert.ErrorAs(t, err, &uperr)
+}
+
+func TestService_OmittedVerseWarning(t *testing.T) {
+	t.Parallel()
+
+	var warnings []error
+	tr := &testResolver{}
+	svc := text.NewService(tr, text.WithWarnings(func(err error) {
+		warnings = append(warnings, err)
+	}))
+
+	ctx := context.Background()
+	_, err := svc.VerseText(ctx, "John 3:16")
+	assert.NoError(t, err)
+	assert.Empty(t, warnings)
+
+	_, err = svc.VerseText(ctx, "Acts 8:36-38")
+	assert.NoError(t, err)
+	require.Len(t, warnings, 1)
+
+	var ow *text.OmittedVerseWarning
+	require.ErrorAs(t, warnings[0], &ow)
+	assert.Equal(t, "ESV", ow.Translation)
+	require.Len(t, ow.Omitted, 1)
+	assert.Equal(t, "Acts 8:37", ow.Omitted[0].Ref())
+	assert.Equal(t, "ESV omits Acts 8:37 from the text of Acts 8:36-38", ow.Error())
+}
+
 func TestService_Sad(t *testing.T) {
 	t.Parallel()
 
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"os"
 	"os/exec"
+	"slices"
 	"text/template"
 
 	"gopkg.in/yaml.v3"
@@ -15,38 +16,174 @@ import (
 
 const (
 	DatabaseFile              = "esv.json"
+	USFMFile                  = "abbr.yaml"
 	CategoryFile              = "categories.yaml"
-	AbbreviationsFile         = "abbr.yaml"
+	PericopeFile              = "pericopes.yaml"
+	OmittedFile               = "omitted.yaml"
+	CanonsFile                = "canons.yaml"
 	VerseTemplateFile         = "verses.go.tmpl"
 	AbbreviationsTemplateFile = "abbrs.go.tmpl"
-	VerseOutputFile           = "../../../pkg/ref/canonical.go"
-	AbbreviationsOutputFile   = "../../../pkg/ref/abbr.go"
 )
 
+// AbbreviationSet names an abbreviations configuration file and the variable
+// and output file to generate from it.
+type AbbreviationSet struct {
+	File    string
+	VarName string
+	Output  string
+}
+
+var AbbreviationSets = []AbbreviationSet{
+	{"abbr.yaml", "Abbreviations", "../../../pkg/ref/abbr.go"},
+	{"abbr.es.yaml", "AbbreviationsES", "../../../pkg/ref/abbr_es.go"},
+	{"abbr.de.yaml", "AbbreviationsDE", "../../../pkg/ref/abbr_de.go"},
+	{"abbr.sbl.yaml", "AbbreviationsSBL", "../../../pkg/ref/abbr_sbl.go"},
+	{"abbr.chicago.yaml", "AbbreviationsChicago", "../../../pkg/ref/abbr_chicago.go"},
+	{"abbr.mla.yaml", "AbbreviationsMLA", "../../../pkg/ref/abbr_mla.go"},
+}
+
 type BooksConfig struct {
 	Books []BookConfig `json:"books"`
 }
 
 type BookConfig struct {
-	Name   string  `json:"name"`
-	Verses [][]int `json:"verses"`
+	Name           string      `json:"name"`
+	USFM           string      `json:"-"`
+	Testament      string      `json:"-"`
+	AlternateNames []string    `json:"-"`
+	JustVerse      bool        `json:"-"`
+	Runs           []RunConfig `json:"-"`
+	Verses         [][]int     `json:"verses"`
+}
+
+// RunConfig is a run of consecutive verses within a chapter.
+type RunConfig struct {
+	Chapter int
+	First   int
+	Last    int
+}
+
+// makeRuns compresses the chapter and verse pairs of the book into runs of
+// consecutive verses. Books without chapters have a chapter of 0 in the
+// database, but are recorded in chapter 1.
+func (b *BookConfig) makeRuns() {
+	b.JustVerse = len(b.Verses) > 0 && b.Verses[0][0] == 0
+	b.Runs = nil
+	for _, cv := range b.Verses {
+		chapter, verse := cv[0], cv[1]
+		if b.JustVerse {
+			chapter = 1
+		}
+
+		if n := len(b.Runs); n > 0 {
+			last := &b.Runs[n-1]
+			if last.Chapter == chapter && last.Last+1 == verse {
+				last.Last = verse
+				continue
+			}
+		}
+
+		b.Runs = append(b.Runs, RunConfig{Chapter: chapter, First: verse, Last: verse})
+	}
+}
+
+// CanonsConfig describes the canons to generate and the books they contain
+// that are not found in the database.
+type CanonsConfig struct {
+	NewTestament string            `yaml:"new_testament"`
+	Canons       []CanonConfig     `yaml:"canons"`
+	Books        []ExtraBookConfig `yaml:"books"`
+}
+
+// CanonConfig describes a single canon to generate.
+type CanonConfig struct {
+	Name          string              `yaml:"name"`
+	VarName       string              `yaml:"var"`
+	Versification string              `yaml:"versification"`
+	Output        string              `yaml:"output"`
+	Books         []string            `yaml:"books"`
+	Extend        map[string][]int    `yaml:"extend"`
+	Categories    map[string][]string `yaml:"categories"`
+	Pericopes     []PericopeConfig    `yaml:"pericopes"`
+}
+
+// ExtraBookConfig describes a book by the number of the last verse of each
+// chapter.
+type ExtraBookConfig struct {
+	Name         string `yaml:"name"`
+	JustVerse    bool   `yaml:"just_verse"`
+	FirstChapter int    `yaml:"first_chapter"`
+	FirstVerse   int    `yaml:"first_verse"`
+	Chapters     []int  `yaml:"chapters"`
+}
+
+// bookConfig returns the book described.
+func (e *ExtraBookConfig) bookConfig() BookConfig {
+	b := BookConfig{
+		Name:      e.Name,
+		JustVerse: e.JustVerse,
+		Runs:      make([]RunConfig, 0, len(e.Chapters)),
+	}
+
+	chapter := max(e.FirstChapter, 1)
+	first := max(e.FirstVerse, 1)
+	for _, last := range e.Chapters {
+		b.Runs = append(b.Runs, RunConfig{Chapter: chapter, First: first, Last: last})
+		chapter++
+		first = 1
+	}
+
+	return b
+}
+
+// extend returns a copy of the book with chapters added to the end, each
+// given by the number of its last verse.
+func (b BookConfig) extend(chapters []int) BookConfig {
+	runs := make([]RunConfig, 0, len(b.Runs)+len(chapters))
+	runs = append(runs, b.Runs...)
+
+	chapter := runs[len(runs)-1].Chapter
+	for _, last := range chapters {
+		chapter++
+		runs = append(runs, RunConfig{Chapter: chapter, First: 1, Last: last})
+	}
+
+	b.Runs = runs
+	return b
 }
 
 type CategoriesConfig struct {
 	Categories map[string][]string `yaml:"categories"`
 }
 
+// PericopeConfig names the passage of a single reference.
+type PericopeConfig struct {
+	Title string `yaml:"title"`
+	Ref   string `yaml:"ref"`
+}
+
+type PericopesConfig struct {
+	Pericopes []PericopeConfig `yaml:"pericopes"`
+}
+
+type OmittedConfig struct {
+	Omitted map[string][]string `yaml:"omitted"`
+}
+
 type OrdinalConfig struct {
 	Standard string   `yaml:"standard"`
 	Accept   []string `yaml:"accept"`
 }
 
 type BookAbbrConfig struct {
-	Name     string   `yaml:"name"`
-	Standard string   `yaml:"standard"`
-	Singular string   `yaml:"singular"`
-	Ordinal  string   `yaml:"ordinal"`
-	Accept   []string `yaml:"accept"`
+	Name       string   `yaml:"name"`
+	Local      string   `yaml:"local"`
+	USFM       string   `yaml:"usfm"`
+	Standard   string   `yaml:"standard"`
+	Singular   string   `yaml:"singular"`
+	Ordinal    string   `yaml:"ordinal"`
+	Accept     []string `yaml:"accept"`
+	Alternates []string `yaml:"alternates"`
 }
 
 type AbbreviationsConfig struct {
@@ -84,8 +221,38 @@ func loadCategories() (*CategoriesConfig, error) {
 	return &catConfig, nil
 }
 
-func loadAbbreviations() (*AbbreviationsConfig, error) {
-	abbrj, err := os.ReadFile(AbbreviationsFile)
+func loadPericopes() (*PericopesConfig, error) {
+	perj, err := os.ReadFile(PericopeFile)
+	if err != nil {
+		return nil, err
+	}
+
+	var perConfig PericopesConfig
+	err = yaml.Unmarshal(perj, &perConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	return &perConfig, nil
+}
+
+func loadOmitted() (*OmittedConfig, error) {
+	omj, err := os.ReadFile(OmittedFile)
+	if err != nil {
+		return nil, err
+	}
+
+	var omConfig OmittedConfig
+	err = yaml.Unmarshal(omj, &omConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	return &omConfig, nil
+}
+
+func loadAbbreviations(file string) (*AbbreviationsConfig, error) {
+	abbrj, err := os.ReadFile(file)
 	if err != nil {
 		return nil, err
 	}
@@ -120,49 +287,228 @@ func loadAbbreviations() (*AbbreviationsConfig, error) {
 				return nil, fmt.Errorf("book named %q has bad ordinal configuration", abbr.Name)
 			}
 		}
+
+		// the alternate names are accepted as they are, even for ordinal books
+		for _, alt := range abbr.Alternates {
+			if !slices.Contains(abbr.Accept, alt) {
+				abbr.Accept = append(abbr.Accept, alt)
+			}
+		}
 	}
 
 	return &abbrConfig, nil
 }
 
+// loadUSFMCodes returns the USFM book codes configured in the USFMFile, mapped
+// from book name.
+func loadUSFMCodes() (map[string]string, error) {
+	abbrConfig, err := loadAbbreviations(USFMFile)
+	if err != nil {
+		return nil, err
+	}
+
+	codes := make(map[string]string, len(abbrConfig.Books))
+	for _, abbr := range abbrConfig.Books {
+		if abbr.USFM == "" {
+			return nil, fmt.Errorf("book named %q has no USFM code", abbr.Name)
+		}
+		codes[abbr.Name] = abbr.USFM
+	}
+
+	return codes, nil
+}
+
+// loadAlternateNames returns the alternate names of the books configured in
+// the USFMFile, mapped from book name.
+func loadAlternateNames() (map[string][]string, error) {
+	abbrConfig, err := loadAbbreviations(USFMFile)
+	if err != nil {
+		return nil, err
+	}
+
+	names := make(map[string][]string, len(abbrConfig.Books))
+	for _, abbr := range abbrConfig.Books {
+		names[abbr.Name] = abbr.Alternates
+	}
+
+	return names, nil
+}
+
+func loadCanons() (*CanonsConfig, error) {
+	canonsj, err := os.ReadFile(CanonsFile)
+	if err != nil {
+		return nil, err
+	}
+
+	var canonsConfig CanonsConfig
+	err = yaml.Unmarshal(canonsj, &canonsConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	return &canonsConfig, nil
+}
+
 func templateVerses() error {
 	bookConfig, err := loadDatabase()
 	if err != nil {
 		return err
 	}
 
+	canonsConfig, err := loadCanons()
+	if err != nil {
+		return err
+	}
+
+	usfmCodes, err := loadUSFMCodes()
+	if err != nil {
+		return err
+	}
+
+	alternateNames, err := loadAlternateNames()
+	if err != nil {
+		return err
+	}
+
+	books := make(map[string]BookConfig, len(bookConfig.Books)+len(canonsConfig.Books))
+	for i := range bookConfig.Books {
+		b := &bookConfig.Books[i]
+		b.makeRuns()
+		books[b.Name] = *b
+	}
+
+	for i := range canonsConfig.Books {
+		b := canonsConfig.Books[i].bookConfig()
+		books[b.Name] = b
+	}
+
 	catConfig, err := loadCategories()
 	if err != nil {
 		return err
 	}
 
-	return applyTemplate(
-		"verses",
-		VerseTemplateFile,
-		VerseOutputFile,
-		struct {
-			Books      []BookConfig
-			Categories map[string][]string
-		}{
-			Books:      bookConfig.Books,
-			Categories: catConfig.Categories,
-		},
-	)
+	perConfig, err := loadPericopes()
+	if err != nil {
+		return err
+	}
+
+	omConfig, err := loadOmitted()
+	if err != nil {
+		return err
+	}
+
+	for _, canon := range canonsConfig.Canons {
+		names := canon.Books
+		if len(names) == 0 {
+			names = make([]string, 0, len(bookConfig.Books))
+			for _, b := range bookConfig.Books {
+				names = append(names, b.Name)
+			}
+		}
+
+		canonBooks := make([]BookConfig, 0, len(names))
+		testament := "OldTestament"
+		for _, name := range names {
+			b, ok := books[name]
+			if !ok {
+				return fmt.Errorf("canon %q has unknown book %q", canon.Name, name)
+			}
+
+			if chapters, ok := canon.Extend[name]; ok {
+				b = b.extend(chapters)
+			}
+
+			b.USFM = usfmCodes[b.Name]
+			if b.USFM == "" {
+				return fmt.Errorf("book named %q has no USFM code", b.Name)
+			}
+
+			if b.Name == canonsConfig.NewTestament {
+				testament = "NewTestament"
+			}
+			b.Testament = testament
+			b.AlternateNames = alternateNames[b.Name]
+
+			canonBooks = append(canonBooks, b)
+		}
+
+		if testament != "NewTestament" {
+			return fmt.Errorf("canon %q does not include %q, the first book of the New Testament", canon.Name, canonsConfig.NewTestament)
+		}
+
+		categories := make(map[string][]string, len(catConfig.Categories)+len(canon.Categories))
+		for k, v := range catConfig.Categories {
+			categories[k] = v
+		}
+		for k, v := range canon.Categories {
+			categories[k] = append(slices.Clone(categories[k]), v...)
+		}
+
+		pericopes := make(map[string]string, len(perConfig.Pericopes)+len(canon.Pericopes))
+		for _, p := range append(slices.Clone(perConfig.Pericopes), canon.Pericopes...) {
+			if _, dup := pericopes[p.Title]; dup {
+				return fmt.Errorf("canon %q has more than one pericope titled %q", canon.Name, p.Title)
+			}
+			pericopes[p.Title] = p.Ref
+		}
+
+		err = applyTemplate(
+			"verses",
+			VerseTemplateFile,
+			canon.Output,
+			struct {
+				VarName       string
+				Name          string
+				Versification string
+				Books         []BookConfig
+				Categories    map[string][]string
+				Pericopes     map[string]string
+				OmittedVerses map[string][]string
+			}{
+				VarName:       canon.VarName,
+				Name:          canon.Name,
+				Versification: canon.Versification,
+				Books:         canonBooks,
+				Categories:    categories,
+				Pericopes:     pericopes,
+				OmittedVerses: omConfig.Omitted,
+			},
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
 }
 
-func templateAbbreviations() error {
-	abbrConfig, err := loadAbbreviations()
+func templateAbbreviations(set AbbreviationSet) error {
+	abbrConfig, err := loadAbbreviations(set.File)
 	if err != nil {
 		return err
 	}
 
+	usfmCodes, err := loadUSFMCodes()
+	if err != nil {
+		return err
+	}
+
+	// the USFM codes are the same in every language
+	for _, abbr := range abbrConfig.Books {
+		if abbr.USFM == "" {
+			abbr.USFM = usfmCodes[abbr.Name]
+		}
+	}
+
 	return applyTemplate(
 		"abbrs",
 		AbbreviationsTemplateFile,
-		AbbreviationsOutputFile,
+		set.Output,
 		struct {
+			VarName       string
 			Abbreviations []*BookAbbrConfig
 		}{
+			VarName:       set.VarName,
 			Abbreviations: abbrConfig.Books,
 		},
 	)
@@ -180,9 +526,6 @@ func applyTemplate(
 	}
 
 	tmpl := template.New(name)
-	tmpl.Funcs(map[string]any{
-		"Mod": func(a, b int) bool { return a%b == 0 },
-	})
 	_, err = tmpl.Parse(string(tmplBytes))
 	if err != nil {
 		return err
@@ -230,8 +573,10 @@ func main() {
 		panic(err)
 	}
 
-	err = templateAbbreviations()
-	if err != nil {
-		panic(err)
+	for _, set := range AbbreviationSets {
+		err = templateAbbreviations(set)
+		if err != nil {
+			panic(err)
+		}
 	}
 }